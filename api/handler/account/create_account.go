@@ -7,6 +7,7 @@ import (
 	"github.com/jseow5177/pockteer-be/api/presenter"
 	"github.com/jseow5177/pockteer-be/config"
 	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
 	"github.com/jseow5177/pockteer-be/pkg/validator"
 	"github.com/rs/zerolog/log"
 )
@@ -24,10 +25,57 @@ var CreateAccountValidator = validator.MustForm(map[string]validator.Validator{
 		Optional: true,
 		MaxLen:   uint32(config.MaxAccountNoteLength),
 	},
+	"icon": &validator.String{
+		Optional:  true,
+		UnsetZero: true,
+		MaxLen:    uint32(config.MaxIconLength),
+	},
+	"color": &validator.String{
+		Optional:   true,
+		UnsetZero:  true,
+		Validators: []validator.StringFunc{entity.CheckColor},
+	},
 	"account_type": &validator.UInt32{
 		Optional:   false,
 		Validators: []validator.UInt32Func{entity.CheckChildAccountType},
 	},
+	"statement_day": &validator.UInt32{
+		Optional: true,
+		Min:      goutil.Uint32(1),
+		Max:      goutil.Uint32(entity.MaxCycleDay),
+	},
+	"due_day": &validator.UInt32{
+		Optional: true,
+		Min:      goutil.Uint32(1),
+		Max:      goutil.Uint32(entity.MaxCycleDay),
+	},
+	"credit_limit": &validator.String{
+		Optional:   true,
+		Validators: []validator.StringFunc{entity.CheckPositiveMonetaryStr},
+	},
+	"principal": &validator.String{
+		Optional:   true,
+		Validators: []validator.StringFunc{entity.CheckPositiveMonetaryStr},
+	},
+	"interest_rate": &validator.String{
+		Optional:   true,
+		Validators: []validator.StringFunc{entity.CheckPositiveMonetaryStr},
+	},
+	"term": &validator.UInt32{
+		Optional: true,
+		Min:      goutil.Uint32(1),
+		Max:      goutil.Uint32(entity.MaxLoanTerm),
+	},
+	"start_time": &validator.UInt64{
+		Optional: true,
+	},
+	"compound_frequency": &validator.UInt32{
+		Optional:   true,
+		Validators: []validator.UInt32Func{entity.CheckCompoundFrequency},
+	},
+	"interest_category_id": &validator.String{
+		Optional: true,
+	},
 	"holdings": &validator.Slice{
 		Optional:  true,
 		MaxLen:    20,
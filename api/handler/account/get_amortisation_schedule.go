@@ -0,0 +1,31 @@
+package account
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var GetAmortisationScheduleValidator = validator.MustForm(map[string]validator.Validator{
+	"account_id": &validator.String{
+		Optional: false,
+	},
+	"app_meta": entity.AppMetaValidator(),
+})
+
+func (h *accountHandler) GetAmortisationSchedule(ctx context.Context, req *presenter.GetAmortisationScheduleRequest, res *presenter.GetAmortisationScheduleResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.accountUseCase.GetAmortisationSchedule(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get amortisation schedule, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
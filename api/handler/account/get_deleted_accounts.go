@@ -0,0 +1,26 @@
+package account
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var GetDeletedAccountsValidator = validator.MustForm(map[string]validator.Validator{})
+
+func (h *accountHandler) GetDeletedAccounts(ctx context.Context, req *presenter.GetDeletedAccountsRequest, res *presenter.GetDeletedAccountsResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.accountUseCase.GetDeletedAccounts(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get deleted accounts, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
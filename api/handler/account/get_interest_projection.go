@@ -0,0 +1,33 @@
+package account
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var GetInterestProjectionValidator = validator.MustForm(map[string]validator.Validator{
+	"account_id": &validator.String{
+		Optional: false,
+	},
+	"project_time": &validator.UInt64{
+		Optional: false,
+	},
+})
+
+func (h *accountHandler) GetInterestProjection(ctx context.Context, req *presenter.GetInterestProjectionRequest, res *presenter.GetInterestProjectionResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.accountUseCase.GetInterestProjection(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get interest projection, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package account
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var RestoreAccountValidator = validator.MustForm(map[string]validator.Validator{
+	"account_id": &validator.String{
+		Optional: false,
+	},
+})
+
+func (h *accountHandler) RestoreAccount(ctx context.Context, req *presenter.RestoreAccountRequest, res *presenter.RestoreAccountResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.accountUseCase.RestoreAccount(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to restore account, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
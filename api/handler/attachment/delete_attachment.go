@@ -0,0 +1,30 @@
+package attachment
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var DeleteAttachmentValidator = validator.MustForm(map[string]validator.Validator{
+	"attachment_id": &validator.String{
+		Optional: false,
+	},
+})
+
+func (h *attachmentHandler) DeleteAttachment(ctx context.Context, req *presenter.DeleteAttachmentRequest, res *presenter.DeleteAttachmentResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.attachmentUseCase.DeleteAttachment(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to delete attachment, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package attachment
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var DownloadAttachmentValidator = validator.MustForm(map[string]validator.Validator{
+	"attachment_id": &validator.String{
+		Optional: false,
+	},
+})
+
+func (h *attachmentHandler) DownloadAttachment(ctx context.Context, req *presenter.DownloadAttachmentRequest, res *presenter.DownloadAttachmentResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.attachmentUseCase.DownloadAttachment(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to download attachment, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
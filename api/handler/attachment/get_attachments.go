@@ -0,0 +1,30 @@
+package attachment
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var GetAttachmentsValidator = validator.MustForm(map[string]validator.Validator{
+	"transaction_id": &validator.String{
+		Optional: false,
+	},
+})
+
+func (h *attachmentHandler) GetAttachments(ctx context.Context, req *presenter.GetAttachmentsRequest, res *presenter.GetAttachmentsResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.attachmentUseCase.GetAttachments(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get attachments, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
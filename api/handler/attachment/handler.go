@@ -0,0 +1,13 @@
+package attachment
+
+import "github.com/jseow5177/pockteer-be/usecase/attachment"
+
+type attachmentHandler struct {
+	attachmentUseCase attachment.UseCase
+}
+
+func NewAttachmentHandler(attachmentUseCase attachment.UseCase) *attachmentHandler {
+	return &attachmentHandler{
+		attachmentUseCase,
+	}
+}
@@ -0,0 +1,39 @@
+package attachment
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var UploadAttachmentValidator = validator.MustForm(map[string]validator.Validator{
+	"transaction_id": &validator.String{
+		Optional: false,
+	},
+	"file_name": &validator.String{
+		Optional: false,
+		MaxLen:   uint32(config.MaxAttachmentNameLength),
+	},
+	"content": &validator.Slice{
+		Optional: false,
+		MaxLen:   uint32(config.MaxAttachmentSize),
+	},
+})
+
+func (h *attachmentHandler) UploadAttachment(ctx context.Context, req *presenter.UploadAttachmentRequest, res *presenter.UploadAttachmentResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.attachmentUseCase.UploadAttachment(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to upload attachment, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/jseow5177/pockteer-be/api/presenter"
 	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
 	"github.com/jseow5177/pockteer-be/pkg/validator"
 	"github.com/rs/zerolog/log"
 )
@@ -30,6 +31,18 @@ func NewCreateBudgetValidator(optionalCategoryID bool) validator.Validator {
 			Optional:   true,
 			Validators: []validator.UInt32Func{entity.CheckBudgetRepeat},
 		},
+		"week_start_day": &validator.UInt32{
+			Optional:   true,
+			Validators: []validator.UInt32Func{entity.CheckWeekday},
+		},
+		"period_days": &validator.UInt32{
+			Optional: true,
+			Min:      goutil.Uint32(1),
+			Max:      goutil.Uint32(entity.MaxBudgetPeriodDays),
+		},
+		"rollover": &validator.Bool{
+			Optional: true,
+		},
 	})
 }
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/jseow5177/pockteer-be/api/handler/budget"
 	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/config"
 	"github.com/jseow5177/pockteer-be/entity"
 	"github.com/jseow5177/pockteer-be/pkg/validator"
 )
@@ -19,6 +20,20 @@ var CreateCategoryValidator = validator.MustForm(map[string]validator.Validator{
 		Optional:   false,
 		Validators: []validator.UInt32Func{entity.CheckCategoryType},
 	},
+	"parent_category_id": &validator.String{
+		Optional:  true,
+		UnsetZero: true,
+	},
+	"icon": &validator.String{
+		Optional:  true,
+		UnsetZero: true,
+		MaxLen:    uint32(config.MaxIconLength),
+	},
+	"color": &validator.String{
+		Optional:   true,
+		UnsetZero:  true,
+		Validators: []validator.StringFunc{entity.CheckColor},
+	},
 	"budget": budget.NewCreateBudgetValidator(true),
 })
 
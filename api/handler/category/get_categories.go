@@ -19,6 +19,10 @@ var GetCategoriesValidator = validator.MustForm(map[string]validator.Validator{
 		Optional:  true,
 		Validator: &validator.String{},
 	},
+	"parent_category_id": &validator.String{
+		Optional:  true,
+		UnsetZero: true,
+	},
 })
 
 func (h *categoryHandler) GetCategories(ctx context.Context, req *presenter.GetCategoriesRequest, res *presenter.GetCategoriesResponse) error {
@@ -0,0 +1,32 @@
+package category
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var GetCategoryTreeValidator = validator.MustForm(map[string]validator.Validator{
+	"category_type": &validator.UInt32{
+		Optional:   true,
+		UnsetZero:  true,
+		Validators: []validator.UInt32Func{entity.CheckCategoryType},
+	},
+})
+
+func (h *categoryHandler) GetCategoryTree(ctx context.Context, req *presenter.GetCategoryTreeRequest, res *presenter.GetCategoryTreeResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.categoryUseCase.GetCategoryTree(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get category tree, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package category
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var GetDeletedCategoriesValidator = validator.MustForm(map[string]validator.Validator{})
+
+func (h *categoryHandler) GetDeletedCategories(ctx context.Context, req *presenter.GetDeletedCategoriesRequest, res *presenter.GetDeletedCategoriesResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.categoryUseCase.GetDeletedCategories(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get deleted categories, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
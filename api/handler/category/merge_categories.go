@@ -0,0 +1,38 @@
+package category
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var MergeCategoriesValidator = validator.MustForm(map[string]validator.Validator{
+	"app_meta": entity.AppMetaValidator(),
+	"source_category_id": &validator.String{
+		Optional: false,
+	},
+	"target_category_id": &validator.String{
+		Optional: false,
+	},
+	"budget_conflict": &validator.UInt32{
+		Optional:   true,
+		Validators: []validator.UInt32Func{entity.CheckBudgetConflict},
+	},
+})
+
+func (h *categoryHandler) MergeCategories(ctx context.Context, req *presenter.MergeCategoriesRequest, res *presenter.MergeCategoriesResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.categoryUseCase.MergeCategories(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to merge categories, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
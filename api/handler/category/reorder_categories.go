@@ -0,0 +1,32 @@
+package category
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var ReorderCategoriesValidator = validator.MustForm(map[string]validator.Validator{
+	"category_ids": &validator.Slice{
+		Optional:  false,
+		MinLen:    1,
+		Validator: &validator.String{},
+	},
+})
+
+func (h *categoryHandler) ReorderCategories(ctx context.Context, req *presenter.ReorderCategoriesRequest, res *presenter.ReorderCategoriesResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.categoryUseCase.ReorderCategories(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to reorder categories, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package category
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var RestoreCategoryValidator = validator.MustForm(map[string]validator.Validator{
+	"category_id": &validator.String{
+		Optional: false,
+	},
+})
+
+func (h *categoryHandler) RestoreCategory(ctx context.Context, req *presenter.RestoreCategoryRequest, res *presenter.RestoreCategoryResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.categoryUseCase.RestoreCategory(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to restore category, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
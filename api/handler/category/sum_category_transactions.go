@@ -22,6 +22,10 @@ var SumCategoryTransactionsValidator = validator.MustForm(map[string]validator.V
 		Optional:   false,
 		Validators: []validator.UInt32Func{entity.CheckCategoryType},
 	},
+	"parent_category_id": &validator.String{
+		Optional:  true,
+		UnsetZero: true,
+	},
 })
 
 func (h *categoryHandler) SumCategoryTransactions(
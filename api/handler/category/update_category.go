@@ -6,6 +6,7 @@ import (
 	"github.com/rs/zerolog/log"
 
 	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/config"
 	"github.com/jseow5177/pockteer-be/entity"
 	"github.com/jseow5177/pockteer-be/pkg/validator"
 )
@@ -18,6 +19,17 @@ var UpdateCategoryValidator = validator.MustForm(map[string]validator.Validator{
 		Optional:  true,
 		UnsetZero: true,
 	},
+	"parent_category_id": &validator.String{
+		Optional: true,
+	},
+	"icon": &validator.String{
+		Optional: true,
+		MaxLen:   uint32(config.MaxIconLength),
+	},
+	"color": &validator.String{
+		Optional:   true,
+		Validators: []validator.StringFunc{entity.CheckColor},
+	},
 })
 
 func (h *categoryHandler) UpdateCategory(ctx context.Context, req *presenter.UpdateCategoryRequest, res *presenter.UpdateCategoryResponse) error {
@@ -0,0 +1,30 @@
+package exporter
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/jseow5177/pockteer-be/usecase/exporter"
+	"github.com/rs/zerolog/log"
+)
+
+var ExportDataValidator = validator.MustForm(map[string]validator.Validator{})
+
+func (h *exporterHandler) ExportData(ctx context.Context, req *presenter.ExportDataRequest, res *presenter.ExportDataResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	// the archive is written as the response is streamed
+	res.Set(exporter.ArchiveName(time.Now()), func(w io.Writer) error {
+		if _, err := h.exporterUseCase.ExportData(ctx, req.ToUseCaseReq(user.GetUserID(), w)); err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to export data, err: %v", err)
+			return err
+		}
+		return nil
+	})
+
+	return nil
+}
@@ -0,0 +1,13 @@
+package exporter
+
+import "github.com/jseow5177/pockteer-be/usecase/exporter"
+
+type exporterHandler struct {
+	exporterUseCase exporter.UseCase
+}
+
+func NewExporterHandler(exporterUseCase exporter.UseCase) *exporterHandler {
+	return &exporterHandler{
+		exporterUseCase,
+	}
+}
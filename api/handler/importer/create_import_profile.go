@@ -0,0 +1,67 @@
+package importer
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var CreateImportProfileValidator = validator.MustForm(map[string]validator.Validator{
+	"profile_name": &validator.String{
+		Optional: false,
+	},
+	"delimiter": &validator.String{
+		Optional:  true,
+		UnsetZero: true,
+	},
+	"skip_rows": &validator.UInt32{
+		Optional: true,
+	},
+	"date_column": &validator.String{
+		Optional: false,
+	},
+	"date_format": &validator.UInt32{
+		Optional:   true,
+		Validators: []validator.UInt32Func{entity.CheckImportDateFormat},
+	},
+	"amount_column": &validator.String{
+		Optional: true,
+	},
+	"amount_sign": &validator.UInt32{
+		Optional:   true,
+		Validators: []validator.UInt32Func{entity.CheckImportAmountSign},
+	},
+	"debit_column": &validator.String{
+		Optional: true,
+	},
+	"credit_column": &validator.String{
+		Optional: true,
+	},
+	"note_column": &validator.String{
+		Optional: true,
+	},
+	"currency_column": &validator.String{
+		Optional: true,
+	},
+})
+
+func (h *importerHandler) CreateImportProfile(
+	ctx context.Context,
+	req *presenter.CreateImportProfileRequest,
+	res *presenter.CreateImportProfileResponse,
+) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.importerUseCase.CreateImportProfile(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to create import profile, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
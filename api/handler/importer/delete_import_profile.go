@@ -0,0 +1,34 @@
+package importer
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var DeleteImportProfileValidator = validator.MustForm(map[string]validator.Validator{
+	"import_profile_id": &validator.String{
+		Optional: false,
+	},
+})
+
+func (h *importerHandler) DeleteImportProfile(
+	ctx context.Context,
+	req *presenter.DeleteImportProfileRequest,
+	res *presenter.DeleteImportProfileResponse,
+) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.importerUseCase.DeleteImportProfile(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to delete import profile, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package importer
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var GetImportProfileValidator = validator.MustForm(map[string]validator.Validator{
+	"import_profile_id": &validator.String{
+		Optional: false,
+	},
+})
+
+func (h *importerHandler) GetImportProfile(
+	ctx context.Context,
+	req *presenter.GetImportProfileRequest,
+	res *presenter.GetImportProfileResponse,
+) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.importerUseCase.GetImportProfile(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get import profile, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
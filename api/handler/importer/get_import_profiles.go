@@ -0,0 +1,30 @@
+package importer
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var GetImportProfilesValidator = validator.MustForm(map[string]validator.Validator{})
+
+func (h *importerHandler) GetImportProfiles(
+	ctx context.Context,
+	req *presenter.GetImportProfilesRequest,
+	res *presenter.GetImportProfilesResponse,
+) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.importerUseCase.GetImportProfiles(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get import profiles, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
@@ -0,0 +1,13 @@
+package importer
+
+import "github.com/jseow5177/pockteer-be/usecase/importer"
+
+type importerHandler struct {
+	importerUseCase importer.UseCase
+}
+
+func NewImporterHandler(importerUseCase importer.UseCase) *importerHandler {
+	return &importerHandler{
+		importerUseCase,
+	}
+}
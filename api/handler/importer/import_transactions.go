@@ -0,0 +1,58 @@
+package importer
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var ImportTransactionsValidator = validator.MustForm(map[string]validator.Validator{
+	"app_meta": entity.AppMetaValidator(),
+	"account_id": &validator.String{
+		Optional: false,
+	},
+	"import_profile_id": &validator.String{
+		Optional:  true,
+		UnsetZero: true,
+	},
+	"format": &validator.UInt32{
+		Optional:   true,
+		UnsetZero:  true,
+		Validators: []validator.UInt32Func{entity.CheckImportFormat},
+	},
+	"data": &validator.String{
+		Optional: false,
+	},
+	"expense_category_id": &validator.String{
+		Optional:  true,
+		UnsetZero: true,
+	},
+	"income_category_id": &validator.String{
+		Optional:  true,
+		UnsetZero: true,
+	},
+	"dry_run": &validator.Bool{
+		Optional: true,
+	},
+})
+
+func (h *importerHandler) ImportTransactions(
+	ctx context.Context,
+	req *presenter.ImportTransactionsRequest,
+	res *presenter.ImportTransactionsResponse,
+) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.importerUseCase.ImportTransactions(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to import transactions, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package importer
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var UpdateImportProfileValidator = validator.MustForm(map[string]validator.Validator{
+	"import_profile_id": &validator.String{
+		Optional: false,
+	},
+	"profile_name": &validator.String{
+		Optional:  true,
+		UnsetZero: true,
+	},
+	"delimiter": &validator.String{
+		Optional:  true,
+		UnsetZero: true,
+	},
+	"skip_rows": &validator.UInt32{
+		Optional: true,
+	},
+	"date_column": &validator.String{
+		Optional:  true,
+		UnsetZero: true,
+	},
+	"date_format": &validator.UInt32{
+		Optional:   true,
+		Validators: []validator.UInt32Func{entity.CheckImportDateFormat},
+	},
+	"amount_column": &validator.String{
+		Optional: true,
+	},
+	"amount_sign": &validator.UInt32{
+		Optional:   true,
+		Validators: []validator.UInt32Func{entity.CheckImportAmountSign},
+	},
+	"debit_column": &validator.String{
+		Optional: true,
+	},
+	"credit_column": &validator.String{
+		Optional: true,
+	},
+	"note_column": &validator.String{
+		Optional: true,
+	},
+	"currency_column": &validator.String{
+		Optional: true,
+	},
+})
+
+func (h *importerHandler) UpdateImportProfile(
+	ctx context.Context,
+	req *presenter.UpdateImportProfileRequest,
+	res *presenter.UpdateImportProfileResponse,
+) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.importerUseCase.UpdateImportProfile(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to update import profile, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
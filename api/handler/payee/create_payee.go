@@ -0,0 +1,39 @@
+package payee
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var CreatePayeeValidator = validator.MustForm(map[string]validator.Validator{
+	"payee_name": &validator.String{
+		Optional: false,
+		MaxLen:   uint32(config.MaxPayeeNameLength),
+	},
+	"aliases": &validator.Slice{
+		Optional: true,
+		MaxLen:   uint32(config.MaxPayeeAliases),
+		Validator: &validator.String{
+			MaxLen: uint32(config.MaxPayeeNameLength),
+		},
+	},
+})
+
+func (h *payeeHandler) CreatePayee(ctx context.Context, req *presenter.CreatePayeeRequest, res *presenter.CreatePayeeResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.payeeUseCase.CreatePayee(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to create payee, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package payee
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var DeletePayeeValidator = validator.MustForm(map[string]validator.Validator{
+	"payee_id": &validator.String{
+		Optional: false,
+	},
+})
+
+func (h *payeeHandler) DeletePayee(ctx context.Context, req *presenter.DeletePayeeRequest, res *presenter.DeletePayeeResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.payeeUseCase.DeletePayee(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to delete payee, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
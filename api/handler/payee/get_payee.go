@@ -0,0 +1,30 @@
+package payee
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var GetPayeeValidator = validator.MustForm(map[string]validator.Validator{
+	"payee_id": &validator.String{
+		Optional: false,
+	},
+})
+
+func (h *payeeHandler) GetPayee(ctx context.Context, req *presenter.GetPayeeRequest, res *presenter.GetPayeeResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.payeeUseCase.GetPayee(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get payee, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
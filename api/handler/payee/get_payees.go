@@ -0,0 +1,31 @@
+package payee
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var GetPayeesValidator = validator.MustForm(map[string]validator.Validator{
+	"payee_ids": &validator.Slice{
+		Optional:  true,
+		Validator: &validator.String{},
+	},
+})
+
+func (h *payeeHandler) GetPayees(ctx context.Context, req *presenter.GetPayeesRequest, res *presenter.GetPayeesResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.payeeUseCase.GetPayees(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get payees, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
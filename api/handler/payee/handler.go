@@ -0,0 +1,13 @@
+package payee
+
+import "github.com/jseow5177/pockteer-be/usecase/payee"
+
+type payeeHandler struct {
+	payeeUseCase payee.UseCase
+}
+
+func NewPayeeHandler(payeeUseCase payee.UseCase) *payeeHandler {
+	return &payeeHandler{
+		payeeUseCase,
+	}
+}
@@ -0,0 +1,48 @@
+package payee
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var SumTransactionsByPayeeValidator = validator.MustForm(map[string]validator.Validator{
+	"transaction_time": validator.MustForm(map[string]validator.Validator{
+		"gte": &validator.UInt64{
+			Optional: false,
+		},
+		"lte": &validator.UInt64{
+			Optional: false,
+		},
+	}),
+	"transaction_type": &validator.UInt32{
+		Optional:   true,
+		UnsetZero:  true,
+		Validators: []validator.UInt32Func{entity.CheckCategoryType},
+	},
+	"limit": &validator.UInt32{
+		Optional:  true,
+		UnsetZero: true,
+	},
+})
+
+func (h *payeeHandler) SumTransactionsByPayee(
+	ctx context.Context,
+	req *presenter.SumTransactionsByPayeeRequest,
+	res *presenter.SumTransactionsByPayeeResponse,
+) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.payeeUseCase.SumTransactionsByPayee(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to sum transactions by payee, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package payee
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var UpdatePayeeValidator = validator.MustForm(map[string]validator.Validator{
+	"payee_id": &validator.String{
+		Optional: false,
+	},
+	"payee_name": &validator.String{
+		Optional:  true,
+		UnsetZero: true,
+		MaxLen:    uint32(config.MaxPayeeNameLength),
+	},
+	"aliases": &validator.Slice{
+		Optional: true,
+		MaxLen:   uint32(config.MaxPayeeAliases),
+		Validator: &validator.String{
+			MaxLen: uint32(config.MaxPayeeNameLength),
+		},
+	},
+})
+
+func (h *payeeHandler) UpdatePayee(ctx context.Context, req *presenter.UpdatePayeeRequest, res *presenter.UpdatePayeeResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.payeeUseCase.UpdatePayee(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to update payee, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
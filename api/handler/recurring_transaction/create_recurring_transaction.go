@@ -0,0 +1,77 @@
+package recurringtransaction
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var CreateRecurringTransactionValidator = validator.MustForm(map[string]validator.Validator{
+	"app_meta": entity.AppMetaValidator(),
+	"category_id": &validator.String{
+		Optional: true,
+	},
+	"account_id": &validator.String{
+		Optional: true,
+	},
+	"from_account_id": &validator.String{
+		Optional: true,
+	},
+	"to_account_id": &validator.String{
+		Optional: true,
+	},
+	"currency": &validator.String{
+		Optional:   false,
+		Validators: []validator.StringFunc{entity.CheckCurrency},
+	},
+	"amount": &validator.String{
+		Optional:   false,
+		Validators: []validator.StringFunc{entity.CheckMonetaryStr},
+	},
+	"transaction_type": &validator.UInt32{
+		Optional:   false,
+		Validators: []validator.UInt32Func{entity.CheckTransactionType},
+	},
+	"note": &validator.String{
+		Optional: true,
+		MaxLen:   uint32(config.MaxTransactionNoteLength),
+	},
+	"frequency": &validator.UInt32{
+		Optional:   false,
+		Validators: []validator.UInt32Func{entity.CheckRecurringTransactionFrequency},
+	},
+	"interval": &validator.UInt32{
+		Optional: true,
+	},
+	"start_time": &validator.UInt64{
+		Optional: false,
+	},
+	"end_time": &validator.UInt64{
+		Optional: true,
+	},
+	"max_occurrences": &validator.UInt32{
+		Optional: true,
+	},
+})
+
+func (h *recurringTransactionHandler) CreateRecurringTransaction(
+	ctx context.Context,
+	req *presenter.CreateRecurringTransactionRequest,
+	res *presenter.CreateRecurringTransactionResponse,
+) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.recurringTransactionUseCase.CreateRecurringTransaction(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to create recurring transaction, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
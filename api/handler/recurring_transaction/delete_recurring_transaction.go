@@ -0,0 +1,34 @@
+package recurringtransaction
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var DeleteRecurringTransactionValidator = validator.MustForm(map[string]validator.Validator{
+	"recurring_transaction_id": &validator.String{
+		Optional: false,
+	},
+})
+
+func (h *recurringTransactionHandler) DeleteRecurringTransaction(
+	ctx context.Context,
+	req *presenter.DeleteRecurringTransactionRequest,
+	res *presenter.DeleteRecurringTransactionResponse,
+) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.recurringTransactionUseCase.DeleteRecurringTransaction(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to delete recurring transaction, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package recurringtransaction
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var GetRecurringTransactionValidator = validator.MustForm(map[string]validator.Validator{
+	"recurring_transaction_id": &validator.String{
+		Optional: false,
+	},
+})
+
+func (h *recurringTransactionHandler) GetRecurringTransaction(
+	ctx context.Context,
+	req *presenter.GetRecurringTransactionRequest,
+	res *presenter.GetRecurringTransactionResponse,
+) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.recurringTransactionUseCase.GetRecurringTransaction(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get recurring transaction, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package recurringtransaction
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var GetRecurringTransactionsValidator = validator.MustForm(map[string]validator.Validator{
+	"paging": entity.PagingValidator(true),
+})
+
+func (h *recurringTransactionHandler) GetRecurringTransactions(
+	ctx context.Context,
+	req *presenter.GetRecurringTransactionsRequest,
+	res *presenter.GetRecurringTransactionsResponse,
+) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.recurringTransactionUseCase.GetRecurringTransactions(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get recurring transactions, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package recurringtransaction
+
+import (
+	rtuc "github.com/jseow5177/pockteer-be/usecase/recurring_transaction"
+)
+
+type recurringTransactionHandler struct {
+	recurringTransactionUseCase rtuc.UseCase
+}
+
+func NewRecurringTransactionHandler(recurringTransactionUseCase rtuc.UseCase) *recurringTransactionHandler {
+	return &recurringTransactionHandler{
+		recurringTransactionUseCase,
+	}
+}
@@ -0,0 +1,71 @@
+package recurringtransaction
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var UpdateRecurringTransactionValidator = validator.MustForm(map[string]validator.Validator{
+	"recurring_transaction_id": &validator.String{
+		Optional: false,
+	},
+	"category_id": &validator.String{
+		Optional:  true,
+		UnsetZero: true,
+	},
+	"account_id": &validator.String{
+		Optional:  true,
+		UnsetZero: true,
+	},
+	"from_account_id": &validator.String{
+		Optional:  true,
+		UnsetZero: true,
+	},
+	"to_account_id": &validator.String{
+		Optional:  true,
+		UnsetZero: true,
+	},
+	"amount": &validator.String{
+		Optional:   true,
+		UnsetZero:  true,
+		Validators: []validator.StringFunc{entity.CheckMonetaryStr},
+	},
+	"currency": &validator.String{
+		Optional:   true,
+		UnsetZero:  true,
+		Validators: []validator.StringFunc{entity.CheckCurrency},
+	},
+	"note": &validator.String{
+		Optional: true,
+		MaxLen:   uint32(config.MaxTransactionNoteLength),
+	},
+	"end_time": &validator.UInt64{
+		Optional: true,
+	},
+	"max_occurrences": &validator.UInt32{
+		Optional: true,
+	},
+})
+
+func (h *recurringTransactionHandler) UpdateRecurringTransaction(
+	ctx context.Context,
+	req *presenter.UpdateRecurringTransactionRequest,
+	res *presenter.UpdateRecurringTransactionResponse,
+) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.recurringTransactionUseCase.UpdateRecurringTransaction(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to update recurring transaction, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
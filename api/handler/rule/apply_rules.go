@@ -0,0 +1,35 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var ApplyRulesValidator = validator.MustForm(map[string]validator.Validator{
+	"transaction_time": validator.MustForm(map[string]validator.Validator{
+		"gte": &validator.UInt64{
+			Optional: false,
+		},
+		"lte": &validator.UInt64{
+			Optional: false,
+		},
+	}),
+})
+
+func (h *ruleHandler) ApplyRules(ctx context.Context, req *presenter.ApplyRulesRequest, res *presenter.ApplyRulesResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.ruleUseCase.ApplyRules(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to apply rules, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
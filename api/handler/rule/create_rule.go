@@ -0,0 +1,62 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var CreateRuleValidator = validator.MustForm(map[string]validator.Validator{
+	"rule_name": &validator.String{
+		Optional: false,
+		MaxLen:   uint32(config.MaxRuleNameLength),
+	},
+	"category_id": &validator.String{
+		Optional: false,
+	},
+	"position": &validator.UInt32{
+		Optional: true,
+	},
+	"note_contains": &validator.String{
+		Optional: true,
+		MaxLen:   uint32(config.MaxTransactionNoteLength),
+	},
+	"amount_min": &validator.String{
+		Optional:   true,
+		Validators: []validator.StringFunc{entity.CheckMonetaryStr},
+	},
+	"amount_max": &validator.String{
+		Optional:   true,
+		Validators: []validator.StringFunc{entity.CheckMonetaryStr},
+	},
+	"account_id": &validator.String{
+		Optional: true,
+	},
+	"currency": &validator.String{
+		Optional:   true,
+		Validators: []validator.StringFunc{entity.CheckCurrency},
+	},
+	"transaction_type": &validator.UInt32{
+		Optional:   true,
+		UnsetZero:  true,
+		Validators: []validator.UInt32Func{entity.CheckCategoryType},
+	},
+})
+
+func (h *ruleHandler) CreateRule(ctx context.Context, req *presenter.CreateRuleRequest, res *presenter.CreateRuleResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.ruleUseCase.CreateRule(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to create rule, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var GetRuleValidator = validator.MustForm(map[string]validator.Validator{
+	"rule_id": &validator.String{
+		Optional: false,
+	},
+})
+
+func (h *ruleHandler) GetRule(ctx context.Context, req *presenter.GetRuleRequest, res *presenter.GetRuleResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.ruleUseCase.GetRule(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get rule, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
@@ -0,0 +1,13 @@
+package rule
+
+import "github.com/jseow5177/pockteer-be/usecase/rule"
+
+type ruleHandler struct {
+	ruleUseCase rule.UseCase
+}
+
+func NewRuleHandler(ruleUseCase rule.UseCase) *ruleHandler {
+	return &ruleHandler{
+		ruleUseCase,
+	}
+}
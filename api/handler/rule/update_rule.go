@@ -0,0 +1,65 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var UpdateRuleValidator = validator.MustForm(map[string]validator.Validator{
+	"rule_id": &validator.String{
+		Optional: false,
+	},
+	"rule_name": &validator.String{
+		Optional:  true,
+		UnsetZero: true,
+		MaxLen:    uint32(config.MaxRuleNameLength),
+	},
+	"category_id": &validator.String{
+		Optional:  true,
+		UnsetZero: true,
+	},
+	"position": &validator.UInt32{
+		Optional: true,
+	},
+	// an empty or zero condition clears it
+	"note_contains": &validator.String{
+		Optional: true,
+		MaxLen:   uint32(config.MaxTransactionNoteLength),
+	},
+	"amount_min": &validator.String{
+		Optional:   true,
+		Validators: []validator.StringFunc{entity.CheckMonetaryStr},
+	},
+	"amount_max": &validator.String{
+		Optional:   true,
+		Validators: []validator.StringFunc{entity.CheckMonetaryStr},
+	},
+	"account_id": &validator.String{
+		Optional: true,
+	},
+	"currency": &validator.String{
+		Optional: true,
+	},
+	"transaction_type": &validator.UInt32{
+		Optional: true,
+	},
+})
+
+func (h *ruleHandler) UpdateRule(ctx context.Context, req *presenter.UpdateRuleRequest, res *presenter.UpdateRuleResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.ruleUseCase.UpdateRule(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to update rule, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
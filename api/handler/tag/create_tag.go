@@ -0,0 +1,33 @@
+package tag
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+)
+
+var CreateTagValidator = validator.MustForm(map[string]validator.Validator{
+	"tag_name": &validator.String{
+		Optional: false,
+		MaxLen:   uint32(config.MaxTagNameLength),
+	},
+})
+
+func (h *tagHandler) CreateTag(ctx context.Context, req *presenter.CreateTagRequest, res *presenter.CreateTagResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.tagUseCase.CreateTag(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to create tag, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package tag
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var GetTagValidator = validator.MustForm(map[string]validator.Validator{
+	"tag_id": &validator.String{
+		Optional: false,
+	},
+})
+
+func (h *tagHandler) GetTag(ctx context.Context, req *presenter.GetTagRequest, res *presenter.GetTagResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.tagUseCase.GetTag(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get tag, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package tag
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var GetTagsValidator = validator.MustForm(map[string]validator.Validator{
+	"tag_ids": &validator.Slice{
+		Optional:  true,
+		Validator: &validator.String{},
+	},
+})
+
+func (h *tagHandler) GetTags(ctx context.Context, req *presenter.GetTagsRequest, res *presenter.GetTagsResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.tagUseCase.GetTags(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get tags, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
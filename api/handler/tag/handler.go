@@ -0,0 +1,13 @@
+package tag
+
+import "github.com/jseow5177/pockteer-be/usecase/tag"
+
+type tagHandler struct {
+	tagUseCase tag.UseCase
+}
+
+func NewTagHandler(tagUseCase tag.UseCase) *tagHandler {
+	return &tagHandler{
+		tagUseCase,
+	}
+}
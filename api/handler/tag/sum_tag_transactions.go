@@ -0,0 +1,48 @@
+package tag
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var SumTagTransactionsValidator = validator.MustForm(map[string]validator.Validator{
+	"tag_ids": &validator.Slice{
+		Optional:  true,
+		Validator: &validator.String{},
+	},
+	"transaction_time": validator.MustForm(map[string]validator.Validator{
+		"gte": &validator.UInt64{
+			Optional: false,
+		},
+		"lte": &validator.UInt64{
+			Optional: false,
+		},
+	}),
+	"transaction_type": &validator.UInt32{
+		Optional:   true,
+		UnsetZero:  true,
+		Validators: []validator.UInt32Func{entity.CheckCategoryType},
+	},
+})
+
+func (h *tagHandler) SumTagTransactions(
+	ctx context.Context,
+	req *presenter.SumTagTransactionsRequest,
+	res *presenter.SumTagTransactionsResponse,
+) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.tagUseCase.SumTagTransactions(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to sum tag transactions, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
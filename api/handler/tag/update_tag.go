@@ -0,0 +1,37 @@
+package tag
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+)
+
+var UpdateTagValidator = validator.MustForm(map[string]validator.Validator{
+	"tag_id": &validator.String{
+		Optional: false,
+	},
+	"tag_name": &validator.String{
+		Optional:  true,
+		UnsetZero: true,
+		MaxLen:    uint32(config.MaxTagNameLength),
+	},
+})
+
+func (h *tagHandler) UpdateTag(ctx context.Context, req *presenter.UpdateTagRequest, res *presenter.UpdateTagResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.tagUseCase.UpdateTag(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to update tag, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package transaction
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var BulkDeleteTransactionsValidator = validator.MustForm(map[string]validator.Validator{
+	"transaction_ids": &validator.Slice{
+		Optional:  true,
+		Validator: new(validator.String),
+	},
+	"query": bulkTransactionQueryValidator,
+})
+
+func (h *transactionHandler) BulkDeleteTransactions(ctx context.Context, req *presenter.BulkDeleteTransactionsRequest, res *presenter.BulkDeleteTransactionsResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.transactionUseCase.BulkDeleteTransactions(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to bulk delete transactions, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
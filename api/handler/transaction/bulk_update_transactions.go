@@ -0,0 +1,76 @@
+package transaction
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+// bulkTransactionQueryValidator selects transactions like get_transactions, without paging.
+var bulkTransactionQueryValidator = validator.OptionalForm(map[string]validator.Validator{
+	"category_id": &validator.String{
+		Optional: true,
+	},
+	"account_id": &validator.String{
+		Optional:  true,
+		UnsetZero: true,
+	},
+	"category_ids": &validator.Slice{
+		Optional:  true,
+		Validator: new(validator.String),
+	},
+	"transaction_type": &validator.UInt32{
+		Optional:   true,
+		UnsetZero:  true,
+		Validators: []validator.UInt32Func{entity.CheckTransactionType},
+	},
+	"transaction_time": validator.MustForm(map[string]validator.Validator{
+		"gte": &validator.UInt64{
+			Optional: false,
+		},
+		"lte": &validator.UInt64{
+			Optional: false,
+		},
+	}),
+	"tag_ids": &validator.Slice{
+		Optional:  true,
+		Validator: new(validator.String),
+	},
+})
+
+var BulkUpdateTransactionsValidator = validator.MustForm(map[string]validator.Validator{
+	"transaction_ids": &validator.Slice{
+		Optional:  true,
+		Validator: new(validator.String),
+	},
+	"query": bulkTransactionQueryValidator,
+	"category_id": &validator.String{
+		Optional:  true,
+		UnsetZero: true,
+	},
+	"account_id": &validator.String{
+		Optional:  true,
+		UnsetZero: true,
+	},
+	"tag_ids": &validator.Slice{
+		Optional:  true,
+		Validator: new(validator.String),
+	},
+})
+
+func (h *transactionHandler) BulkUpdateTransactions(ctx context.Context, req *presenter.BulkUpdateTransactionsRequest, res *presenter.BulkUpdateTransactionsResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.transactionUseCase.BulkUpdateTransactions(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to bulk update transactions, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
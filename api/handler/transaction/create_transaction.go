@@ -42,6 +42,47 @@ var CreateTransactionValidator = validator.MustForm(map[string]validator.Validat
 		Optional: true,
 		MaxLen:   uint32(config.MaxTransactionNoteLength),
 	},
+	"clear_status": &validator.UInt32{
+		Optional:   true,
+		Validators: []validator.UInt32Func{entity.CheckClearStatus},
+	},
+	"received_currency": &validator.String{
+		Optional:   true,
+		Validators: []validator.StringFunc{entity.CheckCurrency},
+	},
+	"received_amount": &validator.String{
+		Optional:   true,
+		Validators: []validator.StringFunc{entity.CheckMonetaryStr},
+	},
+	"fee": &validator.String{
+		Optional:   true,
+		Validators: []validator.StringFunc{entity.CheckMonetaryStr},
+	},
+	"refunded_transaction_id": &validator.String{
+		Optional: true,
+	},
+	"payee_id": &validator.String{
+		Optional: true,
+	},
+	"interest_category_id": &validator.String{
+		Optional: true,
+	},
+	"splits": &validator.Slice{
+		Optional: true,
+		Validator: validator.MustForm(map[string]validator.Validator{
+			"category_id": &validator.String{
+				Optional: false,
+			},
+			"amount": &validator.String{
+				Optional:   false,
+				Validators: []validator.StringFunc{entity.CheckMonetaryStr},
+			},
+		}),
+	},
+	"tag_ids": &validator.Slice{
+		Optional:  true,
+		Validator: new(validator.String),
+	},
 })
 
 func (h *transactionHandler) CreateTransaction(ctx context.Context, req *presenter.CreateTransactionRequest, res *presenter.CreateTransactionResponse) error {
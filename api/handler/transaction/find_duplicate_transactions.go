@@ -0,0 +1,50 @@
+package transaction
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var FindDuplicateTransactionsValidator = validator.MustForm(map[string]validator.Validator{
+	"account_id": &validator.String{
+		Optional: true,
+	},
+	"transaction_time": validator.MustForm(map[string]validator.Validator{
+		"gte": &validator.UInt64{
+			Optional: false,
+		},
+		"lte": &validator.UInt64{
+			Optional: false,
+		},
+	}),
+	"window": &validator.UInt64{
+		Optional:  true,
+		UnsetZero: true,
+	},
+	"min_confidence": &validator.String{
+		Optional:   true,
+		Validators: []validator.StringFunc{entity.CheckMonetaryStr},
+	},
+})
+
+func (h *transactionHandler) FindDuplicateTransactions(
+	ctx context.Context,
+	req *presenter.FindDuplicateTransactionsRequest,
+	res *presenter.FindDuplicateTransactionsResponse,
+) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.transactionUseCase.FindDuplicateTransactions(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to find duplicate transactions, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package transaction
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var GetAccountBalanceHistoryValidator = validator.MustForm(map[string]validator.Validator{
+	"account_id": &validator.String{
+		Optional: false,
+	},
+	"app_meta": entity.AppMetaValidator(),
+	"unit": &validator.UInt32{
+		Optional:   false,
+		Validators: []validator.UInt32Func{entity.CheckSnapshotUnit},
+	},
+	"interval": &validator.UInt32{
+		Optional: false,
+		Min:      goutil.Uint32(1),
+		Max:      goutil.Uint32(config.MaxBalanceHistoryInterval),
+	},
+})
+
+func (h *transactionHandler) GetAccountBalanceHistory(ctx context.Context, req *presenter.GetAccountBalanceHistoryRequest, res *presenter.GetAccountBalanceHistoryResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.transactionUseCase.GetAccountBalanceHistory(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get account balance history, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package transaction
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var GetCreditCardCycleValidator = validator.MustForm(map[string]validator.Validator{
+	"account_id": &validator.String{
+		Optional: false,
+	},
+	"app_meta": entity.AppMetaValidator(),
+})
+
+func (h *transactionHandler) GetCreditCardCycle(ctx context.Context, req *presenter.GetCreditCardCycleRequest, res *presenter.GetCreditCardCycleResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.transactionUseCase.GetCreditCardCycle(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get credit card cycle, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
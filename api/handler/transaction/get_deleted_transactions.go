@@ -0,0 +1,28 @@
+package transaction
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var GetDeletedTransactionsValidator = validator.MustForm(map[string]validator.Validator{
+	"paging": entity.PagingValidator(true),
+})
+
+func (h *transactionHandler) GetDeletedTransactions(ctx context.Context, req *presenter.GetDeletedTransactionsRequest, res *presenter.GetDeletedTransactionsResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.transactionUseCase.GetDeletedTransactions(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get deleted transactions, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
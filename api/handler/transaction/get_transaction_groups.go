@@ -31,6 +31,10 @@ var GetTransactionGroupsValidator = validator.MustForm(map[string]validator.Vali
 			Optional: false,
 		},
 	}),
+	"tag_ids": &validator.Slice{
+		Optional:  true,
+		Validator: new(validator.String),
+	},
 	"paging": entity.PagingValidator(true),
 })
 
@@ -34,6 +34,10 @@ var GetTransactionsValidator = validator.MustForm(map[string]validator.Validator
 			Optional: false,
 		},
 	}),
+	"tag_ids": &validator.Slice{
+		Optional:  true,
+		Validator: new(validator.String),
+	},
 	"paging": entity.PagingValidator(true),
 })
 
@@ -0,0 +1,33 @@
+package transaction
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var MergeTransactionsValidator = validator.MustForm(map[string]validator.Validator{
+	"transaction_id": &validator.String{
+		Optional: false,
+	},
+	"duplicate_transaction_id": &validator.String{
+		Optional: false,
+	},
+})
+
+func (h *transactionHandler) MergeTransactions(ctx context.Context, req *presenter.MergeTransactionsRequest, res *presenter.MergeTransactionsResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.transactionUseCase.MergeTransactions(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to merge transactions, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package transaction
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var ReconcileAccountValidator = validator.MustForm(map[string]validator.Validator{
+	"account_id": &validator.String{
+		Optional: false,
+	},
+	"statement_time": &validator.UInt64{
+		Optional: false,
+	},
+	"closing_balance": &validator.String{
+		Optional:   false,
+		Validators: []validator.StringFunc{entity.CheckMonetaryStr},
+	},
+})
+
+func (h *transactionHandler) ReconcileAccount(ctx context.Context, req *presenter.ReconcileAccountRequest, res *presenter.ReconcileAccountResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.transactionUseCase.ReconcileAccount(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to reconcile account, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package transaction
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var RestoreTransactionValidator = validator.MustForm(map[string]validator.Validator{
+	"transaction_id": &validator.String{
+		Optional: false,
+	},
+})
+
+func (h *transactionHandler) RestoreTransaction(ctx context.Context, req *presenter.RestoreTransactionRequest, res *presenter.RestoreTransactionResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.transactionUseCase.RestoreTransaction(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to restore transaction, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
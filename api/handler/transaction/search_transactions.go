@@ -0,0 +1,50 @@
+package transaction
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/api/presenter"
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/validator"
+	"github.com/rs/zerolog/log"
+)
+
+var SearchTransactionsValidator = validator.MustForm(map[string]validator.Validator{
+	"keyword": &validator.String{
+		Optional: false,
+		MinLen:   1,
+		MaxLen:   uint32(config.MaxTransactionNoteLength),
+	},
+	"account_id": &validator.String{
+		Optional:  true,
+		UnsetZero: true,
+	},
+	"category_id": &validator.String{
+		Optional:  true,
+		UnsetZero: true,
+	},
+	"transaction_time": validator.OptionalForm(map[string]validator.Validator{
+		"gte": &validator.UInt64{
+			Optional: true,
+		},
+		"lte": &validator.UInt64{
+			Optional: true,
+		},
+	}),
+	"paging": entity.PagingValidator(true),
+})
+
+func (h *transactionHandler) SearchTransactions(ctx context.Context, req *presenter.SearchTransactionsRequest, res *presenter.SearchTransactionsResponse) error {
+	user := entity.GetUserFromCtx(ctx)
+
+	useCaseRes, err := h.transactionUseCase.SearchTransactions(ctx, req.ToUseCaseReq(user.GetUserID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to search transactions, err: %v", err)
+		return err
+	}
+
+	res.Set(useCaseRes)
+
+	return nil
+}
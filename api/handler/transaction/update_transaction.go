@@ -49,11 +49,53 @@ var UpdateTransactionValidator = validator.MustForm(map[string]validator.Validat
 		UnsetZero: true,
 		MaxLen:    uint32(config.MaxTransactionNoteLength),
 	},
+	"clear_status": &validator.UInt32{
+		Optional:   true,
+		UnsetZero:  true,
+		Validators: []validator.UInt32Func{entity.CheckClearStatus},
+	},
 	"currency": &validator.String{
 		Optional:   true,
 		UnsetZero:  true,
 		Validators: []validator.StringFunc{entity.CheckCurrency},
 	},
+	"received_currency": &validator.String{
+		Optional:   true,
+		UnsetZero:  true,
+		Validators: []validator.StringFunc{entity.CheckCurrency},
+	},
+	"received_amount": &validator.String{
+		Optional:   true,
+		UnsetZero:  true,
+		Validators: []validator.StringFunc{entity.CheckMonetaryStr},
+	},
+	"fee": &validator.String{
+		Optional:   true,
+		UnsetZero:  true,
+		Validators: []validator.StringFunc{entity.CheckMonetaryStr},
+	},
+	"refunded_transaction_id": &validator.String{
+		Optional: true,
+	},
+	"payee_id": &validator.String{
+		Optional: true,
+	},
+	"splits": &validator.Slice{
+		Optional: true,
+		Validator: validator.MustForm(map[string]validator.Validator{
+			"category_id": &validator.String{
+				Optional: false,
+			},
+			"amount": &validator.String{
+				Optional:   false,
+				Validators: []validator.StringFunc{entity.CheckMonetaryStr},
+			},
+		}),
+	},
+	"tag_ids": &validator.Slice{
+		Optional:  true,
+		Validator: new(validator.String),
+	},
 })
 
 func (h *transactionHandler) UpdateTransaction(ctx context.Context, req *presenter.UpdateTransactionRequest, res *presenter.UpdateTransactionResponse) error {
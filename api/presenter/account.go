@@ -11,19 +11,32 @@ import (
 )
 
 type Account struct {
-	AccountID     *string    `json:"account_id,omitempty"`
-	AccountName   *string    `json:"account_name,omitempty"`
-	Balance       *string    `json:"balance,omitempty"`
-	Currency      *string    `json:"currency,omitempty"`
-	AccountType   *uint32    `json:"account_type,omitempty"`
-	AccountStatus *uint32    `json:"account_status,omitempty"`
-	Note          *string    `json:"note,omitempty"`
-	CreateTime    *uint64    `json:"create_time,omitempty"`
-	UpdateTime    *uint64    `json:"update_time,omitempty"`
-	TotalCost     *string    `json:"total_cost,omitempty"`
-	Holdings      []*Holding `json:"holdings,omitempty"`
-	Gain          *string    `json:"gain,omitempty"`
-	PercentGain   *string    `json:"percent_gain,omitempty"`
+	AccountID          *string    `json:"account_id,omitempty"`
+	AccountName        *string    `json:"account_name,omitempty"`
+	Balance            *string    `json:"balance,omitempty"`
+	Currency           *string    `json:"currency,omitempty"`
+	AccountType        *uint32    `json:"account_type,omitempty"`
+	AccountStatus      *uint32    `json:"account_status,omitempty"`
+	Note               *string    `json:"note,omitempty"`
+	Icon               *string    `json:"icon,omitempty"`
+	Color              *string    `json:"color,omitempty"`
+	Position           *uint32    `json:"position,omitempty"`
+	CreateTime         *uint64    `json:"create_time,omitempty"`
+	UpdateTime         *uint64    `json:"update_time,omitempty"`
+	TotalCost          *string    `json:"total_cost,omitempty"`
+	Holdings           []*Holding `json:"holdings,omitempty"`
+	Gain               *string    `json:"gain,omitempty"`
+	PercentGain        *string    `json:"percent_gain,omitempty"`
+	StatementDay       *uint32    `json:"statement_day,omitempty"`
+	DueDay             *uint32    `json:"due_day,omitempty"`
+	CreditLimit        *string    `json:"credit_limit,omitempty"`
+	Principal          *string    `json:"principal,omitempty"`
+	InterestRate       *string    `json:"interest_rate,omitempty"`
+	Term               *uint32    `json:"term,omitempty"`
+	StartTime          *uint64    `json:"start_time,omitempty"`
+	CompoundFrequency  *uint32    `json:"compound_frequency,omitempty"`
+	InterestCategoryID *string    `json:"interest_category_id,omitempty"`
+	LastInterestTime   *uint64    `json:"last_interest_time,omitempty"`
 }
 
 func (ac *Account) GetAccountID() string {
@@ -68,6 +81,27 @@ func (ac *Account) GetAccountType() uint32 {
 	return 0
 }
 
+func (ac *Account) GetIcon() string {
+	if ac != nil && ac.Icon != nil {
+		return *ac.Icon
+	}
+	return ""
+}
+
+func (ac *Account) GetColor() string {
+	if ac != nil && ac.Color != nil {
+		return *ac.Color
+	}
+	return ""
+}
+
+func (ac *Account) GetPosition() uint32 {
+	if ac != nil && ac.Position != nil {
+		return *ac.Position
+	}
+	return 0
+}
+
 func (ac *Account) GetNote() string {
 	if ac != nil && ac.Note != nil {
 		return *ac.Note
@@ -104,12 +138,23 @@ func (ac *Account) GetHoldings() []*Holding {
 }
 
 type CreateAccountRequest struct {
-	AccountName *string                 `json:"account_name,omitempty"`
-	Balance     *string                 `json:"balance,omitempty"`
-	Note        *string                 `json:"note,omitempty"`
-	AccountType *uint32                 `json:"account_type,omitempty"`
-	Currency    *string                 `json:"currency,omitempty"` // no op
-	Holdings    []*CreateHoldingRequest `json:"holdings,omitempty"` // only for InitUser
+	AccountName        *string                 `json:"account_name,omitempty"`
+	Balance            *string                 `json:"balance,omitempty"`
+	Note               *string                 `json:"note,omitempty"`
+	Icon               *string                 `json:"icon,omitempty"`
+	Color              *string                 `json:"color,omitempty"`
+	AccountType        *uint32                 `json:"account_type,omitempty"`
+	Currency           *string                 `json:"currency,omitempty"` // no op
+	StatementDay       *uint32                 `json:"statement_day,omitempty"`
+	DueDay             *uint32                 `json:"due_day,omitempty"`
+	CreditLimit        *string                 `json:"credit_limit,omitempty"`
+	Principal          *string                 `json:"principal,omitempty"`
+	InterestRate       *string                 `json:"interest_rate,omitempty"`
+	Term               *uint32                 `json:"term,omitempty"`
+	StartTime          *uint64                 `json:"start_time,omitempty"`
+	CompoundFrequency  *uint32                 `json:"compound_frequency,omitempty"`
+	InterestCategoryID *string                 `json:"interest_category_id,omitempty"`
+	Holdings           []*CreateHoldingRequest `json:"holdings,omitempty"` // only for InitUser
 }
 
 func (m *CreateAccountRequest) GetAccountName() string {
@@ -126,6 +171,20 @@ func (m *CreateAccountRequest) GetBalance() string {
 	return ""
 }
 
+func (m *CreateAccountRequest) GetIcon() string {
+	if m != nil && m.Icon != nil {
+		return *m.Icon
+	}
+	return ""
+}
+
+func (m *CreateAccountRequest) GetColor() string {
+	if m != nil && m.Color != nil {
+		return *m.Color
+	}
+	return ""
+}
+
 func (m *CreateAccountRequest) GetNote() string {
 	if m != nil && m.Note != nil {
 		return *m.Note
@@ -147,6 +206,27 @@ func (m *CreateAccountRequest) GetAccountType() uint32 {
 	return 0
 }
 
+func (m *CreateAccountRequest) GetStatementDay() uint32 {
+	if m != nil && m.StatementDay != nil {
+		return *m.StatementDay
+	}
+	return 0
+}
+
+func (m *CreateAccountRequest) GetDueDay() uint32 {
+	if m != nil && m.DueDay != nil {
+		return *m.DueDay
+	}
+	return 0
+}
+
+func (m *CreateAccountRequest) GetCreditLimit() string {
+	if m != nil && m.CreditLimit != nil {
+		return *m.CreditLimit
+	}
+	return ""
+}
+
 func (m *CreateAccountRequest) GetHoldings() []*CreateHoldingRequest {
 	if m != nil && m.Holdings != nil {
 		return m.Holdings
@@ -154,6 +234,48 @@ func (m *CreateAccountRequest) GetHoldings() []*CreateHoldingRequest {
 	return nil
 }
 
+func (m *CreateAccountRequest) GetPrincipal() string {
+	if m != nil && m.Principal != nil {
+		return *m.Principal
+	}
+	return ""
+}
+
+func (m *CreateAccountRequest) GetInterestRate() string {
+	if m != nil && m.InterestRate != nil {
+		return *m.InterestRate
+	}
+	return ""
+}
+
+func (m *CreateAccountRequest) GetTerm() uint32 {
+	if m != nil && m.Term != nil {
+		return *m.Term
+	}
+	return 0
+}
+
+func (m *CreateAccountRequest) GetStartTime() uint64 {
+	if m != nil && m.StartTime != nil {
+		return *m.StartTime
+	}
+	return 0
+}
+
+func (m *CreateAccountRequest) GetCompoundFrequency() uint32 {
+	if m != nil && m.CompoundFrequency != nil {
+		return *m.CompoundFrequency
+	}
+	return 0
+}
+
+func (m *CreateAccountRequest) GetInterestCategoryID() string {
+	if m != nil && m.InterestCategoryID != nil {
+		return *m.InterestCategoryID
+	}
+	return ""
+}
+
 func (m *CreateAccountRequest) ToUseCaseReq(userID string) *account.CreateAccountRequest {
 	var balance *float64
 	if m.Balance != nil {
@@ -161,19 +283,48 @@ func (m *CreateAccountRequest) ToUseCaseReq(userID string) *account.CreateAccoun
 		balance = goutil.Float64(b)
 	}
 
+	var creditLimit *float64
+	if m.CreditLimit != nil {
+		cl, _ := util.MonetaryStrToFloat(m.GetCreditLimit())
+		creditLimit = goutil.Float64(cl)
+	}
+
+	var principal *float64
+	if m.Principal != nil {
+		p, _ := util.MonetaryStrToFloat(m.GetPrincipal())
+		principal = goutil.Float64(p)
+	}
+
+	var interestRate *float64
+	if m.InterestRate != nil {
+		ir, _ := util.MonetaryStrToFloat(m.GetInterestRate())
+		interestRate = goutil.Float64(ir)
+	}
+
 	hs := make([]*holding.CreateHoldingRequest, 0)
 	for _, r := range m.Holdings {
 		hs = append(hs, r.ToUseCaseReq(userID))
 	}
 
 	return &account.CreateAccountRequest{
-		UserID:      goutil.String(userID),
-		AccountName: m.AccountName,
-		Balance:     balance,
-		AccountType: m.AccountType,
-		Note:        m.Note,
-		Currency:    m.Currency,
-		Holdings:    hs,
+		UserID:             goutil.String(userID),
+		AccountName:        m.AccountName,
+		Balance:            balance,
+		AccountType:        m.AccountType,
+		Note:               m.Note,
+		Icon:               m.Icon,
+		Color:              m.Color,
+		Currency:           m.Currency,
+		StatementDay:       m.StatementDay,
+		DueDay:             m.DueDay,
+		CreditLimit:        creditLimit,
+		Principal:          principal,
+		InterestRate:       interestRate,
+		Term:               m.Term,
+		StartTime:          m.StartTime,
+		CompoundFrequency:  m.CompoundFrequency,
+		InterestCategoryID: m.InterestCategoryID,
+		Holdings:           hs,
 	}
 }
 
@@ -295,11 +446,23 @@ func (m *GetAccountsResponse) Set(useCaseRes *account.GetAccountsResponse) {
 }
 
 type UpdateAccountRequest struct {
-	AccountID   *string `json:"account_id,omitempty"`
-	AccountName *string `json:"account_name,omitempty"`
-	Balance     *string `json:"balance,omitempty"`
-	Note        *string `json:"note,omitempty"`
-	UpdateMode  *uint32 `json:"update_mode,omitempty"`
+	AccountID          *string `json:"account_id,omitempty"`
+	AccountName        *string `json:"account_name,omitempty"`
+	Balance            *string `json:"balance,omitempty"`
+	Note               *string `json:"note,omitempty"`
+	Icon               *string `json:"icon,omitempty"`
+	Color              *string `json:"color,omitempty"`
+	Position           *uint32 `json:"position,omitempty"`
+	UpdateMode         *uint32 `json:"update_mode,omitempty"`
+	StatementDay       *uint32 `json:"statement_day,omitempty"`
+	DueDay             *uint32 `json:"due_day,omitempty"`
+	CreditLimit        *string `json:"credit_limit,omitempty"`
+	Principal          *string `json:"principal,omitempty"`
+	InterestRate       *string `json:"interest_rate,omitempty"`
+	Term               *uint32 `json:"term,omitempty"`
+	StartTime          *uint64 `json:"start_time,omitempty"`
+	CompoundFrequency  *uint32 `json:"compound_frequency,omitempty"`
+	InterestCategoryID *string `json:"interest_category_id,omitempty"`
 }
 
 func (m *UpdateAccountRequest) GetAccountID() string {
@@ -323,6 +486,27 @@ func (m *UpdateAccountRequest) GetBalance() string {
 	return ""
 }
 
+func (m *UpdateAccountRequest) GetIcon() string {
+	if m != nil && m.Icon != nil {
+		return *m.Icon
+	}
+	return ""
+}
+
+func (m *UpdateAccountRequest) GetColor() string {
+	if m != nil && m.Color != nil {
+		return *m.Color
+	}
+	return ""
+}
+
+func (m *UpdateAccountRequest) GetPosition() uint32 {
+	if m != nil && m.Position != nil {
+		return *m.Position
+	}
+	return 0
+}
+
 func (m *UpdateAccountRequest) GetNote() string {
 	if m != nil && m.Note != nil {
 		return *m.Note
@@ -330,6 +514,27 @@ func (m *UpdateAccountRequest) GetNote() string {
 	return ""
 }
 
+func (m *UpdateAccountRequest) GetStatementDay() uint32 {
+	if m != nil && m.StatementDay != nil {
+		return *m.StatementDay
+	}
+	return 0
+}
+
+func (m *UpdateAccountRequest) GetDueDay() uint32 {
+	if m != nil && m.DueDay != nil {
+		return *m.DueDay
+	}
+	return 0
+}
+
+func (m *UpdateAccountRequest) GetCreditLimit() string {
+	if m != nil && m.CreditLimit != nil {
+		return *m.CreditLimit
+	}
+	return ""
+}
+
 func (m *UpdateAccountRequest) GetUpdateMode() uint32 {
 	if m != nil && m.UpdateMode != nil {
 		return *m.UpdateMode
@@ -337,19 +542,92 @@ func (m *UpdateAccountRequest) GetUpdateMode() uint32 {
 	return 0
 }
 
+func (m *UpdateAccountRequest) GetPrincipal() string {
+	if m != nil && m.Principal != nil {
+		return *m.Principal
+	}
+	return ""
+}
+
+func (m *UpdateAccountRequest) GetInterestRate() string {
+	if m != nil && m.InterestRate != nil {
+		return *m.InterestRate
+	}
+	return ""
+}
+
+func (m *UpdateAccountRequest) GetTerm() uint32 {
+	if m != nil && m.Term != nil {
+		return *m.Term
+	}
+	return 0
+}
+
+func (m *UpdateAccountRequest) GetStartTime() uint64 {
+	if m != nil && m.StartTime != nil {
+		return *m.StartTime
+	}
+	return 0
+}
+
+func (m *UpdateAccountRequest) GetCompoundFrequency() uint32 {
+	if m != nil && m.CompoundFrequency != nil {
+		return *m.CompoundFrequency
+	}
+	return 0
+}
+
+func (m *UpdateAccountRequest) GetInterestCategoryID() string {
+	if m != nil && m.InterestCategoryID != nil {
+		return *m.InterestCategoryID
+	}
+	return ""
+}
+
 func (m *UpdateAccountRequest) ToUseCaseReq(userID string) *account.UpdateAccountRequest {
 	var balance *float64
 	if m.Balance != nil {
 		b, _ := util.MonetaryStrToFloat(m.GetBalance())
 		balance = goutil.Float64(b)
 	}
+
+	var creditLimit *float64
+	if m.CreditLimit != nil {
+		cl, _ := util.MonetaryStrToFloat(m.GetCreditLimit())
+		creditLimit = goutil.Float64(cl)
+	}
+
+	var principal *float64
+	if m.Principal != nil {
+		p, _ := util.MonetaryStrToFloat(m.GetPrincipal())
+		principal = goutil.Float64(p)
+	}
+
+	var interestRate *float64
+	if m.InterestRate != nil {
+		ir, _ := util.MonetaryStrToFloat(m.GetInterestRate())
+		interestRate = goutil.Float64(ir)
+	}
+
 	return &account.UpdateAccountRequest{
-		UserID:      goutil.String(userID),
-		AccountID:   m.AccountID,
-		AccountName: m.AccountName,
-		Balance:     balance,
-		Note:        m.Note,
-		UpdateMode:  m.UpdateMode,
+		UserID:             goutil.String(userID),
+		AccountID:          m.AccountID,
+		AccountName:        m.AccountName,
+		Balance:            balance,
+		Note:               m.Note,
+		Icon:               m.Icon,
+		Color:              m.Color,
+		Position:           m.Position,
+		UpdateMode:         m.UpdateMode,
+		StatementDay:       m.StatementDay,
+		DueDay:             m.DueDay,
+		CreditLimit:        creditLimit,
+		Principal:          principal,
+		InterestRate:       interestRate,
+		Term:               m.Term,
+		StartTime:          m.StartTime,
+		CompoundFrequency:  m.CompoundFrequency,
+		InterestCategoryID: m.InterestCategoryID,
 	}
 }
 
@@ -390,6 +668,62 @@ type DeleteAccountResponse struct{}
 
 func (m *DeleteAccountResponse) Set(useCaseRes *account.DeleteAccountResponse) {}
 
+type GetDeletedAccountsRequest struct{}
+
+func (m *GetDeletedAccountsRequest) ToUseCaseReq(userID string) *account.GetDeletedAccountsRequest {
+	return &account.GetDeletedAccountsRequest{
+		UserID: goutil.String(userID),
+	}
+}
+
+type GetDeletedAccountsResponse struct {
+	Accounts []*Account `json:"accounts,omitempty"`
+}
+
+func (m *GetDeletedAccountsResponse) GetAccounts() []*Account {
+	if m != nil && m.Accounts != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *GetDeletedAccountsResponse) Set(useCaseRes *account.GetDeletedAccountsResponse) {
+	m.Accounts = toAccounts(useCaseRes.Accounts)
+}
+
+type RestoreAccountRequest struct {
+	AccountID *string `json:"account_id,omitempty"`
+}
+
+func (m *RestoreAccountRequest) GetAccountID() string {
+	if m != nil && m.AccountID != nil {
+		return *m.AccountID
+	}
+	return ""
+}
+
+func (m *RestoreAccountRequest) ToUseCaseReq(userID string) *account.RestoreAccountRequest {
+	return &account.RestoreAccountRequest{
+		UserID:    goutil.String(userID),
+		AccountID: m.AccountID,
+	}
+}
+
+type RestoreAccountResponse struct {
+	Account *Account `json:"account,omitempty"`
+}
+
+func (m *RestoreAccountResponse) GetAccount() *Account {
+	if m != nil && m.Account != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *RestoreAccountResponse) Set(useCaseRes *account.RestoreAccountResponse) {
+	m.Account = toAccount(useCaseRes.Account)
+}
+
 type GetAccountsSummaryRequest struct {
 	AppMeta  *AppMeta `json:"app_meta,omitempty"`
 	Unit     *uint32  `json:"unit,omitempty"`
@@ -437,3 +771,192 @@ func (m *GetAccountsSummaryResponse) Set(useCaseRes *account.GetAccountsSummaryR
 	m.AssetValue = toSummaries(useCaseRes.AssetValue)
 	m.DebtValue = toSummaries(useCaseRes.DebtValue)
 }
+
+type AmortisationPeriod struct {
+	Period      *uint32 `json:"period,omitempty"`
+	PaymentTime *uint64 `json:"payment_time,omitempty"`
+	Payment     *string `json:"payment,omitempty"`
+	Interest    *string `json:"interest,omitempty"`
+	Principal   *string `json:"principal,omitempty"`
+	Balance     *string `json:"balance,omitempty"`
+}
+
+func (ap *AmortisationPeriod) GetPeriod() uint32 {
+	if ap != nil && ap.Period != nil {
+		return *ap.Period
+	}
+	return 0
+}
+
+func (ap *AmortisationPeriod) GetPaymentTime() uint64 {
+	if ap != nil && ap.PaymentTime != nil {
+		return *ap.PaymentTime
+	}
+	return 0
+}
+
+func (ap *AmortisationPeriod) GetPayment() string {
+	if ap != nil && ap.Payment != nil {
+		return *ap.Payment
+	}
+	return ""
+}
+
+func (ap *AmortisationPeriod) GetInterest() string {
+	if ap != nil && ap.Interest != nil {
+		return *ap.Interest
+	}
+	return ""
+}
+
+func (ap *AmortisationPeriod) GetPrincipal() string {
+	if ap != nil && ap.Principal != nil {
+		return *ap.Principal
+	}
+	return ""
+}
+
+func (ap *AmortisationPeriod) GetBalance() string {
+	if ap != nil && ap.Balance != nil {
+		return *ap.Balance
+	}
+	return ""
+}
+
+type GetAmortisationScheduleRequest struct {
+	AccountID *string  `json:"account_id,omitempty"`
+	AppMeta   *AppMeta `json:"app_meta,omitempty"`
+}
+
+func (m *GetAmortisationScheduleRequest) GetAccountID() string {
+	if m != nil && m.AccountID != nil {
+		return *m.AccountID
+	}
+	return ""
+}
+
+func (m *GetAmortisationScheduleRequest) GetAppMeta() *AppMeta {
+	if m != nil && m.AppMeta != nil {
+		return m.AppMeta
+	}
+	return nil
+}
+
+func (m *GetAmortisationScheduleRequest) ToUseCaseReq(userID string) *account.GetAmortisationScheduleRequest {
+	return &account.GetAmortisationScheduleRequest{
+		UserID:    goutil.String(userID),
+		AccountID: m.AccountID,
+		AppMeta:   m.AppMeta.toAppMeta(),
+	}
+}
+
+type GetAmortisationScheduleResponse struct {
+	Account       *Account              `json:"account,omitempty"`
+	Periods       []*AmortisationPeriod `json:"periods,omitempty"`
+	TotalPayment  *string               `json:"total_payment,omitempty"`
+	TotalInterest *string               `json:"total_interest,omitempty"`
+}
+
+func (m *GetAmortisationScheduleResponse) GetAccount() *Account {
+	if m != nil && m.Account != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *GetAmortisationScheduleResponse) GetPeriods() []*AmortisationPeriod {
+	if m != nil && m.Periods != nil {
+		return m.Periods
+	}
+	return nil
+}
+
+func (m *GetAmortisationScheduleResponse) GetTotalPayment() string {
+	if m != nil && m.TotalPayment != nil {
+		return *m.TotalPayment
+	}
+	return ""
+}
+
+func (m *GetAmortisationScheduleResponse) GetTotalInterest() string {
+	if m != nil && m.TotalInterest != nil {
+		return *m.TotalInterest
+	}
+	return ""
+}
+
+func (m *GetAmortisationScheduleResponse) Set(useCaseRes *account.GetAmortisationScheduleResponse) {
+	m.Account = toAccount(useCaseRes.Account)
+	m.Periods = toAmortisationPeriods(useCaseRes.Periods)
+	m.TotalPayment = goutil.String(fmt.Sprint(useCaseRes.GetTotalPayment()))
+	m.TotalInterest = goutil.String(fmt.Sprint(useCaseRes.GetTotalInterest()))
+}
+
+type GetInterestProjectionRequest struct {
+	AccountID   *string `json:"account_id,omitempty"`
+	ProjectTime *uint64 `json:"project_time,omitempty"`
+}
+
+func (m *GetInterestProjectionRequest) GetAccountID() string {
+	if m != nil && m.AccountID != nil {
+		return *m.AccountID
+	}
+	return ""
+}
+
+func (m *GetInterestProjectionRequest) GetProjectTime() uint64 {
+	if m != nil && m.ProjectTime != nil {
+		return *m.ProjectTime
+	}
+	return 0
+}
+
+func (m *GetInterestProjectionRequest) ToUseCaseReq(userID string) *account.GetInterestProjectionRequest {
+	return &account.GetInterestProjectionRequest{
+		UserID:      goutil.String(userID),
+		AccountID:   m.AccountID,
+		ProjectTime: m.ProjectTime,
+	}
+}
+
+type GetInterestProjectionResponse struct {
+	Account          *Account `json:"account,omitempty"`
+	ProjectedBalance *string  `json:"projected_balance,omitempty"`
+	Interest         *string  `json:"interest,omitempty"`
+	Periods          *uint32  `json:"periods,omitempty"`
+}
+
+func (m *GetInterestProjectionResponse) GetAccount() *Account {
+	if m != nil && m.Account != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *GetInterestProjectionResponse) GetProjectedBalance() string {
+	if m != nil && m.ProjectedBalance != nil {
+		return *m.ProjectedBalance
+	}
+	return ""
+}
+
+func (m *GetInterestProjectionResponse) GetInterest() string {
+	if m != nil && m.Interest != nil {
+		return *m.Interest
+	}
+	return ""
+}
+
+func (m *GetInterestProjectionResponse) GetPeriods() uint32 {
+	if m != nil && m.Periods != nil {
+		return *m.Periods
+	}
+	return 0
+}
+
+func (m *GetInterestProjectionResponse) Set(useCaseRes *account.GetInterestProjectionResponse) {
+	m.Account = toAccount(useCaseRes.Account)
+	m.ProjectedBalance = goutil.String(fmt.Sprint(useCaseRes.GetProjectedBalance()))
+	m.Interest = goutil.String(fmt.Sprint(useCaseRes.GetInterest()))
+	m.Periods = useCaseRes.Periods
+}
@@ -0,0 +1,221 @@
+package presenter
+
+import (
+	"io"
+
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/jseow5177/pockteer-be/usecase/attachment"
+)
+
+type Attachment struct {
+	AttachmentID  *string `json:"attachment_id,omitempty"`
+	TransactionID *string `json:"transaction_id,omitempty"`
+	FileName      *string `json:"file_name,omitempty"`
+	ContentType   *string `json:"content_type,omitempty"`
+	Size          *uint64 `json:"size,omitempty"`
+	CreateTime    *uint64 `json:"create_time,omitempty"`
+	UpdateTime    *uint64 `json:"update_time,omitempty"`
+}
+
+func (a *Attachment) GetAttachmentID() string {
+	if a != nil && a.AttachmentID != nil {
+		return *a.AttachmentID
+	}
+	return ""
+}
+
+func (a *Attachment) GetTransactionID() string {
+	if a != nil && a.TransactionID != nil {
+		return *a.TransactionID
+	}
+	return ""
+}
+
+func (a *Attachment) GetFileName() string {
+	if a != nil && a.FileName != nil {
+		return *a.FileName
+	}
+	return ""
+}
+
+func (a *Attachment) GetContentType() string {
+	if a != nil && a.ContentType != nil {
+		return *a.ContentType
+	}
+	return ""
+}
+
+func (a *Attachment) GetSize() uint64 {
+	if a != nil && a.Size != nil {
+		return *a.Size
+	}
+	return 0
+}
+
+func (a *Attachment) GetCreateTime() uint64 {
+	if a != nil && a.CreateTime != nil {
+		return *a.CreateTime
+	}
+	return 0
+}
+
+func (a *Attachment) GetUpdateTime() uint64 {
+	if a != nil && a.UpdateTime != nil {
+		return *a.UpdateTime
+	}
+	return 0
+}
+
+type UploadAttachmentRequest struct {
+	TransactionID *string `json:"transaction_id,omitempty"`
+	FileName      *string `json:"file_name,omitempty"`
+	Content       []byte  `json:"content,omitempty"` // base64 encoded in json
+}
+
+func (m *UploadAttachmentRequest) GetTransactionID() string {
+	if m != nil && m.TransactionID != nil {
+		return *m.TransactionID
+	}
+	return ""
+}
+
+func (m *UploadAttachmentRequest) GetFileName() string {
+	if m != nil && m.FileName != nil {
+		return *m.FileName
+	}
+	return ""
+}
+
+func (m *UploadAttachmentRequest) GetContent() []byte {
+	if m != nil && m.Content != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (m *UploadAttachmentRequest) ToUseCaseReq(userID string) *attachment.UploadAttachmentRequest {
+	return &attachment.UploadAttachmentRequest{
+		UserID:        goutil.String(userID),
+		TransactionID: m.TransactionID,
+		FileName:      m.FileName,
+		Content:       m.Content,
+	}
+}
+
+type UploadAttachmentResponse struct {
+	Attachment *Attachment `json:"attachment,omitempty"`
+}
+
+func (m *UploadAttachmentResponse) GetAttachment() *Attachment {
+	if m != nil && m.Attachment != nil {
+		return m.Attachment
+	}
+	return nil
+}
+
+func (m *UploadAttachmentResponse) Set(useCaseRes *attachment.UploadAttachmentResponse) {
+	m.Attachment = toAttachment(useCaseRes.Attachment)
+}
+
+type GetAttachmentsRequest struct {
+	TransactionID *string `json:"transaction_id,omitempty"`
+}
+
+func (m *GetAttachmentsRequest) GetTransactionID() string {
+	if m != nil && m.TransactionID != nil {
+		return *m.TransactionID
+	}
+	return ""
+}
+
+func (m *GetAttachmentsRequest) ToUseCaseReq(userID string) *attachment.GetAttachmentsRequest {
+	return &attachment.GetAttachmentsRequest{
+		UserID:        goutil.String(userID),
+		TransactionID: m.TransactionID,
+	}
+}
+
+type GetAttachmentsResponse struct {
+	Attachments []*Attachment `json:"attachments,omitempty"`
+}
+
+func (m *GetAttachmentsResponse) GetAttachments() []*Attachment {
+	if m != nil && m.Attachments != nil {
+		return m.Attachments
+	}
+	return nil
+}
+
+func (m *GetAttachmentsResponse) Set(useCaseRes *attachment.GetAttachmentsResponse) {
+	m.Attachments = toAttachments(useCaseRes.Attachments)
+}
+
+type DownloadAttachmentRequest struct {
+	AttachmentID *string `json:"attachment_id,omitempty"`
+}
+
+func (m *DownloadAttachmentRequest) GetAttachmentID() string {
+	if m != nil && m.AttachmentID != nil {
+		return *m.AttachmentID
+	}
+	return ""
+}
+
+func (m *DownloadAttachmentRequest) ToUseCaseReq(userID string) *attachment.DownloadAttachmentRequest {
+	return &attachment.DownloadAttachmentRequest{
+		UserID:       goutil.String(userID),
+		AttachmentID: m.AttachmentID,
+	}
+}
+
+// DownloadAttachmentResponse is streamed to the client as the raw file.
+type DownloadAttachmentResponse struct {
+	fileName    string
+	contentType string
+	content     io.ReadCloser
+}
+
+func (m *DownloadAttachmentResponse) ContentType() string {
+	return m.contentType
+}
+
+func (m *DownloadAttachmentResponse) FileName() string {
+	return m.fileName
+}
+
+func (m *DownloadAttachmentResponse) Stream(w io.Writer) error {
+	defer m.content.Close()
+
+	_, err := io.Copy(w, m.content)
+	return err
+}
+
+func (m *DownloadAttachmentResponse) Set(useCaseRes *attachment.DownloadAttachmentResponse) {
+	a := useCaseRes.GetAttachment()
+
+	m.fileName = a.GetFileName()
+	m.contentType = a.GetContentType()
+	m.content = useCaseRes.Content
+}
+
+type DeleteAttachmentRequest struct {
+	AttachmentID *string `json:"attachment_id,omitempty"`
+}
+
+func (m *DeleteAttachmentRequest) GetAttachmentID() string {
+	if m != nil && m.AttachmentID != nil {
+		return *m.AttachmentID
+	}
+	return ""
+}
+
+func (m *DeleteAttachmentRequest) ToUseCaseReq(userID string) *attachment.DeleteAttachmentRequest {
+	return &attachment.DeleteAttachmentRequest{
+		UserID:       goutil.String(userID),
+		AttachmentID: m.AttachmentID,
+	}
+}
+
+type DeleteAttachmentResponse struct{}
+
+func (m *DeleteAttachmentResponse) Set(useCaseRes *attachment.DeleteAttachmentResponse) {}
@@ -10,11 +10,16 @@ type Budget struct {
 	BudgetID     *string `json:"budget_id,omitempty"`
 	CategoryID   *string `json:"category_id,omitempty"`
 	BudgetType   *uint32 `json:"budget_type,omitempty"`
+	WeekStartDay *uint32 `json:"week_start_day,omitempty"`
+	PeriodDays   *uint32 `json:"period_days,omitempty"`
+	AnchorDate   *uint64 `json:"anchor_date,omitempty"`
+	Rollover     *bool   `json:"rollover,omitempty"`
 	BudgetStatus *uint32 `json:"budget_status,omitempty"`
 	Amount       *string `json:"amount,omitempty"`
 	CreateTime   *uint64 `json:"create_time,omitempty"`
 	UpdateTime   *uint64 `json:"update_time,omitempty"`
 	UsedAmount   *string `json:"used_amount,omitempty"`
+	CarriedOver  *string `json:"carried_over,omitempty"`
 	Remain       *string `json:"remain,omitempty"`
 	Currency     *string `json:"currency,omitempty"`
 }
@@ -47,6 +52,34 @@ func (b *Budget) GetBudgetType() uint32 {
 	return 0
 }
 
+func (b *Budget) GetWeekStartDay() uint32 {
+	if b != nil && b.WeekStartDay != nil {
+		return *b.WeekStartDay
+	}
+	return 0
+}
+
+func (b *Budget) GetPeriodDays() uint32 {
+	if b != nil && b.PeriodDays != nil {
+		return *b.PeriodDays
+	}
+	return 0
+}
+
+func (b *Budget) GetAnchorDate() uint64 {
+	if b != nil && b.AnchorDate != nil {
+		return *b.AnchorDate
+	}
+	return 0
+}
+
+func (b *Budget) GetRollover() bool {
+	if b != nil && b.Rollover != nil {
+		return *b.Rollover
+	}
+	return false
+}
+
 func (b *Budget) GetBudgetStatus() uint32 {
 	if b != nil && b.BudgetStatus != nil {
 		return *b.BudgetStatus
@@ -68,6 +101,13 @@ func (b *Budget) GetUsedAmount() string {
 	return ""
 }
 
+func (b *Budget) GetCarriedOver() string {
+	if b != nil && b.CarriedOver != nil {
+		return *b.CarriedOver
+	}
+	return ""
+}
+
 func (b *Budget) GetRemain() string {
 	if b != nil && b.Remain != nil {
 		return *b.Remain
@@ -136,6 +176,9 @@ type CreateBudgetRequest struct {
 	CategoryID   *string `json:"category_id,omitempty"`
 	BudgetType   *uint32 `json:"budget_type,omitempty"`
 	BudgetRepeat *uint32 `json:"budget_repeat,omitempty"`
+	WeekStartDay *uint32 `json:"week_start_day,omitempty"`
+	PeriodDays   *uint32 `json:"period_days,omitempty"`
+	Rollover     *bool   `json:"rollover,omitempty"`
 	Amount       *string `json:"amount,omitempty"`
 	Currency     *string `json:"currency,omitempty"` // no op
 }
@@ -168,6 +211,27 @@ func (m *CreateBudgetRequest) GetBudgetRepeat() uint32 {
 	return 0
 }
 
+func (m *CreateBudgetRequest) GetWeekStartDay() uint32 {
+	if m != nil && m.WeekStartDay != nil {
+		return *m.WeekStartDay
+	}
+	return 0
+}
+
+func (m *CreateBudgetRequest) GetPeriodDays() uint32 {
+	if m != nil && m.PeriodDays != nil {
+		return *m.PeriodDays
+	}
+	return 0
+}
+
+func (m *CreateBudgetRequest) GetRollover() bool {
+	if m != nil && m.Rollover != nil {
+		return *m.Rollover
+	}
+	return false
+}
+
 func (m *CreateBudgetRequest) GetAmount() string {
 	if m != nil && m.Amount != nil {
 		return *m.Amount
@@ -195,6 +259,9 @@ func (m *CreateBudgetRequest) ToUseCaseReq(userID string) *budget.CreateBudgetRe
 		BudgetType:   m.BudgetType,
 		BudgetDate:   m.BudgetDate,
 		BudgetRepeat: m.BudgetRepeat,
+		WeekStartDay: m.WeekStartDay,
+		PeriodDays:   m.PeriodDays,
+		Rollover:     m.Rollover,
 		Currency:     m.Currency,
 	}
 }
@@ -259,6 +326,9 @@ type UpdateBudgetRequest struct {
 	CategoryID   *string `json:"category_id,omitempty"`
 	BudgetType   *uint32 `json:"budget_type,omitempty"`
 	BudgetRepeat *uint32 `json:"budget_repeat,omitempty"`
+	WeekStartDay *uint32 `json:"week_start_day,omitempty"`
+	PeriodDays   *uint32 `json:"period_days,omitempty"`
+	Rollover     *bool   `json:"rollover,omitempty"`
 	Amount       *string `json:"amount,omitempty"`
 }
 
@@ -290,6 +360,27 @@ func (m *UpdateBudgetRequest) GetBudgetRepeat() uint32 {
 	return 0
 }
 
+func (m *UpdateBudgetRequest) GetWeekStartDay() uint32 {
+	if m != nil && m.WeekStartDay != nil {
+		return *m.WeekStartDay
+	}
+	return 0
+}
+
+func (m *UpdateBudgetRequest) GetPeriodDays() uint32 {
+	if m != nil && m.PeriodDays != nil {
+		return *m.PeriodDays
+	}
+	return 0
+}
+
+func (m *UpdateBudgetRequest) GetRollover() bool {
+	if m != nil && m.Rollover != nil {
+		return *m.Rollover
+	}
+	return false
+}
+
 func (m *UpdateBudgetRequest) GetAmount() string {
 	if m != nil && m.Amount != nil {
 		return *m.Amount
@@ -310,6 +401,9 @@ func (m *UpdateBudgetRequest) ToUseCaseReq(userID string) *budget.UpdateBudgetRe
 		BudgetType:   m.BudgetType,
 		BudgetDate:   m.BudgetDate,
 		BudgetRepeat: m.BudgetRepeat,
+		WeekStartDay: m.WeekStartDay,
+		PeriodDays:   m.PeriodDays,
+		Rollover:     m.Rollover,
 	}
 }
 
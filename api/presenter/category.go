@@ -7,13 +7,18 @@ import (
 )
 
 type Category struct {
-	CategoryID     *string `json:"category_id,omitempty"`
-	CategoryName   *string `json:"category_name,omitempty"`
-	CategoryType   *uint32 `json:"category_type,omitempty"`
-	CategoryStatus *uint32 `json:"category_status,omitempty"`
-	CreateTime     *uint64 `json:"create_time,omitempty"`
-	UpdateTime     *uint64 `json:"update_time,omitempty"`
-	Budget         *Budget `json:"budget,omitempty"`
+	CategoryID       *string     `json:"category_id,omitempty"`
+	ParentCategoryID *string     `json:"parent_category_id,omitempty"`
+	CategoryName     *string     `json:"category_name,omitempty"`
+	CategoryType     *uint32     `json:"category_type,omitempty"`
+	CategoryStatus   *uint32     `json:"category_status,omitempty"`
+	Icon             *string     `json:"icon,omitempty"`
+	Color            *string     `json:"color,omitempty"`
+	Position         *uint32     `json:"position,omitempty"`
+	CreateTime       *uint64     `json:"create_time,omitempty"`
+	UpdateTime       *uint64     `json:"update_time,omitempty"`
+	Budget           *Budget     `json:"budget,omitempty"`
+	Children         []*Category `json:"children,omitempty"`
 }
 
 func (c *Category) GetCategoryID() string {
@@ -23,6 +28,13 @@ func (c *Category) GetCategoryID() string {
 	return ""
 }
 
+func (c *Category) GetParentCategoryID() string {
+	if c != nil && c.ParentCategoryID != nil {
+		return *c.ParentCategoryID
+	}
+	return ""
+}
+
 func (c *Category) GetCategoryName() string {
 	if c != nil && c.CategoryName != nil {
 		return *c.CategoryName
@@ -44,6 +56,27 @@ func (c *Category) GetCategoryStatus() uint32 {
 	return 0
 }
 
+func (c *Category) GetIcon() string {
+	if c != nil && c.Icon != nil {
+		return *c.Icon
+	}
+	return ""
+}
+
+func (c *Category) GetColor() string {
+	if c != nil && c.Color != nil {
+		return *c.Color
+	}
+	return ""
+}
+
+func (c *Category) GetPosition() uint32 {
+	if c != nil && c.Position != nil {
+		return *c.Position
+	}
+	return 0
+}
+
 func (c *Category) GetCreateTime() uint64 {
 	if c != nil && c.CreateTime != nil {
 		return *c.CreateTime
@@ -65,10 +98,20 @@ func (c *Category) GetBudget() *Budget {
 	return nil
 }
 
+func (c *Category) GetChildren() []*Category {
+	if c != nil && c.Children != nil {
+		return c.Children
+	}
+	return nil
+}
+
 type CreateCategoryRequest struct {
-	CategoryName *string              `json:"category_name,omitempty"`
-	CategoryType *uint32              `json:"category_type,omitempty"`
-	Budget       *CreateBudgetRequest `json:"budget,omitempty"` // only for InitUser
+	CategoryName     *string              `json:"category_name,omitempty"`
+	CategoryType     *uint32              `json:"category_type,omitempty"`
+	ParentCategoryID *string              `json:"parent_category_id,omitempty"`
+	Icon             *string              `json:"icon,omitempty"`
+	Color            *string              `json:"color,omitempty"`
+	Budget           *CreateBudgetRequest `json:"budget,omitempty"` // only for InitUser
 }
 
 func (m *CreateCategoryRequest) GetCategoryName() string {
@@ -85,6 +128,27 @@ func (m *CreateCategoryRequest) GetCategoryType() uint32 {
 	return 0
 }
 
+func (m *CreateCategoryRequest) GetParentCategoryID() string {
+	if m != nil && m.ParentCategoryID != nil {
+		return *m.ParentCategoryID
+	}
+	return ""
+}
+
+func (m *CreateCategoryRequest) GetIcon() string {
+	if m != nil && m.Icon != nil {
+		return *m.Icon
+	}
+	return ""
+}
+
+func (m *CreateCategoryRequest) GetColor() string {
+	if m != nil && m.Color != nil {
+		return *m.Color
+	}
+	return ""
+}
+
 func (m *CreateCategoryRequest) GetBudget() *CreateBudgetRequest {
 	if m != nil && m.Budget != nil {
 		return m.Budget
@@ -98,10 +162,13 @@ func (m *CreateCategoryRequest) ToUseCaseReq(userID string) *category.CreateCate
 		b = m.Budget.ToUseCaseReq(userID)
 	}
 	return &category.CreateCategoryRequest{
-		UserID:       goutil.String(userID),
-		CategoryName: m.CategoryName,
-		CategoryType: m.CategoryType,
-		Budget:       b,
+		UserID:           goutil.String(userID),
+		CategoryName:     m.CategoryName,
+		CategoryType:     m.CategoryType,
+		ParentCategoryID: m.ParentCategoryID,
+		Icon:             m.Icon,
+		Color:            m.Color,
+		Budget:           b,
 	}
 }
 
@@ -121,8 +188,9 @@ func (m *CreateCategoryResponse) Set(useCaseRes *category.CreateCategoryResponse
 }
 
 type GetCategoriesRequest struct {
-	CategoryType *uint32  `json:"category_type,omitempty"`
-	CategoryIDs  []string `json:"category_ids,omitempty"`
+	CategoryType     *uint32  `json:"category_type,omitempty"`
+	CategoryIDs      []string `json:"category_ids,omitempty"`
+	ParentCategoryID *string  `json:"parent_category_id,omitempty"`
 }
 
 func (m *GetCategoriesRequest) GetCategoryType() uint32 {
@@ -139,11 +207,19 @@ func (m *GetCategoriesRequest) GetCategoryIDs() []string {
 	return nil
 }
 
+func (m *GetCategoriesRequest) GetParentCategoryID() string {
+	if m != nil && m.ParentCategoryID != nil {
+		return *m.ParentCategoryID
+	}
+	return ""
+}
+
 func (m *GetCategoriesRequest) ToUseCaseReq(userID string) *category.GetCategoriesRequest {
 	return &category.GetCategoriesRequest{
-		UserID:       goutil.String(userID),
-		CategoryType: m.CategoryType,
-		CategoryIDs:  m.CategoryIDs,
+		UserID:           goutil.String(userID),
+		CategoryType:     m.CategoryType,
+		CategoryIDs:      m.CategoryIDs,
+		ParentCategoryID: m.ParentCategoryID,
 	}
 }
 
@@ -162,9 +238,45 @@ func (m *GetCategoriesResponse) Set(useCaseRes *category.GetCategoriesResponse)
 	m.Categories = toCategories(useCaseRes.Categories)
 }
 
+type GetCategoryTreeRequest struct {
+	CategoryType *uint32 `json:"category_type,omitempty"`
+}
+
+func (m *GetCategoryTreeRequest) GetCategoryType() uint32 {
+	if m != nil && m.CategoryType != nil {
+		return *m.CategoryType
+	}
+	return 0
+}
+
+func (m *GetCategoryTreeRequest) ToUseCaseReq(userID string) *category.GetCategoryTreeRequest {
+	return &category.GetCategoryTreeRequest{
+		UserID:       goutil.String(userID),
+		CategoryType: m.CategoryType,
+	}
+}
+
+type GetCategoryTreeResponse struct {
+	Categories []*Category `json:"categories,omitempty"`
+}
+
+func (m *GetCategoryTreeResponse) GetCategories() []*Category {
+	if m != nil && m.Categories != nil {
+		return m.Categories
+	}
+	return nil
+}
+
+func (m *GetCategoryTreeResponse) Set(useCaseRes *category.GetCategoryTreeResponse) {
+	m.Categories = toCategories(useCaseRes.Categories)
+}
+
 type UpdateCategoryRequest struct {
-	CategoryID   *string `json:"category_id,omitempty"`
-	CategoryName *string `json:"category_name,omitempty"`
+	CategoryID       *string `json:"category_id,omitempty"`
+	CategoryName     *string `json:"category_name,omitempty"`
+	ParentCategoryID *string `json:"parent_category_id,omitempty"` // empty to move to the top level
+	Icon             *string `json:"icon,omitempty"`
+	Color            *string `json:"color,omitempty"`
 }
 
 func (m *UpdateCategoryRequest) GetCategoryID() string {
@@ -181,11 +293,35 @@ func (m *UpdateCategoryRequest) GetCategoryName() string {
 	return ""
 }
 
+func (m *UpdateCategoryRequest) GetParentCategoryID() string {
+	if m != nil && m.ParentCategoryID != nil {
+		return *m.ParentCategoryID
+	}
+	return ""
+}
+
+func (m *UpdateCategoryRequest) GetIcon() string {
+	if m != nil && m.Icon != nil {
+		return *m.Icon
+	}
+	return ""
+}
+
+func (m *UpdateCategoryRequest) GetColor() string {
+	if m != nil && m.Color != nil {
+		return *m.Color
+	}
+	return ""
+}
+
 func (m *UpdateCategoryRequest) ToUseCaseReq(userID string) *category.UpdateCategoryRequest {
 	return &category.UpdateCategoryRequest{
-		UserID:       goutil.String(userID),
-		CategoryID:   m.CategoryID,
-		CategoryName: m.CategoryName,
+		UserID:           goutil.String(userID),
+		CategoryID:       m.CategoryID,
+		CategoryName:     m.CategoryName,
+		ParentCategoryID: m.ParentCategoryID,
+		Icon:             m.Icon,
+		Color:            m.Color,
 	}
 }
 
@@ -361,9 +497,168 @@ type DeleteCategoryResponse struct{}
 
 func (m *DeleteCategoryResponse) Set(useCaseRes *category.DeleteCategoryResponse) {}
 
+type GetDeletedCategoriesRequest struct{}
+
+func (m *GetDeletedCategoriesRequest) ToUseCaseReq(userID string) *category.GetDeletedCategoriesRequest {
+	return &category.GetDeletedCategoriesRequest{
+		UserID: goutil.String(userID),
+	}
+}
+
+type GetDeletedCategoriesResponse struct {
+	Categories []*Category `json:"categories,omitempty"`
+}
+
+func (m *GetDeletedCategoriesResponse) GetCategories() []*Category {
+	if m != nil && m.Categories != nil {
+		return m.Categories
+	}
+	return nil
+}
+
+func (m *GetDeletedCategoriesResponse) Set(useCaseRes *category.GetDeletedCategoriesResponse) {
+	m.Categories = toCategories(useCaseRes.Categories)
+}
+
+type RestoreCategoryRequest struct {
+	CategoryID *string `json:"category_id,omitempty"`
+}
+
+func (m *RestoreCategoryRequest) GetCategoryID() string {
+	if m != nil && m.CategoryID != nil {
+		return *m.CategoryID
+	}
+	return ""
+}
+
+func (m *RestoreCategoryRequest) ToUseCaseReq(userID string) *category.RestoreCategoryRequest {
+	return &category.RestoreCategoryRequest{
+		UserID:     goutil.String(userID),
+		CategoryID: m.CategoryID,
+	}
+}
+
+type RestoreCategoryResponse struct {
+	Category *Category `json:"category,omitempty"`
+}
+
+func (m *RestoreCategoryResponse) GetCategory() *Category {
+	if m != nil && m.Category != nil {
+		return m.Category
+	}
+	return nil
+}
+
+func (m *RestoreCategoryResponse) Set(useCaseRes *category.RestoreCategoryResponse) {
+	m.Category = toCategory(useCaseRes.Category)
+}
+
+type MergeCategoriesRequest struct {
+	AppMeta          *AppMeta `json:"app_meta,omitempty"`
+	SourceCategoryID *string  `json:"source_category_id,omitempty"`
+	TargetCategoryID *string  `json:"target_category_id,omitempty"`
+	BudgetConflict   *uint32  `json:"budget_conflict,omitempty"`
+}
+
+func (m *MergeCategoriesRequest) GetAppMeta() *AppMeta {
+	if m != nil && m.AppMeta != nil {
+		return m.AppMeta
+	}
+	return nil
+}
+
+func (m *MergeCategoriesRequest) GetSourceCategoryID() string {
+	if m != nil && m.SourceCategoryID != nil {
+		return *m.SourceCategoryID
+	}
+	return ""
+}
+
+func (m *MergeCategoriesRequest) GetTargetCategoryID() string {
+	if m != nil && m.TargetCategoryID != nil {
+		return *m.TargetCategoryID
+	}
+	return ""
+}
+
+func (m *MergeCategoriesRequest) GetBudgetConflict() uint32 {
+	if m != nil && m.BudgetConflict != nil {
+		return *m.BudgetConflict
+	}
+	return 0
+}
+
+func (m *MergeCategoriesRequest) ToUseCaseReq(userID string) *category.MergeCategoriesRequest {
+	return &category.MergeCategoriesRequest{
+		AppMeta:          m.AppMeta.toAppMeta(),
+		UserID:           goutil.String(userID),
+		SourceCategoryID: m.SourceCategoryID,
+		TargetCategoryID: m.TargetCategoryID,
+		BudgetConflict:   m.BudgetConflict,
+	}
+}
+
+type MergeCategoriesResponse struct {
+	Category     *Category `json:"category,omitempty"`
+	Transactions *uint32   `json:"transactions,omitempty"`
+}
+
+func (m *MergeCategoriesResponse) GetCategory() *Category {
+	if m != nil && m.Category != nil {
+		return m.Category
+	}
+	return nil
+}
+
+func (m *MergeCategoriesResponse) GetTransactions() uint32 {
+	if m != nil && m.Transactions != nil {
+		return *m.Transactions
+	}
+	return 0
+}
+
+func (m *MergeCategoriesResponse) Set(useCaseRes *category.MergeCategoriesResponse) {
+	m.Category = toCategory(useCaseRes.Category)
+	m.Transactions = useCaseRes.Transactions
+}
+
+type ReorderCategoriesRequest struct {
+	CategoryIDs []string `json:"category_ids,omitempty"`
+}
+
+func (m *ReorderCategoriesRequest) GetCategoryIDs() []string {
+	if m != nil && m.CategoryIDs != nil {
+		return m.CategoryIDs
+	}
+	return nil
+}
+
+func (m *ReorderCategoriesRequest) ToUseCaseReq(userID string) *category.ReorderCategoriesRequest {
+	return &category.ReorderCategoriesRequest{
+		UserID:      goutil.String(userID),
+		CategoryIDs: m.CategoryIDs,
+	}
+}
+
+type ReorderCategoriesResponse struct {
+	Categories []*Category `json:"categories,omitempty"`
+}
+
+func (m *ReorderCategoriesResponse) GetCategories() []*Category {
+	if m != nil && m.Categories != nil {
+		return m.Categories
+	}
+	return nil
+}
+
+func (m *ReorderCategoriesResponse) Set(useCaseRes *category.ReorderCategoriesResponse) {
+	m.Categories = toCategories(useCaseRes.Categories)
+}
+
 type SumCategoryTransactionsRequest struct {
-	TransactionTime *RangeFilter `json:"transaction_time,omitempty"`
-	TransactionType *uint32      `json:"transaction_type,omitempty"`
+	TransactionTime  *RangeFilter `json:"transaction_time,omitempty"`
+	TransactionType  *uint32      `json:"transaction_type,omitempty"`
+	ParentCategoryID *string      `json:"parent_category_id,omitempty"`
 }
 
 func (m *SumCategoryTransactionsRequest) GetTransactionTime() *RangeFilter {
@@ -380,11 +675,19 @@ func (m *SumCategoryTransactionsRequest) GetTransactionType() uint32 {
 	return 0
 }
 
+func (m *SumCategoryTransactionsRequest) GetParentCategoryID() string {
+	if m != nil && m.ParentCategoryID != nil {
+		return *m.ParentCategoryID
+	}
+	return ""
+}
+
 func (m *SumCategoryTransactionsRequest) ToUseCaseReq(userID string) *category.SumCategoryTransactionsRequest {
 	return &category.SumCategoryTransactionsRequest{
-		UserID:          goutil.String(userID),
-		TransactionType: m.TransactionType,
-		TransactionTime: m.TransactionTime.toRangeFilter(),
+		UserID:           goutil.String(userID),
+		TransactionType:  m.TransactionType,
+		TransactionTime:  m.TransactionTime.toRangeFilter(),
+		ParentCategoryID: m.ParentCategoryID,
 	}
 }
 
@@ -0,0 +1,40 @@
+package presenter
+
+import (
+	"io"
+
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/jseow5177/pockteer-be/usecase/exporter"
+)
+
+type ExportDataRequest struct{}
+
+func (m *ExportDataRequest) ToUseCaseReq(userID string, w io.Writer) *exporter.ExportDataRequest {
+	return &exporter.ExportDataRequest{
+		UserID: goutil.String(userID),
+		Writer: w,
+	}
+}
+
+// ExportDataResponse is streamed to the client as a zip file.
+type ExportDataResponse struct {
+	fileName string
+	stream   func(w io.Writer) error
+}
+
+func (m *ExportDataResponse) ContentType() string {
+	return "application/zip"
+}
+
+func (m *ExportDataResponse) FileName() string {
+	return m.fileName
+}
+
+func (m *ExportDataResponse) Stream(w io.Writer) error {
+	return m.stream(w)
+}
+
+func (m *ExportDataResponse) Set(fileName string, stream func(w io.Writer) error) {
+	m.fileName = fileName
+	m.stream = stream
+}
@@ -0,0 +1,593 @@
+package presenter
+
+import (
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/jseow5177/pockteer-be/usecase/importer"
+)
+
+type ImportProfile struct {
+	ImportProfileID *string `json:"import_profile_id,omitempty"`
+	ProfileName     *string `json:"profile_name,omitempty"`
+	Delimiter       *string `json:"delimiter,omitempty"`
+	SkipRows        *uint32 `json:"skip_rows,omitempty"`
+	DateColumn      *string `json:"date_column,omitempty"`
+	DateFormat      *uint32 `json:"date_format,omitempty"`
+	AmountColumn    *string `json:"amount_column,omitempty"`
+	AmountSign      *uint32 `json:"amount_sign,omitempty"`
+	DebitColumn     *string `json:"debit_column,omitempty"`
+	CreditColumn    *string `json:"credit_column,omitempty"`
+	NoteColumn      *string `json:"note_column,omitempty"`
+	CurrencyColumn  *string `json:"currency_column,omitempty"`
+	ProfileStatus   *uint32 `json:"profile_status,omitempty"`
+	CreateTime      *uint64 `json:"create_time,omitempty"`
+	UpdateTime      *uint64 `json:"update_time,omitempty"`
+}
+
+func (ip *ImportProfile) GetImportProfileID() string {
+	if ip != nil && ip.ImportProfileID != nil {
+		return *ip.ImportProfileID
+	}
+	return ""
+}
+
+func (ip *ImportProfile) GetProfileName() string {
+	if ip != nil && ip.ProfileName != nil {
+		return *ip.ProfileName
+	}
+	return ""
+}
+
+func (ip *ImportProfile) GetDelimiter() string {
+	if ip != nil && ip.Delimiter != nil {
+		return *ip.Delimiter
+	}
+	return ""
+}
+
+func (ip *ImportProfile) GetSkipRows() uint32 {
+	if ip != nil && ip.SkipRows != nil {
+		return *ip.SkipRows
+	}
+	return 0
+}
+
+func (ip *ImportProfile) GetDateColumn() string {
+	if ip != nil && ip.DateColumn != nil {
+		return *ip.DateColumn
+	}
+	return ""
+}
+
+func (ip *ImportProfile) GetDateFormat() uint32 {
+	if ip != nil && ip.DateFormat != nil {
+		return *ip.DateFormat
+	}
+	return 0
+}
+
+func (ip *ImportProfile) GetAmountColumn() string {
+	if ip != nil && ip.AmountColumn != nil {
+		return *ip.AmountColumn
+	}
+	return ""
+}
+
+func (ip *ImportProfile) GetAmountSign() uint32 {
+	if ip != nil && ip.AmountSign != nil {
+		return *ip.AmountSign
+	}
+	return 0
+}
+
+func (ip *ImportProfile) GetDebitColumn() string {
+	if ip != nil && ip.DebitColumn != nil {
+		return *ip.DebitColumn
+	}
+	return ""
+}
+
+func (ip *ImportProfile) GetCreditColumn() string {
+	if ip != nil && ip.CreditColumn != nil {
+		return *ip.CreditColumn
+	}
+	return ""
+}
+
+func (ip *ImportProfile) GetNoteColumn() string {
+	if ip != nil && ip.NoteColumn != nil {
+		return *ip.NoteColumn
+	}
+	return ""
+}
+
+func (ip *ImportProfile) GetCurrencyColumn() string {
+	if ip != nil && ip.CurrencyColumn != nil {
+		return *ip.CurrencyColumn
+	}
+	return ""
+}
+
+func (ip *ImportProfile) GetProfileStatus() uint32 {
+	if ip != nil && ip.ProfileStatus != nil {
+		return *ip.ProfileStatus
+	}
+	return 0
+}
+
+func (ip *ImportProfile) GetCreateTime() uint64 {
+	if ip != nil && ip.CreateTime != nil {
+		return *ip.CreateTime
+	}
+	return 0
+}
+
+func (ip *ImportProfile) GetUpdateTime() uint64 {
+	if ip != nil && ip.UpdateTime != nil {
+		return *ip.UpdateTime
+	}
+	return 0
+}
+
+type ImportRow struct {
+	Row         *uint32      `json:"row,omitempty"`
+	Transaction *Transaction `json:"transaction,omitempty"`
+	Error       *string      `json:"error,omitempty"`
+	Duplicate   *bool        `json:"duplicate,omitempty"`
+}
+
+func (ir *ImportRow) GetRow() uint32 {
+	if ir != nil && ir.Row != nil {
+		return *ir.Row
+	}
+	return 0
+}
+
+func (ir *ImportRow) GetTransaction() *Transaction {
+	if ir != nil && ir.Transaction != nil {
+		return ir.Transaction
+	}
+	return nil
+}
+
+func (ir *ImportRow) GetError() string {
+	if ir != nil && ir.Error != nil {
+		return *ir.Error
+	}
+	return ""
+}
+
+func (ir *ImportRow) GetDuplicate() bool {
+	if ir != nil && ir.Duplicate != nil {
+		return *ir.Duplicate
+	}
+	return false
+}
+
+type CreateImportProfileRequest struct {
+	ProfileName    *string `json:"profile_name,omitempty"`
+	Delimiter      *string `json:"delimiter,omitempty"`
+	SkipRows       *uint32 `json:"skip_rows,omitempty"`
+	DateColumn     *string `json:"date_column,omitempty"`
+	DateFormat     *uint32 `json:"date_format,omitempty"`
+	AmountColumn   *string `json:"amount_column,omitempty"`
+	AmountSign     *uint32 `json:"amount_sign,omitempty"`
+	DebitColumn    *string `json:"debit_column,omitempty"`
+	CreditColumn   *string `json:"credit_column,omitempty"`
+	NoteColumn     *string `json:"note_column,omitempty"`
+	CurrencyColumn *string `json:"currency_column,omitempty"`
+}
+
+func (m *CreateImportProfileRequest) GetProfileName() string {
+	if m != nil && m.ProfileName != nil {
+		return *m.ProfileName
+	}
+	return ""
+}
+
+func (m *CreateImportProfileRequest) GetDelimiter() string {
+	if m != nil && m.Delimiter != nil {
+		return *m.Delimiter
+	}
+	return ""
+}
+
+func (m *CreateImportProfileRequest) GetSkipRows() uint32 {
+	if m != nil && m.SkipRows != nil {
+		return *m.SkipRows
+	}
+	return 0
+}
+
+func (m *CreateImportProfileRequest) GetDateColumn() string {
+	if m != nil && m.DateColumn != nil {
+		return *m.DateColumn
+	}
+	return ""
+}
+
+func (m *CreateImportProfileRequest) GetDateFormat() uint32 {
+	if m != nil && m.DateFormat != nil {
+		return *m.DateFormat
+	}
+	return 0
+}
+
+func (m *CreateImportProfileRequest) GetAmountColumn() string {
+	if m != nil && m.AmountColumn != nil {
+		return *m.AmountColumn
+	}
+	return ""
+}
+
+func (m *CreateImportProfileRequest) GetAmountSign() uint32 {
+	if m != nil && m.AmountSign != nil {
+		return *m.AmountSign
+	}
+	return 0
+}
+
+func (m *CreateImportProfileRequest) GetDebitColumn() string {
+	if m != nil && m.DebitColumn != nil {
+		return *m.DebitColumn
+	}
+	return ""
+}
+
+func (m *CreateImportProfileRequest) GetCreditColumn() string {
+	if m != nil && m.CreditColumn != nil {
+		return *m.CreditColumn
+	}
+	return ""
+}
+
+func (m *CreateImportProfileRequest) GetNoteColumn() string {
+	if m != nil && m.NoteColumn != nil {
+		return *m.NoteColumn
+	}
+	return ""
+}
+
+func (m *CreateImportProfileRequest) GetCurrencyColumn() string {
+	if m != nil && m.CurrencyColumn != nil {
+		return *m.CurrencyColumn
+	}
+	return ""
+}
+
+func (m *CreateImportProfileRequest) ToUseCaseReq(userID string) *importer.CreateImportProfileRequest {
+	return &importer.CreateImportProfileRequest{
+		UserID:         goutil.String(userID),
+		ProfileName:    m.ProfileName,
+		Delimiter:      m.Delimiter,
+		SkipRows:       m.SkipRows,
+		DateColumn:     m.DateColumn,
+		DateFormat:     m.DateFormat,
+		AmountColumn:   m.AmountColumn,
+		AmountSign:     m.AmountSign,
+		DebitColumn:    m.DebitColumn,
+		CreditColumn:   m.CreditColumn,
+		NoteColumn:     m.NoteColumn,
+		CurrencyColumn: m.CurrencyColumn,
+	}
+}
+
+type CreateImportProfileResponse struct {
+	ImportProfile *ImportProfile `json:"import_profile,omitempty"`
+}
+
+func (m *CreateImportProfileResponse) GetImportProfile() *ImportProfile {
+	if m != nil && m.ImportProfile != nil {
+		return m.ImportProfile
+	}
+	return nil
+}
+
+func (m *CreateImportProfileResponse) Set(useCaseRes *importer.CreateImportProfileResponse) {
+	m.ImportProfile = toImportProfile(useCaseRes.ImportProfile)
+}
+
+type UpdateImportProfileRequest struct {
+	ImportProfileID *string `json:"import_profile_id,omitempty"`
+	ProfileName     *string `json:"profile_name,omitempty"`
+	Delimiter       *string `json:"delimiter,omitempty"`
+	SkipRows        *uint32 `json:"skip_rows,omitempty"`
+	DateColumn      *string `json:"date_column,omitempty"`
+	DateFormat      *uint32 `json:"date_format,omitempty"`
+	AmountColumn    *string `json:"amount_column,omitempty"`
+	AmountSign      *uint32 `json:"amount_sign,omitempty"`
+	DebitColumn     *string `json:"debit_column,omitempty"`
+	CreditColumn    *string `json:"credit_column,omitempty"`
+	NoteColumn      *string `json:"note_column,omitempty"`
+	CurrencyColumn  *string `json:"currency_column,omitempty"`
+}
+
+func (m *UpdateImportProfileRequest) GetImportProfileID() string {
+	if m != nil && m.ImportProfileID != nil {
+		return *m.ImportProfileID
+	}
+	return ""
+}
+
+func (m *UpdateImportProfileRequest) GetProfileName() string {
+	if m != nil && m.ProfileName != nil {
+		return *m.ProfileName
+	}
+	return ""
+}
+
+func (m *UpdateImportProfileRequest) GetDelimiter() string {
+	if m != nil && m.Delimiter != nil {
+		return *m.Delimiter
+	}
+	return ""
+}
+
+func (m *UpdateImportProfileRequest) GetSkipRows() uint32 {
+	if m != nil && m.SkipRows != nil {
+		return *m.SkipRows
+	}
+	return 0
+}
+
+func (m *UpdateImportProfileRequest) GetDateColumn() string {
+	if m != nil && m.DateColumn != nil {
+		return *m.DateColumn
+	}
+	return ""
+}
+
+func (m *UpdateImportProfileRequest) GetDateFormat() uint32 {
+	if m != nil && m.DateFormat != nil {
+		return *m.DateFormat
+	}
+	return 0
+}
+
+func (m *UpdateImportProfileRequest) GetAmountColumn() string {
+	if m != nil && m.AmountColumn != nil {
+		return *m.AmountColumn
+	}
+	return ""
+}
+
+func (m *UpdateImportProfileRequest) GetAmountSign() uint32 {
+	if m != nil && m.AmountSign != nil {
+		return *m.AmountSign
+	}
+	return 0
+}
+
+func (m *UpdateImportProfileRequest) GetDebitColumn() string {
+	if m != nil && m.DebitColumn != nil {
+		return *m.DebitColumn
+	}
+	return ""
+}
+
+func (m *UpdateImportProfileRequest) GetCreditColumn() string {
+	if m != nil && m.CreditColumn != nil {
+		return *m.CreditColumn
+	}
+	return ""
+}
+
+func (m *UpdateImportProfileRequest) GetNoteColumn() string {
+	if m != nil && m.NoteColumn != nil {
+		return *m.NoteColumn
+	}
+	return ""
+}
+
+func (m *UpdateImportProfileRequest) GetCurrencyColumn() string {
+	if m != nil && m.CurrencyColumn != nil {
+		return *m.CurrencyColumn
+	}
+	return ""
+}
+
+func (m *UpdateImportProfileRequest) ToUseCaseReq(userID string) *importer.UpdateImportProfileRequest {
+	return &importer.UpdateImportProfileRequest{
+		UserID:          goutil.String(userID),
+		ImportProfileID: m.ImportProfileID,
+		ProfileName:     m.ProfileName,
+		Delimiter:       m.Delimiter,
+		SkipRows:        m.SkipRows,
+		DateColumn:      m.DateColumn,
+		DateFormat:      m.DateFormat,
+		AmountColumn:    m.AmountColumn,
+		AmountSign:      m.AmountSign,
+		DebitColumn:     m.DebitColumn,
+		CreditColumn:    m.CreditColumn,
+		NoteColumn:      m.NoteColumn,
+		CurrencyColumn:  m.CurrencyColumn,
+	}
+}
+
+type UpdateImportProfileResponse struct {
+	ImportProfile *ImportProfile `json:"import_profile,omitempty"`
+}
+
+func (m *UpdateImportProfileResponse) GetImportProfile() *ImportProfile {
+	if m != nil && m.ImportProfile != nil {
+		return m.ImportProfile
+	}
+	return nil
+}
+
+func (m *UpdateImportProfileResponse) Set(useCaseRes *importer.UpdateImportProfileResponse) {
+	m.ImportProfile = toImportProfile(useCaseRes.ImportProfile)
+}
+
+type GetImportProfileRequest struct {
+	ImportProfileID *string `json:"import_profile_id,omitempty"`
+}
+
+func (m *GetImportProfileRequest) GetImportProfileID() string {
+	if m != nil && m.ImportProfileID != nil {
+		return *m.ImportProfileID
+	}
+	return ""
+}
+
+func (m *GetImportProfileRequest) ToUseCaseReq(userID string) *importer.GetImportProfileRequest {
+	return &importer.GetImportProfileRequest{
+		UserID:          goutil.String(userID),
+		ImportProfileID: m.ImportProfileID,
+	}
+}
+
+type GetImportProfileResponse struct {
+	ImportProfile *ImportProfile `json:"import_profile,omitempty"`
+}
+
+func (m *GetImportProfileResponse) GetImportProfile() *ImportProfile {
+	if m != nil && m.ImportProfile != nil {
+		return m.ImportProfile
+	}
+	return nil
+}
+
+func (m *GetImportProfileResponse) Set(useCaseRes *importer.GetImportProfileResponse) {
+	m.ImportProfile = toImportProfile(useCaseRes.ImportProfile)
+}
+
+type GetImportProfilesRequest struct{}
+
+func (m *GetImportProfilesRequest) ToUseCaseReq(userID string) *importer.GetImportProfilesRequest {
+	return &importer.GetImportProfilesRequest{
+		UserID: goutil.String(userID),
+	}
+}
+
+type GetImportProfilesResponse struct {
+	ImportProfiles []*ImportProfile `json:"import_profiles,omitempty"`
+}
+
+func (m *GetImportProfilesResponse) GetImportProfiles() []*ImportProfile {
+	if m != nil && m.ImportProfiles != nil {
+		return m.ImportProfiles
+	}
+	return nil
+}
+
+func (m *GetImportProfilesResponse) Set(useCaseRes *importer.GetImportProfilesResponse) {
+	m.ImportProfiles = toImportProfiles(useCaseRes.ImportProfiles)
+}
+
+type DeleteImportProfileRequest struct {
+	ImportProfileID *string `json:"import_profile_id,omitempty"`
+}
+
+func (m *DeleteImportProfileRequest) GetImportProfileID() string {
+	if m != nil && m.ImportProfileID != nil {
+		return *m.ImportProfileID
+	}
+	return ""
+}
+
+func (m *DeleteImportProfileRequest) ToUseCaseReq(userID string) *importer.DeleteImportProfileRequest {
+	return &importer.DeleteImportProfileRequest{
+		UserID:          goutil.String(userID),
+		ImportProfileID: m.ImportProfileID,
+	}
+}
+
+type DeleteImportProfileResponse struct{}
+
+func (m *DeleteImportProfileResponse) Set(useCaseRes *importer.DeleteImportProfileResponse) {}
+
+type ImportTransactionsRequest struct {
+	AppMeta           *AppMeta `json:"app_meta,omitempty"`
+	AccountID         *string  `json:"account_id,omitempty"`
+	ImportProfileID   *string  `json:"import_profile_id,omitempty"`
+	Format            *uint32  `json:"format,omitempty"`
+	Data              *string  `json:"data,omitempty"`
+	ExpenseCategoryID *string  `json:"expense_category_id,omitempty"`
+	IncomeCategoryID  *string  `json:"income_category_id,omitempty"`
+	DryRun            *bool    `json:"dry_run,omitempty"`
+}
+
+func (m *ImportTransactionsRequest) GetAppMeta() *AppMeta {
+	if m != nil && m.AppMeta != nil {
+		return m.AppMeta
+	}
+	return nil
+}
+
+func (m *ImportTransactionsRequest) GetAccountID() string {
+	if m != nil && m.AccountID != nil {
+		return *m.AccountID
+	}
+	return ""
+}
+
+func (m *ImportTransactionsRequest) GetImportProfileID() string {
+	if m != nil && m.ImportProfileID != nil {
+		return *m.ImportProfileID
+	}
+	return ""
+}
+
+func (m *ImportTransactionsRequest) GetFormat() uint32 {
+	if m != nil && m.Format != nil {
+		return *m.Format
+	}
+	return 0
+}
+
+func (m *ImportTransactionsRequest) GetData() string {
+	if m != nil && m.Data != nil {
+		return *m.Data
+	}
+	return ""
+}
+
+func (m *ImportTransactionsRequest) GetExpenseCategoryID() string {
+	if m != nil && m.ExpenseCategoryID != nil {
+		return *m.ExpenseCategoryID
+	}
+	return ""
+}
+
+func (m *ImportTransactionsRequest) GetIncomeCategoryID() string {
+	if m != nil && m.IncomeCategoryID != nil {
+		return *m.IncomeCategoryID
+	}
+	return ""
+}
+
+func (m *ImportTransactionsRequest) GetDryRun() bool {
+	if m != nil && m.DryRun != nil {
+		return *m.DryRun
+	}
+	return false
+}
+
+func (m *ImportTransactionsRequest) ToUseCaseReq(userID string) *importer.ImportTransactionsRequest {
+	return &importer.ImportTransactionsRequest{
+		UserID:            goutil.String(userID),
+		AppMeta:           m.AppMeta.toAppMeta(),
+		AccountID:         m.AccountID,
+		ImportProfileID:   m.ImportProfileID,
+		Format:            m.Format,
+		Data:              m.Data,
+		ExpenseCategoryID: m.ExpenseCategoryID,
+		IncomeCategoryID:  m.IncomeCategoryID,
+		DryRun:            m.DryRun,
+	}
+}
+
+type ImportTransactionsResponse struct {
+	Rows []*ImportRow `json:"rows,omitempty"`
+}
+
+func (m *ImportTransactionsResponse) GetRows() []*ImportRow {
+	if m != nil && m.Rows != nil {
+		return m.Rows
+	}
+	return nil
+}
+
+func (m *ImportTransactionsResponse) Set(useCaseRes *importer.ImportTransactionsResponse) {
+	m.Rows = toImportRows(useCaseRes.Rows)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/jseow5177/pockteer-be/entity"
 	"github.com/jseow5177/pockteer-be/pkg/goutil"
 	"github.com/jseow5177/pockteer-be/usecase/common"
+	"github.com/jseow5177/pockteer-be/usecase/transaction"
 )
 
 type Paging struct {
@@ -113,6 +114,11 @@ func toBudget(b *entity.Budget) *Budget {
 		usedAmount = goutil.String(fmt.Sprint(b.GetUsedAmount()))
 	}
 
+	var carriedOver *string
+	if b.CarriedOver != nil {
+		carriedOver = goutil.String(fmt.Sprint(b.GetCarriedOver()))
+	}
+
 	var remain *string
 	if b.Remain != nil {
 		remain = goutil.String(fmt.Sprint(b.GetRemain()))
@@ -122,12 +128,17 @@ func toBudget(b *entity.Budget) *Budget {
 		BudgetID:     b.BudgetID,
 		CategoryID:   b.CategoryID,
 		BudgetType:   b.BudgetType,
+		WeekStartDay: b.WeekStartDay,
+		PeriodDays:   b.PeriodDays,
+		AnchorDate:   b.AnchorDate,
+		Rollover:     b.Rollover,
 		Currency:     b.Currency,
 		BudgetStatus: b.BudgetStatus,
 		Amount:       amount,
 		CreateTime:   b.CreateTime,
 		UpdateTime:   b.UpdateTime,
 		UsedAmount:   usedAmount,
+		CarriedOver:  carriedOver,
 		Remain:       remain,
 	}
 }
@@ -137,14 +148,24 @@ func toCategory(c *entity.Category) *Category {
 		return nil
 	}
 
+	var children []*Category
+	if c.Children != nil {
+		children = toCategories(c.Children)
+	}
+
 	return &Category{
-		CategoryID:     c.CategoryID,
-		CategoryName:   c.CategoryName,
-		CategoryType:   c.CategoryType,
-		CategoryStatus: c.CategoryStatus,
-		CreateTime:     c.CreateTime,
-		UpdateTime:     c.UpdateTime,
-		Budget:         toBudget(c.Budget),
+		CategoryID:       c.CategoryID,
+		ParentCategoryID: c.ParentCategoryID,
+		CategoryName:     c.CategoryName,
+		CategoryType:     c.CategoryType,
+		CategoryStatus:   c.CategoryStatus,
+		Icon:             c.Icon,
+		Color:            c.Color,
+		Position:         c.Position,
+		CreateTime:       c.CreateTime,
+		UpdateTime:       c.UpdateTime,
+		Budget:           toBudget(c.Budget),
+		Children:         children,
 	}
 }
 
@@ -156,6 +177,128 @@ func toCategories(cs []*entity.Category) []*Category {
 	return categories
 }
 
+func toTag(t *entity.Tag) *Tag {
+	if t == nil {
+		return nil
+	}
+
+	return &Tag{
+		TagID:      t.TagID,
+		TagName:    t.TagName,
+		TagStatus:  t.TagStatus,
+		CreateTime: t.CreateTime,
+		UpdateTime: t.UpdateTime,
+	}
+}
+
+func toTags(ts []*entity.Tag) []*Tag {
+	tags := make([]*Tag, len(ts))
+	for idx, t := range ts {
+		tags[idx] = toTag(t)
+	}
+	return tags
+}
+
+func toPayee(p *entity.Payee) *Payee {
+	if p == nil {
+		return nil
+	}
+
+	return &Payee{
+		PayeeID:     p.PayeeID,
+		PayeeName:   p.PayeeName,
+		Aliases:     p.Aliases,
+		PayeeStatus: p.PayeeStatus,
+		CreateTime:  p.CreateTime,
+		UpdateTime:  p.UpdateTime,
+	}
+}
+
+func toPayees(ps []*entity.Payee) []*Payee {
+	payees := make([]*Payee, len(ps))
+	for idx, p := range ps {
+		payees[idx] = toPayee(p)
+	}
+	return payees
+}
+
+func toAttachment(a *entity.Attachment) *Attachment {
+	if a == nil {
+		return nil
+	}
+
+	return &Attachment{
+		AttachmentID:  a.AttachmentID,
+		TransactionID: a.TransactionID,
+		FileName:      a.FileName,
+		ContentType:   a.ContentType,
+		Size:          a.Size,
+		CreateTime:    a.CreateTime,
+		UpdateTime:    a.UpdateTime,
+	}
+}
+
+func toAttachments(as []*entity.Attachment) []*Attachment {
+	attachments := make([]*Attachment, len(as))
+	for idx, a := range as {
+		attachments[idx] = toAttachment(a)
+	}
+	return attachments
+}
+
+func toImportProfile(ip *entity.ImportProfile) *ImportProfile {
+	if ip == nil {
+		return nil
+	}
+
+	return &ImportProfile{
+		ImportProfileID: ip.ImportProfileID,
+		ProfileName:     ip.ProfileName,
+		Delimiter:       ip.Delimiter,
+		SkipRows:        ip.SkipRows,
+		DateColumn:      ip.DateColumn,
+		DateFormat:      ip.DateFormat,
+		AmountColumn:    ip.AmountColumn,
+		AmountSign:      ip.AmountSign,
+		DebitColumn:     ip.DebitColumn,
+		CreditColumn:    ip.CreditColumn,
+		NoteColumn:      ip.NoteColumn,
+		CurrencyColumn:  ip.CurrencyColumn,
+		ProfileStatus:   ip.ProfileStatus,
+		CreateTime:      ip.CreateTime,
+		UpdateTime:      ip.UpdateTime,
+	}
+}
+
+func toImportProfiles(ips []*entity.ImportProfile) []*ImportProfile {
+	importProfiles := make([]*ImportProfile, len(ips))
+	for idx, ip := range ips {
+		importProfiles[idx] = toImportProfile(ip)
+	}
+	return importProfiles
+}
+
+func toImportRow(ir *entity.ImportRow) *ImportRow {
+	if ir == nil {
+		return nil
+	}
+
+	return &ImportRow{
+		Row:         ir.Row,
+		Transaction: toTransaction(ir.Transaction),
+		Error:       ir.Error,
+		Duplicate:   ir.Duplicate,
+	}
+}
+
+func toImportRows(irs []*entity.ImportRow) []*ImportRow {
+	importRows := make([]*ImportRow, len(irs))
+	for idx, ir := range irs {
+		importRows[idx] = toImportRow(ir)
+	}
+	return importRows
+}
+
 func toUserMeta(um *entity.UserMeta) *UserMeta {
 	if um == nil {
 		return nil
@@ -194,25 +337,69 @@ func toTransaction(t *entity.Transaction) *Transaction {
 		amount = goutil.String(fmt.Sprint(t.GetAmount()))
 	}
 
+	var (
+		receivedAmount, receivedCurrency, impliedRate *string
+	)
+	if t.HasReceivedAmount() {
+		receivedAmount = goutil.String(fmt.Sprint(t.GetReceivedAmount()))
+		receivedCurrency = t.ReceivedCurrency
+		impliedRate = goutil.String(fmt.Sprint(t.GetImpliedRate()))
+	}
+
+	var fee *string
+	if t.GetFee() > 0 {
+		fee = goutil.String(fmt.Sprint(t.GetFee()))
+	}
+
 	return &Transaction{
-		TransactionID:     t.TransactionID,
-		Amount:            amount,
-		CategoryID:        t.CategoryID,
-		Category:          toCategory(t.Category),
-		AccountID:         t.AccountID,
-		Account:           toAccount(t.Account),
-		FromAccountID:     t.FromAccountID,
-		FromAccount:       toAccount(t.FromAccount),
-		ToAccountID:       t.ToAccountID,
-		ToAccount:         toAccount(t.ToAccount),
-		Currency:          t.Currency,
-		Note:              t.Note,
-		TransactionStatus: t.TransactionStatus,
-		TransactionType:   t.TransactionType,
-		TransactionTime:   t.TransactionTime,
-		CreateTime:        t.CreateTime,
-		UpdateTime:        t.UpdateTime,
+		TransactionID:          t.TransactionID,
+		Amount:                 amount,
+		ReceivedAmount:         receivedAmount,
+		ReceivedCurrency:       receivedCurrency,
+		Fee:                    fee,
+		ImpliedRate:            impliedRate,
+		CategoryID:             t.CategoryID,
+		Category:               toCategory(t.Category),
+		AccountID:              t.AccountID,
+		Account:                toAccount(t.Account),
+		FromAccountID:          t.FromAccountID,
+		FromAccount:            toAccount(t.FromAccount),
+		ToAccountID:            t.ToAccountID,
+		ToAccount:              toAccount(t.ToAccount),
+		Currency:               t.Currency,
+		Note:                   t.Note,
+		TransactionStatus:      t.TransactionStatus,
+		ClearStatus:            t.ClearStatus,
+		TransactionType:        t.TransactionType,
+		TransactionTime:        t.TransactionTime,
+		RecurringTransactionID: t.RecurringTransactionID,
+		ExternalID:             t.ExternalID,
+		RefundedTransactionID:  t.RefundedTransactionID,
+		RepaymentTransactionID: t.RepaymentTransactionID,
+		PayeeID:                t.PayeeID,
+		Payee:                  toPayee(t.Payee),
+		Splits:                 toTransactionSplits(t.Splits),
+		TagIDs:                 t.TagIDs,
+		Tags:                   toTags(t.Tags),
+		CreateTime:             t.CreateTime,
+		UpdateTime:             t.UpdateTime,
+	}
+}
+
+func toTransactionSplits(tss []*entity.TransactionSplit) []*TransactionSplit {
+	if tss == nil {
+		return nil
 	}
+
+	splits := make([]*TransactionSplit, len(tss))
+	for idx, ts := range tss {
+		splits[idx] = &TransactionSplit{
+			CategoryID: ts.CategoryID,
+			Category:   toCategory(ts.Category),
+			Amount:     goutil.String(fmt.Sprint(ts.GetAmount())),
+		}
+	}
+	return splits
 }
 
 func toTransactions(ts []*entity.Transaction) []*Transaction {
@@ -223,6 +410,83 @@ func toTransactions(ts []*entity.Transaction) []*Transaction {
 	return transactions
 }
 
+func toDuplicateTransaction(dt *transaction.DuplicateTransaction) *DuplicateTransaction {
+	if dt == nil {
+		return nil
+	}
+
+	var confidence *string
+	if dt.Confidence != nil {
+		confidence = goutil.String(fmt.Sprint(dt.GetConfidence()))
+	}
+
+	return &DuplicateTransaction{
+		Transaction: toTransaction(dt.Transaction),
+		Duplicate:   toTransaction(dt.Duplicate),
+		Confidence:  confidence,
+	}
+}
+
+func toDuplicateTransactions(dts []*transaction.DuplicateTransaction) []*DuplicateTransaction {
+	duplicates := make([]*DuplicateTransaction, len(dts))
+	for idx, dt := range dts {
+		duplicates[idx] = toDuplicateTransaction(dt)
+	}
+	return duplicates
+}
+
+func toBulkTransactionSelection(userID string, transactionIDs []string, query *GetTransactionsRequest) *transaction.BulkTransactionSelection {
+	sel := &transaction.BulkTransactionSelection{
+		TransactionIDs: transactionIDs,
+	}
+	if query != nil {
+		sel.Query = query.ToUseCaseReq(userID)
+	}
+	return sel
+}
+
+func toRecurringTransaction(rt *entity.RecurringTransaction) *RecurringTransaction {
+	if rt == nil {
+		return nil
+	}
+
+	var amount *string
+	if rt.Amount != nil {
+		amount = goutil.String(fmt.Sprint(rt.GetAmount()))
+	}
+
+	return &RecurringTransaction{
+		RecurringTransactionID:     rt.RecurringTransactionID,
+		CategoryID:                 rt.CategoryID,
+		AccountID:                  rt.AccountID,
+		FromAccountID:              rt.FromAccountID,
+		ToAccountID:                rt.ToAccountID,
+		Currency:                   rt.Currency,
+		Amount:                     amount,
+		Note:                       rt.Note,
+		TransactionType:            rt.TransactionType,
+		Frequency:                  rt.Frequency,
+		Interval:                   rt.Interval,
+		Timezone:                   rt.Timezone,
+		StartTime:                  rt.StartTime,
+		EndTime:                    rt.EndTime,
+		MaxOccurrences:             rt.MaxOccurrences,
+		OccurrenceCount:            rt.OccurrenceCount,
+		NextTime:                   rt.NextTime,
+		RecurringTransactionStatus: rt.RecurringTransactionStatus,
+		CreateTime:                 rt.CreateTime,
+		UpdateTime:                 rt.UpdateTime,
+	}
+}
+
+func toRecurringTransactions(rts []*entity.RecurringTransaction) []*RecurringTransaction {
+	recurringTransactions := make([]*RecurringTransaction, len(rts))
+	for idx, rt := range rts {
+		recurringTransactions[idx] = toRecurringTransaction(rt)
+	}
+	return recurringTransactions
+}
+
 func toHolding(h *entity.Holding) *Holding {
 	if h == nil {
 		return nil
@@ -377,19 +641,47 @@ func toAccount(ac *entity.Account) *Account {
 		percentGain = goutil.String(fmt.Sprint(ac.GetPercentGain()))
 	}
 
+	var creditLimit *string
+	if ac.CreditLimit != nil {
+		creditLimit = goutil.String(fmt.Sprint(ac.GetCreditLimit()))
+	}
+
+	var principal *string
+	if ac.Principal != nil {
+		principal = goutil.String(fmt.Sprint(ac.GetPrincipal()))
+	}
+
+	var interestRate *string
+	if ac.InterestRate != nil {
+		interestRate = goutil.String(fmt.Sprint(ac.GetInterestRate()))
+	}
+
 	return &Account{
-		AccountID:     ac.AccountID,
-		AccountName:   ac.AccountName,
-		Currency:      ac.Currency,
-		Balance:       balance,
-		AccountType:   ac.AccountType,
-		AccountStatus: ac.AccountStatus,
-		Note:          ac.Note,
-		CreateTime:    ac.CreateTime,
-		UpdateTime:    ac.UpdateTime,
-		Gain:          gain,
-		PercentGain:   percentGain,
-		Holdings:      toHoldings(ac.Holdings),
+		AccountID:          ac.AccountID,
+		AccountName:        ac.AccountName,
+		Currency:           ac.Currency,
+		Balance:            balance,
+		AccountType:        ac.AccountType,
+		AccountStatus:      ac.AccountStatus,
+		Note:               ac.Note,
+		Icon:               ac.Icon,
+		Color:              ac.Color,
+		Position:           ac.Position,
+		CreateTime:         ac.CreateTime,
+		UpdateTime:         ac.UpdateTime,
+		Gain:               gain,
+		PercentGain:        percentGain,
+		Holdings:           toHoldings(ac.Holdings),
+		StatementDay:       ac.StatementDay,
+		DueDay:             ac.DueDay,
+		CreditLimit:        creditLimit,
+		Principal:          principal,
+		InterestRate:       interestRate,
+		Term:               ac.Term,
+		StartTime:          ac.StartTime,
+		CompoundFrequency:  ac.CompoundFrequency,
+		InterestCategoryID: ac.InterestCategoryID,
+		LastInterestTime:   ac.LastInterestTime,
 	}
 }
 
@@ -401,6 +693,29 @@ func toAccounts(acs []*entity.Account) []*Account {
 	return accounts
 }
 
+func toAmortisationPeriod(ap *entity.AmortisationPeriod) *AmortisationPeriod {
+	if ap == nil {
+		return nil
+	}
+
+	return &AmortisationPeriod{
+		Period:      goutil.Uint32(ap.Period),
+		PaymentTime: goutil.Uint64(ap.PaymentTime),
+		Payment:     goutil.String(fmt.Sprint(ap.Payment)),
+		Interest:    goutil.String(fmt.Sprint(ap.Interest)),
+		Principal:   goutil.String(fmt.Sprint(ap.Principal)),
+		Balance:     goutil.String(fmt.Sprint(ap.Balance)),
+	}
+}
+
+func toAmortisationPeriods(aps []*entity.AmortisationPeriod) []*AmortisationPeriod {
+	periods := make([]*AmortisationPeriod, len(aps))
+	for idx, ap := range aps {
+		periods[idx] = toAmortisationPeriod(ap)
+	}
+	return periods
+}
+
 func toSummary(s *common.Summary) *Summary {
 	if s == nil {
 		return nil
@@ -435,6 +750,8 @@ func toSummary(s *common.Summary) *Summary {
 		Date:            s.Date,
 		Category:        toCategory(s.Category),
 		Account:         toAccount(s.Account),
+		Tag:             toTag(s.Tag),
+		Payee:           toPayee(s.Payee),
 		TransactionType: s.TransactionType,
 		Sum:             sum,
 		TotalExpense:    totalExpense,
@@ -527,3 +844,43 @@ func toMetrics(mts []*entity.Metric) []*Metric {
 	}
 	return metrics
 }
+
+func toRule(r *entity.Rule) *Rule {
+	if r == nil {
+		return nil
+	}
+
+	var amountMin, amountMax *string
+	if r.AmountMin != nil {
+		amountMin = goutil.String(fmt.Sprint(r.GetAmountMin()))
+	}
+
+	if r.AmountMax != nil {
+		amountMax = goutil.String(fmt.Sprint(r.GetAmountMax()))
+	}
+
+	return &Rule{
+		RuleID:          r.RuleID,
+		RuleName:        r.RuleName,
+		CategoryID:      r.CategoryID,
+		Position:        r.Position,
+		NoteContains:    r.NoteContains,
+		AmountMin:       amountMin,
+		AmountMax:       amountMax,
+		AccountID:       r.AccountID,
+		Currency:        r.Currency,
+		TransactionType: r.TransactionType,
+		RuleStatus:      r.RuleStatus,
+		CreateTime:      r.CreateTime,
+		UpdateTime:      r.UpdateTime,
+		Category:        toCategory(r.Category),
+	}
+}
+
+func toRules(rs []*entity.Rule) []*Rule {
+	rules := make([]*Rule, len(rs))
+	for idx, r := range rs {
+		rules[idx] = toRule(r)
+	}
+	return rules
+}
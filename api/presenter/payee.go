@@ -0,0 +1,282 @@
+package presenter
+
+import (
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/jseow5177/pockteer-be/usecase/payee"
+)
+
+type Payee struct {
+	PayeeID     *string  `json:"payee_id,omitempty"`
+	PayeeName   *string  `json:"payee_name,omitempty"`
+	Aliases     []string `json:"aliases,omitempty"`
+	PayeeStatus *uint32  `json:"payee_status,omitempty"`
+	CreateTime  *uint64  `json:"create_time,omitempty"`
+	UpdateTime  *uint64  `json:"update_time,omitempty"`
+}
+
+func (p *Payee) GetPayeeID() string {
+	if p != nil && p.PayeeID != nil {
+		return *p.PayeeID
+	}
+	return ""
+}
+
+func (p *Payee) GetPayeeName() string {
+	if p != nil && p.PayeeName != nil {
+		return *p.PayeeName
+	}
+	return ""
+}
+
+func (p *Payee) GetAliases() []string {
+	if p != nil && p.Aliases != nil {
+		return p.Aliases
+	}
+	return nil
+}
+
+func (p *Payee) GetPayeeStatus() uint32 {
+	if p != nil && p.PayeeStatus != nil {
+		return *p.PayeeStatus
+	}
+	return 0
+}
+
+func (p *Payee) GetCreateTime() uint64 {
+	if p != nil && p.CreateTime != nil {
+		return *p.CreateTime
+	}
+	return 0
+}
+
+func (p *Payee) GetUpdateTime() uint64 {
+	if p != nil && p.UpdateTime != nil {
+		return *p.UpdateTime
+	}
+	return 0
+}
+
+type CreatePayeeRequest struct {
+	PayeeName *string  `json:"payee_name,omitempty"`
+	Aliases   []string `json:"aliases,omitempty"`
+}
+
+func (m *CreatePayeeRequest) GetPayeeName() string {
+	if m != nil && m.PayeeName != nil {
+		return *m.PayeeName
+	}
+	return ""
+}
+
+func (m *CreatePayeeRequest) GetAliases() []string {
+	if m != nil && m.Aliases != nil {
+		return m.Aliases
+	}
+	return nil
+}
+
+func (m *CreatePayeeRequest) ToUseCaseReq(userID string) *payee.CreatePayeeRequest {
+	return &payee.CreatePayeeRequest{
+		UserID:    goutil.String(userID),
+		PayeeName: m.PayeeName,
+		Aliases:   m.Aliases,
+	}
+}
+
+type CreatePayeeResponse struct {
+	Payee *Payee `json:"payee,omitempty"`
+}
+
+func (m *CreatePayeeResponse) GetPayee() *Payee {
+	if m != nil && m.Payee != nil {
+		return m.Payee
+	}
+	return nil
+}
+
+func (m *CreatePayeeResponse) Set(useCaseRes *payee.CreatePayeeResponse) {
+	m.Payee = toPayee(useCaseRes.Payee)
+}
+
+type UpdatePayeeRequest struct {
+	PayeeID   *string  `json:"payee_id,omitempty"`
+	PayeeName *string  `json:"payee_name,omitempty"`
+	Aliases   []string `json:"aliases,omitempty"`
+}
+
+func (m *UpdatePayeeRequest) GetPayeeID() string {
+	if m != nil && m.PayeeID != nil {
+		return *m.PayeeID
+	}
+	return ""
+}
+
+func (m *UpdatePayeeRequest) GetPayeeName() string {
+	if m != nil && m.PayeeName != nil {
+		return *m.PayeeName
+	}
+	return ""
+}
+
+func (m *UpdatePayeeRequest) GetAliases() []string {
+	if m != nil && m.Aliases != nil {
+		return m.Aliases
+	}
+	return nil
+}
+
+func (m *UpdatePayeeRequest) ToUseCaseReq(userID string) *payee.UpdatePayeeRequest {
+	return &payee.UpdatePayeeRequest{
+		UserID:    goutil.String(userID),
+		PayeeID:   m.PayeeID,
+		PayeeName: m.PayeeName,
+		Aliases:   m.Aliases,
+	}
+}
+
+type UpdatePayeeResponse struct {
+	Payee *Payee `json:"payee,omitempty"`
+}
+
+func (m *UpdatePayeeResponse) GetPayee() *Payee {
+	if m != nil && m.Payee != nil {
+		return m.Payee
+	}
+	return nil
+}
+
+func (m *UpdatePayeeResponse) Set(useCaseRes *payee.UpdatePayeeResponse) {
+	m.Payee = toPayee(useCaseRes.Payee)
+}
+
+type GetPayeeRequest struct {
+	PayeeID *string `json:"payee_id,omitempty"`
+}
+
+func (m *GetPayeeRequest) GetPayeeID() string {
+	if m != nil && m.PayeeID != nil {
+		return *m.PayeeID
+	}
+	return ""
+}
+
+func (m *GetPayeeRequest) ToUseCaseReq(userID string) *payee.GetPayeeRequest {
+	return &payee.GetPayeeRequest{
+		UserID:  goutil.String(userID),
+		PayeeID: m.PayeeID,
+	}
+}
+
+type GetPayeeResponse struct {
+	Payee *Payee `json:"payee,omitempty"`
+}
+
+func (m *GetPayeeResponse) GetPayee() *Payee {
+	if m != nil && m.Payee != nil {
+		return m.Payee
+	}
+	return nil
+}
+
+func (m *GetPayeeResponse) Set(useCaseRes *payee.GetPayeeResponse) {
+	m.Payee = toPayee(useCaseRes.Payee)
+}
+
+type GetPayeesRequest struct {
+	PayeeIDs []string `json:"payee_ids,omitempty"`
+}
+
+func (m *GetPayeesRequest) GetPayeeIDs() []string {
+	if m != nil && m.PayeeIDs != nil {
+		return m.PayeeIDs
+	}
+	return nil
+}
+
+func (m *GetPayeesRequest) ToUseCaseReq(userID string) *payee.GetPayeesRequest {
+	return &payee.GetPayeesRequest{
+		UserID:   goutil.String(userID),
+		PayeeIDs: m.PayeeIDs,
+	}
+}
+
+type GetPayeesResponse struct {
+	Payees []*Payee `json:"payees,omitempty"`
+}
+
+func (m *GetPayeesResponse) GetPayees() []*Payee {
+	if m != nil && m.Payees != nil {
+		return m.Payees
+	}
+	return nil
+}
+
+func (m *GetPayeesResponse) Set(useCaseRes *payee.GetPayeesResponse) {
+	m.Payees = toPayees(useCaseRes.Payees)
+}
+
+type DeletePayeeRequest struct {
+	PayeeID *string `json:"payee_id"`
+}
+
+func (m *DeletePayeeRequest) GetPayeeID() string {
+	if m != nil && m.PayeeID != nil {
+		return *m.PayeeID
+	}
+	return ""
+}
+
+func (m *DeletePayeeRequest) ToUseCaseReq(userID string) *payee.DeletePayeeRequest {
+	return &payee.DeletePayeeRequest{
+		UserID:  goutil.String(userID),
+		PayeeID: m.PayeeID,
+	}
+}
+
+type DeletePayeeResponse struct{}
+
+func (m *DeletePayeeResponse) Set(useCaseRes *payee.DeletePayeeResponse) {}
+
+type SumTransactionsByPayeeRequest struct {
+	TransactionTime *RangeFilter `json:"transaction_time,omitempty"`
+	TransactionType *uint32      `json:"transaction_type,omitempty"`
+	Limit           *uint32      `json:"limit,omitempty"`
+}
+
+func (m *SumTransactionsByPayeeRequest) GetTransactionTime() *RangeFilter {
+	if m != nil && m.TransactionTime != nil {
+		return m.TransactionTime
+	}
+	return nil
+}
+
+func (m *SumTransactionsByPayeeRequest) GetTransactionType() uint32 {
+	if m != nil && m.TransactionType != nil {
+		return *m.TransactionType
+	}
+	return 0
+}
+
+func (m *SumTransactionsByPayeeRequest) GetLimit() uint32 {
+	if m != nil && m.Limit != nil {
+		return *m.Limit
+	}
+	return 0
+}
+
+func (m *SumTransactionsByPayeeRequest) ToUseCaseReq(userID string) *payee.SumTransactionsByPayeeRequest {
+	return &payee.SumTransactionsByPayeeRequest{
+		UserID:          goutil.String(userID),
+		TransactionTime: m.TransactionTime.toRangeFilter(),
+		TransactionType: m.TransactionType,
+		Limit:           m.Limit,
+	}
+}
+
+type SumTransactionsByPayeeResponse struct {
+	Sums []*Summary `json:"sums,omitempty"`
+}
+
+func (m *SumTransactionsByPayeeResponse) Set(useCaseRes *payee.SumTransactionsByPayeeResponse) {
+	m.Sums = toSummaries(useCaseRes.Sums)
+}
@@ -0,0 +1,544 @@
+package presenter
+
+import (
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	rtuc "github.com/jseow5177/pockteer-be/usecase/recurring_transaction"
+	"github.com/jseow5177/pockteer-be/util"
+)
+
+type RecurringTransaction struct {
+	RecurringTransactionID     *string `json:"recurring_transaction_id,omitempty"`
+	CategoryID                 *string `json:"category_id,omitempty"`
+	AccountID                  *string `json:"account_id,omitempty"`
+	FromAccountID              *string `json:"from_account_id,omitempty"`
+	ToAccountID                *string `json:"to_account_id,omitempty"`
+	Currency                   *string `json:"currency,omitempty"`
+	Amount                     *string `json:"amount,omitempty"`
+	Note                       *string `json:"note,omitempty"`
+	TransactionType            *uint32 `json:"transaction_type,omitempty"`
+	Frequency                  *uint32 `json:"frequency,omitempty"`
+	Interval                   *uint32 `json:"interval,omitempty"`
+	Timezone                   *string `json:"timezone,omitempty"`
+	StartTime                  *uint64 `json:"start_time,omitempty"`
+	EndTime                    *uint64 `json:"end_time,omitempty"`
+	MaxOccurrences             *uint32 `json:"max_occurrences,omitempty"`
+	OccurrenceCount            *uint32 `json:"occurrence_count,omitempty"`
+	NextTime                   *uint64 `json:"next_time,omitempty"`
+	RecurringTransactionStatus *uint32 `json:"recurring_transaction_status,omitempty"`
+	CreateTime                 *uint64 `json:"create_time,omitempty"`
+	UpdateTime                 *uint64 `json:"update_time,omitempty"`
+}
+
+func (rt *RecurringTransaction) GetRecurringTransactionID() string {
+	if rt != nil && rt.RecurringTransactionID != nil {
+		return *rt.RecurringTransactionID
+	}
+	return ""
+}
+
+func (rt *RecurringTransaction) GetCategoryID() string {
+	if rt != nil && rt.CategoryID != nil {
+		return *rt.CategoryID
+	}
+	return ""
+}
+
+func (rt *RecurringTransaction) GetAccountID() string {
+	if rt != nil && rt.AccountID != nil {
+		return *rt.AccountID
+	}
+	return ""
+}
+
+func (rt *RecurringTransaction) GetFromAccountID() string {
+	if rt != nil && rt.FromAccountID != nil {
+		return *rt.FromAccountID
+	}
+	return ""
+}
+
+func (rt *RecurringTransaction) GetToAccountID() string {
+	if rt != nil && rt.ToAccountID != nil {
+		return *rt.ToAccountID
+	}
+	return ""
+}
+
+func (rt *RecurringTransaction) GetCurrency() string {
+	if rt != nil && rt.Currency != nil {
+		return *rt.Currency
+	}
+	return ""
+}
+
+func (rt *RecurringTransaction) GetAmount() string {
+	if rt != nil && rt.Amount != nil {
+		return *rt.Amount
+	}
+	return ""
+}
+
+func (rt *RecurringTransaction) GetNote() string {
+	if rt != nil && rt.Note != nil {
+		return *rt.Note
+	}
+	return ""
+}
+
+func (rt *RecurringTransaction) GetTransactionType() uint32 {
+	if rt != nil && rt.TransactionType != nil {
+		return *rt.TransactionType
+	}
+	return 0
+}
+
+func (rt *RecurringTransaction) GetFrequency() uint32 {
+	if rt != nil && rt.Frequency != nil {
+		return *rt.Frequency
+	}
+	return 0
+}
+
+func (rt *RecurringTransaction) GetInterval() uint32 {
+	if rt != nil && rt.Interval != nil {
+		return *rt.Interval
+	}
+	return 0
+}
+
+func (rt *RecurringTransaction) GetTimezone() string {
+	if rt != nil && rt.Timezone != nil {
+		return *rt.Timezone
+	}
+	return ""
+}
+
+func (rt *RecurringTransaction) GetStartTime() uint64 {
+	if rt != nil && rt.StartTime != nil {
+		return *rt.StartTime
+	}
+	return 0
+}
+
+func (rt *RecurringTransaction) GetEndTime() uint64 {
+	if rt != nil && rt.EndTime != nil {
+		return *rt.EndTime
+	}
+	return 0
+}
+
+func (rt *RecurringTransaction) GetMaxOccurrences() uint32 {
+	if rt != nil && rt.MaxOccurrences != nil {
+		return *rt.MaxOccurrences
+	}
+	return 0
+}
+
+func (rt *RecurringTransaction) GetOccurrenceCount() uint32 {
+	if rt != nil && rt.OccurrenceCount != nil {
+		return *rt.OccurrenceCount
+	}
+	return 0
+}
+
+func (rt *RecurringTransaction) GetNextTime() uint64 {
+	if rt != nil && rt.NextTime != nil {
+		return *rt.NextTime
+	}
+	return 0
+}
+
+func (rt *RecurringTransaction) GetRecurringTransactionStatus() uint32 {
+	if rt != nil && rt.RecurringTransactionStatus != nil {
+		return *rt.RecurringTransactionStatus
+	}
+	return 0
+}
+
+func (rt *RecurringTransaction) GetCreateTime() uint64 {
+	if rt != nil && rt.CreateTime != nil {
+		return *rt.CreateTime
+	}
+	return 0
+}
+
+func (rt *RecurringTransaction) GetUpdateTime() uint64 {
+	if rt != nil && rt.UpdateTime != nil {
+		return *rt.UpdateTime
+	}
+	return 0
+}
+
+type CreateRecurringTransactionRequest struct {
+	AppMeta         *AppMeta `json:"app_meta,omitempty"`
+	CategoryID      *string  `json:"category_id,omitempty"`
+	AccountID       *string  `json:"account_id,omitempty"`
+	FromAccountID   *string  `json:"from_account_id,omitempty"`
+	ToAccountID     *string  `json:"to_account_id,omitempty"`
+	Amount          *string  `json:"amount,omitempty"`
+	Currency        *string  `json:"currency,omitempty"`
+	TransactionType *uint32  `json:"transaction_type,omitempty"`
+	Note            *string  `json:"note,omitempty"`
+	Frequency       *uint32  `json:"frequency,omitempty"`
+	Interval        *uint32  `json:"interval,omitempty"`
+	StartTime       *uint64  `json:"start_time,omitempty"`
+	EndTime         *uint64  `json:"end_time,omitempty"`
+	MaxOccurrences  *uint32  `json:"max_occurrences,omitempty"`
+}
+
+func (m *CreateRecurringTransactionRequest) GetAppMeta() *AppMeta {
+	if m != nil && m.AppMeta != nil {
+		return m.AppMeta
+	}
+	return nil
+}
+
+func (m *CreateRecurringTransactionRequest) GetCategoryID() string {
+	if m != nil && m.CategoryID != nil {
+		return *m.CategoryID
+	}
+	return ""
+}
+
+func (m *CreateRecurringTransactionRequest) GetAccountID() string {
+	if m != nil && m.AccountID != nil {
+		return *m.AccountID
+	}
+	return ""
+}
+
+func (m *CreateRecurringTransactionRequest) GetFromAccountID() string {
+	if m != nil && m.FromAccountID != nil {
+		return *m.FromAccountID
+	}
+	return ""
+}
+
+func (m *CreateRecurringTransactionRequest) GetToAccountID() string {
+	if m != nil && m.ToAccountID != nil {
+		return *m.ToAccountID
+	}
+	return ""
+}
+
+func (m *CreateRecurringTransactionRequest) GetAmount() string {
+	if m != nil && m.Amount != nil {
+		return *m.Amount
+	}
+	return ""
+}
+
+func (m *CreateRecurringTransactionRequest) GetCurrency() string {
+	if m != nil && m.Currency != nil {
+		return *m.Currency
+	}
+	return ""
+}
+
+func (m *CreateRecurringTransactionRequest) GetTransactionType() uint32 {
+	if m != nil && m.TransactionType != nil {
+		return *m.TransactionType
+	}
+	return 0
+}
+
+func (m *CreateRecurringTransactionRequest) GetNote() string {
+	if m != nil && m.Note != nil {
+		return *m.Note
+	}
+	return ""
+}
+
+func (m *CreateRecurringTransactionRequest) GetFrequency() uint32 {
+	if m != nil && m.Frequency != nil {
+		return *m.Frequency
+	}
+	return 0
+}
+
+func (m *CreateRecurringTransactionRequest) GetInterval() uint32 {
+	if m != nil && m.Interval != nil {
+		return *m.Interval
+	}
+	return 0
+}
+
+func (m *CreateRecurringTransactionRequest) GetStartTime() uint64 {
+	if m != nil && m.StartTime != nil {
+		return *m.StartTime
+	}
+	return 0
+}
+
+func (m *CreateRecurringTransactionRequest) GetEndTime() uint64 {
+	if m != nil && m.EndTime != nil {
+		return *m.EndTime
+	}
+	return 0
+}
+
+func (m *CreateRecurringTransactionRequest) GetMaxOccurrences() uint32 {
+	if m != nil && m.MaxOccurrences != nil {
+		return *m.MaxOccurrences
+	}
+	return 0
+}
+
+func (m *CreateRecurringTransactionRequest) ToUseCaseReq(userID string) *rtuc.CreateRecurringTransactionRequest {
+	var amount *float64
+	if m.Amount != nil {
+		a, _ := util.MonetaryStrToFloat(m.GetAmount())
+		amount = goutil.Float64(a)
+	}
+
+	return &rtuc.CreateRecurringTransactionRequest{
+		AppMeta:         m.AppMeta.toAppMeta(),
+		UserID:          goutil.String(userID),
+		CategoryID:      m.CategoryID,
+		AccountID:       m.AccountID,
+		FromAccountID:   m.FromAccountID,
+		ToAccountID:     m.ToAccountID,
+		Amount:          amount,
+		Currency:        m.Currency,
+		TransactionType: m.TransactionType,
+		Note:            m.Note,
+		Frequency:       m.Frequency,
+		Interval:        m.Interval,
+		StartTime:       m.StartTime,
+		EndTime:         m.EndTime,
+		MaxOccurrences:  m.MaxOccurrences,
+	}
+}
+
+type CreateRecurringTransactionResponse struct {
+	RecurringTransaction *RecurringTransaction `json:"recurring_transaction,omitempty"`
+}
+
+func (m *CreateRecurringTransactionResponse) GetRecurringTransaction() *RecurringTransaction {
+	if m != nil && m.RecurringTransaction != nil {
+		return m.RecurringTransaction
+	}
+	return nil
+}
+
+func (m *CreateRecurringTransactionResponse) Set(useCaseRes *rtuc.CreateRecurringTransactionResponse) {
+	m.RecurringTransaction = toRecurringTransaction(useCaseRes.RecurringTransaction)
+}
+
+type UpdateRecurringTransactionRequest struct {
+	RecurringTransactionID *string `json:"recurring_transaction_id,omitempty"`
+	CategoryID             *string `json:"category_id,omitempty"`
+	AccountID              *string `json:"account_id,omitempty"`
+	FromAccountID          *string `json:"from_account_id,omitempty"`
+	ToAccountID            *string `json:"to_account_id,omitempty"`
+	Amount                 *string `json:"amount,omitempty"`
+	Currency               *string `json:"currency,omitempty"`
+	Note                   *string `json:"note,omitempty"`
+	EndTime                *uint64 `json:"end_time,omitempty"`
+	MaxOccurrences         *uint32 `json:"max_occurrences,omitempty"`
+}
+
+func (m *UpdateRecurringTransactionRequest) GetRecurringTransactionID() string {
+	if m != nil && m.RecurringTransactionID != nil {
+		return *m.RecurringTransactionID
+	}
+	return ""
+}
+
+func (m *UpdateRecurringTransactionRequest) GetCategoryID() string {
+	if m != nil && m.CategoryID != nil {
+		return *m.CategoryID
+	}
+	return ""
+}
+
+func (m *UpdateRecurringTransactionRequest) GetAccountID() string {
+	if m != nil && m.AccountID != nil {
+		return *m.AccountID
+	}
+	return ""
+}
+
+func (m *UpdateRecurringTransactionRequest) GetFromAccountID() string {
+	if m != nil && m.FromAccountID != nil {
+		return *m.FromAccountID
+	}
+	return ""
+}
+
+func (m *UpdateRecurringTransactionRequest) GetToAccountID() string {
+	if m != nil && m.ToAccountID != nil {
+		return *m.ToAccountID
+	}
+	return ""
+}
+
+func (m *UpdateRecurringTransactionRequest) GetAmount() string {
+	if m != nil && m.Amount != nil {
+		return *m.Amount
+	}
+	return ""
+}
+
+func (m *UpdateRecurringTransactionRequest) GetCurrency() string {
+	if m != nil && m.Currency != nil {
+		return *m.Currency
+	}
+	return ""
+}
+
+func (m *UpdateRecurringTransactionRequest) GetNote() string {
+	if m != nil && m.Note != nil {
+		return *m.Note
+	}
+	return ""
+}
+
+func (m *UpdateRecurringTransactionRequest) GetEndTime() uint64 {
+	if m != nil && m.EndTime != nil {
+		return *m.EndTime
+	}
+	return 0
+}
+
+func (m *UpdateRecurringTransactionRequest) GetMaxOccurrences() uint32 {
+	if m != nil && m.MaxOccurrences != nil {
+		return *m.MaxOccurrences
+	}
+	return 0
+}
+
+func (m *UpdateRecurringTransactionRequest) ToUseCaseReq(userID string) *rtuc.UpdateRecurringTransactionRequest {
+	var amount *float64
+	if m.Amount != nil {
+		a, _ := util.MonetaryStrToFloat(m.GetAmount())
+		amount = goutil.Float64(a)
+	}
+
+	return &rtuc.UpdateRecurringTransactionRequest{
+		UserID:                 goutil.String(userID),
+		RecurringTransactionID: m.RecurringTransactionID,
+		CategoryID:             m.CategoryID,
+		AccountID:              m.AccountID,
+		FromAccountID:          m.FromAccountID,
+		ToAccountID:            m.ToAccountID,
+		Amount:                 amount,
+		Currency:               m.Currency,
+		Note:                   m.Note,
+		EndTime:                m.EndTime,
+		MaxOccurrences:         m.MaxOccurrences,
+	}
+}
+
+type UpdateRecurringTransactionResponse struct {
+	RecurringTransaction *RecurringTransaction `json:"recurring_transaction,omitempty"`
+}
+
+func (m *UpdateRecurringTransactionResponse) GetRecurringTransaction() *RecurringTransaction {
+	if m != nil && m.RecurringTransaction != nil {
+		return m.RecurringTransaction
+	}
+	return nil
+}
+
+func (m *UpdateRecurringTransactionResponse) Set(useCaseRes *rtuc.UpdateRecurringTransactionResponse) {
+	m.RecurringTransaction = toRecurringTransaction(useCaseRes.RecurringTransaction)
+}
+
+type GetRecurringTransactionRequest struct {
+	RecurringTransactionID *string `json:"recurring_transaction_id,omitempty"`
+}
+
+func (m *GetRecurringTransactionRequest) GetRecurringTransactionID() string {
+	if m != nil && m.RecurringTransactionID != nil {
+		return *m.RecurringTransactionID
+	}
+	return ""
+}
+
+func (m *GetRecurringTransactionRequest) ToUseCaseReq(userID string) *rtuc.GetRecurringTransactionRequest {
+	return &rtuc.GetRecurringTransactionRequest{
+		UserID:                 goutil.String(userID),
+		RecurringTransactionID: m.RecurringTransactionID,
+	}
+}
+
+type GetRecurringTransactionResponse struct {
+	RecurringTransaction *RecurringTransaction `json:"recurring_transaction,omitempty"`
+}
+
+func (m *GetRecurringTransactionResponse) GetRecurringTransaction() *RecurringTransaction {
+	if m != nil && m.RecurringTransaction != nil {
+		return m.RecurringTransaction
+	}
+	return nil
+}
+
+func (m *GetRecurringTransactionResponse) Set(useCaseRes *rtuc.GetRecurringTransactionResponse) {
+	m.RecurringTransaction = toRecurringTransaction(useCaseRes.RecurringTransaction)
+}
+
+type GetRecurringTransactionsRequest struct {
+	Paging *Paging `json:"paging,omitempty"`
+}
+
+func (m *GetRecurringTransactionsRequest) GetPaging() *Paging {
+	if m != nil && m.Paging != nil {
+		return m.Paging
+	}
+	return nil
+}
+
+func (m *GetRecurringTransactionsRequest) ToUseCaseReq(userID string) *rtuc.GetRecurringTransactionsRequest {
+	return &rtuc.GetRecurringTransactionsRequest{
+		UserID: goutil.String(userID),
+		Paging: m.Paging.toPaging(),
+	}
+}
+
+type GetRecurringTransactionsResponse struct {
+	RecurringTransactions []*RecurringTransaction `json:"recurring_transactions,omitempty"`
+	Paging                *Paging                 `json:"paging,omitempty"`
+}
+
+func (m *GetRecurringTransactionsResponse) GetRecurringTransactions() []*RecurringTransaction {
+	if m != nil && m.RecurringTransactions != nil {
+		return m.RecurringTransactions
+	}
+	return nil
+}
+
+func (m *GetRecurringTransactionsResponse) GetPaging() *Paging {
+	if m != nil && m.Paging != nil {
+		return m.Paging
+	}
+	return nil
+}
+
+func (m *GetRecurringTransactionsResponse) Set(useCaseRes *rtuc.GetRecurringTransactionsResponse) {
+	m.RecurringTransactions = toRecurringTransactions(useCaseRes.RecurringTransactions)
+	m.Paging = toPaging(useCaseRes.Paging)
+}
+
+type DeleteRecurringTransactionRequest struct {
+	RecurringTransactionID *string `json:"recurring_transaction_id,omitempty"`
+}
+
+func (m *DeleteRecurringTransactionRequest) GetRecurringTransactionID() string {
+	if m != nil && m.RecurringTransactionID != nil {
+		return *m.RecurringTransactionID
+	}
+	return ""
+}
+
+func (m *DeleteRecurringTransactionRequest) ToUseCaseReq(userID string) *rtuc.DeleteRecurringTransactionRequest {
+	return &rtuc.DeleteRecurringTransactionRequest{
+		UserID:                 goutil.String(userID),
+		RecurringTransactionID: m.RecurringTransactionID,
+	}
+}
+
+type DeleteRecurringTransactionResponse struct{}
+
+func (m *DeleteRecurringTransactionResponse) Set(useCaseRes *rtuc.DeleteRecurringTransactionResponse) {
+}
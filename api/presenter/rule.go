@@ -0,0 +1,474 @@
+package presenter
+
+import (
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/jseow5177/pockteer-be/usecase/rule"
+	"github.com/jseow5177/pockteer-be/util"
+)
+
+type Rule struct {
+	RuleID          *string   `json:"rule_id,omitempty"`
+	RuleName        *string   `json:"rule_name,omitempty"`
+	CategoryID      *string   `json:"category_id,omitempty"`
+	Position        *uint32   `json:"position,omitempty"`
+	NoteContains    *string   `json:"note_contains,omitempty"`
+	AmountMin       *string   `json:"amount_min,omitempty"`
+	AmountMax       *string   `json:"amount_max,omitempty"`
+	AccountID       *string   `json:"account_id,omitempty"`
+	Currency        *string   `json:"currency,omitempty"`
+	TransactionType *uint32   `json:"transaction_type,omitempty"`
+	RuleStatus      *uint32   `json:"rule_status,omitempty"`
+	CreateTime      *uint64   `json:"create_time,omitempty"`
+	UpdateTime      *uint64   `json:"update_time,omitempty"`
+	Category        *Category `json:"category,omitempty"`
+}
+
+func (r *Rule) GetRuleID() string {
+	if r != nil && r.RuleID != nil {
+		return *r.RuleID
+	}
+	return ""
+}
+
+func (r *Rule) GetRuleName() string {
+	if r != nil && r.RuleName != nil {
+		return *r.RuleName
+	}
+	return ""
+}
+
+func (r *Rule) GetCategoryID() string {
+	if r != nil && r.CategoryID != nil {
+		return *r.CategoryID
+	}
+	return ""
+}
+
+func (r *Rule) GetPosition() uint32 {
+	if r != nil && r.Position != nil {
+		return *r.Position
+	}
+	return 0
+}
+
+func (r *Rule) GetNoteContains() string {
+	if r != nil && r.NoteContains != nil {
+		return *r.NoteContains
+	}
+	return ""
+}
+
+func (r *Rule) GetAmountMin() string {
+	if r != nil && r.AmountMin != nil {
+		return *r.AmountMin
+	}
+	return ""
+}
+
+func (r *Rule) GetAmountMax() string {
+	if r != nil && r.AmountMax != nil {
+		return *r.AmountMax
+	}
+	return ""
+}
+
+func (r *Rule) GetAccountID() string {
+	if r != nil && r.AccountID != nil {
+		return *r.AccountID
+	}
+	return ""
+}
+
+func (r *Rule) GetCurrency() string {
+	if r != nil && r.Currency != nil {
+		return *r.Currency
+	}
+	return ""
+}
+
+func (r *Rule) GetTransactionType() uint32 {
+	if r != nil && r.TransactionType != nil {
+		return *r.TransactionType
+	}
+	return 0
+}
+
+func (r *Rule) GetRuleStatus() uint32 {
+	if r != nil && r.RuleStatus != nil {
+		return *r.RuleStatus
+	}
+	return 0
+}
+
+func (r *Rule) GetCreateTime() uint64 {
+	if r != nil && r.CreateTime != nil {
+		return *r.CreateTime
+	}
+	return 0
+}
+
+func (r *Rule) GetUpdateTime() uint64 {
+	if r != nil && r.UpdateTime != nil {
+		return *r.UpdateTime
+	}
+	return 0
+}
+
+func (r *Rule) GetCategory() *Category {
+	if r != nil && r.Category != nil {
+		return r.Category
+	}
+	return nil
+}
+
+type CreateRuleRequest struct {
+	RuleName        *string `json:"rule_name,omitempty"`
+	CategoryID      *string `json:"category_id,omitempty"`
+	Position        *uint32 `json:"position,omitempty"`
+	NoteContains    *string `json:"note_contains,omitempty"`
+	AmountMin       *string `json:"amount_min,omitempty"`
+	AmountMax       *string `json:"amount_max,omitempty"`
+	AccountID       *string `json:"account_id,omitempty"`
+	Currency        *string `json:"currency,omitempty"`
+	TransactionType *uint32 `json:"transaction_type,omitempty"`
+}
+
+func (m *CreateRuleRequest) GetRuleName() string {
+	if m != nil && m.RuleName != nil {
+		return *m.RuleName
+	}
+	return ""
+}
+
+func (m *CreateRuleRequest) GetCategoryID() string {
+	if m != nil && m.CategoryID != nil {
+		return *m.CategoryID
+	}
+	return ""
+}
+
+func (m *CreateRuleRequest) GetPosition() uint32 {
+	if m != nil && m.Position != nil {
+		return *m.Position
+	}
+	return 0
+}
+
+func (m *CreateRuleRequest) GetNoteContains() string {
+	if m != nil && m.NoteContains != nil {
+		return *m.NoteContains
+	}
+	return ""
+}
+
+func (m *CreateRuleRequest) GetAmountMin() string {
+	if m != nil && m.AmountMin != nil {
+		return *m.AmountMin
+	}
+	return ""
+}
+
+func (m *CreateRuleRequest) GetAmountMax() string {
+	if m != nil && m.AmountMax != nil {
+		return *m.AmountMax
+	}
+	return ""
+}
+
+func (m *CreateRuleRequest) GetAccountID() string {
+	if m != nil && m.AccountID != nil {
+		return *m.AccountID
+	}
+	return ""
+}
+
+func (m *CreateRuleRequest) GetCurrency() string {
+	if m != nil && m.Currency != nil {
+		return *m.Currency
+	}
+	return ""
+}
+
+func (m *CreateRuleRequest) GetTransactionType() uint32 {
+	if m != nil && m.TransactionType != nil {
+		return *m.TransactionType
+	}
+	return 0
+}
+
+func (m *CreateRuleRequest) ToUseCaseReq(userID string) *rule.CreateRuleRequest {
+	var amountMin, amountMax *float64
+	if m.AmountMin != nil {
+		a, _ := util.MonetaryStrToFloat(m.GetAmountMin())
+		amountMin = goutil.Float64(a)
+	}
+
+	if m.AmountMax != nil {
+		a, _ := util.MonetaryStrToFloat(m.GetAmountMax())
+		amountMax = goutil.Float64(a)
+	}
+
+	return &rule.CreateRuleRequest{
+		UserID:          goutil.String(userID),
+		RuleName:        m.RuleName,
+		CategoryID:      m.CategoryID,
+		Position:        m.Position,
+		NoteContains:    m.NoteContains,
+		AmountMin:       amountMin,
+		AmountMax:       amountMax,
+		AccountID:       m.AccountID,
+		Currency:        m.Currency,
+		TransactionType: m.TransactionType,
+	}
+}
+
+type CreateRuleResponse struct {
+	Rule *Rule `json:"rule,omitempty"`
+}
+
+func (m *CreateRuleResponse) GetRule() *Rule {
+	if m != nil && m.Rule != nil {
+		return m.Rule
+	}
+	return nil
+}
+
+func (m *CreateRuleResponse) Set(useCaseRes *rule.CreateRuleResponse) {
+	m.Rule = toRule(useCaseRes.Rule)
+}
+
+type UpdateRuleRequest struct {
+	RuleID          *string `json:"rule_id,omitempty"`
+	RuleName        *string `json:"rule_name,omitempty"`
+	CategoryID      *string `json:"category_id,omitempty"`
+	Position        *uint32 `json:"position,omitempty"`
+	NoteContains    *string `json:"note_contains,omitempty"`
+	AmountMin       *string `json:"amount_min,omitempty"`
+	AmountMax       *string `json:"amount_max,omitempty"`
+	AccountID       *string `json:"account_id,omitempty"`
+	Currency        *string `json:"currency,omitempty"`
+	TransactionType *uint32 `json:"transaction_type,omitempty"`
+}
+
+func (m *UpdateRuleRequest) GetRuleID() string {
+	if m != nil && m.RuleID != nil {
+		return *m.RuleID
+	}
+	return ""
+}
+
+func (m *UpdateRuleRequest) GetRuleName() string {
+	if m != nil && m.RuleName != nil {
+		return *m.RuleName
+	}
+	return ""
+}
+
+func (m *UpdateRuleRequest) GetCategoryID() string {
+	if m != nil && m.CategoryID != nil {
+		return *m.CategoryID
+	}
+	return ""
+}
+
+func (m *UpdateRuleRequest) GetPosition() uint32 {
+	if m != nil && m.Position != nil {
+		return *m.Position
+	}
+	return 0
+}
+
+func (m *UpdateRuleRequest) GetNoteContains() string {
+	if m != nil && m.NoteContains != nil {
+		return *m.NoteContains
+	}
+	return ""
+}
+
+func (m *UpdateRuleRequest) GetAmountMin() string {
+	if m != nil && m.AmountMin != nil {
+		return *m.AmountMin
+	}
+	return ""
+}
+
+func (m *UpdateRuleRequest) GetAmountMax() string {
+	if m != nil && m.AmountMax != nil {
+		return *m.AmountMax
+	}
+	return ""
+}
+
+func (m *UpdateRuleRequest) GetAccountID() string {
+	if m != nil && m.AccountID != nil {
+		return *m.AccountID
+	}
+	return ""
+}
+
+func (m *UpdateRuleRequest) GetCurrency() string {
+	if m != nil && m.Currency != nil {
+		return *m.Currency
+	}
+	return ""
+}
+
+func (m *UpdateRuleRequest) GetTransactionType() uint32 {
+	if m != nil && m.TransactionType != nil {
+		return *m.TransactionType
+	}
+	return 0
+}
+
+func (m *UpdateRuleRequest) ToUseCaseReq(userID string) *rule.UpdateRuleRequest {
+	var amountMin, amountMax *float64
+	if m.AmountMin != nil {
+		a, _ := util.MonetaryStrToFloat(m.GetAmountMin())
+		amountMin = goutil.Float64(a)
+	}
+
+	if m.AmountMax != nil {
+		a, _ := util.MonetaryStrToFloat(m.GetAmountMax())
+		amountMax = goutil.Float64(a)
+	}
+
+	return &rule.UpdateRuleRequest{
+		UserID:          goutil.String(userID),
+		RuleID:          m.RuleID,
+		RuleName:        m.RuleName,
+		CategoryID:      m.CategoryID,
+		Position:        m.Position,
+		NoteContains:    m.NoteContains,
+		AmountMin:       amountMin,
+		AmountMax:       amountMax,
+		AccountID:       m.AccountID,
+		Currency:        m.Currency,
+		TransactionType: m.TransactionType,
+	}
+}
+
+type UpdateRuleResponse struct {
+	Rule *Rule `json:"rule,omitempty"`
+}
+
+func (m *UpdateRuleResponse) GetRule() *Rule {
+	if m != nil && m.Rule != nil {
+		return m.Rule
+	}
+	return nil
+}
+
+func (m *UpdateRuleResponse) Set(useCaseRes *rule.UpdateRuleResponse) {
+	m.Rule = toRule(useCaseRes.Rule)
+}
+
+type GetRuleRequest struct {
+	RuleID *string `json:"rule_id,omitempty"`
+}
+
+func (m *GetRuleRequest) GetRuleID() string {
+	if m != nil && m.RuleID != nil {
+		return *m.RuleID
+	}
+	return ""
+}
+
+func (m *GetRuleRequest) ToUseCaseReq(userID string) *rule.GetRuleRequest {
+	return &rule.GetRuleRequest{
+		UserID: goutil.String(userID),
+		RuleID: m.RuleID,
+	}
+}
+
+type GetRuleResponse struct {
+	Rule *Rule `json:"rule,omitempty"`
+}
+
+func (m *GetRuleResponse) GetRule() *Rule {
+	if m != nil && m.Rule != nil {
+		return m.Rule
+	}
+	return nil
+}
+
+func (m *GetRuleResponse) Set(useCaseRes *rule.GetRuleResponse) {
+	m.Rule = toRule(useCaseRes.Rule)
+}
+
+type GetRulesRequest struct{}
+
+func (m *GetRulesRequest) ToUseCaseReq(userID string) *rule.GetRulesRequest {
+	return &rule.GetRulesRequest{
+		UserID: goutil.String(userID),
+	}
+}
+
+type GetRulesResponse struct {
+	Rules []*Rule `json:"rules,omitempty"`
+}
+
+func (m *GetRulesResponse) GetRules() []*Rule {
+	if m != nil && m.Rules != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+func (m *GetRulesResponse) Set(useCaseRes *rule.GetRulesResponse) {
+	m.Rules = toRules(useCaseRes.Rules)
+}
+
+type DeleteRuleRequest struct {
+	RuleID *string `json:"rule_id,omitempty"`
+}
+
+func (m *DeleteRuleRequest) GetRuleID() string {
+	if m != nil && m.RuleID != nil {
+		return *m.RuleID
+	}
+	return ""
+}
+
+func (m *DeleteRuleRequest) ToUseCaseReq(userID string) *rule.DeleteRuleRequest {
+	return &rule.DeleteRuleRequest{
+		UserID: goutil.String(userID),
+		RuleID: m.RuleID,
+	}
+}
+
+type DeleteRuleResponse struct{}
+
+func (m *DeleteRuleResponse) Set(useCaseRes *rule.DeleteRuleResponse) {}
+
+type ApplyRulesRequest struct {
+	TransactionTime *RangeFilter `json:"transaction_time,omitempty"`
+}
+
+func (m *ApplyRulesRequest) GetTransactionTime() *RangeFilter {
+	if m != nil && m.TransactionTime != nil {
+		return m.TransactionTime
+	}
+	return nil
+}
+
+func (m *ApplyRulesRequest) ToUseCaseReq(userID string) *rule.ApplyRulesRequest {
+	return &rule.ApplyRulesRequest{
+		UserID:          goutil.String(userID),
+		TransactionTime: m.TransactionTime.toRangeFilter(),
+	}
+}
+
+type ApplyRulesResponse struct {
+	Transactions []*Transaction `json:"transactions,omitempty"`
+}
+
+func (m *ApplyRulesResponse) GetTransactions() []*Transaction {
+	if m != nil && m.Transactions != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+func (m *ApplyRulesResponse) Set(useCaseRes *rule.ApplyRulesResponse) {
+	m.Transactions = toTransactions(useCaseRes.Transactions)
+}
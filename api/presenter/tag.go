@@ -0,0 +1,256 @@
+package presenter
+
+import (
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/jseow5177/pockteer-be/usecase/tag"
+)
+
+type Tag struct {
+	TagID      *string `json:"tag_id,omitempty"`
+	TagName    *string `json:"tag_name,omitempty"`
+	TagStatus  *uint32 `json:"tag_status,omitempty"`
+	CreateTime *uint64 `json:"create_time,omitempty"`
+	UpdateTime *uint64 `json:"update_time,omitempty"`
+}
+
+func (t *Tag) GetTagID() string {
+	if t != nil && t.TagID != nil {
+		return *t.TagID
+	}
+	return ""
+}
+
+func (t *Tag) GetTagName() string {
+	if t != nil && t.TagName != nil {
+		return *t.TagName
+	}
+	return ""
+}
+
+func (t *Tag) GetTagStatus() uint32 {
+	if t != nil && t.TagStatus != nil {
+		return *t.TagStatus
+	}
+	return 0
+}
+
+func (t *Tag) GetCreateTime() uint64 {
+	if t != nil && t.CreateTime != nil {
+		return *t.CreateTime
+	}
+	return 0
+}
+
+func (t *Tag) GetUpdateTime() uint64 {
+	if t != nil && t.UpdateTime != nil {
+		return *t.UpdateTime
+	}
+	return 0
+}
+
+type CreateTagRequest struct {
+	TagName *string `json:"tag_name,omitempty"`
+}
+
+func (m *CreateTagRequest) GetTagName() string {
+	if m != nil && m.TagName != nil {
+		return *m.TagName
+	}
+	return ""
+}
+
+func (m *CreateTagRequest) ToUseCaseReq(userID string) *tag.CreateTagRequest {
+	return &tag.CreateTagRequest{
+		UserID:  goutil.String(userID),
+		TagName: m.TagName,
+	}
+}
+
+type CreateTagResponse struct {
+	Tag *Tag `json:"tag,omitempty"`
+}
+
+func (m *CreateTagResponse) GetTag() *Tag {
+	if m != nil && m.Tag != nil {
+		return m.Tag
+	}
+	return nil
+}
+
+func (m *CreateTagResponse) Set(useCaseRes *tag.CreateTagResponse) {
+	m.Tag = toTag(useCaseRes.Tag)
+}
+
+type UpdateTagRequest struct {
+	TagID   *string `json:"tag_id,omitempty"`
+	TagName *string `json:"tag_name,omitempty"`
+}
+
+func (m *UpdateTagRequest) GetTagID() string {
+	if m != nil && m.TagID != nil {
+		return *m.TagID
+	}
+	return ""
+}
+
+func (m *UpdateTagRequest) GetTagName() string {
+	if m != nil && m.TagName != nil {
+		return *m.TagName
+	}
+	return ""
+}
+
+func (m *UpdateTagRequest) ToUseCaseReq(userID string) *tag.UpdateTagRequest {
+	return &tag.UpdateTagRequest{
+		UserID:  goutil.String(userID),
+		TagID:   m.TagID,
+		TagName: m.TagName,
+	}
+}
+
+type UpdateTagResponse struct {
+	Tag *Tag `json:"tag,omitempty"`
+}
+
+func (m *UpdateTagResponse) GetTag() *Tag {
+	if m != nil && m.Tag != nil {
+		return m.Tag
+	}
+	return nil
+}
+
+func (m *UpdateTagResponse) Set(useCaseRes *tag.UpdateTagResponse) {
+	m.Tag = toTag(useCaseRes.Tag)
+}
+
+type GetTagRequest struct {
+	TagID *string `json:"tag_id,omitempty"`
+}
+
+func (m *GetTagRequest) GetTagID() string {
+	if m != nil && m.TagID != nil {
+		return *m.TagID
+	}
+	return ""
+}
+
+func (m *GetTagRequest) ToUseCaseReq(userID string) *tag.GetTagRequest {
+	return &tag.GetTagRequest{
+		UserID: goutil.String(userID),
+		TagID:  m.TagID,
+	}
+}
+
+type GetTagResponse struct {
+	Tag *Tag `json:"tag,omitempty"`
+}
+
+func (m *GetTagResponse) GetTag() *Tag {
+	if m != nil && m.Tag != nil {
+		return m.Tag
+	}
+	return nil
+}
+
+func (m *GetTagResponse) Set(useCaseRes *tag.GetTagResponse) {
+	m.Tag = toTag(useCaseRes.Tag)
+}
+
+type GetTagsRequest struct {
+	TagIDs []string `json:"tag_ids,omitempty"`
+}
+
+func (m *GetTagsRequest) GetTagIDs() []string {
+	if m != nil && m.TagIDs != nil {
+		return m.TagIDs
+	}
+	return nil
+}
+
+func (m *GetTagsRequest) ToUseCaseReq(userID string) *tag.GetTagsRequest {
+	return &tag.GetTagsRequest{
+		UserID: goutil.String(userID),
+		TagIDs: m.TagIDs,
+	}
+}
+
+type GetTagsResponse struct {
+	Tags []*Tag `json:"tags,omitempty"`
+}
+
+func (m *GetTagsResponse) GetTags() []*Tag {
+	if m != nil && m.Tags != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *GetTagsResponse) Set(useCaseRes *tag.GetTagsResponse) {
+	m.Tags = toTags(useCaseRes.Tags)
+}
+
+type DeleteTagRequest struct {
+	TagID *string `json:"tag_id"`
+}
+
+func (m *DeleteTagRequest) GetTagID() string {
+	if m != nil && m.TagID != nil {
+		return *m.TagID
+	}
+	return ""
+}
+
+func (m *DeleteTagRequest) ToUseCaseReq(userID string) *tag.DeleteTagRequest {
+	return &tag.DeleteTagRequest{
+		UserID: goutil.String(userID),
+		TagID:  m.TagID,
+	}
+}
+
+type DeleteTagResponse struct{}
+
+func (m *DeleteTagResponse) Set(useCaseRes *tag.DeleteTagResponse) {}
+
+type SumTagTransactionsRequest struct {
+	TagIDs          []string     `json:"tag_ids,omitempty"`
+	TransactionTime *RangeFilter `json:"transaction_time,omitempty"`
+	TransactionType *uint32      `json:"transaction_type,omitempty"`
+}
+
+func (m *SumTagTransactionsRequest) GetTagIDs() []string {
+	if m != nil && m.TagIDs != nil {
+		return m.TagIDs
+	}
+	return nil
+}
+
+func (m *SumTagTransactionsRequest) GetTransactionTime() *RangeFilter {
+	if m != nil && m.TransactionTime != nil {
+		return m.TransactionTime
+	}
+	return nil
+}
+
+func (m *SumTagTransactionsRequest) GetTransactionType() uint32 {
+	if m != nil && m.TransactionType != nil {
+		return *m.TransactionType
+	}
+	return 0
+}
+
+func (m *SumTagTransactionsRequest) ToUseCaseReq(userID string) *tag.SumTagTransactionsRequest {
+	return &tag.SumTagTransactionsRequest{
+		UserID:          goutil.String(userID),
+		TagIDs:          m.TagIDs,
+		TransactionType: m.TransactionType,
+		TransactionTime: m.TransactionTime.toRangeFilter(),
+	}
+}
+
+type SumTagTransactionsResponse struct {
+	Sums []*Summary `json:"sums,omitempty"`
+}
+
+func (m *SumTagTransactionsResponse) Set(useCaseRes *tag.SumTagTransactionsResponse) {
+	m.Sums = toSummaries(useCaseRes.Sums)
+}
@@ -1,30 +1,90 @@
 package presenter
 
 import (
+	"fmt"
+
 	"github.com/jseow5177/pockteer-be/entity"
 	"github.com/jseow5177/pockteer-be/pkg/goutil"
 	"github.com/jseow5177/pockteer-be/usecase/transaction"
 	"github.com/jseow5177/pockteer-be/util"
 )
 
+type TransactionSplit struct {
+	CategoryID *string   `json:"category_id,omitempty"`
+	Category   *Category `json:"category,omitempty"`
+	Amount     *string   `json:"amount,omitempty"`
+}
+
+func (ts *TransactionSplit) GetCategoryID() string {
+	if ts != nil && ts.CategoryID != nil {
+		return *ts.CategoryID
+	}
+	return ""
+}
+
+func (ts *TransactionSplit) GetCategory() *Category {
+	if ts != nil && ts.Category != nil {
+		return ts.Category
+	}
+	return nil
+}
+
+func (ts *TransactionSplit) GetAmount() string {
+	if ts != nil && ts.Amount != nil {
+		return *ts.Amount
+	}
+	return ""
+}
+
+func (ts *TransactionSplit) toTransactionSplit() *entity.TransactionSplit {
+	amount, _ := util.MonetaryStrToFloat(ts.GetAmount())
+	return entity.NewTransactionSplit(ts.GetCategoryID(), amount)
+}
+
+func toTransactionSplitEntities(tss []*TransactionSplit) []*entity.TransactionSplit {
+	if tss == nil {
+		return nil
+	}
+
+	splits := make([]*entity.TransactionSplit, 0, len(tss))
+	for _, ts := range tss {
+		splits = append(splits, ts.toTransactionSplit())
+	}
+	return splits
+}
+
 type Transaction struct {
-	TransactionID     *string   `json:"transaction_id,omitempty"`
-	CategoryID        *string   `json:"category_id,omitempty"`
-	Category          *Category `json:"category,omitempty"`
-	AccountID         *string   `json:"account_id,omitempty"`
-	Account           *Account  `json:"account,omitempty"`
-	FromAccountID     *string   `json:"from_account_id,omitempty"`
-	FromAccount       *Account  `json:"from_account,omitempty"`
-	ToAccountID       *string   `json:"to_account_id,omitempty"`
-	ToAccount         *Account  `json:"to_account,omitempty"`
-	Currency          *string   `json:"currency,omitempty"`
-	Amount            *string   `json:"amount,omitempty"`
-	Note              *string   `json:"note,omitempty"`
-	TransactionStatus *uint32   `json:"transaction_status,omitempty"`
-	TransactionType   *uint32   `json:"transaction_type,omitempty"`
-	TransactionTime   *uint64   `json:"transaction_time,omitempty"`
-	CreateTime        *uint64   `json:"create_time,omitempty"`
-	UpdateTime        *uint64   `json:"update_time,omitempty"`
+	TransactionID          *string             `json:"transaction_id,omitempty"`
+	CategoryID             *string             `json:"category_id,omitempty"`
+	Category               *Category           `json:"category,omitempty"`
+	AccountID              *string             `json:"account_id,omitempty"`
+	Account                *Account            `json:"account,omitempty"`
+	FromAccountID          *string             `json:"from_account_id,omitempty"`
+	FromAccount            *Account            `json:"from_account,omitempty"`
+	ToAccountID            *string             `json:"to_account_id,omitempty"`
+	ToAccount              *Account            `json:"to_account,omitempty"`
+	Currency               *string             `json:"currency,omitempty"`
+	Amount                 *string             `json:"amount,omitempty"`
+	ReceivedCurrency       *string             `json:"received_currency,omitempty"`
+	ReceivedAmount         *string             `json:"received_amount,omitempty"`
+	Fee                    *string             `json:"fee,omitempty"`
+	ImpliedRate            *string             `json:"implied_rate,omitempty"`
+	Note                   *string             `json:"note,omitempty"`
+	TransactionStatus      *uint32             `json:"transaction_status,omitempty"`
+	ClearStatus            *uint32             `json:"clear_status,omitempty"`
+	TransactionType        *uint32             `json:"transaction_type,omitempty"`
+	TransactionTime        *uint64             `json:"transaction_time,omitempty"`
+	RecurringTransactionID *string             `json:"recurring_transaction_id,omitempty"`
+	ExternalID             *string             `json:"external_id,omitempty"`
+	RefundedTransactionID  *string             `json:"refunded_transaction_id,omitempty"`
+	RepaymentTransactionID *string             `json:"repayment_transaction_id,omitempty"`
+	PayeeID                *string             `json:"payee_id,omitempty"`
+	Payee                  *Payee              `json:"payee,omitempty"`
+	Splits                 []*TransactionSplit `json:"splits,omitempty"`
+	TagIDs                 []string            `json:"tag_ids,omitempty"`
+	Tags                   []*Tag              `json:"tags,omitempty"`
+	CreateTime             *uint64             `json:"create_time,omitempty"`
+	UpdateTime             *uint64             `json:"update_time,omitempty"`
 }
 
 func (t *Transaction) GetTransactionID() string {
@@ -55,6 +115,34 @@ func (t *Transaction) GetToAccountID() string {
 	return ""
 }
 
+func (t *Transaction) GetReceivedCurrency() string {
+	if t != nil && t.ReceivedCurrency != nil {
+		return *t.ReceivedCurrency
+	}
+	return ""
+}
+
+func (t *Transaction) GetReceivedAmount() string {
+	if t != nil && t.ReceivedAmount != nil {
+		return *t.ReceivedAmount
+	}
+	return ""
+}
+
+func (t *Transaction) GetFee() string {
+	if t != nil && t.Fee != nil {
+		return *t.Fee
+	}
+	return ""
+}
+
+func (t *Transaction) GetImpliedRate() string {
+	if t != nil && t.ImpliedRate != nil {
+		return *t.ImpliedRate
+	}
+	return ""
+}
+
 func (t *Transaction) GetCurrency() string {
 	if t != nil && t.Currency != nil {
 		return *t.Currency
@@ -69,6 +157,13 @@ func (t *Transaction) GetNote() string {
 	return ""
 }
 
+func (t *Transaction) GetClearStatus() uint32 {
+	if t != nil && t.ClearStatus != nil {
+		return *t.ClearStatus
+	}
+	return 0
+}
+
 func (t *Transaction) GetTransactionType() uint32 {
 	if t != nil && t.TransactionType != nil {
 		return *t.TransactionType
@@ -90,6 +185,69 @@ func (t *Transaction) GetTransactionTime() uint64 {
 	return 0
 }
 
+func (t *Transaction) GetRecurringTransactionID() string {
+	if t != nil && t.RecurringTransactionID != nil {
+		return *t.RecurringTransactionID
+	}
+	return ""
+}
+
+func (t *Transaction) GetRefundedTransactionID() string {
+	if t != nil && t.RefundedTransactionID != nil {
+		return *t.RefundedTransactionID
+	}
+	return ""
+}
+
+func (t *Transaction) GetRepaymentTransactionID() string {
+	if t != nil && t.RepaymentTransactionID != nil {
+		return *t.RepaymentTransactionID
+	}
+	return ""
+}
+
+func (t *Transaction) GetPayeeID() string {
+	if t != nil && t.PayeeID != nil {
+		return *t.PayeeID
+	}
+	return ""
+}
+
+func (t *Transaction) GetPayee() *Payee {
+	if t != nil && t.Payee != nil {
+		return t.Payee
+	}
+	return nil
+}
+
+func (t *Transaction) GetExternalID() string {
+	if t != nil && t.ExternalID != nil {
+		return *t.ExternalID
+	}
+	return ""
+}
+
+func (t *Transaction) GetSplits() []*TransactionSplit {
+	if t != nil && t.Splits != nil {
+		return t.Splits
+	}
+	return nil
+}
+
+func (t *Transaction) GetTagIDs() []string {
+	if t != nil && t.TagIDs != nil {
+		return t.TagIDs
+	}
+	return nil
+}
+
+func (t *Transaction) GetTags() []*Tag {
+	if t != nil && t.Tags != nil {
+		return t.Tags
+	}
+	return nil
+}
+
 func (t *Transaction) GetCreateTime() uint64 {
 	if t != nil && t.CreateTime != nil {
 		return *t.CreateTime
@@ -133,15 +291,45 @@ func (t *Transaction) GetToAccount() *Account {
 }
 
 type CreateTransactionRequest struct {
-	CategoryID      *string `json:"category_id,omitempty"`
-	AccountID       *string `json:"account_id,omitempty"`
-	FromAccountID   *string `json:"from_account_id,omitempty"`
-	ToAccountID     *string `json:"to_account_id,omitempty"`
-	Amount          *string `json:"amount,omitempty"`
-	Currency        *string `json:"currency,omitempty"`
-	TransactionType *uint32 `json:"transaction_type,omitempty"`
-	TransactionTime *uint64 `json:"transaction_time,omitempty"`
-	Note            *string `json:"note,omitempty"`
+	CategoryID            *string             `json:"category_id,omitempty"`
+	AccountID             *string             `json:"account_id,omitempty"`
+	FromAccountID         *string             `json:"from_account_id,omitempty"`
+	ToAccountID           *string             `json:"to_account_id,omitempty"`
+	Amount                *string             `json:"amount,omitempty"`
+	Currency              *string             `json:"currency,omitempty"`
+	ReceivedAmount        *string             `json:"received_amount,omitempty"`
+	ReceivedCurrency      *string             `json:"received_currency,omitempty"`
+	Fee                   *string             `json:"fee,omitempty"`
+	TransactionType       *uint32             `json:"transaction_type,omitempty"`
+	TransactionTime       *uint64             `json:"transaction_time,omitempty"`
+	Note                  *string             `json:"note,omitempty"`
+	ClearStatus           *uint32             `json:"clear_status,omitempty"`
+	RefundedTransactionID *string             `json:"refunded_transaction_id,omitempty"`
+	PayeeID               *string             `json:"payee_id,omitempty"`
+	Splits                []*TransactionSplit `json:"splits,omitempty"`
+	TagIDs                []string            `json:"tag_ids,omitempty"`
+	InterestCategoryID    *string             `json:"interest_category_id,omitempty"`
+}
+
+func (m *CreateTransactionRequest) GetInterestCategoryID() string {
+	if m != nil && m.InterestCategoryID != nil {
+		return *m.InterestCategoryID
+	}
+	return ""
+}
+
+func (m *CreateTransactionRequest) GetSplits() []*TransactionSplit {
+	if m != nil && m.Splits != nil {
+		return m.Splits
+	}
+	return nil
+}
+
+func (m *CreateTransactionRequest) GetTagIDs() []string {
+	if m != nil && m.TagIDs != nil {
+		return m.TagIDs
+	}
+	return nil
 }
 
 func (m *CreateTransactionRequest) GetAccountID() string {
@@ -179,6 +367,27 @@ func (m *CreateTransactionRequest) GetAmount() string {
 	return ""
 }
 
+func (m *CreateTransactionRequest) GetReceivedAmount() string {
+	if m != nil && m.ReceivedAmount != nil {
+		return *m.ReceivedAmount
+	}
+	return ""
+}
+
+func (m *CreateTransactionRequest) GetReceivedCurrency() string {
+	if m != nil && m.ReceivedCurrency != nil {
+		return *m.ReceivedCurrency
+	}
+	return ""
+}
+
+func (m *CreateTransactionRequest) GetFee() string {
+	if m != nil && m.Fee != nil {
+		return *m.Fee
+	}
+	return ""
+}
+
 func (m *CreateTransactionRequest) GetCurrency() string {
 	if m != nil && m.Currency != nil {
 		return *m.Currency
@@ -200,6 +409,20 @@ func (m *CreateTransactionRequest) GetTransactionTime() uint64 {
 	return 0
 }
 
+func (m *CreateTransactionRequest) GetRefundedTransactionID() string {
+	if m != nil && m.RefundedTransactionID != nil {
+		return *m.RefundedTransactionID
+	}
+	return ""
+}
+
+func (m *CreateTransactionRequest) GetPayeeID() string {
+	if m != nil && m.PayeeID != nil {
+		return *m.PayeeID
+	}
+	return ""
+}
+
 func (m *CreateTransactionRequest) GetNote() string {
 	if m != nil && m.Note != nil {
 		return *m.Note
@@ -207,6 +430,13 @@ func (m *CreateTransactionRequest) GetNote() string {
 	return ""
 }
 
+func (m *CreateTransactionRequest) GetClearStatus() uint32 {
+	if m != nil && m.ClearStatus != nil {
+		return *m.ClearStatus
+	}
+	return 0
+}
+
 func (m *CreateTransactionRequest) ToUseCaseReq(userID string) *transaction.CreateTransactionRequest {
 	var amount *float64
 	if m.Amount != nil {
@@ -214,22 +444,44 @@ func (m *CreateTransactionRequest) ToUseCaseReq(userID string) *transaction.Crea
 		amount = goutil.Float64(a)
 	}
 
+	var receivedAmount *float64
+	if m.ReceivedAmount != nil {
+		ra, _ := util.MonetaryStrToFloat(m.GetReceivedAmount())
+		receivedAmount = goutil.Float64(ra)
+	}
+
+	var fee *float64
+	if m.Fee != nil {
+		f, _ := util.MonetaryStrToFloat(m.GetFee())
+		fee = goutil.Float64(f)
+	}
+
 	return &transaction.CreateTransactionRequest{
-		UserID:          goutil.String(userID),
-		CategoryID:      m.CategoryID,
-		AccountID:       m.AccountID,
-		FromAccountID:   m.FromAccountID,
-		ToAccountID:     m.ToAccountID,
-		Amount:          amount,
-		Currency:        m.Currency,
-		TransactionType: m.TransactionType,
-		TransactionTime: m.TransactionTime,
-		Note:            m.Note,
+		UserID:                goutil.String(userID),
+		CategoryID:            m.CategoryID,
+		AccountID:             m.AccountID,
+		FromAccountID:         m.FromAccountID,
+		ToAccountID:           m.ToAccountID,
+		Amount:                amount,
+		Currency:              m.Currency,
+		ReceivedAmount:        receivedAmount,
+		ReceivedCurrency:      m.ReceivedCurrency,
+		Fee:                   fee,
+		TransactionType:       m.TransactionType,
+		TransactionTime:       m.TransactionTime,
+		Note:                  m.Note,
+		ClearStatus:           m.ClearStatus,
+		RefundedTransactionID: m.RefundedTransactionID,
+		PayeeID:               m.PayeeID,
+		Splits:                toTransactionSplitEntities(m.Splits),
+		TagIDs:                m.TagIDs,
+		InterestCategoryID:    m.InterestCategoryID,
 	}
 }
 
 type CreateTransactionResponse struct {
-	Transaction *Transaction `json:"transaction,omitempty"`
+	Transaction         *Transaction `json:"transaction,omitempty"`
+	InterestTransaction *Transaction `json:"interest_transaction,omitempty"`
 }
 
 func (m *CreateTransactionResponse) GetTransaction() *Transaction {
@@ -239,8 +491,16 @@ func (m *CreateTransactionResponse) GetTransaction() *Transaction {
 	return nil
 }
 
+func (m *CreateTransactionResponse) GetInterestTransaction() *Transaction {
+	if m != nil && m.InterestTransaction != nil {
+		return m.InterestTransaction
+	}
+	return nil
+}
+
 func (m *CreateTransactionResponse) Set(useCaseRes *transaction.CreateTransactionResponse) {
 	m.Transaction = toTransaction(useCaseRes.Transaction)
+	m.InterestTransaction = toTransaction(useCaseRes.InterestTransaction)
 }
 
 type GetTransactionRequest struct {
@@ -282,6 +542,7 @@ type GetTransactionsRequest struct {
 	AccountID       *string      `json:"account_id,omitempty"`
 	TransactionType *uint32      `json:"transaction_type,omitempty"`
 	TransactionTime *RangeFilter `json:"transaction_time,omitempty"`
+	TagIDs          []string     `json:"tag_ids,omitempty"`
 	Paging          *Paging      `json:"paging,omitempty"`
 }
 
@@ -320,6 +581,13 @@ func (m *GetTransactionsRequest) GetTransactionTime() *RangeFilter {
 	return nil
 }
 
+func (m *GetTransactionsRequest) GetTagIDs() []string {
+	if m != nil && m.TagIDs != nil {
+		return m.TagIDs
+	}
+	return nil
+}
+
 func (m *GetTransactionsRequest) GetPaging() *Paging {
 	if m != nil && m.Paging != nil {
 		return m.Paging
@@ -334,6 +602,7 @@ func (m *GetTransactionsRequest) ToUseCaseReq(userID string) *transaction.GetTra
 		AccountID:       m.AccountID,
 		CategoryIDs:     m.CategoryIDs,
 		TransactionType: m.TransactionType,
+		TagIDs:          m.TagIDs,
 		Paging:          m.Paging.toPaging(),
 		TransactionTime: m.TransactionTime.toRangeFilter(),
 	}
@@ -364,16 +633,38 @@ func (m *GetTransactionsResponse) Set(useCaseRes *transaction.GetTransactionsRes
 }
 
 type UpdateTransactionRequest struct {
-	TransactionID   *string `json:"transaction_id,omitempty"`
-	CategoryID      *string `json:"category_id,omitempty"`
-	AccountID       *string `json:"account_id,omitempty"`
-	FromAccountID   *string `json:"from_account_id,omitempty"`
-	ToAccountID     *string `json:"to_account_id,omitempty"`
-	Amount          *string `json:"amount,omitempty"`
-	Note            *string `json:"note,omitempty"`
-	TransactionType *uint32 `json:"transaction_type,omitempty"`
-	TransactionTime *uint64 `json:"transaction_time,omitempty"`
-	Currency        *string `json:"currency,omitempty"`
+	TransactionID         *string             `json:"transaction_id,omitempty"`
+	CategoryID            *string             `json:"category_id,omitempty"`
+	AccountID             *string             `json:"account_id,omitempty"`
+	FromAccountID         *string             `json:"from_account_id,omitempty"`
+	ToAccountID           *string             `json:"to_account_id,omitempty"`
+	Amount                *string             `json:"amount,omitempty"`
+	Note                  *string             `json:"note,omitempty"`
+	ClearStatus           *uint32             `json:"clear_status,omitempty"`
+	TransactionType       *uint32             `json:"transaction_type,omitempty"`
+	TransactionTime       *uint64             `json:"transaction_time,omitempty"`
+	Currency              *string             `json:"currency,omitempty"`
+	ReceivedAmount        *string             `json:"received_amount,omitempty"`
+	ReceivedCurrency      *string             `json:"received_currency,omitempty"`
+	Fee                   *string             `json:"fee,omitempty"`
+	RefundedTransactionID *string             `json:"refunded_transaction_id,omitempty"`
+	PayeeID               *string             `json:"payee_id,omitempty"`
+	Splits                []*TransactionSplit `json:"splits,omitempty"`
+	TagIDs                []string            `json:"tag_ids,omitempty"`
+}
+
+func (t *UpdateTransactionRequest) GetSplits() []*TransactionSplit {
+	if t != nil && t.Splits != nil {
+		return t.Splits
+	}
+	return nil
+}
+
+func (t *UpdateTransactionRequest) GetTagIDs() []string {
+	if t != nil && t.TagIDs != nil {
+		return t.TagIDs
+	}
+	return nil
 }
 
 func (t *UpdateTransactionRequest) GetTransactionID() string {
@@ -418,6 +709,20 @@ func (t *UpdateTransactionRequest) GetAmount() string {
 	return ""
 }
 
+func (t *UpdateTransactionRequest) GetRefundedTransactionID() string {
+	if t != nil && t.RefundedTransactionID != nil {
+		return *t.RefundedTransactionID
+	}
+	return ""
+}
+
+func (t *UpdateTransactionRequest) GetPayeeID() string {
+	if t != nil && t.PayeeID != nil {
+		return *t.PayeeID
+	}
+	return ""
+}
+
 func (t *UpdateTransactionRequest) GetNote() string {
 	if t != nil && t.Note != nil {
 		return *t.Note
@@ -425,6 +730,13 @@ func (t *UpdateTransactionRequest) GetNote() string {
 	return ""
 }
 
+func (t *UpdateTransactionRequest) GetClearStatus() uint32 {
+	if t != nil && t.ClearStatus != nil {
+		return *t.ClearStatus
+	}
+	return 0
+}
+
 func (t *UpdateTransactionRequest) GetTransactionType() uint32 {
 	if t != nil && t.TransactionType != nil {
 		return *t.TransactionType
@@ -439,6 +751,27 @@ func (t *UpdateTransactionRequest) GetTransactionTime() uint64 {
 	return 0
 }
 
+func (t *UpdateTransactionRequest) GetReceivedAmount() string {
+	if t != nil && t.ReceivedAmount != nil {
+		return *t.ReceivedAmount
+	}
+	return ""
+}
+
+func (t *UpdateTransactionRequest) GetReceivedCurrency() string {
+	if t != nil && t.ReceivedCurrency != nil {
+		return *t.ReceivedCurrency
+	}
+	return ""
+}
+
+func (t *UpdateTransactionRequest) GetFee() string {
+	if t != nil && t.Fee != nil {
+		return *t.Fee
+	}
+	return ""
+}
+
 func (t *UpdateTransactionRequest) GetCurrency() string {
 	if t != nil && t.Currency != nil {
 		return *t.Currency
@@ -452,18 +785,39 @@ func (m *UpdateTransactionRequest) ToUseCaseReq(userID string) *transaction.Upda
 		a, _ := util.MonetaryStrToFloat(m.GetAmount())
 		amount = goutil.Float64(a)
 	}
+
+	var receivedAmount *float64
+	if m.ReceivedAmount != nil {
+		ra, _ := util.MonetaryStrToFloat(m.GetReceivedAmount())
+		receivedAmount = goutil.Float64(ra)
+	}
+
+	var fee *float64
+	if m.Fee != nil {
+		f, _ := util.MonetaryStrToFloat(m.GetFee())
+		fee = goutil.Float64(f)
+	}
+
 	return &transaction.UpdateTransactionRequest{
-		UserID:          goutil.String(userID),
-		AccountID:       m.AccountID,
-		FromAccountID:   m.FromAccountID,
-		ToAccountID:     m.ToAccountID,
-		TransactionID:   m.TransactionID,
-		Note:            m.Note,
-		Amount:          amount,
-		TransactionTime: m.TransactionTime,
-		CategoryID:      m.CategoryID,
-		TransactionType: m.TransactionType,
-		Currency:        m.Currency,
+		UserID:                goutil.String(userID),
+		AccountID:             m.AccountID,
+		FromAccountID:         m.FromAccountID,
+		ToAccountID:           m.ToAccountID,
+		TransactionID:         m.TransactionID,
+		Note:                  m.Note,
+		ClearStatus:           m.ClearStatus,
+		Amount:                amount,
+		TransactionTime:       m.TransactionTime,
+		CategoryID:            m.CategoryID,
+		TransactionType:       m.TransactionType,
+		Currency:              m.Currency,
+		ReceivedAmount:        receivedAmount,
+		ReceivedCurrency:      m.ReceivedCurrency,
+		Fee:                   fee,
+		RefundedTransactionID: m.RefundedTransactionID,
+		PayeeID:               m.PayeeID,
+		Splits:                toTransactionSplitEntities(m.Splits),
+		TagIDs:                m.TagIDs,
 	}
 }
 
@@ -486,6 +840,8 @@ type Summary struct {
 	Date            *string        `json:"date,omitempty"`
 	Account         *Account       `json:"account,omitempty"`
 	Category        *Category      `json:"category,omitempty"`
+	Tag             *Tag           `json:"tag,omitempty"`
+	Payee           *Payee         `json:"payee,omitempty"`
 	TransactionType *uint32        `json:"transaction_type,omitempty"`
 	Sum             *string        `json:"sum,omitempty"`
 	TotalExpense    *string        `json:"total_expense,omitempty"`
@@ -503,6 +859,13 @@ func (m *Summary) GetDate() string {
 	return ""
 }
 
+func (m *Summary) GetPayee() *Payee {
+	if m != nil && m.Payee != nil {
+		return m.Payee
+	}
+	return nil
+}
+
 func (m *Summary) GetAccount() *Account {
 	if m != nil && m.Account != nil {
 		return m.Account
@@ -517,6 +880,13 @@ func (m *Summary) GetCategory() *Category {
 	return nil
 }
 
+func (m *Summary) GetTag() *Tag {
+	if m != nil && m.Tag != nil {
+		return m.Tag
+	}
+	return nil
+}
+
 func (m *Summary) GetSum() string {
 	if m != nil && m.Sum != nil {
 		return *m.Sum
@@ -720,3 +1090,675 @@ func (m *GetTransactionsSummaryResponse) Set(useCaseRes *transaction.GetTransact
 	m.TotalExpense = toSummaries(useCaseRes.TotalExpense)
 	m.TotalIncome = toSummaries(useCaseRes.TotalIncome)
 }
+
+type DuplicateTransaction struct {
+	Transaction *Transaction `json:"transaction,omitempty"`
+	Duplicate   *Transaction `json:"duplicate,omitempty"`
+	Confidence  *string      `json:"confidence,omitempty"`
+}
+
+func (dt *DuplicateTransaction) GetTransaction() *Transaction {
+	if dt != nil && dt.Transaction != nil {
+		return dt.Transaction
+	}
+	return nil
+}
+
+func (dt *DuplicateTransaction) GetDuplicate() *Transaction {
+	if dt != nil && dt.Duplicate != nil {
+		return dt.Duplicate
+	}
+	return nil
+}
+
+func (dt *DuplicateTransaction) GetConfidence() string {
+	if dt != nil && dt.Confidence != nil {
+		return *dt.Confidence
+	}
+	return ""
+}
+
+type FindDuplicateTransactionsRequest struct {
+	AccountID       *string      `json:"account_id,omitempty"`
+	TransactionTime *RangeFilter `json:"transaction_time,omitempty"`
+	Window          *uint64      `json:"window,omitempty"`
+	MinConfidence   *string      `json:"min_confidence,omitempty"`
+}
+
+func (m *FindDuplicateTransactionsRequest) GetAccountID() string {
+	if m != nil && m.AccountID != nil {
+		return *m.AccountID
+	}
+	return ""
+}
+
+func (m *FindDuplicateTransactionsRequest) GetTransactionTime() *RangeFilter {
+	if m != nil && m.TransactionTime != nil {
+		return m.TransactionTime
+	}
+	return nil
+}
+
+func (m *FindDuplicateTransactionsRequest) GetWindow() uint64 {
+	if m != nil && m.Window != nil {
+		return *m.Window
+	}
+	return 0
+}
+
+func (m *FindDuplicateTransactionsRequest) GetMinConfidence() string {
+	if m != nil && m.MinConfidence != nil {
+		return *m.MinConfidence
+	}
+	return ""
+}
+
+func (m *FindDuplicateTransactionsRequest) ToUseCaseReq(userID string) *transaction.FindDuplicateTransactionsRequest {
+	var minConfidence *float64
+	if m.MinConfidence != nil {
+		c, _ := util.MonetaryStrToFloat(m.GetMinConfidence())
+		minConfidence = goutil.Float64(c)
+	}
+
+	return &transaction.FindDuplicateTransactionsRequest{
+		UserID:          goutil.String(userID),
+		AccountID:       m.AccountID,
+		TransactionTime: m.TransactionTime.toRangeFilter(),
+		Window:          m.Window,
+		MinConfidence:   minConfidence,
+	}
+}
+
+type FindDuplicateTransactionsResponse struct {
+	Duplicates []*DuplicateTransaction `json:"duplicates,omitempty"`
+}
+
+func (m *FindDuplicateTransactionsResponse) GetDuplicates() []*DuplicateTransaction {
+	if m != nil && m.Duplicates != nil {
+		return m.Duplicates
+	}
+	return nil
+}
+
+func (m *FindDuplicateTransactionsResponse) Set(useCaseRes *transaction.FindDuplicateTransactionsResponse) {
+	m.Duplicates = toDuplicateTransactions(useCaseRes.Duplicates)
+}
+
+type MergeTransactionsRequest struct {
+	TransactionID          *string `json:"transaction_id,omitempty"`
+	DuplicateTransactionID *string `json:"duplicate_transaction_id,omitempty"`
+}
+
+func (m *MergeTransactionsRequest) GetTransactionID() string {
+	if m != nil && m.TransactionID != nil {
+		return *m.TransactionID
+	}
+	return ""
+}
+
+func (m *MergeTransactionsRequest) GetDuplicateTransactionID() string {
+	if m != nil && m.DuplicateTransactionID != nil {
+		return *m.DuplicateTransactionID
+	}
+	return ""
+}
+
+func (m *MergeTransactionsRequest) ToUseCaseReq(userID string) *transaction.MergeTransactionsRequest {
+	return &transaction.MergeTransactionsRequest{
+		UserID:                 goutil.String(userID),
+		TransactionID:          m.TransactionID,
+		DuplicateTransactionID: m.DuplicateTransactionID,
+	}
+}
+
+type MergeTransactionsResponse struct {
+	Transaction *Transaction `json:"transaction,omitempty"`
+}
+
+func (m *MergeTransactionsResponse) GetTransaction() *Transaction {
+	if m != nil && m.Transaction != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *MergeTransactionsResponse) Set(useCaseRes *transaction.MergeTransactionsResponse) {
+	m.Transaction = toTransaction(useCaseRes.Transaction)
+}
+
+type GetDeletedTransactionsRequest struct {
+	Paging *Paging `json:"paging,omitempty"`
+}
+
+func (m *GetDeletedTransactionsRequest) GetPaging() *Paging {
+	if m != nil && m.Paging != nil {
+		return m.Paging
+	}
+	return nil
+}
+
+func (m *GetDeletedTransactionsRequest) ToUseCaseReq(userID string) *transaction.GetDeletedTransactionsRequest {
+	return &transaction.GetDeletedTransactionsRequest{
+		UserID: goutil.String(userID),
+		Paging: m.Paging.toPaging(),
+	}
+}
+
+type GetDeletedTransactionsResponse struct {
+	Transactions []*Transaction `json:"transactions,omitempty"`
+	Paging       *Paging        `json:"paging,omitempty"`
+}
+
+func (m *GetDeletedTransactionsResponse) GetTransactions() []*Transaction {
+	if m != nil && m.Transactions != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+func (m *GetDeletedTransactionsResponse) GetPaging() *Paging {
+	if m != nil && m.Paging != nil {
+		return m.Paging
+	}
+	return nil
+}
+
+func (m *GetDeletedTransactionsResponse) Set(useCaseRes *transaction.GetDeletedTransactionsResponse) {
+	m.Transactions = toTransactions(useCaseRes.Transactions)
+	m.Paging = toPaging(useCaseRes.Paging)
+}
+
+type RestoreTransactionRequest struct {
+	TransactionID *string `json:"transaction_id,omitempty"`
+}
+
+func (m *RestoreTransactionRequest) GetTransactionID() string {
+	if m != nil && m.TransactionID != nil {
+		return *m.TransactionID
+	}
+	return ""
+}
+
+func (m *RestoreTransactionRequest) ToUseCaseReq(userID string) *transaction.RestoreTransactionRequest {
+	return &transaction.RestoreTransactionRequest{
+		UserID:        goutil.String(userID),
+		TransactionID: m.TransactionID,
+	}
+}
+
+type RestoreTransactionResponse struct {
+	Transaction *Transaction `json:"transaction,omitempty"`
+}
+
+func (m *RestoreTransactionResponse) GetTransaction() *Transaction {
+	if m != nil && m.Transaction != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *RestoreTransactionResponse) Set(useCaseRes *transaction.RestoreTransactionResponse) {
+	m.Transaction = toTransaction(useCaseRes.Transaction)
+}
+
+type SearchTransactionsRequest struct {
+	Keyword         *string      `json:"keyword,omitempty"`
+	AccountID       *string      `json:"account_id,omitempty"`
+	CategoryID      *string      `json:"category_id,omitempty"`
+	TransactionTime *RangeFilter `json:"transaction_time,omitempty"`
+	Paging          *Paging      `json:"paging,omitempty"`
+}
+
+func (m *SearchTransactionsRequest) GetKeyword() string {
+	if m != nil && m.Keyword != nil {
+		return *m.Keyword
+	}
+	return ""
+}
+
+func (m *SearchTransactionsRequest) GetAccountID() string {
+	if m != nil && m.AccountID != nil {
+		return *m.AccountID
+	}
+	return ""
+}
+
+func (m *SearchTransactionsRequest) GetCategoryID() string {
+	if m != nil && m.CategoryID != nil {
+		return *m.CategoryID
+	}
+	return ""
+}
+
+func (m *SearchTransactionsRequest) GetTransactionTime() *RangeFilter {
+	if m != nil && m.TransactionTime != nil {
+		return m.TransactionTime
+	}
+	return nil
+}
+
+func (m *SearchTransactionsRequest) GetPaging() *Paging {
+	if m != nil && m.Paging != nil {
+		return m.Paging
+	}
+	return nil
+}
+
+func (m *SearchTransactionsRequest) ToUseCaseReq(userID string) *transaction.SearchTransactionsRequest {
+	return &transaction.SearchTransactionsRequest{
+		UserID:          goutil.String(userID),
+		Keyword:         m.Keyword,
+		AccountID:       m.AccountID,
+		CategoryID:      m.CategoryID,
+		TransactionTime: m.TransactionTime.toRangeFilter(),
+		Paging:          m.Paging.toPaging(),
+	}
+}
+
+type SearchTransactionsResponse struct {
+	Transactions []*Transaction `json:"transactions,omitempty"`
+	Paging       *Paging        `json:"paging,omitempty"`
+}
+
+func (m *SearchTransactionsResponse) GetTransactions() []*Transaction {
+	if m != nil && m.Transactions != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+func (m *SearchTransactionsResponse) GetPaging() *Paging {
+	if m != nil && m.Paging != nil {
+		return m.Paging
+	}
+	return nil
+}
+
+func (m *SearchTransactionsResponse) Set(useCaseRes *transaction.SearchTransactionsResponse) {
+	m.Transactions = toTransactions(useCaseRes.Transactions)
+	m.Paging = toPaging(useCaseRes.Paging)
+}
+
+type BulkUpdateTransactionsRequest struct {
+	TransactionIDs []string                `json:"transaction_ids,omitempty"`
+	Query          *GetTransactionsRequest `json:"query,omitempty"`
+	CategoryID     *string                 `json:"category_id,omitempty"`
+	AccountID      *string                 `json:"account_id,omitempty"`
+	TagIDs         []string                `json:"tag_ids,omitempty"`
+}
+
+func (m *BulkUpdateTransactionsRequest) GetTransactionIDs() []string {
+	if m != nil && m.TransactionIDs != nil {
+		return m.TransactionIDs
+	}
+	return nil
+}
+
+func (m *BulkUpdateTransactionsRequest) GetQuery() *GetTransactionsRequest {
+	if m != nil && m.Query != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *BulkUpdateTransactionsRequest) GetCategoryID() string {
+	if m != nil && m.CategoryID != nil {
+		return *m.CategoryID
+	}
+	return ""
+}
+
+func (m *BulkUpdateTransactionsRequest) GetAccountID() string {
+	if m != nil && m.AccountID != nil {
+		return *m.AccountID
+	}
+	return ""
+}
+
+func (m *BulkUpdateTransactionsRequest) GetTagIDs() []string {
+	if m != nil && m.TagIDs != nil {
+		return m.TagIDs
+	}
+	return nil
+}
+
+func (m *BulkUpdateTransactionsRequest) ToUseCaseReq(userID string) *transaction.BulkUpdateTransactionsRequest {
+	return &transaction.BulkUpdateTransactionsRequest{
+		UserID:     goutil.String(userID),
+		Selection:  toBulkTransactionSelection(userID, m.TransactionIDs, m.Query),
+		CategoryID: m.CategoryID,
+		AccountID:  m.AccountID,
+		TagIDs:     m.TagIDs,
+	}
+}
+
+type BulkUpdateTransactionsResponse struct {
+	Transactions []*Transaction `json:"transactions,omitempty"`
+}
+
+func (m *BulkUpdateTransactionsResponse) GetTransactions() []*Transaction {
+	if m != nil && m.Transactions != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+func (m *BulkUpdateTransactionsResponse) Set(useCaseRes *transaction.BulkUpdateTransactionsResponse) {
+	m.Transactions = toTransactions(useCaseRes.Transactions)
+}
+
+type BulkDeleteTransactionsRequest struct {
+	TransactionIDs []string                `json:"transaction_ids,omitempty"`
+	Query          *GetTransactionsRequest `json:"query,omitempty"`
+}
+
+func (m *BulkDeleteTransactionsRequest) GetTransactionIDs() []string {
+	if m != nil && m.TransactionIDs != nil {
+		return m.TransactionIDs
+	}
+	return nil
+}
+
+func (m *BulkDeleteTransactionsRequest) GetQuery() *GetTransactionsRequest {
+	if m != nil && m.Query != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *BulkDeleteTransactionsRequest) ToUseCaseReq(userID string) *transaction.BulkDeleteTransactionsRequest {
+	return &transaction.BulkDeleteTransactionsRequest{
+		UserID:    goutil.String(userID),
+		Selection: toBulkTransactionSelection(userID, m.TransactionIDs, m.Query),
+	}
+}
+
+type BulkDeleteTransactionsResponse struct {
+	Deleted *uint32 `json:"deleted,omitempty"`
+}
+
+func (m *BulkDeleteTransactionsResponse) GetDeleted() uint32 {
+	if m != nil && m.Deleted != nil {
+		return *m.Deleted
+	}
+	return 0
+}
+
+func (m *BulkDeleteTransactionsResponse) Set(useCaseRes *transaction.BulkDeleteTransactionsResponse) {
+	m.Deleted = useCaseRes.Deleted
+}
+
+type ReconcileAccountRequest struct {
+	AccountID      *string `json:"account_id,omitempty"`
+	StatementTime  *uint64 `json:"statement_time,omitempty"`
+	ClosingBalance *string `json:"closing_balance,omitempty"` // negative for debt accounts, as the account balance
+}
+
+func (m *ReconcileAccountRequest) GetAccountID() string {
+	if m != nil && m.AccountID != nil {
+		return *m.AccountID
+	}
+	return ""
+}
+
+func (m *ReconcileAccountRequest) GetStatementTime() uint64 {
+	if m != nil && m.StatementTime != nil {
+		return *m.StatementTime
+	}
+	return 0
+}
+
+func (m *ReconcileAccountRequest) GetClosingBalance() string {
+	if m != nil && m.ClosingBalance != nil {
+		return *m.ClosingBalance
+	}
+	return ""
+}
+
+func (m *ReconcileAccountRequest) ToUseCaseReq(userID string) *transaction.ReconcileAccountRequest {
+	closingBalance, _ := util.MonetaryStrToFloat(m.GetClosingBalance())
+
+	return &transaction.ReconcileAccountRequest{
+		UserID:         goutil.String(userID),
+		AccountID:      m.AccountID,
+		StatementTime:  m.StatementTime,
+		ClosingBalance: goutil.Float64(closingBalance),
+	}
+}
+
+type ReconcileAccountResponse struct {
+	ClearedBalance *string `json:"cleared_balance,omitempty"`
+	Difference     *string `json:"difference,omitempty"`
+	IsReconciled   *bool   `json:"is_reconciled,omitempty"`
+	Reconciled     *uint32 `json:"reconciled,omitempty"`
+}
+
+func (m *ReconcileAccountResponse) GetClearedBalance() string {
+	if m != nil && m.ClearedBalance != nil {
+		return *m.ClearedBalance
+	}
+	return ""
+}
+
+func (m *ReconcileAccountResponse) GetDifference() string {
+	if m != nil && m.Difference != nil {
+		return *m.Difference
+	}
+	return ""
+}
+
+func (m *ReconcileAccountResponse) GetIsReconciled() bool {
+	if m != nil && m.IsReconciled != nil {
+		return *m.IsReconciled
+	}
+	return false
+}
+
+func (m *ReconcileAccountResponse) GetReconciled() uint32 {
+	if m != nil && m.Reconciled != nil {
+		return *m.Reconciled
+	}
+	return 0
+}
+
+func (m *ReconcileAccountResponse) Set(useCaseRes *transaction.ReconcileAccountResponse) {
+	m.ClearedBalance = goutil.String(fmt.Sprint(useCaseRes.GetClearedBalance()))
+	m.Difference = goutil.String(fmt.Sprint(useCaseRes.GetDifference()))
+	m.IsReconciled = goutil.Bool(useCaseRes.IsReconciled())
+	m.Reconciled = useCaseRes.Reconciled
+}
+
+type GetCreditCardCycleRequest struct {
+	AccountID *string  `json:"account_id,omitempty"`
+	AppMeta   *AppMeta `json:"app_meta,omitempty"`
+}
+
+func (m *GetCreditCardCycleRequest) GetAccountID() string {
+	if m != nil && m.AccountID != nil {
+		return *m.AccountID
+	}
+	return ""
+}
+
+func (m *GetCreditCardCycleRequest) GetAppMeta() *AppMeta {
+	if m != nil && m.AppMeta != nil {
+		return m.AppMeta
+	}
+	return nil
+}
+
+func (m *GetCreditCardCycleRequest) ToUseCaseReq(userID string) *transaction.GetCreditCardCycleRequest {
+	return &transaction.GetCreditCardCycleRequest{
+		UserID:    goutil.String(userID),
+		AccountID: m.AccountID,
+		AppMeta:   m.AppMeta.toAppMeta(),
+	}
+}
+
+type GetCreditCardCycleResponse struct {
+	Account          *Account `json:"account,omitempty"`
+	CycleStart       *uint64  `json:"cycle_start,omitempty"`
+	CycleEnd         *uint64  `json:"cycle_end,omitempty"`
+	DueTime          *uint64  `json:"due_time,omitempty"`
+	CycleSpend       *string  `json:"cycle_spend,omitempty"`
+	StatementBalance *string  `json:"statement_balance,omitempty"`
+	Payments         *string  `json:"payments,omitempty"`
+	MinimumPayment   *string  `json:"minimum_payment,omitempty"`
+	AmountDue        *string  `json:"amount_due,omitempty"`
+	Utilisation      *string  `json:"utilisation,omitempty"`
+}
+
+func (m *GetCreditCardCycleResponse) GetAccount() *Account {
+	if m != nil && m.Account != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *GetCreditCardCycleResponse) GetCycleStart() uint64 {
+	if m != nil && m.CycleStart != nil {
+		return *m.CycleStart
+	}
+	return 0
+}
+
+func (m *GetCreditCardCycleResponse) GetCycleEnd() uint64 {
+	if m != nil && m.CycleEnd != nil {
+		return *m.CycleEnd
+	}
+	return 0
+}
+
+func (m *GetCreditCardCycleResponse) GetDueTime() uint64 {
+	if m != nil && m.DueTime != nil {
+		return *m.DueTime
+	}
+	return 0
+}
+
+func (m *GetCreditCardCycleResponse) GetCycleSpend() string {
+	if m != nil && m.CycleSpend != nil {
+		return *m.CycleSpend
+	}
+	return ""
+}
+
+func (m *GetCreditCardCycleResponse) GetStatementBalance() string {
+	if m != nil && m.StatementBalance != nil {
+		return *m.StatementBalance
+	}
+	return ""
+}
+
+func (m *GetCreditCardCycleResponse) GetPayments() string {
+	if m != nil && m.Payments != nil {
+		return *m.Payments
+	}
+	return ""
+}
+
+func (m *GetCreditCardCycleResponse) GetMinimumPayment() string {
+	if m != nil && m.MinimumPayment != nil {
+		return *m.MinimumPayment
+	}
+	return ""
+}
+
+func (m *GetCreditCardCycleResponse) GetAmountDue() string {
+	if m != nil && m.AmountDue != nil {
+		return *m.AmountDue
+	}
+	return ""
+}
+
+func (m *GetCreditCardCycleResponse) GetUtilisation() string {
+	if m != nil && m.Utilisation != nil {
+		return *m.Utilisation
+	}
+	return ""
+}
+
+func (m *GetCreditCardCycleResponse) Set(useCaseRes *transaction.GetCreditCardCycleResponse) {
+	m.Account = toAccount(useCaseRes.Account)
+	m.CycleStart = useCaseRes.CycleStart
+	m.CycleEnd = useCaseRes.CycleEnd
+	m.DueTime = useCaseRes.DueTime
+	m.CycleSpend = goutil.String(fmt.Sprint(useCaseRes.GetCycleSpend()))
+	m.StatementBalance = goutil.String(fmt.Sprint(useCaseRes.GetStatementBalance()))
+	m.Payments = goutil.String(fmt.Sprint(useCaseRes.GetPayments()))
+	m.MinimumPayment = goutil.String(fmt.Sprint(useCaseRes.GetMinimumPayment()))
+	m.AmountDue = goutil.String(fmt.Sprint(useCaseRes.GetAmountDue()))
+
+	if useCaseRes.Utilisation != nil {
+		m.Utilisation = goutil.String(fmt.Sprint(useCaseRes.GetUtilisation()))
+	}
+}
+
+type GetAccountBalanceHistoryRequest struct {
+	AccountID *string  `json:"account_id,omitempty"`
+	AppMeta   *AppMeta `json:"app_meta,omitempty"`
+	Unit      *uint32  `json:"unit,omitempty"`
+	Interval  *uint32  `json:"interval,omitempty"`
+}
+
+func (m *GetAccountBalanceHistoryRequest) GetAccountID() string {
+	if m != nil && m.AccountID != nil {
+		return *m.AccountID
+	}
+	return ""
+}
+
+func (m *GetAccountBalanceHistoryRequest) GetAppMeta() *AppMeta {
+	if m != nil && m.AppMeta != nil {
+		return m.AppMeta
+	}
+	return nil
+}
+
+func (m *GetAccountBalanceHistoryRequest) GetUnit() uint32 {
+	if m != nil && m.Unit != nil {
+		return *m.Unit
+	}
+	return 0
+}
+
+func (m *GetAccountBalanceHistoryRequest) GetInterval() uint32 {
+	if m != nil && m.Interval != nil {
+		return *m.Interval
+	}
+	return 0
+}
+
+func (m *GetAccountBalanceHistoryRequest) ToUseCaseReq(userID string) *transaction.GetAccountBalanceHistoryRequest {
+	return &transaction.GetAccountBalanceHistoryRequest{
+		UserID:    goutil.String(userID),
+		AccountID: m.AccountID,
+		AppMeta:   m.AppMeta.toAppMeta(),
+		Unit:      m.Unit,
+		Interval:  m.Interval,
+	}
+}
+
+type GetAccountBalanceHistoryResponse struct {
+	Account  *Account   `json:"account,omitempty"`
+	Balances []*Summary `json:"balances,omitempty"`
+}
+
+func (m *GetAccountBalanceHistoryResponse) GetAccount() *Account {
+	if m != nil && m.Account != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *GetAccountBalanceHistoryResponse) GetBalances() []*Summary {
+	if m != nil && m.Balances != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+func (m *GetAccountBalanceHistoryResponse) Set(useCaseRes *transaction.GetAccountBalanceHistoryResponse) {
+	m.Account = toAccount(useCaseRes.Account)
+	m.Balances = toSummaries(useCaseRes.Balances)
+}
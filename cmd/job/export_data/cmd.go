@@ -0,0 +1,101 @@
+package exportdata
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/dep/repo/mongo"
+	"github.com/jseow5177/pockteer-be/pkg/errutil"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/jseow5177/pockteer-be/usecase/exporter"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	ErrMissingUserID = errutil.ValidationError(errors.New("user_id is required"))
+)
+
+type ExportDataCmd struct {
+	mongo *mongo.Mongo
+
+	exporterUseCase exporter.UseCase
+
+	userID string
+	out    string
+}
+
+func (c *ExportDataCmd) initFlags() error {
+	flagSet := flag.NewFlagSet(fmt.Sprintf("%s %s", filepath.Base(os.Args[0]), os.Args[1]), flag.ExitOnError)
+
+	flagSet.StringVar(&c.userID, "user_id", "", "user to export")
+	flagSet.StringVar(&c.out, "out", exporter.ArchiveName(time.Now()), "path of the zip archive")
+
+	if err := flagSet.Parse(os.Args[2:]); err != nil {
+		return err
+	}
+
+	if c.userID == "" {
+		return ErrMissingUserID
+	}
+
+	return nil
+}
+
+func (c *ExportDataCmd) Init(ctx context.Context, cfg *config.Config) error {
+	var err error
+
+	if err = c.initFlags(); err != nil {
+		return err
+	}
+
+	// init mongo
+	c.mongo, err = mongo.NewMongo(ctx, cfg.Mongo)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to init mongo client, err: %v", err)
+		return err
+	}
+
+	c.exporterUseCase = exporter.NewExporterUseCase(
+		mongo.NewAccountMongo(c.mongo),
+		mongo.NewTransactionMongo(c.mongo),
+		mongo.NewCategoryMongo(c.mongo),
+		mongo.NewBudgetMongo(c.mongo),
+		mongo.NewHoldingMongo(c.mongo),
+		mongo.NewLotMongo(c.mongo),
+		mongo.NewSnapshotMongo(c.mongo),
+	)
+
+	return nil
+}
+
+func (c *ExportDataCmd) Run(ctx context.Context) error {
+	f, err := os.Create(c.out)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to create archive file, err: %v", err)
+		return err
+	}
+	defer f.Close()
+
+	res, err := c.exporterUseCase.ExportData(ctx, &exporter.ExportDataRequest{
+		UserID: goutil.String(c.userID),
+		Writer: f,
+	})
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to export data, userID: %v, err: %v", c.userID, err)
+		return err
+	}
+
+	log.Ctx(ctx).Info().Msgf("exported %d records of user %v to %v", res.GetRecords(), c.userID, c.out)
+
+	return nil
+}
+
+func (c *ExportDataCmd) Clean(ctx context.Context) error {
+	return c.mongo.Close(ctx)
+}
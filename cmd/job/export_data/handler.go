@@ -0,0 +1,72 @@
+package exportdata
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/jseow5177/pockteer-be/usecase/exporter"
+	"github.com/rs/zerolog/log"
+)
+
+type ExportDataHandler struct {
+	cmd *ExportDataCmd
+}
+
+type ExportDataRequest struct {
+	UserID *string `json:"user_id,omitempty"`
+}
+
+func (m *ExportDataRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+// ExportDataResponse is streamed to the admin as a zip file.
+type ExportDataResponse struct {
+	fileName string
+	stream   func(w io.Writer) error
+}
+
+func (m *ExportDataResponse) ContentType() string {
+	return "application/zip"
+}
+
+func (m *ExportDataResponse) FileName() string {
+	return m.fileName
+}
+
+func (m *ExportDataResponse) Stream(w io.Writer) error {
+	return m.stream(w)
+}
+
+func NewExportDataHandler(exporterUseCase exporter.UseCase) *ExportDataHandler {
+	return &ExportDataHandler{
+		cmd: &ExportDataCmd{
+			exporterUseCase: exporterUseCase,
+		},
+	}
+}
+
+func (h *ExportDataHandler) ExportData(ctx context.Context, req *ExportDataRequest, res *ExportDataResponse) error {
+	if req.GetUserID() == "" {
+		return ErrMissingUserID
+	}
+
+	res.fileName = exporter.ArchiveName(time.Now())
+	res.stream = func(w io.Writer) error {
+		if _, err := h.cmd.exporterUseCase.ExportData(ctx, &exporter.ExportDataRequest{
+			UserID: goutil.String(req.GetUserID()),
+			Writer: w,
+		}); err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to export data, userID: %v, err: %v", req.GetUserID(), err)
+			return err
+		}
+		return nil
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package initnotetokens
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/dep/repo"
+	"github.com/jseow5177/pockteer-be/dep/repo/mongo"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/filter"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/rs/zerolog/log"
+)
+
+type InitNoteTokens struct {
+	mongo *mongo.Mongo
+
+	userRepo        repo.UserRepo
+	transactionRepo repo.TransactionRepo
+}
+
+func (c *InitNoteTokens) Init(ctx context.Context, cfg *config.Config) error {
+	var err error
+
+	// init mongo
+	c.mongo, err = mongo.NewMongo(ctx, cfg.Mongo)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to init mongo client, err: %v", err)
+		return err
+	}
+
+	// init repos
+	c.userRepo = mongo.NewUserMongo(c.mongo)
+	c.transactionRepo = mongo.NewTransactionMongo(c.mongo)
+
+	return nil
+}
+
+func (c *InitNoteTokens) Run(ctx context.Context) error {
+	if err := mongo.InitTransactionIndexes(ctx, c.mongo); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to init transaction indexes, err: %v", err)
+		return err
+	}
+
+	var (
+		page  = 1
+		limit = 1000
+		count = 0
+	)
+
+	p := &repo.Paging{
+		Limit: goutil.Uint32(uint32(limit)),
+		Page:  goutil.Uint32(uint32(page)),
+	}
+
+	for {
+		us, err := c.userRepo.GetMany(ctx, repo.NewUserFilter(
+			repo.WithUserPaging(p),
+		))
+		if err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to get users from repo, err: %v", err)
+			return err
+		}
+
+		for _, u := range us {
+			n, err := c.initUserNoteTokens(ctx, u)
+			if err != nil {
+				// continue with other users, the job can be rerun safely
+				log.Ctx(ctx).Error().Msgf("fail to init note tokens, userID: %v, err: %v", u.GetUserID(), err)
+				continue
+			}
+			count += n
+		}
+
+		if len(us) < limit {
+			break
+		}
+
+		page++
+		p.Page = goutil.Uint32(uint32(page))
+	}
+
+	log.Ctx(ctx).Info().Msgf("init note tokens of %d transactions", count)
+
+	return nil
+}
+
+func (c *InitNoteTokens) Clean(ctx context.Context) error {
+	return c.mongo.Close(ctx)
+}
+
+// initUserNoteTokens rewrites the note of every transaction of the user, which also saves its tokens.
+// Update time is left untouched, as the transaction itself does not change.
+func (c *InitNoteTokens) initUserNoteTokens(ctx context.Context, u *entity.User) (int, error) {
+	var (
+		page  = 1
+		limit = 1000
+		count = 0
+	)
+
+	for {
+		ts, err := c.transactionRepo.GetMany(ctx, &repo.TransactionQuery{
+			Filters: []*repo.TransactionFilter{
+				repo.NewTransactionFilter(
+					u.GetUserID(),
+					repo.WithTransactionStatus(nil), // deleted transactions may be restored
+				),
+			},
+			Paging: &repo.Paging{
+				Limit: goutil.Uint32(uint32(limit)),
+				Page:  goutil.Uint32(uint32(page)),
+				Sorts: []filter.Sort{
+					&repo.Sort{
+						Field: goutil.String("_id"),
+						Order: goutil.String(config.OrderAsc),
+					},
+				},
+			},
+		})
+		if err != nil {
+			return count, err
+		}
+
+		for _, t := range ts {
+			if t.GetNote() == "" {
+				continue
+			}
+
+			if err := c.transactionRepo.Update(ctx, repo.NewTransactionFilter(
+				u.GetUserID(),
+				repo.WithTransactionID(t.TransactionID),
+				repo.WithTransactionStatus(nil),
+			), &entity.TransactionUpdate{
+				Note: t.Note,
+			}); err != nil {
+				return count, err
+			}
+			count++
+		}
+
+		if len(ts) < limit {
+			break
+		}
+
+		page++
+	}
+
+	return count, nil
+}
@@ -11,9 +11,15 @@ import (
 	"github.com/jseow5177/pockteer-be/pkg/logger"
 	"github.com/rs/zerolog/log"
 
+	ed "github.com/jseow5177/pockteer-be/cmd/job/export_data"
 	ier "github.com/jseow5177/pockteer-be/cmd/job/init_exchange_rates"
+	nt "github.com/jseow5177/pockteer-be/cmd/job/init_note_tokens"
 	is "github.com/jseow5177/pockteer-be/cmd/job/init_symbols"
+	pi "github.com/jseow5177/pockteer-be/cmd/job/post_interest"
+	pt "github.com/jseow5177/pockteer-be/cmd/job/purge_trash"
+	rrt "github.com/jseow5177/pockteer-be/cmd/job/run_recurring_transactions"
 	ss "github.com/jseow5177/pockteer-be/cmd/job/save_snapshot"
+	spr "github.com/jseow5177/pockteer-be/cmd/job/send_payment_reminders"
 	sq "github.com/jseow5177/pockteer-be/cmd/job/sync_quotes"
 )
 
@@ -43,6 +49,30 @@ var cmds = map[string]struct {
 		desc: "take snapshots of user financial status",
 		job:  new(ss.SaveSnapshot),
 	},
+	"run_recurring_transactions": {
+		desc: "create due transactions from recurring transactions",
+		job:  new(rrt.RunRecurringTransactions),
+	},
+	"export_data": {
+		desc: "export user data as a zip of csv and json files",
+		job:  new(ed.ExportDataCmd),
+	},
+	"purge_trash": {
+		desc: "hard delete records deleted longer than the retention period",
+		job:  new(pt.PurgeTrash),
+	},
+	"init_note_tokens": {
+		desc: "create the note search index and save search tokens of existing transactions",
+		job:  new(nt.InitNoteTokens),
+	},
+	"send_payment_reminders": {
+		desc: "email reminders of credit card payments due in a number of days",
+		job:  new(spr.SendPaymentReminders),
+	},
+	"post_interest": {
+		desc: "post interest accrued on bank accounts as income transactions",
+		job:  new(pi.PostInterest),
+	},
 }
 
 func main() {
@@ -0,0 +1,116 @@
+package postinterest
+
+import (
+	"context"
+	"time"
+
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/dep/api/finnhub"
+	"github.com/jseow5177/pockteer-be/dep/repo"
+	"github.com/jseow5177/pockteer-be/dep/repo/mongo"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/rs/zerolog/log"
+
+	acuc "github.com/jseow5177/pockteer-be/usecase/account"
+)
+
+type PostInterest struct {
+	mongo *mongo.Mongo
+
+	accountUseCase acuc.UseCase
+
+	userRepo repo.UserRepo
+}
+
+func (c *PostInterest) Init(ctx context.Context, cfg *config.Config) error {
+	var err error
+
+	// init mongo
+	c.mongo, err = mongo.NewMongo(ctx, cfg.Mongo)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to init mongo client, err: %v", err)
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = c.mongo.Close(ctx)
+		}
+	}()
+
+	securityAPI := finnhub.NewFinnHubMgr(cfg.FinnHub)
+	quoteRepo, err := mongo.NewQuoteMongo(ctx, c.mongo, securityAPI)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to init quote repo, err: %v", err)
+		return err
+	}
+
+	// init repos
+	c.userRepo = mongo.NewUserMongo(c.mongo)
+
+	exchangeRateRepo, err := mongo.NewExchangeRateMongo(ctx, c.mongo)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to init exchange rate repo, err: %v", err)
+		return err
+	}
+
+	// init use cases
+	c.accountUseCase = acuc.NewAccountUseCase(
+		c.mongo, mongo.NewAccountMongo(c.mongo), mongo.NewTransactionMongo(c.mongo), mongo.NewHoldingMongo(c.mongo),
+		mongo.NewLotMongo(c.mongo), quoteRepo, mongo.NewSecurityMongo(c.mongo), exchangeRateRepo, mongo.NewSnapshotMongo(c.mongo),
+		mongo.NewCategoryMongo(c.mongo),
+	)
+
+	return nil
+}
+
+func (c *PostInterest) Run(ctx context.Context) error {
+	var (
+		page  = 1
+		limit = 1000
+		now   = uint64(time.Now().UnixMilli())
+		count = 0
+	)
+
+	p := &repo.Paging{
+		Limit: goutil.Uint32(uint32(limit)),
+		Page:  goutil.Uint32(uint32(page)),
+	}
+
+	for {
+		us, err := c.userRepo.GetMany(ctx, repo.NewUserFilter(
+			repo.WithUserPaging(p),
+		))
+		if err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to get users from repo, err: %v", err)
+			return err
+		}
+
+		for _, u := range us {
+			res, err := c.accountUseCase.PostInterest(ctx, &acuc.PostInterestRequest{
+				UserID: u.UserID,
+				Now:    goutil.Uint64(now),
+			})
+			if err != nil {
+				// continue with other users, accrued interest is posted on the next run
+				log.Ctx(ctx).Error().Msgf("fail to post interest, userID: %v, err: %v", u.GetUserID(), err)
+				continue
+			}
+			count += len(res.GetTransactions())
+		}
+
+		if len(us) < limit {
+			break
+		}
+
+		page++
+		p.Page = goutil.Uint32(uint32(page))
+	}
+
+	log.Ctx(ctx).Info().Msgf("posted %d interest transactions", count)
+
+	return nil
+}
+
+func (c *PostInterest) Clean(ctx context.Context) error {
+	return c.mongo.Close(ctx)
+}
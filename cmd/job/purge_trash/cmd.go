@@ -0,0 +1,132 @@
+package purgetrash
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/dep/blob/local"
+	"github.com/jseow5177/pockteer-be/dep/repo"
+	"github.com/jseow5177/pockteer-be/dep/repo/mongo"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/jseow5177/pockteer-be/usecase/trash"
+	"github.com/rs/zerolog/log"
+)
+
+type PurgeTrash struct {
+	mongo *mongo.Mongo
+
+	trashUseCase trash.UseCase
+
+	userRepo repo.UserRepo
+
+	retentionDays int
+}
+
+func (c *PurgeTrash) initFlags() error {
+	flagSet := flag.NewFlagSet(fmt.Sprintf("%s %s", filepath.Base(os.Args[0]), os.Args[1]), flag.ExitOnError)
+
+	flagSet.IntVar(&c.retentionDays, "retention_days", config.DefaultTrashRetentionDays, "days to keep deleted records")
+
+	return flagSet.Parse(os.Args[2:])
+}
+
+func (c *PurgeTrash) Init(ctx context.Context, cfg *config.Config) error {
+	var err error
+
+	if err = c.initFlags(); err != nil {
+		return err
+	}
+
+	// init mongo
+	c.mongo, err = mongo.NewMongo(ctx, cfg.Mongo)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to init mongo client, err: %v", err)
+		return err
+	}
+
+	// init repos
+	c.userRepo = mongo.NewUserMongo(c.mongo)
+
+	// init blob store
+	attachmentStore, err := local.NewLocalStore(cfg.AttachmentStore)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to init attachment store, err: %v", err)
+		return err
+	}
+
+	// init use cases
+	c.trashUseCase = trash.NewTrashUseCase(
+		c.mongo,
+		mongo.NewTransactionMongo(c.mongo),
+		mongo.NewAccountMongo(c.mongo),
+		mongo.NewCategoryMongo(c.mongo),
+		mongo.NewHoldingMongo(c.mongo),
+		mongo.NewLotMongo(c.mongo),
+		mongo.NewAttachmentMongo(c.mongo),
+		attachmentStore,
+	)
+
+	return nil
+}
+
+func (c *PurgeTrash) Run(ctx context.Context) error {
+	var (
+		page   = 1
+		limit  = 1000
+		before = uint64(time.Now().AddDate(0, 0, -c.retentionDays).UnixMilli())
+
+		transactions, accounts, categories, attachments uint32
+	)
+
+	p := &repo.Paging{
+		Limit: goutil.Uint32(uint32(limit)),
+		Page:  goutil.Uint32(uint32(page)),
+	}
+
+	for {
+		us, err := c.userRepo.GetMany(ctx, repo.NewUserFilter(
+			repo.WithUserPaging(p),
+		))
+		if err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to get users from repo, err: %v", err)
+			return err
+		}
+
+		for _, u := range us {
+			res, err := c.trashUseCase.PurgeTrash(ctx, &trash.PurgeTrashRequest{
+				UserID: u.UserID,
+				Before: goutil.Uint64(before),
+			})
+			if err != nil {
+				// continue with other users, the trash is purged again on the next run
+				log.Ctx(ctx).Error().Msgf("fail to purge trash, userID: %v, err: %v", u.GetUserID(), err)
+				continue
+			}
+			transactions += res.GetTransactions()
+			accounts += res.GetAccounts()
+			categories += res.GetCategories()
+			attachments += res.GetAttachments()
+		}
+
+		if len(us) < limit {
+			break
+		}
+
+		page++
+		p.Page = goutil.Uint32(uint32(page))
+	}
+
+	log.Ctx(ctx).Info().Msgf("purged %d transactions, %d accounts and %d categories deleted over %d days ago, with %d attachments",
+		transactions, accounts, categories, c.retentionDays, attachments)
+
+	return nil
+}
+
+func (c *PurgeTrash) Clean(ctx context.Context) error {
+	return c.mongo.Close(ctx)
+}
@@ -0,0 +1,122 @@
+package runrecurringtransactions
+
+import (
+	"context"
+	"time"
+
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/dep/repo"
+	"github.com/jseow5177/pockteer-be/dep/repo/mongo"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/rs/zerolog/log"
+
+	rtuc "github.com/jseow5177/pockteer-be/usecase/recurring_transaction"
+	ruc "github.com/jseow5177/pockteer-be/usecase/rule"
+	tuc "github.com/jseow5177/pockteer-be/usecase/transaction"
+)
+
+type RunRecurringTransactions struct {
+	mongo *mongo.Mongo
+
+	recurringTransactionUseCase rtuc.UseCase
+
+	userRepo repo.UserRepo
+}
+
+func (c *RunRecurringTransactions) Init(ctx context.Context, cfg *config.Config) error {
+	var err error
+
+	// init mongo
+	c.mongo, err = mongo.NewMongo(ctx, cfg.Mongo)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to init mongo client, err: %v", err)
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = c.mongo.Close(ctx)
+		}
+	}()
+
+	// init repos
+	c.userRepo = mongo.NewUserMongo(c.mongo)
+
+	var (
+		categoryRepo    = mongo.NewCategoryMongo(c.mongo)
+		accountRepo     = mongo.NewAccountMongo(c.mongo)
+		transactionRepo = mongo.NewTransactionMongo(c.mongo)
+	)
+
+	exchangeRateRepo, err := mongo.NewExchangeRateMongo(ctx, c.mongo)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to init exchange rate repo, err: %v", err)
+		return err
+	}
+
+	// init use cases
+	ruleUseCase := ruc.NewRuleUseCase(c.mongo, mongo.NewRuleMongo(c.mongo), categoryRepo, accountRepo, transactionRepo)
+	transactionUseCase := tuc.NewTransactionUseCase(
+		c.mongo, categoryRepo, accountRepo,
+		transactionRepo, mongo.NewBudgetMongo(c.mongo), exchangeRateRepo, mongo.NewTagMongo(c.mongo),
+		mongo.NewPayeeMongo(c.mongo), ruleUseCase,
+	)
+	c.recurringTransactionUseCase = rtuc.NewRecurringTransactionUseCase(
+		mongo.NewRecurringTransactionMongo(c.mongo), transactionRepo, categoryRepo, accountRepo, transactionUseCase,
+	)
+
+	return nil
+}
+
+func (c *RunRecurringTransactions) Run(ctx context.Context) error {
+	var (
+		page  = 1
+		limit = 1000
+		now   = uint64(time.Now().UnixMilli())
+		count = 0
+	)
+
+	p := &repo.Paging{
+		Limit: goutil.Uint32(uint32(limit)),
+		Page:  goutil.Uint32(uint32(page)),
+	}
+
+	for {
+		us, err := c.userRepo.GetMany(ctx, repo.NewUserFilter(
+			repo.WithUserPaging(p),
+		))
+		if err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to get users from repo, err: %v", err)
+			return err
+		}
+
+		for _, u := range us {
+			ctxWithUser := entity.SetUserToCtx(ctx, u)
+			res, err := c.recurringTransactionUseCase.RunRecurringTransactions(ctxWithUser, &rtuc.RunRecurringTransactionsRequest{
+				UserID: u.UserID,
+				Now:    goutil.Uint64(now),
+			})
+			if err != nil {
+				// continue with other users, missed occurrences are caught up on the next run
+				log.Ctx(ctx).Error().Msgf("fail to run recurring transactions, userID: %v, err: %v", u.GetUserID(), err)
+				continue
+			}
+			count += len(res.GetTransactions())
+		}
+
+		if len(us) < limit {
+			break
+		}
+
+		page++
+		p.Page = goutil.Uint32(uint32(page))
+	}
+
+	log.Ctx(ctx).Info().Msgf("created %d transactions from recurring transactions", count)
+
+	return nil
+}
+
+func (c *RunRecurringTransactions) Clean(ctx context.Context) error {
+	return c.mongo.Close(ctx)
+}
@@ -0,0 +1,217 @@
+package sendpaymentreminders
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/dep/mailer"
+	"github.com/jseow5177/pockteer-be/dep/mailer/brevo"
+	"github.com/jseow5177/pockteer-be/dep/mailer/gmail"
+	"github.com/jseow5177/pockteer-be/dep/repo"
+	"github.com/jseow5177/pockteer-be/dep/repo/mongo"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/jseow5177/pockteer-be/usecase/common"
+	"github.com/rs/zerolog/log"
+
+	ruc "github.com/jseow5177/pockteer-be/usecase/rule"
+	tuc "github.com/jseow5177/pockteer-be/usecase/transaction"
+)
+
+type SendPaymentReminders struct {
+	mongo  *mongo.Mongo
+	mailer mailer.Mailer
+
+	transactionUseCase tuc.UseCase
+
+	userRepo    repo.UserRepo
+	accountRepo repo.AccountRepo
+
+	daysBefore int
+	timezone   string
+}
+
+func (c *SendPaymentReminders) initFlags() error {
+	flagSet := flag.NewFlagSet(fmt.Sprintf("%s %s", filepath.Base(os.Args[0]), os.Args[1]), flag.ExitOnError)
+
+	flagSet.IntVar(&c.daysBefore, "days_before", config.DefaultPaymentReminderDays, "days before the due date to send reminders")
+	flagSet.StringVar(&c.timezone, "timezone", config.DefaultPaymentReminderTZ, "timezone of statement and due dates")
+
+	return flagSet.Parse(os.Args[2:])
+}
+
+func (c *SendPaymentReminders) Init(ctx context.Context, cfg *config.Config) error {
+	var err error
+
+	if err = c.initFlags(); err != nil {
+		return err
+	}
+
+	if err = entity.CheckTimezone(c.timezone); err != nil {
+		return err
+	}
+
+	// init mongo
+	c.mongo, err = mongo.NewMongo(ctx, cfg.Mongo)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to init mongo client, err: %v", err)
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = c.mongo.Close(ctx)
+		}
+	}()
+
+	// init mailer
+	if cfg.Global.UseGmail {
+		c.mailer, err = gmail.NewGmailMgr(cfg.Gmail)
+	} else {
+		c.mailer, err = brevo.NewBrevoMgr(cfg.Brevo)
+	}
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to init mailer, err: %v", err)
+		return err
+	}
+
+	// init repos
+	c.userRepo = mongo.NewUserMongo(c.mongo)
+	c.accountRepo = mongo.NewAccountMongo(c.mongo)
+
+	var (
+		categoryRepo    = mongo.NewCategoryMongo(c.mongo)
+		transactionRepo = mongo.NewTransactionMongo(c.mongo)
+	)
+
+	exchangeRateRepo, err := mongo.NewExchangeRateMongo(ctx, c.mongo)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to init exchange rate repo, err: %v", err)
+		return err
+	}
+
+	// init use cases
+	ruleUseCase := ruc.NewRuleUseCase(c.mongo, mongo.NewRuleMongo(c.mongo), categoryRepo, c.accountRepo, transactionRepo)
+	c.transactionUseCase = tuc.NewTransactionUseCase(
+		c.mongo, categoryRepo, c.accountRepo,
+		transactionRepo, mongo.NewBudgetMongo(c.mongo), exchangeRateRepo, mongo.NewTagMongo(c.mongo),
+		mongo.NewPayeeMongo(c.mongo), ruleUseCase,
+	)
+
+	return nil
+}
+
+func (c *SendPaymentReminders) Run(ctx context.Context) error {
+	loc, err := time.LoadLocation(c.timezone)
+	if err != nil {
+		return err
+	}
+
+	var (
+		page  = 1
+		limit = 1000
+		now   = time.Now().In(loc)
+		count = 0
+	)
+
+	// reminders go out for statements due on this date
+	y, m, d := now.AddDate(0, 0, c.daysBefore).Date()
+	dueDate := time.Date(y, m, d, 0, 0, 0, 0, loc)
+
+	p := &repo.Paging{
+		Limit: goutil.Uint32(uint32(limit)),
+		Page:  goutil.Uint32(uint32(page)),
+	}
+
+	for {
+		us, err := c.userRepo.GetMany(ctx, repo.NewUserFilter(
+			repo.WithUserPaging(p),
+		))
+		if err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to get users from repo, err: %v", err)
+			return err
+		}
+
+		for _, u := range us {
+			sent, err := c.sendReminders(ctx, u, now, dueDate)
+			if err != nil {
+				// continue with other users
+				log.Ctx(ctx).Error().Msgf("fail to send payment reminders, userID: %v, err: %v", u.GetUserID(), err)
+			}
+			count += sent
+		}
+
+		if len(us) < limit {
+			break
+		}
+
+		page++
+		p.Page = goutil.Uint32(uint32(page))
+	}
+
+	log.Ctx(ctx).Info().Msgf("sent %d payment reminders for statements due on %v", count, dueDate.Format("2006-01-02"))
+
+	return nil
+}
+
+func (c *SendPaymentReminders) sendReminders(ctx context.Context, u *entity.User, now, dueDate time.Time) (int, error) {
+	acs, err := c.accountRepo.GetMany(ctx, repo.NewAccountFilter(
+		u.GetUserID(),
+		repo.WitAccountType(goutil.Uint32(uint32(entity.DebtCreditCard))),
+	))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get credit cards from repo, err: %v", err)
+		return 0, err
+	}
+
+	var sent int
+	for _, ac := range acs {
+		cycle := ac.GetStatementCycle(now)
+		if cycle == nil || !cycle.DueDate.Equal(dueDate) {
+			continue
+		}
+
+		res, err := c.transactionUseCase.GetCreditCardCycle(entity.SetUserToCtx(ctx, u), &tuc.GetCreditCardCycleRequest{
+			UserID:    u.UserID,
+			AccountID: ac.AccountID,
+			AppMeta: &common.AppMeta{
+				Timezone: goutil.String(c.timezone),
+			},
+		})
+		if err != nil {
+			return sent, err
+		}
+
+		if res.GetAmountDue() <= 0 {
+			continue
+		}
+
+		if err := c.mailer.SendEmail(ctx, mailer.TemplatePaymentDue, &mailer.SendEmailRequest{
+			To: u.GetEmail(),
+			Params: map[string]interface{}{
+				"account_name":    ac.GetAccountName(),
+				"currency":        ac.GetCurrency(),
+				"amount_due":      fmt.Sprint(res.GetAmountDue()),
+				"minimum_payment": fmt.Sprint(res.GetMinimumPayment()),
+				"due_date":        dueDate.Format("2 Jan 2006"),
+			},
+		}); err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to send payment reminder, accountID: %v, err: %v", ac.GetAccountID(), err)
+			return sent, err
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
+func (c *SendPaymentReminders) Clean(ctx context.Context) error {
+	if err := c.mailer.Close(ctx); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to close mailer, err: %v", err)
+	}
+	return c.mongo.Close(ctx)
+}
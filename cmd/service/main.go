@@ -18,6 +18,8 @@ import (
 	"github.com/jseow5177/pockteer-be/config"
 	"github.com/jseow5177/pockteer-be/dep/api"
 	"github.com/jseow5177/pockteer-be/dep/api/finnhub"
+	"github.com/jseow5177/pockteer-be/dep/blob"
+	"github.com/jseow5177/pockteer-be/dep/blob/local"
 	"github.com/jseow5177/pockteer-be/dep/mailer"
 	"github.com/jseow5177/pockteer-be/dep/mailer/brevo"
 	"github.com/jseow5177/pockteer-be/dep/mailer/gmail"
@@ -30,28 +32,43 @@ import (
 	"github.com/jseow5177/pockteer-be/pkg/service"
 
 	ach "github.com/jseow5177/pockteer-be/api/handler/account"
+	ath "github.com/jseow5177/pockteer-be/api/handler/attachment"
 	bh "github.com/jseow5177/pockteer-be/api/handler/budget"
 	ch "github.com/jseow5177/pockteer-be/api/handler/category"
 	erh "github.com/jseow5177/pockteer-be/api/handler/exchange_rate"
+	exh "github.com/jseow5177/pockteer-be/api/handler/exporter"
 	fh "github.com/jseow5177/pockteer-be/api/handler/feedback"
 	hh "github.com/jseow5177/pockteer-be/api/handler/holding"
+	ih "github.com/jseow5177/pockteer-be/api/handler/importer"
 	lh "github.com/jseow5177/pockteer-be/api/handler/lot"
 	mth "github.com/jseow5177/pockteer-be/api/handler/metric"
+	pyh "github.com/jseow5177/pockteer-be/api/handler/payee"
+	rth "github.com/jseow5177/pockteer-be/api/handler/recurring_transaction"
+	rh "github.com/jseow5177/pockteer-be/api/handler/rule"
 	sh "github.com/jseow5177/pockteer-be/api/handler/security"
+	tgh "github.com/jseow5177/pockteer-be/api/handler/tag"
 	th "github.com/jseow5177/pockteer-be/api/handler/transaction"
 	uh "github.com/jseow5177/pockteer-be/api/handler/user"
 
+	edjh "github.com/jseow5177/pockteer-be/cmd/job/export_data"
 	sqjh "github.com/jseow5177/pockteer-be/cmd/job/sync_quotes"
 
 	acuc "github.com/jseow5177/pockteer-be/usecase/account"
+	atuc "github.com/jseow5177/pockteer-be/usecase/attachment"
 	buc "github.com/jseow5177/pockteer-be/usecase/budget"
 	cuc "github.com/jseow5177/pockteer-be/usecase/category"
 	eruc "github.com/jseow5177/pockteer-be/usecase/exchange_rate"
+	exuc "github.com/jseow5177/pockteer-be/usecase/exporter"
 	fuc "github.com/jseow5177/pockteer-be/usecase/feedback"
 	huc "github.com/jseow5177/pockteer-be/usecase/holding"
+	iuc "github.com/jseow5177/pockteer-be/usecase/importer"
 	luc "github.com/jseow5177/pockteer-be/usecase/lot"
 	mtuc "github.com/jseow5177/pockteer-be/usecase/metric"
+	pyuc "github.com/jseow5177/pockteer-be/usecase/payee"
+	rtuc "github.com/jseow5177/pockteer-be/usecase/recurring_transaction"
+	ruc "github.com/jseow5177/pockteer-be/usecase/rule"
 	suc "github.com/jseow5177/pockteer-be/usecase/security"
+	tguc "github.com/jseow5177/pockteer-be/usecase/tag"
 	ttuc "github.com/jseow5177/pockteer-be/usecase/token"
 	tuc "github.com/jseow5177/pockteer-be/usecase/transaction"
 	uuc "github.com/jseow5177/pockteer-be/usecase/user"
@@ -66,36 +83,50 @@ type server struct {
 
 	mongo *mongo.Mongo
 
-	categoryRepo     repo.CategoryRepo
-	transactionRepo  repo.TransactionRepo
-	budgetRepo       repo.BudgetRepo
-	userRepo         repo.UserRepo
-	accountRepo      repo.AccountRepo
-	holdingRepo      repo.HoldingRepo
-	lotRepo          repo.LotRepo
-	securityRepo     repo.SecurityRepo
-	quoteRepo        repo.QuoteRepo
-	feedbackRepo     repo.FeedbackRepo
-	otpRepo          repo.OTPRepo
-	exchangeRateRepo repo.ExchangeRateRepo
-	snapshotRepo     repo.SnapshotRepo
+	categoryRepo             repo.CategoryRepo
+	transactionRepo          repo.TransactionRepo
+	budgetRepo               repo.BudgetRepo
+	userRepo                 repo.UserRepo
+	accountRepo              repo.AccountRepo
+	holdingRepo              repo.HoldingRepo
+	lotRepo                  repo.LotRepo
+	securityRepo             repo.SecurityRepo
+	quoteRepo                repo.QuoteRepo
+	feedbackRepo             repo.FeedbackRepo
+	otpRepo                  repo.OTPRepo
+	exchangeRateRepo         repo.ExchangeRateRepo
+	snapshotRepo             repo.SnapshotRepo
+	recurringTransactionRepo repo.RecurringTransactionRepo
+	tagRepo                  repo.TagRepo
+	payeeRepo                repo.PayeeRepo
+	importProfileRepo        repo.ImportProfileRepo
+	ruleRepo                 repo.RuleRepo
+	attachmentRepo           repo.AttachmentRepo
 
 	securityAPI     api.SecurityAPI
 	exchangeRateAPI api.ExchangeRateAPI
 	mailer          mailer.Mailer
-
-	categoryUseCase     cuc.UseCase
-	transactionUseCase  tuc.UseCase
-	budgetUseCase       buc.UseCase
-	userUseCase         uuc.UseCase
-	tokenUseCase        ttuc.UseCase
-	accountUseCase      acuc.UseCase
-	securityUseCase     suc.UseCase
-	holdingUseCase      huc.UseCase
-	lotUseCase          luc.UseCase
-	feedbackUseCase     fuc.UseCase
-	exchangeRateUseCase eruc.UseCase
-	metricUseCase       mtuc.UseCase
+	attachmentStore blob.Store
+
+	categoryUseCase             cuc.UseCase
+	transactionUseCase          tuc.UseCase
+	budgetUseCase               buc.UseCase
+	userUseCase                 uuc.UseCase
+	tokenUseCase                ttuc.UseCase
+	accountUseCase              acuc.UseCase
+	securityUseCase             suc.UseCase
+	holdingUseCase              huc.UseCase
+	lotUseCase                  luc.UseCase
+	feedbackUseCase             fuc.UseCase
+	exchangeRateUseCase         eruc.UseCase
+	metricUseCase               mtuc.UseCase
+	recurringTransactionUseCase rtuc.UseCase
+	tagUseCase                  tguc.UseCase
+	payeeUseCase                pyuc.UseCase
+	importerUseCase             iuc.UseCase
+	exporterUseCase             exuc.UseCase
+	ruleUseCase                 ruc.UseCase
+	attachmentUseCase           atuc.UseCase
 }
 
 func main() {
@@ -171,6 +202,17 @@ func (s *server) Start() error {
 	s.lotRepo = mongo.NewLotMongo(s.mongo)
 	s.securityRepo = mongo.NewSecurityMongo(s.mongo)
 	s.snapshotRepo = mongo.NewSnapshotMongo(s.mongo)
+	s.recurringTransactionRepo = mongo.NewRecurringTransactionMongo(s.mongo)
+	s.tagRepo = mongo.NewTagMongo(s.mongo)
+	s.payeeRepo = mongo.NewPayeeMongo(s.mongo)
+	s.importProfileRepo = mongo.NewImportProfileMongo(s.mongo)
+	s.ruleRepo = mongo.NewRuleMongo(s.mongo)
+	s.attachmentRepo = mongo.NewAttachmentMongo(s.mongo)
+
+	if err = mongo.InitTransactionIndexes(s.ctx, s.mongo); err != nil {
+		log.Ctx(s.ctx).Error().Msgf("fail to init transaction indexes, err: %v", err)
+		return err
+	}
 
 	s.exchangeRateRepo, err = mongo.NewExchangeRateMongo(s.ctx, s.mongo)
 	if err != nil {
@@ -203,6 +245,13 @@ func (s *server) Start() error {
 		return err
 	}
 
+	// init blob store
+	s.attachmentStore, err = local.NewLocalStore(s.cfg.AttachmentStore)
+	if err != nil {
+		log.Ctx(s.ctx).Error().Msgf("fail to init attachment store, err: %v", err)
+		return err
+	}
+
 	s.otpRepo, err = mem.NewOTPMemCache(s.cfg.OTPMemCache)
 	if err != nil {
 		log.Ctx(s.ctx).Error().Msgf("fail to init otp repo, err: %v", err)
@@ -210,13 +259,15 @@ func (s *server) Start() error {
 	}
 
 	// init use cases
+	s.ruleUseCase = ruc.NewRuleUseCase(s.mongo, s.ruleRepo, s.categoryRepo, s.accountRepo, s.transactionRepo)
 	s.transactionUseCase = tuc.NewTransactionUseCase(
 		s.mongo, s.categoryRepo, s.accountRepo,
-		s.transactionRepo, s.budgetRepo, s.exchangeRateRepo)
+		s.transactionRepo, s.budgetRepo, s.exchangeRateRepo, s.tagRepo, s.payeeRepo, s.ruleUseCase)
 	s.budgetUseCase = buc.NewBudgetUseCase(s.mongo, s.budgetRepo, s.categoryRepo, s.transactionRepo)
 	s.categoryUseCase = cuc.NewCategoryUseCase(
 		s.mongo, s.categoryRepo, s.transactionRepo,
-		s.budgetUseCase, s.budgetRepo, s.exchangeRateRepo)
+		s.budgetUseCase, s.budgetRepo, s.exchangeRateRepo,
+		s.ruleRepo, s.recurringTransactionRepo, s.accountRepo)
 	s.tokenUseCase = ttuc.NewTokenUseCase(s.cfg.Tokens)
 	s.securityUseCase = suc.NewSecurityUseCase(s.securityRepo)
 	s.lotUseCase = luc.NewLotUseCase(s.lotRepo, s.holdingRepo)
@@ -226,7 +277,7 @@ func (s *server) Start() error {
 	)
 	s.accountUseCase = acuc.NewAccountUseCase(
 		s.mongo, s.accountRepo, s.transactionRepo,
-		s.holdingRepo, s.lotRepo, s.quoteRepo, s.securityRepo, s.exchangeRateRepo, s.snapshotRepo,
+		s.holdingRepo, s.lotRepo, s.quoteRepo, s.securityRepo, s.exchangeRateRepo, s.snapshotRepo, s.categoryRepo,
 	)
 	s.feedbackUseCase = fuc.NewFeedbackUseCase(s.feedbackRepo)
 	s.userUseCase = uuc.NewUserUseCase(
@@ -235,6 +286,19 @@ func (s *server) Start() error {
 	)
 	s.exchangeRateUseCase = eruc.NewExchangeRateUseCase(s.exchangeRateAPI, s.exchangeRateRepo)
 	s.metricUseCase = mtuc.NewMetricUseCase(s.accountUseCase, s.transactionUseCase)
+	s.recurringTransactionUseCase = rtuc.NewRecurringTransactionUseCase(
+		s.recurringTransactionRepo, s.transactionRepo, s.categoryRepo, s.accountRepo, s.transactionUseCase,
+	)
+	s.tagUseCase = tguc.NewTagUseCase(s.tagRepo, s.transactionRepo, s.exchangeRateRepo)
+	s.payeeUseCase = pyuc.NewPayeeUseCase(s.payeeRepo, s.transactionRepo, s.exchangeRateRepo)
+	s.importerUseCase = iuc.NewImporterUseCase(
+		s.mongo, s.importProfileRepo, s.accountRepo, s.categoryRepo, s.transactionRepo, s.transactionUseCase, s.ruleUseCase,
+	)
+	s.exporterUseCase = exuc.NewExporterUseCase(
+		s.accountRepo, s.transactionRepo, s.categoryRepo,
+		s.budgetRepo, s.holdingRepo, s.lotRepo, s.snapshotRepo,
+	)
+	s.attachmentUseCase = atuc.NewAttachmentUseCase(s.attachmentRepo, s.transactionRepo, s.attachmentStore)
 
 	// start server
 	addr := fmt.Sprintf(":%d", s.opt.Port)
@@ -328,6 +392,24 @@ func (s *server) initAdminRoutes(r *router.HttpRouter) {
 		},
 		Middlewares: []router.Middleware{adminAuthMiddleware},
 	})
+
+	// ========== Export data ========== //
+
+	exportDataHandler := edjh.NewExportDataHandler(s.exporterUseCase)
+
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathAdminExportData,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(edjh.ExportDataRequest),
+			Res:       new(edjh.ExportDataResponse),
+			Validator: nil,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return exportDataHandler.ExportData(ctx, req.(*edjh.ExportDataRequest), res.(*edjh.ExportDataResponse))
+			},
+		},
+		Middlewares: []router.Middleware{adminAuthMiddleware},
+	})
 }
 
 func (s *server) initUserRoutes(r *router.HttpRouter) {
@@ -401,6 +483,82 @@ func (s *server) initUserRoutes(r *router.HttpRouter) {
 		Middlewares: []router.Middleware{userAuthMiddleware},
 	})
 
+	// get deleted categories
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathGetDeletedCategories,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.GetDeletedCategoriesRequest),
+			Res:       new(presenter.GetDeletedCategoriesResponse),
+			Validator: ch.GetDeletedCategoriesValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return categoryHandler.GetDeletedCategories(
+					ctx,
+					req.(*presenter.GetDeletedCategoriesRequest),
+					res.(*presenter.GetDeletedCategoriesResponse),
+				)
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// restore category
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathRestoreCategory,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.RestoreCategoryRequest),
+			Res:       new(presenter.RestoreCategoryResponse),
+			Validator: ch.RestoreCategoryValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return categoryHandler.RestoreCategory(
+					ctx,
+					req.(*presenter.RestoreCategoryRequest),
+					res.(*presenter.RestoreCategoryResponse),
+				)
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// merge categories
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathMergeCategories,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.MergeCategoriesRequest),
+			Res:       new(presenter.MergeCategoriesResponse),
+			Validator: ch.MergeCategoriesValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return categoryHandler.MergeCategories(
+					ctx,
+					req.(*presenter.MergeCategoriesRequest),
+					res.(*presenter.MergeCategoriesResponse),
+				)
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// reorder categories
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathReorderCategories,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.ReorderCategoriesRequest),
+			Res:       new(presenter.ReorderCategoriesResponse),
+			Validator: ch.ReorderCategoriesValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return categoryHandler.ReorderCategories(
+					ctx,
+					req.(*presenter.ReorderCategoriesRequest),
+					res.(*presenter.ReorderCategoriesResponse),
+				)
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
 	// get category
 	r.RegisterHttpRoute(&router.HttpRoute{
 		Path:   config.PathGetCategory,
@@ -446,6 +604,21 @@ func (s *server) initUserRoutes(r *router.HttpRouter) {
 		Middlewares: []router.Middleware{userAuthMiddleware},
 	})
 
+	// get category tree
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathGetCategoryTree,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.GetCategoryTreeRequest),
+			Res:       new(presenter.GetCategoryTreeResponse),
+			Validator: ch.GetCategoryTreeValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return categoryHandler.GetCategoryTree(ctx, req.(*presenter.GetCategoryTreeRequest), res.(*presenter.GetCategoryTreeResponse))
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
 	// get categories budget
 	r.RegisterHttpRoute(&router.HttpRoute{
 		Path:   config.PathGetCategoriesBudget,
@@ -559,6 +732,36 @@ func (s *server) initUserRoutes(r *router.HttpRouter) {
 		Middlewares: []router.Middleware{userAuthMiddleware},
 	})
 
+	// get amortisation schedule
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathGetAmortisationSchedule,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.GetAmortisationScheduleRequest),
+			Res:       new(presenter.GetAmortisationScheduleResponse),
+			Validator: ach.GetAmortisationScheduleValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return accountHandler.GetAmortisationSchedule(ctx, req.(*presenter.GetAmortisationScheduleRequest), res.(*presenter.GetAmortisationScheduleResponse))
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// get interest projection
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathGetInterestProjection,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.GetInterestProjectionRequest),
+			Res:       new(presenter.GetInterestProjectionResponse),
+			Validator: ach.GetInterestProjectionValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return accountHandler.GetInterestProjection(ctx, req.(*presenter.GetInterestProjectionRequest), res.(*presenter.GetInterestProjectionResponse))
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
 	// delete account
 	r.RegisterHttpRoute(&router.HttpRoute{
 		Path:   config.PathDeleteAccount,
@@ -574,6 +777,44 @@ func (s *server) initUserRoutes(r *router.HttpRouter) {
 		Middlewares: []router.Middleware{userAuthMiddleware},
 	})
 
+	// get deleted accounts
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathGetDeletedAccounts,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.GetDeletedAccountsRequest),
+			Res:       new(presenter.GetDeletedAccountsResponse),
+			Validator: ach.GetDeletedAccountsValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return accountHandler.GetDeletedAccounts(
+					ctx,
+					req.(*presenter.GetDeletedAccountsRequest),
+					res.(*presenter.GetDeletedAccountsResponse),
+				)
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// restore account
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathRestoreAccount,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.RestoreAccountRequest),
+			Res:       new(presenter.RestoreAccountResponse),
+			Validator: ach.RestoreAccountValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return accountHandler.RestoreAccount(
+					ctx,
+					req.(*presenter.RestoreAccountRequest),
+					res.(*presenter.RestoreAccountResponse),
+				)
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
 	// ========== Transaction ========== //
 
 	transactionHandler := th.NewTransactionHandler(s.transactionUseCase)
@@ -638,6 +879,25 @@ func (s *server) initUserRoutes(r *router.HttpRouter) {
 		Middlewares: []router.Middleware{userAuthMiddleware},
 	})
 
+	// search transactions
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathSearchTransactions,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.SearchTransactionsRequest),
+			Res:       new(presenter.SearchTransactionsResponse),
+			Validator: th.SearchTransactionsValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return transactionHandler.SearchTransactions(
+					ctx,
+					req.(*presenter.SearchTransactionsRequest),
+					res.(*presenter.SearchTransactionsResponse),
+				)
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
 	// get transaction groups
 	r.RegisterHttpRoute(&router.HttpRoute{
 		Path:   config.PathGetTransactionGroups,
@@ -670,6 +930,101 @@ func (s *server) initUserRoutes(r *router.HttpRouter) {
 		Middlewares: []router.Middleware{userAuthMiddleware},
 	})
 
+	// get deleted transactions
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathGetDeletedTransactions,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.GetDeletedTransactionsRequest),
+			Res:       new(presenter.GetDeletedTransactionsResponse),
+			Validator: th.GetDeletedTransactionsValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return transactionHandler.GetDeletedTransactions(
+					ctx,
+					req.(*presenter.GetDeletedTransactionsRequest),
+					res.(*presenter.GetDeletedTransactionsResponse),
+				)
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// restore transaction
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathRestoreTransaction,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.RestoreTransactionRequest),
+			Res:       new(presenter.RestoreTransactionResponse),
+			Validator: th.RestoreTransactionValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return transactionHandler.RestoreTransaction(
+					ctx,
+					req.(*presenter.RestoreTransactionRequest),
+					res.(*presenter.RestoreTransactionResponse),
+				)
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// reconcile account
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathReconcileAccount,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.ReconcileAccountRequest),
+			Res:       new(presenter.ReconcileAccountResponse),
+			Validator: th.ReconcileAccountValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return transactionHandler.ReconcileAccount(
+					ctx,
+					req.(*presenter.ReconcileAccountRequest),
+					res.(*presenter.ReconcileAccountResponse),
+				)
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// get credit card cycle
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathGetCreditCardCycle,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.GetCreditCardCycleRequest),
+			Res:       new(presenter.GetCreditCardCycleResponse),
+			Validator: th.GetCreditCardCycleValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return transactionHandler.GetCreditCardCycle(
+					ctx,
+					req.(*presenter.GetCreditCardCycleRequest),
+					res.(*presenter.GetCreditCardCycleResponse),
+				)
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// get account balance history
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathGetAccountBalanceHistory,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.GetAccountBalanceHistoryRequest),
+			Res:       new(presenter.GetAccountBalanceHistoryResponse),
+			Validator: th.GetAccountBalanceHistoryValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return transactionHandler.GetAccountBalanceHistory(
+					ctx,
+					req.(*presenter.GetAccountBalanceHistoryRequest),
+					res.(*presenter.GetAccountBalanceHistoryResponse),
+				)
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
 	// sum transactions
 	r.RegisterHttpRoute(&router.HttpRoute{
 		Path:   config.PathSumTransactions,
@@ -700,6 +1055,662 @@ func (s *server) initUserRoutes(r *router.HttpRouter) {
 		Middlewares: []router.Middleware{userAuthMiddleware},
 	})
 
+	// find duplicate transactions
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathFindDuplicateTransactions,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.FindDuplicateTransactionsRequest),
+			Res:       new(presenter.FindDuplicateTransactionsResponse),
+			Validator: th.FindDuplicateTransactionsValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return transactionHandler.FindDuplicateTransactions(
+					ctx,
+					req.(*presenter.FindDuplicateTransactionsRequest),
+					res.(*presenter.FindDuplicateTransactionsResponse),
+				)
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// merge transactions
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathMergeTransactions,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.MergeTransactionsRequest),
+			Res:       new(presenter.MergeTransactionsResponse),
+			Validator: th.MergeTransactionsValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return transactionHandler.MergeTransactions(
+					ctx,
+					req.(*presenter.MergeTransactionsRequest),
+					res.(*presenter.MergeTransactionsResponse),
+				)
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// bulk update transactions
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathBulkUpdateTransactions,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.BulkUpdateTransactionsRequest),
+			Res:       new(presenter.BulkUpdateTransactionsResponse),
+			Validator: th.BulkUpdateTransactionsValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return transactionHandler.BulkUpdateTransactions(
+					ctx,
+					req.(*presenter.BulkUpdateTransactionsRequest),
+					res.(*presenter.BulkUpdateTransactionsResponse),
+				)
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// bulk delete transactions
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathBulkDeleteTransactions,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.BulkDeleteTransactionsRequest),
+			Res:       new(presenter.BulkDeleteTransactionsResponse),
+			Validator: th.BulkDeleteTransactionsValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return transactionHandler.BulkDeleteTransactions(
+					ctx,
+					req.(*presenter.BulkDeleteTransactionsRequest),
+					res.(*presenter.BulkDeleteTransactionsResponse),
+				)
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// ========== Recurring Transaction ========== //
+
+	recurringTransactionHandler := rth.NewRecurringTransactionHandler(s.recurringTransactionUseCase)
+
+	// create recurring transaction
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathCreateRecurringTransaction,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.CreateRecurringTransactionRequest),
+			Res:       new(presenter.CreateRecurringTransactionResponse),
+			Validator: rth.CreateRecurringTransactionValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return recurringTransactionHandler.CreateRecurringTransaction(
+					ctx, req.(*presenter.CreateRecurringTransactionRequest), res.(*presenter.CreateRecurringTransactionResponse),
+				)
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// update recurring transaction
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathUpdateRecurringTransaction,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.UpdateRecurringTransactionRequest),
+			Res:       new(presenter.UpdateRecurringTransactionResponse),
+			Validator: rth.UpdateRecurringTransactionValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return recurringTransactionHandler.UpdateRecurringTransaction(
+					ctx, req.(*presenter.UpdateRecurringTransactionRequest), res.(*presenter.UpdateRecurringTransactionResponse),
+				)
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// get recurring transaction
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathGetRecurringTransaction,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.GetRecurringTransactionRequest),
+			Res:       new(presenter.GetRecurringTransactionResponse),
+			Validator: rth.GetRecurringTransactionValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return recurringTransactionHandler.GetRecurringTransaction(
+					ctx, req.(*presenter.GetRecurringTransactionRequest), res.(*presenter.GetRecurringTransactionResponse),
+				)
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// get recurring transactions
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathGetRecurringTransactions,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.GetRecurringTransactionsRequest),
+			Res:       new(presenter.GetRecurringTransactionsResponse),
+			Validator: rth.GetRecurringTransactionsValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return recurringTransactionHandler.GetRecurringTransactions(
+					ctx, req.(*presenter.GetRecurringTransactionsRequest), res.(*presenter.GetRecurringTransactionsResponse),
+				)
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// delete recurring transaction
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathDeleteRecurringTransaction,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.DeleteRecurringTransactionRequest),
+			Res:       new(presenter.DeleteRecurringTransactionResponse),
+			Validator: rth.DeleteRecurringTransactionValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return recurringTransactionHandler.DeleteRecurringTransaction(
+					ctx, req.(*presenter.DeleteRecurringTransactionRequest), res.(*presenter.DeleteRecurringTransactionResponse),
+				)
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// ========== Tag ========== //
+
+	tagHandler := tgh.NewTagHandler(s.tagUseCase)
+
+	// create tag
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathCreateTag,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.CreateTagRequest),
+			Res:       new(presenter.CreateTagResponse),
+			Validator: tgh.CreateTagValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return tagHandler.CreateTag(ctx, req.(*presenter.CreateTagRequest), res.(*presenter.CreateTagResponse))
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// update tag
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathUpdateTag,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.UpdateTagRequest),
+			Res:       new(presenter.UpdateTagResponse),
+			Validator: tgh.UpdateTagValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return tagHandler.UpdateTag(ctx, req.(*presenter.UpdateTagRequest), res.(*presenter.UpdateTagResponse))
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// get tag
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathGetTag,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.GetTagRequest),
+			Res:       new(presenter.GetTagResponse),
+			Validator: tgh.GetTagValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return tagHandler.GetTag(ctx, req.(*presenter.GetTagRequest), res.(*presenter.GetTagResponse))
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// get tags
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathGetTags,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.GetTagsRequest),
+			Res:       new(presenter.GetTagsResponse),
+			Validator: tgh.GetTagsValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return tagHandler.GetTags(ctx, req.(*presenter.GetTagsRequest), res.(*presenter.GetTagsResponse))
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// delete tag
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathDeleteTag,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.DeleteTagRequest),
+			Res:       new(presenter.DeleteTagResponse),
+			Validator: tgh.DeleteTagValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return tagHandler.DeleteTag(ctx, req.(*presenter.DeleteTagRequest), res.(*presenter.DeleteTagResponse))
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// sum tag transactions
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathSumTagTransactions,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.SumTagTransactionsRequest),
+			Res:       new(presenter.SumTagTransactionsResponse),
+			Validator: tgh.SumTagTransactionsValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return tagHandler.SumTagTransactions(
+					ctx,
+					req.(*presenter.SumTagTransactionsRequest),
+					res.(*presenter.SumTagTransactionsResponse),
+				)
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// ========== Payee ========== //
+
+	payeeHandler := pyh.NewPayeeHandler(s.payeeUseCase)
+
+	// create payee
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathCreatePayee,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.CreatePayeeRequest),
+			Res:       new(presenter.CreatePayeeResponse),
+			Validator: pyh.CreatePayeeValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return payeeHandler.CreatePayee(ctx, req.(*presenter.CreatePayeeRequest), res.(*presenter.CreatePayeeResponse))
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// update payee
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathUpdatePayee,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.UpdatePayeeRequest),
+			Res:       new(presenter.UpdatePayeeResponse),
+			Validator: pyh.UpdatePayeeValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return payeeHandler.UpdatePayee(ctx, req.(*presenter.UpdatePayeeRequest), res.(*presenter.UpdatePayeeResponse))
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// get payee
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathGetPayee,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.GetPayeeRequest),
+			Res:       new(presenter.GetPayeeResponse),
+			Validator: pyh.GetPayeeValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return payeeHandler.GetPayee(ctx, req.(*presenter.GetPayeeRequest), res.(*presenter.GetPayeeResponse))
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// get payees
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathGetPayees,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.GetPayeesRequest),
+			Res:       new(presenter.GetPayeesResponse),
+			Validator: pyh.GetPayeesValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return payeeHandler.GetPayees(ctx, req.(*presenter.GetPayeesRequest), res.(*presenter.GetPayeesResponse))
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// delete payee
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathDeletePayee,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.DeletePayeeRequest),
+			Res:       new(presenter.DeletePayeeResponse),
+			Validator: pyh.DeletePayeeValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return payeeHandler.DeletePayee(ctx, req.(*presenter.DeletePayeeRequest), res.(*presenter.DeletePayeeResponse))
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// sum transactions by payee
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathSumTransactionsByPayee,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.SumTransactionsByPayeeRequest),
+			Res:       new(presenter.SumTransactionsByPayeeResponse),
+			Validator: pyh.SumTransactionsByPayeeValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return payeeHandler.SumTransactionsByPayee(
+					ctx,
+					req.(*presenter.SumTransactionsByPayeeRequest),
+					res.(*presenter.SumTransactionsByPayeeResponse),
+				)
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// ========== Attachment ========== //
+
+	attachmentHandler := ath.NewAttachmentHandler(s.attachmentUseCase)
+
+	// upload attachment
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathUploadAttachment,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.UploadAttachmentRequest),
+			Res:       new(presenter.UploadAttachmentResponse),
+			Validator: ath.UploadAttachmentValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return attachmentHandler.UploadAttachment(ctx, req.(*presenter.UploadAttachmentRequest), res.(*presenter.UploadAttachmentResponse))
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// get attachments
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathGetAttachments,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.GetAttachmentsRequest),
+			Res:       new(presenter.GetAttachmentsResponse),
+			Validator: ath.GetAttachmentsValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return attachmentHandler.GetAttachments(ctx, req.(*presenter.GetAttachmentsRequest), res.(*presenter.GetAttachmentsResponse))
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// download attachment
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathDownloadAttachment,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.DownloadAttachmentRequest),
+			Res:       new(presenter.DownloadAttachmentResponse),
+			Validator: ath.DownloadAttachmentValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return attachmentHandler.DownloadAttachment(ctx, req.(*presenter.DownloadAttachmentRequest), res.(*presenter.DownloadAttachmentResponse))
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// delete attachment
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathDeleteAttachment,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.DeleteAttachmentRequest),
+			Res:       new(presenter.DeleteAttachmentResponse),
+			Validator: ath.DeleteAttachmentValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return attachmentHandler.DeleteAttachment(ctx, req.(*presenter.DeleteAttachmentRequest), res.(*presenter.DeleteAttachmentResponse))
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// ========== Rule ========== //
+
+	ruleHandler := rh.NewRuleHandler(s.ruleUseCase)
+
+	// create rule
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathCreateRule,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.CreateRuleRequest),
+			Res:       new(presenter.CreateRuleResponse),
+			Validator: rh.CreateRuleValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return ruleHandler.CreateRule(ctx, req.(*presenter.CreateRuleRequest), res.(*presenter.CreateRuleResponse))
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// update rule
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathUpdateRule,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.UpdateRuleRequest),
+			Res:       new(presenter.UpdateRuleResponse),
+			Validator: rh.UpdateRuleValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return ruleHandler.UpdateRule(ctx, req.(*presenter.UpdateRuleRequest), res.(*presenter.UpdateRuleResponse))
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// get rule
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathGetRule,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.GetRuleRequest),
+			Res:       new(presenter.GetRuleResponse),
+			Validator: rh.GetRuleValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return ruleHandler.GetRule(ctx, req.(*presenter.GetRuleRequest), res.(*presenter.GetRuleResponse))
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// get rules
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathGetRules,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.GetRulesRequest),
+			Res:       new(presenter.GetRulesResponse),
+			Validator: rh.GetRulesValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return ruleHandler.GetRules(ctx, req.(*presenter.GetRulesRequest), res.(*presenter.GetRulesResponse))
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// delete rule
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathDeleteRule,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.DeleteRuleRequest),
+			Res:       new(presenter.DeleteRuleResponse),
+			Validator: rh.DeleteRuleValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return ruleHandler.DeleteRule(ctx, req.(*presenter.DeleteRuleRequest), res.(*presenter.DeleteRuleResponse))
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// apply rules
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathApplyRules,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.ApplyRulesRequest),
+			Res:       new(presenter.ApplyRulesResponse),
+			Validator: rh.ApplyRulesValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return ruleHandler.ApplyRules(ctx, req.(*presenter.ApplyRulesRequest), res.(*presenter.ApplyRulesResponse))
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// ========== Import ========== //
+
+	importerHandler := ih.NewImporterHandler(s.importerUseCase)
+
+	// create import profile
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathCreateImportProfile,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.CreateImportProfileRequest),
+			Res:       new(presenter.CreateImportProfileResponse),
+			Validator: ih.CreateImportProfileValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return importerHandler.CreateImportProfile(
+					ctx,
+					req.(*presenter.CreateImportProfileRequest),
+					res.(*presenter.CreateImportProfileResponse),
+				)
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// update import profile
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathUpdateImportProfile,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.UpdateImportProfileRequest),
+			Res:       new(presenter.UpdateImportProfileResponse),
+			Validator: ih.UpdateImportProfileValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return importerHandler.UpdateImportProfile(
+					ctx,
+					req.(*presenter.UpdateImportProfileRequest),
+					res.(*presenter.UpdateImportProfileResponse),
+				)
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// get import profile
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathGetImportProfile,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.GetImportProfileRequest),
+			Res:       new(presenter.GetImportProfileResponse),
+			Validator: ih.GetImportProfileValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return importerHandler.GetImportProfile(
+					ctx,
+					req.(*presenter.GetImportProfileRequest),
+					res.(*presenter.GetImportProfileResponse),
+				)
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// get import profiles
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathGetImportProfiles,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.GetImportProfilesRequest),
+			Res:       new(presenter.GetImportProfilesResponse),
+			Validator: ih.GetImportProfilesValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return importerHandler.GetImportProfiles(
+					ctx,
+					req.(*presenter.GetImportProfilesRequest),
+					res.(*presenter.GetImportProfilesResponse),
+				)
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// delete import profile
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathDeleteImportProfile,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.DeleteImportProfileRequest),
+			Res:       new(presenter.DeleteImportProfileResponse),
+			Validator: ih.DeleteImportProfileValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return importerHandler.DeleteImportProfile(
+					ctx,
+					req.(*presenter.DeleteImportProfileRequest),
+					res.(*presenter.DeleteImportProfileResponse),
+				)
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// import transactions
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathImportTransactions,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.ImportTransactionsRequest),
+			Res:       new(presenter.ImportTransactionsResponse),
+			Validator: ih.ImportTransactionsValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return importerHandler.ImportTransactions(
+					ctx,
+					req.(*presenter.ImportTransactionsRequest),
+					res.(*presenter.ImportTransactionsResponse),
+				)
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
+	// ========== Export ========== //
+
+	exporterHandler := exh.NewExporterHandler(s.exporterUseCase)
+
+	// export data
+	r.RegisterHttpRoute(&router.HttpRoute{
+		Path:   config.PathExportData,
+		Method: http.MethodPost,
+		Handler: router.Handler{
+			Req:       new(presenter.ExportDataRequest),
+			Res:       new(presenter.ExportDataResponse),
+			Validator: exh.ExportDataValidator,
+			HandleFunc: func(ctx context.Context, req, res interface{}) error {
+				return exporterHandler.ExportData(ctx, req.(*presenter.ExportDataRequest), res.(*presenter.ExportDataResponse))
+			},
+		},
+		Middlewares: []router.Middleware{userAuthMiddleware},
+	})
+
 	// ========== User ========== //
 
 	userHandler := uh.NewUserHandler(s.userUseCase)
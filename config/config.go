@@ -24,6 +24,7 @@ type Config struct {
 	OTPMemCache         *MemCache             `json:"otp_mem_cache"`
 	Brevo               *Brevo                `json:"brevo"`
 	Gmail               *Gmail                `json:"gmail"`
+	AttachmentStore     *LocalBlobStore       `json:"attachment_store"`
 	Global              *Global               `json:"global"`
 }
 
@@ -149,6 +150,10 @@ type Gmail struct {
 	Password string `json:"password"`
 }
 
+type LocalBlobStore struct {
+	Dir string `json:"dir"`
+}
+
 func NewConfig() *Config {
 	return &Config{
 		RateLimits: make(map[string]*RateLimit),
@@ -210,6 +215,9 @@ func NewConfig() *Config {
 			Email:    "",
 			Password: "",
 		},
+		AttachmentStore: &LocalBlobStore{
+			Dir: "data/attachments",
+		},
 		ExchangeRateHost: &ExchangeRateHost{
 			BaseURL: "https://api.currencyapi.com/v3",
 			APIKey:  "",
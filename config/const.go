@@ -4,59 +4,113 @@ const (
 	PathHealthCheck = "/"
 
 	// User APIs
-	PathV1Prefix                = "/api/v1/"
-	PathSignUp                  = PathV1Prefix + "sign_up"
-	PathLogin                   = PathV1Prefix + "log_in"
-	PathGetUser                 = PathV1Prefix + "get_user"
-	PathVerifyEmail             = PathV1Prefix + "verify_email"
-	PathInitUser                = PathV1Prefix + "init_user"
-	PathSendOTP                 = PathV1Prefix + "send_otp"
-	PathUpdateUserMeta          = PathV1Prefix + "update_user_meta"
-	PathCreateAccount           = PathV1Prefix + "create_account"
-	PathGetAccount              = PathV1Prefix + "get_account"
-	PathUpdateAccount           = PathV1Prefix + "update_account"
-	PathGetAccounts             = PathV1Prefix + "get_accounts"
-	PathDeleteAccount           = PathV1Prefix + "delete_account"
-	PathGetAccountsSummary      = PathV1Prefix + "get_accounts_summary"
-	PathCreateCategory          = PathV1Prefix + "create_category"
-	PathUpdateCategory          = PathV1Prefix + "update_category"
-	PathGetCategory             = PathV1Prefix + "get_category"
-	PathGetCategoryBudget       = PathV1Prefix + "get_category_budget"
-	PathGetCategories           = PathV1Prefix + "get_categories"
-	PathGetCategoriesBudget     = PathV1Prefix + "get_categories_budget"
-	PathDeleteCategory          = PathV1Prefix + "delete_category"
-	PathSumCategoryTransactions = PathV1Prefix + "sum_category_transactions"
-	PathCreateTransaction       = PathV1Prefix + "create_transaction"
-	PathUpdateTransaction       = PathV1Prefix + "update_transaction"
-	PathDeleteTransaction       = PathV1Prefix + "delete_transaction"
-	PathGetTransaction          = PathV1Prefix + "get_transaction"
-	PathGetTransactions         = PathV1Prefix + "get_transactions"
-	PathGetTransactionGroups    = PathV1Prefix + "get_transaction_groups"
-	PathSumTransactions         = PathV1Prefix + "sum_transactions"
-	PathGetTransactionsSummary  = PathV1Prefix + "get_transactions_summary"
-	PathGetBudget               = PathV1Prefix + "get_budget"
-	PathUpdateBudget            = PathV1Prefix + "update_budget"
-	PathGetBudgets              = PathV1Prefix + "get_budgets"
-	PathCreateBudget            = PathV1Prefix + "create_budget"
-	PathDeleteBudget            = PathV1Prefix + "delete_budget"
-	PathSearchSecurities        = PathV1Prefix + "search_securities"
-	PathCreateHolding           = PathV1Prefix + "create_holding"
-	PathUpdateHolding           = PathV1Prefix + "update_holding"
-	PathGetHolding              = PathV1Prefix + "get_holding"
-	PathDeleteHolding           = PathV1Prefix + "delete_holding"
-	PathCreateLot               = PathV1Prefix + "create_lot"
-	PathDeleteLot               = PathV1Prefix + "delete_lot"
-	PathUpdateLot               = PathV1Prefix + "update_lot"
-	PathGetLot                  = PathV1Prefix + "get_lot"
-	PathGetLots                 = PathV1Prefix + "get_lots"
-	PathCreateFeedback          = PathV1Prefix + "create_feedback"
-	PathGetExchangeRate         = PathV1Prefix + "get_exchange_rate"
-	PathGetCurrencies           = PathV1Prefix + "get_currencies"
-	PathGetMetrics              = PathV1Prefix + "get_metrics"
+	PathV1Prefix                   = "/api/v1/"
+	PathSignUp                     = PathV1Prefix + "sign_up"
+	PathLogin                      = PathV1Prefix + "log_in"
+	PathGetUser                    = PathV1Prefix + "get_user"
+	PathVerifyEmail                = PathV1Prefix + "verify_email"
+	PathInitUser                   = PathV1Prefix + "init_user"
+	PathSendOTP                    = PathV1Prefix + "send_otp"
+	PathUpdateUserMeta             = PathV1Prefix + "update_user_meta"
+	PathCreateAccount              = PathV1Prefix + "create_account"
+	PathGetAccount                 = PathV1Prefix + "get_account"
+	PathUpdateAccount              = PathV1Prefix + "update_account"
+	PathGetAccounts                = PathV1Prefix + "get_accounts"
+	PathDeleteAccount              = PathV1Prefix + "delete_account"
+	PathGetDeletedAccounts         = PathV1Prefix + "get_deleted_accounts"
+	PathRestoreAccount             = PathV1Prefix + "restore_account"
+	PathGetAccountsSummary         = PathV1Prefix + "get_accounts_summary"
+	PathGetAmortisationSchedule    = PathV1Prefix + "get_amortisation_schedule"
+	PathGetInterestProjection      = PathV1Prefix + "get_interest_projection"
+	PathCreateCategory             = PathV1Prefix + "create_category"
+	PathUpdateCategory             = PathV1Prefix + "update_category"
+	PathGetCategory                = PathV1Prefix + "get_category"
+	PathGetCategoryBudget          = PathV1Prefix + "get_category_budget"
+	PathGetCategories              = PathV1Prefix + "get_categories"
+	PathGetCategoryTree            = PathV1Prefix + "get_category_tree"
+	PathGetCategoriesBudget        = PathV1Prefix + "get_categories_budget"
+	PathDeleteCategory             = PathV1Prefix + "delete_category"
+	PathGetDeletedCategories       = PathV1Prefix + "get_deleted_categories"
+	PathRestoreCategory            = PathV1Prefix + "restore_category"
+	PathMergeCategories            = PathV1Prefix + "merge_categories"
+	PathReorderCategories          = PathV1Prefix + "reorder_categories"
+	PathSumCategoryTransactions    = PathV1Prefix + "sum_category_transactions"
+	PathCreateTransaction          = PathV1Prefix + "create_transaction"
+	PathUpdateTransaction          = PathV1Prefix + "update_transaction"
+	PathDeleteTransaction          = PathV1Prefix + "delete_transaction"
+	PathGetDeletedTransactions     = PathV1Prefix + "get_deleted_transactions"
+	PathRestoreTransaction         = PathV1Prefix + "restore_transaction"
+	PathReconcileAccount           = PathV1Prefix + "reconcile_account"
+	PathGetCreditCardCycle         = PathV1Prefix + "get_credit_card_cycle"
+	PathGetAccountBalanceHistory   = PathV1Prefix + "get_account_balance_history"
+	PathGetTransaction             = PathV1Prefix + "get_transaction"
+	PathGetTransactions            = PathV1Prefix + "get_transactions"
+	PathSearchTransactions         = PathV1Prefix + "search_transactions"
+	PathGetTransactionGroups       = PathV1Prefix + "get_transaction_groups"
+	PathSumTransactions            = PathV1Prefix + "sum_transactions"
+	PathGetTransactionsSummary     = PathV1Prefix + "get_transactions_summary"
+	PathFindDuplicateTransactions  = PathV1Prefix + "find_duplicate_transactions"
+	PathMergeTransactions          = PathV1Prefix + "merge_transactions"
+	PathBulkUpdateTransactions     = PathV1Prefix + "bulk_update_transactions"
+	PathBulkDeleteTransactions     = PathV1Prefix + "bulk_delete_transactions"
+	PathGetBudget                  = PathV1Prefix + "get_budget"
+	PathUpdateBudget               = PathV1Prefix + "update_budget"
+	PathGetBudgets                 = PathV1Prefix + "get_budgets"
+	PathCreateBudget               = PathV1Prefix + "create_budget"
+	PathDeleteBudget               = PathV1Prefix + "delete_budget"
+	PathSearchSecurities           = PathV1Prefix + "search_securities"
+	PathCreateHolding              = PathV1Prefix + "create_holding"
+	PathUpdateHolding              = PathV1Prefix + "update_holding"
+	PathGetHolding                 = PathV1Prefix + "get_holding"
+	PathDeleteHolding              = PathV1Prefix + "delete_holding"
+	PathCreateLot                  = PathV1Prefix + "create_lot"
+	PathDeleteLot                  = PathV1Prefix + "delete_lot"
+	PathUpdateLot                  = PathV1Prefix + "update_lot"
+	PathGetLot                     = PathV1Prefix + "get_lot"
+	PathGetLots                    = PathV1Prefix + "get_lots"
+	PathCreateFeedback             = PathV1Prefix + "create_feedback"
+	PathGetExchangeRate            = PathV1Prefix + "get_exchange_rate"
+	PathGetCurrencies              = PathV1Prefix + "get_currencies"
+	PathGetMetrics                 = PathV1Prefix + "get_metrics"
+	PathCreateRecurringTransaction = PathV1Prefix + "create_recurring_transaction"
+	PathUpdateRecurringTransaction = PathV1Prefix + "update_recurring_transaction"
+	PathGetRecurringTransaction    = PathV1Prefix + "get_recurring_transaction"
+	PathGetRecurringTransactions   = PathV1Prefix + "get_recurring_transactions"
+	PathDeleteRecurringTransaction = PathV1Prefix + "delete_recurring_transaction"
+	PathCreateTag                  = PathV1Prefix + "create_tag"
+	PathUpdateTag                  = PathV1Prefix + "update_tag"
+	PathGetTag                     = PathV1Prefix + "get_tag"
+	PathGetTags                    = PathV1Prefix + "get_tags"
+	PathDeleteTag                  = PathV1Prefix + "delete_tag"
+	PathSumTagTransactions         = PathV1Prefix + "sum_tag_transactions"
+	PathCreatePayee                = PathV1Prefix + "create_payee"
+	PathUpdatePayee                = PathV1Prefix + "update_payee"
+	PathGetPayee                   = PathV1Prefix + "get_payee"
+	PathGetPayees                  = PathV1Prefix + "get_payees"
+	PathDeletePayee                = PathV1Prefix + "delete_payee"
+	PathSumTransactionsByPayee     = PathV1Prefix + "sum_transactions_by_payee"
+	PathCreateImportProfile        = PathV1Prefix + "create_import_profile"
+	PathUpdateImportProfile        = PathV1Prefix + "update_import_profile"
+	PathGetImportProfile           = PathV1Prefix + "get_import_profile"
+	PathGetImportProfiles          = PathV1Prefix + "get_import_profiles"
+	PathDeleteImportProfile        = PathV1Prefix + "delete_import_profile"
+	PathImportTransactions         = PathV1Prefix + "import_transactions"
+	PathExportData                 = PathV1Prefix + "export_data"
+	PathCreateRule                 = PathV1Prefix + "create_rule"
+	PathUpdateRule                 = PathV1Prefix + "update_rule"
+	PathGetRule                    = PathV1Prefix + "get_rule"
+	PathGetRules                   = PathV1Prefix + "get_rules"
+	PathDeleteRule                 = PathV1Prefix + "delete_rule"
+	PathApplyRules                 = PathV1Prefix + "apply_rules"
+	PathUploadAttachment           = PathV1Prefix + "upload_attachment"
+	PathGetAttachments             = PathV1Prefix + "get_attachments"
+	PathDownloadAttachment         = PathV1Prefix + "download_attachment"
+	PathDeleteAttachment           = PathV1Prefix + "delete_attachment"
 
 	// Admin APIs
 	PathAdminV1Prefix   = "/api/admin/v1/"
 	PathAdminSyncQuotes = PathAdminV1Prefix + "sync_quotes"
+	PathAdminExportData = PathAdminV1Prefix + "export_data"
 )
 
 const (
@@ -70,6 +124,32 @@ const (
 
 	MaxTransactionNoteLength = 120
 	MaxAccountNoteLength     = 60
+	MaxIconLength            = 50
+	MaxTagNameLength         = 30
+	MaxImportRows            = 1000
+	MaxBulkTransactions      = 500
+	MaxRuleNameLength        = 50
+	MaxPayeeNameLength       = 50
+	MaxPayeeAliases          = 20
+	DefaultTopPayees         = 10
+	MaxAttachmentSize        = 5 * 1024 * 1024 // 5 MB
+	MaxAttachmentNameLength  = 100
+	MaxAttachmentsPerTxn     = 10
+
+	ExportPageLimit = 1000
+
+	DefaultDuplicateWindow        = 3 * 24 * 60 * 60 * 1000 // 3 days in ms
+	DefaultDuplicateMinConfidence = 0.5
+
+	DefaultTrashRetentionDays = 30
+
+	DefaultMinPaymentPercent   = 3
+	DefaultPaymentReminderDays = 3
+	DefaultPaymentReminderTZ   = "Asia/Singapore"
+
+	MaxBalanceHistoryInterval = 366
+
+	MinNoteTokenLength = 2
 
 	PasswordMinLength = 8
 	SaltByteSize      = 24
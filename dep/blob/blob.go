@@ -0,0 +1,20 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var (
+	ErrBlobNotFound = errors.New("blob not found")
+	ErrInvalidKey   = errors.New("invalid blob key")
+)
+
+// Store keeps binary objects by key. Keys are slash-separated paths, e.g. userID/objectID.
+type Store interface {
+	Put(ctx context.Context, key string, r io.Reader) error
+	// Get returns the content of the blob, the caller must close it.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
@@ -22,11 +22,13 @@ var (
 var emailTmpls embed.FS
 
 var emailPaths = map[uint32]string{
-	uint32(mailer.TemplateOTP): "tmpl/verify_otp.html",
+	uint32(mailer.TemplateOTP):        "tmpl/verify_otp.html",
+	uint32(mailer.TemplatePaymentDue): "tmpl/payment_due.html",
 }
 
 var emailSubjects = map[uint32]string{
-	uint32(mailer.TemplateOTP): "One-Time Password",
+	uint32(mailer.TemplateOTP):        "One-Time Password",
+	uint32(mailer.TemplatePaymentDue): "Credit Card Payment Due",
 }
 
 type GmailMgr struct {
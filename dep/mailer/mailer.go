@@ -6,6 +6,7 @@ type Template uint32
 
 const (
 	TemplateOTP Template = iota + 1
+	TemplatePaymentDue
 )
 
 type SendEmailRequest struct {
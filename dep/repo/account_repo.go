@@ -20,16 +20,21 @@ type AccountRepo interface {
 	Create(ctx context.Context, ac *entity.Account) (string, error)
 	CreateMany(ctx context.Context, acs []*entity.Account) ([]string, error)
 	Update(ctx context.Context, acf *AccountFilter, acu *entity.AccountUpdate) error
+
+	DeleteMany(ctx context.Context, acf *AccountFilter) error
 }
 
 type AccountFilter struct {
-	UserID            *string  `filter:"user_id"`
-	AccountID         *string  `filter:"_id"`
-	AccountIDs        []string `filter:"_id__in"`
-	AccountName       *string  `filter:"account_name"`
-	AccountType       *uint32  `filter:"account_type"`
-	AccountTypeBitPos []uint32 `filter:"account_type__bitsAllSet"` // bit pos
-	AccountStatus     *uint32  `filter:"account_status"`
+	UserID             *string  `filter:"user_id"`
+	AccountID          *string  `filter:"_id"`
+	AccountIDs         []string `filter:"_id__in"`
+	AccountName        *string  `filter:"account_name"`
+	AccountType        *uint32  `filter:"account_type"`
+	AccountTypeBitPos  []uint32 `filter:"account_type__bitsAllSet"` // bit pos
+	AccountStatus      *uint32  `filter:"account_status"`
+	UpdateTimeLte      *uint64  `filter:"update_time__lte"`
+	InterestCategoryID *string  `filter:"interest_category_id"`
+	Paging             *Paging  `filter:"-"`
 }
 
 type AccountFilterOption = func(acf *AccountFilter)
@@ -72,6 +77,24 @@ func WitAccountType(accountType *uint32) AccountFilterOption {
 	}
 }
 
+func WithAccountUpdateTimeLte(updateTimeLte *uint64) AccountFilterOption {
+	return func(acf *AccountFilter) {
+		acf.UpdateTimeLte = updateTimeLte
+	}
+}
+
+func WithAccountInterestCategoryID(interestCategoryID *string) AccountFilterOption {
+	return func(acf *AccountFilter) {
+		acf.InterestCategoryID = interestCategoryID
+	}
+}
+
+func WithAccountPaging(paging *Paging) AccountFilterOption {
+	return func(acf *AccountFilter) {
+		acf.Paging = paging
+	}
+}
+
 func NewAccountFilter(userID string, opts ...AccountFilterOption) *AccountFilter {
 	acf := &AccountFilter{
 		UserID:        goutil.String(userID),
@@ -131,3 +154,17 @@ func (f *AccountFilter) GetAccountTypeBitPos() []uint32 {
 	}
 	return nil
 }
+
+func (f *AccountFilter) GetInterestCategoryID() string {
+	if f != nil && f.InterestCategoryID != nil {
+		return *f.InterestCategoryID
+	}
+	return ""
+}
+
+func (f *AccountFilter) GetPaging() *Paging {
+	if f != nil && f.Paging != nil {
+		return f.Paging
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/errutil"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+)
+
+var (
+	ErrAttachmentNotFound = errutil.NotFoundError(errors.New("attachment not found"))
+)
+
+type AttachmentRepo interface {
+	Get(ctx context.Context, af *AttachmentFilter) (*entity.Attachment, error)
+	GetMany(ctx context.Context, af *AttachmentFilter) ([]*entity.Attachment, error)
+
+	Create(ctx context.Context, a *entity.Attachment) (string, error)
+
+	DeleteMany(ctx context.Context, af *AttachmentFilter) error
+}
+
+type AttachmentFilter struct {
+	UserID         *string  `filter:"user_id"`
+	AttachmentID   *string  `filter:"_id"`
+	TransactionID  *string  `filter:"transaction_id"`
+	TransactionIDs []string `filter:"transaction_id__in"`
+}
+
+type AttachmentFilterOption = func(af *AttachmentFilter)
+
+func WithAttachmentID(attachmentID *string) AttachmentFilterOption {
+	return func(af *AttachmentFilter) {
+		af.AttachmentID = attachmentID
+	}
+}
+
+func WithAttachmentTransactionID(transactionID *string) AttachmentFilterOption {
+	return func(af *AttachmentFilter) {
+		af.TransactionID = transactionID
+	}
+}
+
+func WithAttachmentTransactionIDs(transactionIDs []string) AttachmentFilterOption {
+	return func(af *AttachmentFilter) {
+		af.TransactionIDs = transactionIDs
+	}
+}
+
+func NewAttachmentFilter(userID string, opts ...AttachmentFilterOption) *AttachmentFilter {
+	af := &AttachmentFilter{
+		UserID: goutil.String(userID),
+	}
+	for _, opt := range opts {
+		opt(af)
+	}
+	return af
+}
+
+func (f *AttachmentFilter) GetUserID() string {
+	if f != nil && f.UserID != nil {
+		return *f.UserID
+	}
+	return ""
+}
+
+func (f *AttachmentFilter) GetAttachmentID() string {
+	if f != nil && f.AttachmentID != nil {
+		return *f.AttachmentID
+	}
+	return ""
+}
+
+func (f *AttachmentFilter) GetTransactionID() string {
+	if f != nil && f.TransactionID != nil {
+		return *f.TransactionID
+	}
+	return ""
+}
+
+func (f *AttachmentFilter) GetTransactionIDs() []string {
+	if f != nil && f.TransactionIDs != nil {
+		return f.TransactionIDs
+	}
+	return nil
+}
@@ -19,6 +19,7 @@ var (
 
 type BudgetRepo interface {
 	Get(ctx context.Context, f *GetBudgetFilter) (*entity.Budget, error)
+	GetMany(ctx context.Context, bq *BudgetQuery) ([]*entity.Budget, error)
 
 	Create(ctx context.Context, b *entity.Budget) (string, error)
 	CreateMany(ctx context.Context, bs []*entity.Budget) ([]string, error)
@@ -32,6 +33,9 @@ type DeleteBudgetFilter struct {
 	BudgetDate   *string
 	BudgetRepeat *uint32
 	BudgetType   *uint32
+	WeekStartDay *uint32
+	PeriodDays   *uint32
+	AnchorDate   *uint64
 }
 
 func (m *DeleteBudgetFilter) GetUserID() string {
@@ -69,6 +73,27 @@ func (m *DeleteBudgetFilter) GetBudgetType() uint32 {
 	return 0
 }
 
+func (m *DeleteBudgetFilter) GetWeekStartDay() uint32 {
+	if m != nil && m.WeekStartDay != nil {
+		return *m.WeekStartDay
+	}
+	return 0
+}
+
+func (m *DeleteBudgetFilter) GetPeriodDays() uint32 {
+	if m != nil && m.PeriodDays != nil {
+		return *m.PeriodDays
+	}
+	return 0
+}
+
+func (m *DeleteBudgetFilter) GetAnchorDate() uint64 {
+	if m != nil && m.AnchorDate != nil {
+		return *m.AnchorDate
+	}
+	return 0
+}
+
 type GetBudgetFilter struct {
 	UserID     *string
 	CategoryID *string
@@ -171,13 +196,14 @@ func (q *BudgetQuery) GetOp() filter.BoolOp {
 }
 
 type BudgetFilter struct {
-	UserID       *string `filter:"user_id"`
-	CategoryID   *string `filter:"category_id"`
-	StartDate    *uint64 `filter:"start_date"`
-	StartDateLte *uint64 `filter:"start_date__lte"`
-	EndDate      *uint64 `filter:"end_date"`
-	EndDateGte   *uint64 `filter:"end_date__gte"`
-	BudgetStatus *uint32 `filter:"budget_status"`
+	UserID       *string  `filter:"user_id"`
+	CategoryID   *string  `filter:"category_id"`
+	CategoryIDs  []string `filter:"category_id__in"`
+	StartDate    *uint64  `filter:"start_date"`
+	StartDateLte *uint64  `filter:"start_date__lte"`
+	EndDate      *uint64  `filter:"end_date"`
+	EndDateGte   *uint64  `filter:"end_date__gte"`
+	BudgetStatus *uint32  `filter:"budget_status"`
 }
 
 func (f *BudgetFilter) GetCategoryID() string {
@@ -187,6 +213,13 @@ func (f *BudgetFilter) GetCategoryID() string {
 	return ""
 }
 
+func (f *BudgetFilter) GetCategoryIDs() []string {
+	if f != nil && f.CategoryIDs != nil {
+		return f.CategoryIDs
+	}
+	return nil
+}
+
 func (f *BudgetFilter) GetUserID() string {
 	if f != nil && f.UserID != nil {
 		return *f.UserID
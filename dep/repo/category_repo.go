@@ -20,15 +20,21 @@ type CategoryRepo interface {
 	Create(ctx context.Context, c *entity.Category) (string, error)
 	CreateMany(ctx context.Context, cs []*entity.Category) ([]string, error)
 	Update(ctx context.Context, cf *CategoryFilter, c *entity.CategoryUpdate) error
+	UpdateMany(ctx context.Context, cf *CategoryFilter, c *entity.CategoryUpdate) error
+
+	DeleteMany(ctx context.Context, cf *CategoryFilter) error
 }
 
 type CategoryFilter struct {
-	UserID         *string  `filter:"user_id"`
-	CategoryID     *string  `filter:"_id"`
-	CategoryIDs    []string `filter:"_id__in"`
-	CategoryType   *uint32  `filter:"category_type"`
-	CategoryStatus *uint32  `filter:"category_status"`
-	CategoryName   *string  `filter:"category_name"`
+	UserID           *string  `filter:"user_id"`
+	CategoryID       *string  `filter:"_id"`
+	CategoryIDs      []string `filter:"_id__in"`
+	ParentCategoryID *string  `filter:"parent_category_id"`
+	CategoryType     *uint32  `filter:"category_type"`
+	CategoryStatus   *uint32  `filter:"category_status"`
+	CategoryName     *string  `filter:"category_name"`
+	UpdateTimeLte    *uint64  `filter:"update_time__lte"`
+	Paging           *Paging  `filter:"-"`
 }
 
 type CategoryFilterOption = func(cf *CategoryFilter)
@@ -45,6 +51,12 @@ func WithCategoryIDs(categoryIDs []string) CategoryFilterOption {
 	}
 }
 
+func WithCategoryParentCategoryID(parentCategoryID *string) CategoryFilterOption {
+	return func(cf *CategoryFilter) {
+		cf.ParentCategoryID = parentCategoryID
+	}
+}
+
 func WithCategoryType(categoryType *uint32) CategoryFilterOption {
 	return func(cf *CategoryFilter) {
 		cf.CategoryType = categoryType
@@ -63,6 +75,18 @@ func WithCategoryName(categoryName *string) CategoryFilterOption {
 	}
 }
 
+func WithCategoryUpdateTimeLte(updateTimeLte *uint64) CategoryFilterOption {
+	return func(cf *CategoryFilter) {
+		cf.UpdateTimeLte = updateTimeLte
+	}
+}
+
+func WithCategoryPaging(paging *Paging) CategoryFilterOption {
+	return func(cf *CategoryFilter) {
+		cf.Paging = paging
+	}
+}
+
 func NewCategoryFilter(userID string, opts ...CategoryFilterOption) *CategoryFilter {
 	cf := &CategoryFilter{
 		UserID:         goutil.String(userID),
@@ -102,6 +126,13 @@ func (f *CategoryFilter) GetCategoryIDs() []string {
 	return nil
 }
 
+func (f *CategoryFilter) GetParentCategoryID() string {
+	if f != nil && f.ParentCategoryID != nil {
+		return *f.ParentCategoryID
+	}
+	return ""
+}
+
 func (f *CategoryFilter) GetCategoryType() uint32 {
 	if f != nil && f.CategoryType != nil {
 		return *f.CategoryType
@@ -115,3 +146,10 @@ func (f *CategoryFilter) GetCategoryStatus() uint32 {
 	}
 	return 0
 }
+
+func (f *CategoryFilter) GetPaging() *Paging {
+	if f != nil && f.Paging != nil {
+		return f.Paging
+	}
+	return nil
+}
@@ -21,16 +21,20 @@ type HoldingRepo interface {
 	CreateMany(ctx context.Context, hs []*entity.Holding) ([]string, error)
 	Update(ctx context.Context, hf *HoldingFilter, hu *entity.HoldingUpdate) error
 	UpdateMany(ctx context.Context, hf *HoldingFilter, hu *entity.HoldingUpdate) error
+
+	DeleteMany(ctx context.Context, hf *HoldingFilter) error
 }
 
 type HoldingFilter struct {
 	HoldingID     *string  `filter:"_id"`
 	HoldingIDs    []string `filter:"_id__in"`
 	AccountID     *string  `filter:"account_id"`
+	AccountIDs    []string `filter:"account_id__in"`
 	UserID        *string  `filter:"user_id"`
 	Symbol        *string  `filter:"symbol"`
 	HoldingType   *uint32  `filter:"holding_type"`
 	HoldingStatus *uint32  `filter:"holding_status"`
+	UpdateTime    *uint64  `filter:"update_time"`
 	Paging        *Paging  `filter:"-"`
 }
 
@@ -78,6 +82,18 @@ func WithHoldingIDs(holdingIDs []string) HoldingFilterOption {
 	}
 }
 
+func WithHoldingAccountIDs(accountIDs []string) HoldingFilterOption {
+	return func(hf *HoldingFilter) {
+		hf.AccountIDs = accountIDs
+	}
+}
+
+func WithHoldingUpdateTime(updateTime *uint64) HoldingFilterOption {
+	return func(hf *HoldingFilter) {
+		hf.UpdateTime = updateTime
+	}
+}
+
 func WithHoldingPaging(paging *Paging) HoldingFilterOption {
 	return func(hf *HoldingFilter) {
 		hf.Paging = paging
@@ -0,0 +1,74 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/errutil"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+)
+
+var (
+	ErrImportProfileNotFound = errutil.NotFoundError(errors.New("import profile not found"))
+)
+
+type ImportProfileRepo interface {
+	Get(ctx context.Context, ipf *ImportProfileFilter) (*entity.ImportProfile, error)
+	GetMany(ctx context.Context, ipf *ImportProfileFilter) ([]*entity.ImportProfile, error)
+
+	Create(ctx context.Context, ip *entity.ImportProfile) (string, error)
+	Update(ctx context.Context, ipf *ImportProfileFilter, ipu *entity.ImportProfileUpdate) error
+}
+
+type ImportProfileFilter struct {
+	UserID          *string `filter:"user_id"`
+	ImportProfileID *string `filter:"_id"`
+	ProfileStatus   *uint32 `filter:"profile_status"`
+}
+
+type ImportProfileFilterOption = func(ipf *ImportProfileFilter)
+
+func WithImportProfileID(importProfileID *string) ImportProfileFilterOption {
+	return func(ipf *ImportProfileFilter) {
+		ipf.ImportProfileID = importProfileID
+	}
+}
+
+func WithImportProfileStatus(profileStatus *uint32) ImportProfileFilterOption {
+	return func(ipf *ImportProfileFilter) {
+		ipf.ProfileStatus = profileStatus
+	}
+}
+
+func NewImportProfileFilter(userID string, opts ...ImportProfileFilterOption) *ImportProfileFilter {
+	ipf := &ImportProfileFilter{
+		UserID:        goutil.String(userID),
+		ProfileStatus: goutil.Uint32(uint32(entity.ImportProfileStatusNormal)),
+	}
+	for _, opt := range opts {
+		opt(ipf)
+	}
+	return ipf
+}
+
+func (f *ImportProfileFilter) GetUserID() string {
+	if f != nil && f.UserID != nil {
+		return *f.UserID
+	}
+	return ""
+}
+
+func (f *ImportProfileFilter) GetImportProfileID() string {
+	if f != nil && f.ImportProfileID != nil {
+		return *f.ImportProfileID
+	}
+	return ""
+}
+
+func (f *ImportProfileFilter) GetProfileStatus() uint32 {
+	if f != nil && f.ProfileStatus != nil {
+		return *f.ProfileStatus
+	}
+	return 0
+}
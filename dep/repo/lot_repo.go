@@ -21,6 +21,8 @@ type LotRepo interface {
 	CreateMany(ctx context.Context, ls []*entity.Lot) ([]string, error)
 	Update(ctx context.Context, lf *LotFilter, lu *entity.LotUpdate) error
 	UpdateMany(ctx context.Context, lf *LotFilter, lu *entity.LotUpdate) error
+
+	DeleteMany(ctx context.Context, lf *LotFilter) error
 }
 
 type LotFilter struct {
@@ -30,6 +32,7 @@ type LotFilter struct {
 	HoldingID  *string  `filter:"holding_id"`
 	HoldingIDs []string `filter:"holding_id__in"`
 	LotStatus  *uint32  `filter:"lot_status"`
+	UpdateTime *uint64  `filter:"update_time"`
 	Paging     *Paging  `filter:"-"`
 }
 
@@ -53,6 +56,12 @@ func WithLotStatus(lotStatus *uint32) LotFilterOption {
 	}
 }
 
+func WithLotUpdateTime(updateTime *uint64) LotFilterOption {
+	return func(lf *LotFilter) {
+		lf.UpdateTime = updateTime
+	}
+}
+
 func WithLotPaging(paging *Paging) LotFilterOption {
 	return func(lf *LotFilter) {
 		lf.Paging = paging
@@ -80,7 +80,7 @@ func (m *accountMongo) Get(ctx context.Context, acf *repo.AccountFilter) (*entit
 func (m *accountMongo) GetMany(ctx context.Context, acf *repo.AccountFilter) ([]*entity.Account, error) {
 	f := mongoutil.BuildFilter(acf)
 
-	res, err := m.mColl.getMany(ctx, new(model.Account), nil, f)
+	res, err := m.mColl.getMany(ctx, new(model.Account), acf.Paging, f)
 	if err != nil {
 		return nil, err
 	}
@@ -96,3 +96,7 @@ func (m *accountMongo) GetMany(ctx context.Context, acf *repo.AccountFilter) ([]
 
 	return acs, nil
 }
+
+func (m *accountMongo) DeleteMany(ctx context.Context, acf *repo.AccountFilter) error {
+	return m.mColl.deleteMany(ctx, acf)
+}
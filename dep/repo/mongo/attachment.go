@@ -0,0 +1,73 @@
+package mongo
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/dep/repo"
+	"github.com/jseow5177/pockteer-be/dep/repo/mongo/model"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/jseow5177/pockteer-be/pkg/mongoutil"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const attachmentCollName = "attachment"
+
+type attachmentMongo struct {
+	mColl *MongoColl
+}
+
+func NewAttachmentMongo(mongo *Mongo) repo.AttachmentRepo {
+	return &attachmentMongo{
+		mColl: NewMongoColl(mongo, attachmentCollName),
+	}
+}
+
+func (m *attachmentMongo) Create(ctx context.Context, a *entity.Attachment) (string, error) {
+	am := model.ToAttachmentModelFromEntity(a)
+	id, err := m.mColl.create(ctx, am)
+	if err != nil {
+		return "", err
+	}
+	a.SetAttachmentID(goutil.String(id))
+
+	return id, nil
+}
+
+func (m *attachmentMongo) Get(ctx context.Context, af *repo.AttachmentFilter) (*entity.Attachment, error) {
+	f := mongoutil.BuildFilter(af)
+
+	a := new(model.Attachment)
+	if err := m.mColl.get(ctx, &a, f); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, repo.ErrAttachmentNotFound
+		}
+		return nil, err
+	}
+
+	return model.ToAttachmentEntity(a)
+}
+
+func (m *attachmentMongo) GetMany(ctx context.Context, af *repo.AttachmentFilter) ([]*entity.Attachment, error) {
+	f := mongoutil.BuildFilter(af)
+
+	res, err := m.mColl.getMany(ctx, new(model.Attachment), nil, f)
+	if err != nil {
+		return nil, err
+	}
+
+	eas := make([]*entity.Attachment, 0, len(res))
+	for _, r := range res {
+		ea, err := model.ToAttachmentEntity(r.(*model.Attachment))
+		if err != nil {
+			return nil, err
+		}
+		eas = append(eas, ea)
+	}
+
+	return eas, nil
+}
+
+func (m *attachmentMongo) DeleteMany(ctx context.Context, af *repo.AttachmentFilter) error {
+	return m.mColl.deleteMany(ctx, af)
+}
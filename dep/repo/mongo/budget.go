@@ -102,29 +102,26 @@ func (m *budgetMongo) DeleteMany(ctx context.Context, f *repo.BudgetFilter) erro
 }
 
 func (m *budgetMongo) Delete(ctx context.Context, f *repo.DeleteBudgetFilter) error {
-	startDate, endDate, err := entity.GetBudgetStartEnd(
-		f.GetBudgetDate(),
-		f.GetBudgetType(),
-		f.GetBudgetRepeat(),
-	)
-	if err != nil {
-		return err
-	}
-
 	dummyBudget, err := entity.NewBudget(
 		f.GetUserID(),
 		f.GetCategoryID(),
 		entity.WithBudgetCurrency(goutil.String("")),
 		entity.WithBudgetAmount(goutil.Float64(0)),
 		entity.WithBudgetType(f.BudgetType),
+		entity.WithBudgetWeekStartDay(f.WeekStartDay),
+		entity.WithBudgetPeriodDays(f.PeriodDays),
+		entity.WithBudgetAnchorDate(f.AnchorDate),
 		entity.WithBudgetStatus(goutil.Uint32(uint32(entity.BudgetStatusDeleted))),
-		entity.WithBudgetStartDate(goutil.Uint64(startDate)),
-		entity.WithBudgetEndDate(goutil.Uint64(endDate)),
 	)
 	if err != nil {
 		return err
 	}
 
+	// the deleted record covers the same dates as the budget it hides
+	if err := dummyBudget.SetRepeat(f.GetBudgetDate(), f.GetBudgetRepeat()); err != nil {
+		return err
+	}
+
 	if _, err := m.Create(ctx, dummyBudget); err != nil {
 		return err
 	}
@@ -63,6 +63,17 @@ func (m *categoryMongo) Update(ctx context.Context, cf *repo.CategoryFilter, cu
 	return nil
 }
 
+func (m *categoryMongo) UpdateMany(ctx context.Context, cf *repo.CategoryFilter, cu *entity.CategoryUpdate) error {
+	f := mongoutil.BuildFilter(cf)
+
+	cm := model.ToCategoryModelFromUpdate(cu)
+	if err := m.mColl.updateMany(ctx, f, cm); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *categoryMongo) Get(ctx context.Context, cf *repo.CategoryFilter) (*entity.Category, error) {
 	f := mongoutil.BuildFilter(cf)
 
@@ -80,7 +91,7 @@ func (m *categoryMongo) Get(ctx context.Context, cf *repo.CategoryFilter) (*enti
 func (m *categoryMongo) GetMany(ctx context.Context, cf *repo.CategoryFilter) ([]*entity.Category, error) {
 	f := mongoutil.BuildFilter(cf)
 
-	res, err := m.mColl.getMany(ctx, new(model.Category), nil, f)
+	res, err := m.mColl.getMany(ctx, new(model.Category), cf.Paging, f)
 	if err != nil {
 		return nil, err
 	}
@@ -96,3 +107,7 @@ func (m *categoryMongo) GetMany(ctx context.Context, cf *repo.CategoryFilter) ([
 
 	return ecs, nil
 }
+
+func (m *categoryMongo) DeleteMany(ctx context.Context, cf *repo.CategoryFilter) error {
+	return m.mColl.deleteMany(ctx, cf)
+}
@@ -107,3 +107,7 @@ func (m *holdingMongo) GetMany(ctx context.Context, hf *repo.HoldingFilter) ([]*
 
 	return ehs, nil
 }
+
+func (m *holdingMongo) DeleteMany(ctx context.Context, hf *repo.HoldingFilter) error {
+	return m.mColl.deleteMany(ctx, hf)
+}
@@ -0,0 +1,80 @@
+package mongo
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/dep/repo"
+	"github.com/jseow5177/pockteer-be/dep/repo/mongo/model"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/jseow5177/pockteer-be/pkg/mongoutil"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const importProfileCollName = "import_profile"
+
+type importProfileMongo struct {
+	mColl *MongoColl
+}
+
+func NewImportProfileMongo(mongo *Mongo) repo.ImportProfileRepo {
+	return &importProfileMongo{
+		mColl: NewMongoColl(mongo, importProfileCollName),
+	}
+}
+
+func (m *importProfileMongo) Create(ctx context.Context, ip *entity.ImportProfile) (string, error) {
+	ipm := model.ToImportProfileModelFromEntity(ip)
+	id, err := m.mColl.create(ctx, ipm)
+	if err != nil {
+		return "", err
+	}
+	ip.SetImportProfileID(goutil.String(id))
+
+	return id, nil
+}
+
+func (m *importProfileMongo) Update(ctx context.Context, ipf *repo.ImportProfileFilter, ipu *entity.ImportProfileUpdate) error {
+	f := mongoutil.BuildFilter(ipf)
+
+	ipm := model.ToImportProfileModelFromUpdate(ipu)
+	if err := m.mColl.update(ctx, f, ipm); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *importProfileMongo) Get(ctx context.Context, ipf *repo.ImportProfileFilter) (*entity.ImportProfile, error) {
+	f := mongoutil.BuildFilter(ipf)
+
+	ip := new(model.ImportProfile)
+	if err := m.mColl.get(ctx, &ip, f); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, repo.ErrImportProfileNotFound
+		}
+		return nil, err
+	}
+
+	return model.ToImportProfileEntity(ip)
+}
+
+func (m *importProfileMongo) GetMany(ctx context.Context, ipf *repo.ImportProfileFilter) ([]*entity.ImportProfile, error) {
+	f := mongoutil.BuildFilter(ipf)
+
+	res, err := m.mColl.getMany(ctx, new(model.ImportProfile), nil, f)
+	if err != nil {
+		return nil, err
+	}
+
+	eips := make([]*entity.ImportProfile, 0, len(res))
+	for _, r := range res {
+		eip, err := model.ToImportProfileEntity(r.(*model.ImportProfile))
+		if err != nil {
+			return nil, err
+		}
+		eips = append(eips, eip)
+	}
+
+	return eips, nil
+}
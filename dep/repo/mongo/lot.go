@@ -104,3 +104,7 @@ func (m *lotMongo) GetMany(ctx context.Context, lf *repo.LotFilter) ([]*entity.L
 
 	return els, nil
 }
+
+func (m *lotMongo) DeleteMany(ctx context.Context, lf *repo.LotFilter) error {
+	return m.mColl.deleteMany(ctx, lf)
+}
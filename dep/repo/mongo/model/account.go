@@ -7,16 +7,29 @@ import (
 )
 
 type Account struct {
-	AccountID     primitive.ObjectID `bson:"_id,omitempty"`
-	UserID        *string            `bson:"user_id,omitempty"`
-	AccountName   *string            `bson:"account_name,omitempty"`
-	Currency      *string            `bson:"currency,omitempty"`
-	Balance       *float64           `bson:"balance,omitempty"`
-	AccountType   *uint32            `bson:"account_type,omitempty"`
-	AccountStatus *uint32            `bson:"account_status,omitempty"`
-	Note          *string            `bson:"note,omitempty"`
-	CreateTime    *uint64            `bson:"create_time,omitempty"`
-	UpdateTime    *uint64            `bson:"update_time,omitempty"`
+	AccountID          primitive.ObjectID `bson:"_id,omitempty"`
+	UserID             *string            `bson:"user_id,omitempty"`
+	AccountName        *string            `bson:"account_name,omitempty"`
+	Currency           *string            `bson:"currency,omitempty"`
+	Balance            *float64           `bson:"balance,omitempty"`
+	AccountType        *uint32            `bson:"account_type,omitempty"`
+	AccountStatus      *uint32            `bson:"account_status,omitempty"`
+	Note               *string            `bson:"note,omitempty"`
+	Icon               *string            `bson:"icon,omitempty"`
+	Color              *string            `bson:"color,omitempty"`
+	Position           *uint32            `bson:"position,omitempty"`
+	CreateTime         *uint64            `bson:"create_time,omitempty"`
+	UpdateTime         *uint64            `bson:"update_time,omitempty"`
+	StatementDay       *uint32            `bson:"statement_day,omitempty"`
+	DueDay             *uint32            `bson:"due_day,omitempty"`
+	CreditLimit        *float64           `bson:"credit_limit,omitempty"`
+	Principal          *float64           `bson:"principal,omitempty"`
+	InterestRate       *float64           `bson:"interest_rate,omitempty"`
+	Term               *uint32            `bson:"term,omitempty"`
+	StartTime          *uint64            `bson:"start_time,omitempty"`
+	CompoundFrequency  *uint32            `bson:"compound_frequency,omitempty"`
+	InterestCategoryID *string            `bson:"interest_category_id,omitempty"`
+	LastInterestTime   *uint64            `bson:"last_interest_time,omitempty"`
 }
 
 func ToAccountModelFromEntity(ac *entity.Account) *Account {
@@ -30,16 +43,29 @@ func ToAccountModelFromEntity(ac *entity.Account) *Account {
 	}
 
 	return &Account{
-		AccountID:     objID,
-		UserID:        ac.UserID,
-		AccountName:   ac.AccountName,
-		Currency:      ac.Currency,
-		Balance:       ac.Balance,
-		Note:          ac.Note,
-		AccountType:   ac.AccountType,
-		AccountStatus: ac.AccountStatus,
-		CreateTime:    ac.CreateTime,
-		UpdateTime:    ac.UpdateTime,
+		AccountID:          objID,
+		UserID:             ac.UserID,
+		AccountName:        ac.AccountName,
+		Currency:           ac.Currency,
+		Balance:            ac.Balance,
+		Note:               ac.Note,
+		Icon:               ac.Icon,
+		Color:              ac.Color,
+		Position:           ac.Position,
+		AccountType:        ac.AccountType,
+		AccountStatus:      ac.AccountStatus,
+		CreateTime:         ac.CreateTime,
+		UpdateTime:         ac.UpdateTime,
+		StatementDay:       ac.StatementDay,
+		DueDay:             ac.DueDay,
+		CreditLimit:        ac.CreditLimit,
+		Principal:          ac.Principal,
+		InterestRate:       ac.InterestRate,
+		Term:               ac.Term,
+		StartTime:          ac.StartTime,
+		CompoundFrequency:  ac.CompoundFrequency,
+		InterestCategoryID: ac.InterestCategoryID,
+		LastInterestTime:   ac.LastInterestTime,
 	}
 }
 
@@ -49,11 +75,24 @@ func ToAccountModelFromUpdate(acu *entity.AccountUpdate) *Account {
 	}
 
 	return &Account{
-		AccountName:   acu.AccountName,
-		Balance:       acu.Balance,
-		Note:          acu.Note,
-		UpdateTime:    acu.UpdateTime,
-		AccountStatus: acu.AccountStatus,
+		AccountName:        acu.AccountName,
+		Balance:            acu.Balance,
+		Note:               acu.Note,
+		Icon:               acu.Icon,
+		Color:              acu.Color,
+		Position:           acu.Position,
+		UpdateTime:         acu.UpdateTime,
+		AccountStatus:      acu.AccountStatus,
+		StatementDay:       acu.StatementDay,
+		DueDay:             acu.DueDay,
+		CreditLimit:        acu.CreditLimit,
+		Principal:          acu.Principal,
+		InterestRate:       acu.InterestRate,
+		Term:               acu.Term,
+		StartTime:          acu.StartTime,
+		CompoundFrequency:  acu.CompoundFrequency,
+		InterestCategoryID: acu.InterestCategoryID,
+		LastInterestTime:   acu.LastInterestTime,
 	}
 }
 
@@ -71,8 +110,21 @@ func ToAccountEntity(ac *Account) (*entity.Account, error) {
 		entity.WithAccountStatus(ac.AccountStatus),
 		entity.WithAccountType(ac.AccountType),
 		entity.WithAccountNote(ac.Note),
+		entity.WithAccountIcon(ac.Icon),
+		entity.WithAccountColor(ac.Color),
+		entity.WithAccountPosition(ac.Position),
 		entity.WithAccountCreateTime(ac.CreateTime),
 		entity.WithAccountUpdateTime(ac.UpdateTime),
+		entity.WithAccountStatementDay(ac.StatementDay),
+		entity.WithAccountDueDay(ac.DueDay),
+		entity.WithAccountCreditLimit(ac.CreditLimit),
+		entity.WithAccountPrincipal(ac.Principal),
+		entity.WithAccountInterestRate(ac.InterestRate),
+		entity.WithAccountTerm(ac.Term),
+		entity.WithAccountStartTime(ac.StartTime),
+		entity.WithAccountCompoundFrequency(ac.CompoundFrequency),
+		entity.WithAccountInterestCategoryID(ac.InterestCategoryID),
+		entity.WithAccountLastInterestTime(ac.LastInterestTime),
 	)
 }
 
@@ -132,6 +184,27 @@ func (ac *Account) GetNote() string {
 	return ""
 }
 
+func (ac *Account) GetIcon() string {
+	if ac != nil && ac.Icon != nil {
+		return *ac.Icon
+	}
+	return ""
+}
+
+func (ac *Account) GetColor() string {
+	if ac != nil && ac.Color != nil {
+		return *ac.Color
+	}
+	return ""
+}
+
+func (ac *Account) GetPosition() uint32 {
+	if ac != nil && ac.Position != nil {
+		return *ac.Position
+	}
+	return 0
+}
+
 func (ac *Account) GetCreateTime() uint64 {
 	if ac != nil && ac.CreateTime != nil {
 		return *ac.CreateTime
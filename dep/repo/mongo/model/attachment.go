@@ -0,0 +1,123 @@
+package model
+
+import (
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Attachment struct {
+	UserID        *string            `bson:"user_id,omitempty"`
+	AttachmentID  primitive.ObjectID `bson:"_id,omitempty"`
+	TransactionID *string            `bson:"transaction_id,omitempty"`
+	FileName      *string            `bson:"file_name,omitempty"`
+	ContentType   *string            `bson:"content_type,omitempty"`
+	Size          *uint64            `bson:"size,omitempty"`
+	StorageKey    *string            `bson:"storage_key,omitempty"`
+	CreateTime    *uint64            `bson:"create_time,omitempty"`
+	UpdateTime    *uint64            `bson:"update_time,omitempty"`
+}
+
+func ToAttachmentModelFromEntity(a *entity.Attachment) *Attachment {
+	if a == nil {
+		return nil
+	}
+
+	objID := primitive.NilObjectID
+	if primitive.IsValidObjectID(a.GetAttachmentID()) {
+		objID, _ = primitive.ObjectIDFromHex(a.GetAttachmentID())
+	}
+
+	return &Attachment{
+		AttachmentID:  objID,
+		UserID:        a.UserID,
+		TransactionID: a.TransactionID,
+		FileName:      a.FileName,
+		ContentType:   a.ContentType,
+		Size:          a.Size,
+		StorageKey:    a.StorageKey,
+		CreateTime:    a.CreateTime,
+		UpdateTime:    a.UpdateTime,
+	}
+}
+
+func ToAttachmentEntity(a *Attachment) (*entity.Attachment, error) {
+	if a == nil {
+		return nil, nil
+	}
+
+	return entity.NewAttachment(
+		a.GetUserID(),
+		a.GetTransactionID(),
+		a.GetFileName(),
+		a.GetContentType(),
+		a.GetSize(),
+		entity.WithAttachmentID(goutil.String(a.GetAttachmentID())),
+		entity.WithAttachmentStorageKey(a.StorageKey),
+		entity.WithAttachmentCreateTime(a.CreateTime),
+		entity.WithAttachmentUpdateTime(a.UpdateTime),
+	)
+}
+
+func (a *Attachment) GetUserID() string {
+	if a != nil && a.UserID != nil {
+		return *a.UserID
+	}
+	return ""
+}
+
+func (a *Attachment) GetAttachmentID() string {
+	if a != nil {
+		return a.AttachmentID.Hex()
+	}
+	return ""
+}
+
+func (a *Attachment) GetTransactionID() string {
+	if a != nil && a.TransactionID != nil {
+		return *a.TransactionID
+	}
+	return ""
+}
+
+func (a *Attachment) GetFileName() string {
+	if a != nil && a.FileName != nil {
+		return *a.FileName
+	}
+	return ""
+}
+
+func (a *Attachment) GetContentType() string {
+	if a != nil && a.ContentType != nil {
+		return *a.ContentType
+	}
+	return ""
+}
+
+func (a *Attachment) GetSize() uint64 {
+	if a != nil && a.Size != nil {
+		return *a.Size
+	}
+	return 0
+}
+
+func (a *Attachment) GetStorageKey() string {
+	if a != nil && a.StorageKey != nil {
+		return *a.StorageKey
+	}
+	return ""
+}
+
+func (a *Attachment) GetCreateTime() uint64 {
+	if a != nil && a.CreateTime != nil {
+		return *a.CreateTime
+	}
+	return 0
+}
+
+func (a *Attachment) GetUpdateTime() uint64 {
+	if a != nil && a.UpdateTime != nil {
+		return *a.UpdateTime
+	}
+	return 0
+}
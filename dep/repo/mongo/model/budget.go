@@ -12,6 +12,10 @@ type Budget struct {
 	CategoryID   *string            `bson:"category_id,omitempty"`
 	Currency     *string            `bson:"currency,omitempty"`
 	BudgetType   *uint32            `bson:"budget_type,omitempty"`
+	WeekStartDay *uint32            `bson:"week_start_day,omitempty"`
+	PeriodDays   *uint32            `bson:"period_days,omitempty"`
+	AnchorDate   *uint64            `bson:"anchor_date,omitempty"`
+	Rollover     *bool              `bson:"rollover,omitempty"`
 	Amount       *float64           `bson:"amount,omitempty"`
 	BudgetStatus *uint32            `bson:"budget_status,omitempty"`
 	StartDate    *uint64            `bson:"start_date,omitempty"`
@@ -32,6 +36,10 @@ func ToBudgetEntity(b *Budget) (*entity.Budget, error) {
 		entity.WithBudgetCurrency(b.Currency),
 		entity.WithBudgetAmount(b.Amount),
 		entity.WithBudgetType(b.BudgetType),
+		entity.WithBudgetWeekStartDay(b.WeekStartDay),
+		entity.WithBudgetPeriodDays(b.PeriodDays),
+		entity.WithBudgetAnchorDate(b.AnchorDate),
+		entity.WithBudgetRollover(b.Rollover),
 		entity.WithBudgetStatus(b.BudgetStatus),
 		entity.WithBudgetStartDate(b.StartDate),
 		entity.WithBudgetEndDate(b.EndDate),
@@ -55,6 +63,10 @@ func ToBudgetModelFromEntity(b *entity.Budget) *Budget {
 		UserID:       b.UserID,
 		CategoryID:   b.CategoryID,
 		BudgetType:   b.BudgetType,
+		WeekStartDay: b.WeekStartDay,
+		PeriodDays:   b.PeriodDays,
+		AnchorDate:   b.AnchorDate,
+		Rollover:     b.Rollover,
 		BudgetStatus: b.BudgetStatus,
 		Amount:       b.Amount,
 		StartDate:    b.StartDate,
@@ -86,6 +98,34 @@ func (b *Budget) GetCategoryID() string {
 	return ""
 }
 
+func (b *Budget) GetWeekStartDay() uint32 {
+	if b != nil && b.WeekStartDay != nil {
+		return *b.WeekStartDay
+	}
+	return 0
+}
+
+func (b *Budget) GetPeriodDays() uint32 {
+	if b != nil && b.PeriodDays != nil {
+		return *b.PeriodDays
+	}
+	return 0
+}
+
+func (b *Budget) GetAnchorDate() uint64 {
+	if b != nil && b.AnchorDate != nil {
+		return *b.AnchorDate
+	}
+	return 0
+}
+
+func (b *Budget) GetRollover() bool {
+	if b != nil && b.Rollover != nil {
+		return *b.Rollover
+	}
+	return false
+}
+
 func (b *Budget) GetBudgetType() uint32 {
 	if b != nil && b.BudgetType != nil {
 		return *b.BudgetType
@@ -7,13 +7,17 @@ import (
 )
 
 type Category struct {
-	UserID         *string            `bson:"user_id,omitempty"`
-	CategoryID     primitive.ObjectID `bson:"_id,omitempty"`
-	CategoryName   *string            `bson:"category_name,omitempty"`
-	CategoryType   *uint32            `bson:"category_type,omitempty"`
-	CategoryStatus *uint32            `bson:"category_status,omitempty"`
-	CreateTime     *uint64            `bson:"create_time,omitempty"`
-	UpdateTime     *uint64            `bson:"update_time,omitempty"`
+	UserID           *string            `bson:"user_id,omitempty"`
+	CategoryID       primitive.ObjectID `bson:"_id,omitempty"`
+	ParentCategoryID *string            `bson:"parent_category_id,omitempty"`
+	CategoryName     *string            `bson:"category_name,omitempty"`
+	CategoryType     *uint32            `bson:"category_type,omitempty"`
+	CategoryStatus   *uint32            `bson:"category_status,omitempty"`
+	Icon             *string            `bson:"icon,omitempty"`
+	Color            *string            `bson:"color,omitempty"`
+	Position         *uint32            `bson:"position,omitempty"`
+	CreateTime       *uint64            `bson:"create_time,omitempty"`
+	UpdateTime       *uint64            `bson:"update_time,omitempty"`
 }
 
 func ToCategoryModelFromEntity(c *entity.Category) *Category {
@@ -27,13 +31,17 @@ func ToCategoryModelFromEntity(c *entity.Category) *Category {
 	}
 
 	return &Category{
-		CategoryID:     objID,
-		UserID:         c.UserID,
-		CategoryName:   c.CategoryName,
-		CategoryType:   c.CategoryType,
-		CategoryStatus: c.CategoryStatus,
-		CreateTime:     c.CreateTime,
-		UpdateTime:     c.UpdateTime,
+		CategoryID:       objID,
+		UserID:           c.UserID,
+		ParentCategoryID: c.ParentCategoryID,
+		CategoryName:     c.CategoryName,
+		CategoryType:     c.CategoryType,
+		CategoryStatus:   c.CategoryStatus,
+		Icon:             c.Icon,
+		Color:            c.Color,
+		Position:         c.Position,
+		CreateTime:       c.CreateTime,
+		UpdateTime:       c.UpdateTime,
 	}
 }
 
@@ -43,9 +51,13 @@ func ToCategoryModelFromUpdate(cu *entity.CategoryUpdate) *Category {
 	}
 
 	return &Category{
-		CategoryName:   cu.CategoryName,
-		CategoryStatus: cu.CategoryStatus,
-		UpdateTime:     cu.UpdateTime,
+		CategoryName:     cu.CategoryName,
+		ParentCategoryID: cu.ParentCategoryID,
+		CategoryStatus:   cu.CategoryStatus,
+		Icon:             cu.Icon,
+		Color:            cu.Color,
+		Position:         cu.Position,
+		UpdateTime:       cu.UpdateTime,
 	}
 }
 
@@ -58,8 +70,12 @@ func ToCategoryEntity(c *Category) (*entity.Category, error) {
 		c.GetUserID(),
 		c.GetCategoryName(),
 		entity.WithCategoryID(goutil.String(c.GetCategoryID())),
+		entity.WithCategoryParentCategoryID(c.ParentCategoryID),
 		entity.WithCategoryType(c.CategoryType),
 		entity.WithCategoryStatus(c.CategoryStatus),
+		entity.WithCategoryIcon(c.Icon),
+		entity.WithCategoryColor(c.Color),
+		entity.WithCategoryPosition(c.Position),
 		entity.WithCategoryCreateTime(c.CreateTime),
 		entity.WithCategoryUpdateTime(c.UpdateTime),
 	)
@@ -79,6 +95,13 @@ func (c *Category) GetCategoryID() string {
 	return ""
 }
 
+func (c *Category) GetParentCategoryID() string {
+	if c != nil && c.ParentCategoryID != nil {
+		return *c.ParentCategoryID
+	}
+	return ""
+}
+
 func (c *Category) GetCategoryName() string {
 	if c != nil && c.CategoryName != nil {
 		return *c.CategoryName
@@ -100,6 +123,27 @@ func (c *Category) GetCategoryStatus() uint32 {
 	return 0
 }
 
+func (c *Category) GetIcon() string {
+	if c != nil && c.Icon != nil {
+		return *c.Icon
+	}
+	return ""
+}
+
+func (c *Category) GetColor() string {
+	if c != nil && c.Color != nil {
+		return *c.Color
+	}
+	return ""
+}
+
+func (c *Category) GetPosition() uint32 {
+	if c != nil && c.Position != nil {
+		return *c.Position
+	}
+	return 0
+}
+
 func (c *Category) GetCreateTime() uint64 {
 	if c != nil && c.CreateTime != nil {
 		return *c.CreateTime
@@ -0,0 +1,138 @@
+package model
+
+import (
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ImportProfile struct {
+	ImportProfileID primitive.ObjectID `bson:"_id,omitempty"`
+	UserID          *string            `bson:"user_id,omitempty"`
+	ProfileName     *string            `bson:"profile_name,omitempty"`
+	Delimiter       *string            `bson:"delimiter,omitempty"`
+	SkipRows        *uint32            `bson:"skip_rows,omitempty"`
+	DateColumn      *string            `bson:"date_column,omitempty"`
+	DateFormat      *uint32            `bson:"date_format,omitempty"`
+	AmountColumn    *string            `bson:"amount_column,omitempty"`
+	AmountSign      *uint32            `bson:"amount_sign,omitempty"`
+	DebitColumn     *string            `bson:"debit_column,omitempty"`
+	CreditColumn    *string            `bson:"credit_column,omitempty"`
+	NoteColumn      *string            `bson:"note_column,omitempty"`
+	CurrencyColumn  *string            `bson:"currency_column,omitempty"`
+	ProfileStatus   *uint32            `bson:"profile_status,omitempty"`
+	CreateTime      *uint64            `bson:"create_time,omitempty"`
+	UpdateTime      *uint64            `bson:"update_time,omitempty"`
+}
+
+func ToImportProfileModelFromEntity(ip *entity.ImportProfile) *ImportProfile {
+	if ip == nil {
+		return nil
+	}
+
+	objID := primitive.NilObjectID
+	if primitive.IsValidObjectID(ip.GetImportProfileID()) {
+		objID, _ = primitive.ObjectIDFromHex(ip.GetImportProfileID())
+	}
+
+	return &ImportProfile{
+		ImportProfileID: objID,
+		UserID:          ip.UserID,
+		ProfileName:     ip.ProfileName,
+		Delimiter:       ip.Delimiter,
+		SkipRows:        ip.SkipRows,
+		DateColumn:      ip.DateColumn,
+		DateFormat:      ip.DateFormat,
+		AmountColumn:    ip.AmountColumn,
+		AmountSign:      ip.AmountSign,
+		DebitColumn:     ip.DebitColumn,
+		CreditColumn:    ip.CreditColumn,
+		NoteColumn:      ip.NoteColumn,
+		CurrencyColumn:  ip.CurrencyColumn,
+		ProfileStatus:   ip.ProfileStatus,
+		CreateTime:      ip.CreateTime,
+		UpdateTime:      ip.UpdateTime,
+	}
+}
+
+func ToImportProfileModelFromUpdate(ipu *entity.ImportProfileUpdate) *ImportProfile {
+	if ipu == nil {
+		return nil
+	}
+
+	return &ImportProfile{
+		ProfileName:    ipu.ProfileName,
+		Delimiter:      ipu.Delimiter,
+		SkipRows:       ipu.SkipRows,
+		DateColumn:     ipu.DateColumn,
+		DateFormat:     ipu.DateFormat,
+		AmountColumn:   ipu.AmountColumn,
+		AmountSign:     ipu.AmountSign,
+		DebitColumn:    ipu.DebitColumn,
+		CreditColumn:   ipu.CreditColumn,
+		NoteColumn:     ipu.NoteColumn,
+		CurrencyColumn: ipu.CurrencyColumn,
+		ProfileStatus:  ipu.ProfileStatus,
+		UpdateTime:     ipu.UpdateTime,
+	}
+}
+
+func ToImportProfileEntity(ip *ImportProfile) (*entity.ImportProfile, error) {
+	if ip == nil {
+		return nil, nil
+	}
+
+	return entity.NewImportProfile(
+		ip.GetUserID(),
+		ip.GetProfileName(),
+		entity.WithImportProfileID(goutil.String(ip.GetImportProfileID())),
+		entity.WithImportProfileDelimiter(ip.Delimiter),
+		entity.WithImportProfileSkipRows(ip.SkipRows),
+		entity.WithImportProfileDateColumn(ip.DateColumn),
+		entity.WithImportProfileDateFormat(ip.DateFormat),
+		entity.WithImportProfileAmountColumn(ip.AmountColumn),
+		entity.WithImportProfileAmountSign(ip.AmountSign),
+		entity.WithImportProfileDebitColumn(ip.DebitColumn),
+		entity.WithImportProfileCreditColumn(ip.CreditColumn),
+		entity.WithImportProfileNoteColumn(ip.NoteColumn),
+		entity.WithImportProfileCurrencyColumn(ip.CurrencyColumn),
+		entity.WithImportProfileStatus(ip.ProfileStatus),
+		entity.WithImportProfileCreateTime(ip.CreateTime),
+		entity.WithImportProfileUpdateTime(ip.UpdateTime),
+	)
+}
+
+func (ip *ImportProfile) GetImportProfileID() string {
+	if ip != nil {
+		return ip.ImportProfileID.Hex()
+	}
+	return ""
+}
+
+func (ip *ImportProfile) GetUserID() string {
+	if ip != nil && ip.UserID != nil {
+		return *ip.UserID
+	}
+	return ""
+}
+
+func (ip *ImportProfile) GetProfileName() string {
+	if ip != nil && ip.ProfileName != nil {
+		return *ip.ProfileName
+	}
+	return ""
+}
+
+func (ip *ImportProfile) GetCreateTime() uint64 {
+	if ip != nil && ip.CreateTime != nil {
+		return *ip.CreateTime
+	}
+	return 0
+}
+
+func (ip *ImportProfile) GetUpdateTime() uint64 {
+	if ip != nil && ip.UpdateTime != nil {
+		return *ip.UpdateTime
+	}
+	return 0
+}
@@ -0,0 +1,116 @@
+package model
+
+import (
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Payee struct {
+	UserID      *string            `bson:"user_id,omitempty"`
+	PayeeID     primitive.ObjectID `bson:"_id,omitempty"`
+	PayeeName   *string            `bson:"payee_name,omitempty"`
+	Aliases     []string           `bson:"aliases,omitempty"`
+	PayeeStatus *uint32            `bson:"payee_status,omitempty"`
+	CreateTime  *uint64            `bson:"create_time,omitempty"`
+	UpdateTime  *uint64            `bson:"update_time,omitempty"`
+}
+
+func ToPayeeModelFromEntity(p *entity.Payee) *Payee {
+	if p == nil {
+		return nil
+	}
+
+	objID := primitive.NilObjectID
+	if primitive.IsValidObjectID(p.GetPayeeID()) {
+		objID, _ = primitive.ObjectIDFromHex(p.GetPayeeID())
+	}
+
+	return &Payee{
+		PayeeID:     objID,
+		UserID:      p.UserID,
+		PayeeName:   p.PayeeName,
+		Aliases:     p.Aliases,
+		PayeeStatus: p.PayeeStatus,
+		CreateTime:  p.CreateTime,
+		UpdateTime:  p.UpdateTime,
+	}
+}
+
+func ToPayeeModelFromUpdate(pu *entity.PayeeUpdate) *Payee {
+	if pu == nil {
+		return nil
+	}
+
+	return &Payee{
+		PayeeName:   pu.PayeeName,
+		Aliases:     pu.Aliases,
+		PayeeStatus: pu.PayeeStatus,
+		UpdateTime:  pu.UpdateTime,
+	}
+}
+
+func ToPayeeEntity(p *Payee) (*entity.Payee, error) {
+	if p == nil {
+		return nil, nil
+	}
+
+	return entity.NewPayee(
+		p.GetUserID(),
+		p.GetPayeeName(),
+		entity.WithPayeeID(goutil.String(p.GetPayeeID())),
+		entity.WithPayeeAliases(p.Aliases),
+		entity.WithPayeeStatus(p.PayeeStatus),
+		entity.WithPayeeCreateTime(p.CreateTime),
+		entity.WithPayeeUpdateTime(p.UpdateTime),
+	)
+}
+
+func (p *Payee) GetUserID() string {
+	if p != nil && p.UserID != nil {
+		return *p.UserID
+	}
+	return ""
+}
+
+func (p *Payee) GetPayeeID() string {
+	if p != nil {
+		return p.PayeeID.Hex()
+	}
+	return ""
+}
+
+func (p *Payee) GetPayeeName() string {
+	if p != nil && p.PayeeName != nil {
+		return *p.PayeeName
+	}
+	return ""
+}
+
+func (p *Payee) GetAliases() []string {
+	if p != nil && p.Aliases != nil {
+		return p.Aliases
+	}
+	return nil
+}
+
+func (p *Payee) GetPayeeStatus() uint32 {
+	if p != nil && p.PayeeStatus != nil {
+		return *p.PayeeStatus
+	}
+	return 0
+}
+
+func (p *Payee) GetCreateTime() uint64 {
+	if p != nil && p.CreateTime != nil {
+		return *p.CreateTime
+	}
+	return 0
+}
+
+func (p *Payee) GetUpdateTime() uint64 {
+	if p != nil && p.UpdateTime != nil {
+		return *p.UpdateTime
+	}
+	return 0
+}
@@ -0,0 +1,145 @@
+package model
+
+import (
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type RecurringTransaction struct {
+	RecurringTransactionID     primitive.ObjectID `bson:"_id,omitempty"`
+	UserID                     *string            `bson:"user_id,omitempty"`
+	AccountID                  *string            `bson:"account_id,omitempty"`
+	CategoryID                 *string            `bson:"category_id,omitempty"`
+	FromAccountID              *string            `bson:"from_account_id,omitempty"`
+	ToAccountID                *string            `bson:"to_account_id,omitempty"`
+	Amount                     *float64           `bson:"amount,omitempty"`
+	Currency                   *string            `bson:"currency,omitempty"`
+	Note                       *string            `bson:"note,omitempty"`
+	TransactionType            *uint32            `bson:"transaction_type,omitempty"`
+	Frequency                  *uint32            `bson:"frequency,omitempty"`
+	Interval                   *uint32            `bson:"interval,omitempty"`
+	Timezone                   *string            `bson:"timezone,omitempty"`
+	StartTime                  *uint64            `bson:"start_time,omitempty"`
+	EndTime                    *uint64            `bson:"end_time,omitempty"`
+	MaxOccurrences             *uint32            `bson:"max_occurrences,omitempty"`
+	OccurrenceCount            *uint32            `bson:"occurrence_count,omitempty"`
+	NextTime                   *uint64            `bson:"next_time,omitempty"`
+	RecurringTransactionStatus *uint32            `bson:"recurring_transaction_status,omitempty"`
+	CreateTime                 *uint64            `bson:"create_time,omitempty"`
+	UpdateTime                 *uint64            `bson:"update_time,omitempty"`
+}
+
+func ToRecurringTransactionModelFromEntity(rt *entity.RecurringTransaction) *RecurringTransaction {
+	if rt == nil {
+		return nil
+	}
+
+	objID := primitive.NilObjectID
+	if primitive.IsValidObjectID(rt.GetRecurringTransactionID()) {
+		objID, _ = primitive.ObjectIDFromHex(rt.GetRecurringTransactionID())
+	}
+
+	return &RecurringTransaction{
+		RecurringTransactionID:     objID,
+		UserID:                     rt.UserID,
+		AccountID:                  rt.AccountID,
+		CategoryID:                 rt.CategoryID,
+		FromAccountID:              rt.FromAccountID,
+		ToAccountID:                rt.ToAccountID,
+		Amount:                     rt.Amount,
+		Currency:                   rt.Currency,
+		Note:                       rt.Note,
+		TransactionType:            rt.TransactionType,
+		Frequency:                  rt.Frequency,
+		Interval:                   rt.Interval,
+		Timezone:                   rt.Timezone,
+		StartTime:                  rt.StartTime,
+		EndTime:                    rt.EndTime,
+		MaxOccurrences:             rt.MaxOccurrences,
+		OccurrenceCount:            rt.OccurrenceCount,
+		NextTime:                   rt.NextTime,
+		RecurringTransactionStatus: rt.RecurringTransactionStatus,
+		CreateTime:                 rt.CreateTime,
+		UpdateTime:                 rt.UpdateTime,
+	}
+}
+
+func ToRecurringTransactionModelFromUpdate(rtu *entity.RecurringTransactionUpdate) *RecurringTransaction {
+	if rtu == nil {
+		return nil
+	}
+
+	return &RecurringTransaction{
+		AccountID:                  rtu.AccountID,
+		CategoryID:                 rtu.CategoryID,
+		FromAccountID:              rtu.FromAccountID,
+		ToAccountID:                rtu.ToAccountID,
+		Amount:                     rtu.Amount,
+		Currency:                   rtu.Currency,
+		Note:                       rtu.Note,
+		EndTime:                    rtu.EndTime,
+		MaxOccurrences:             rtu.MaxOccurrences,
+		OccurrenceCount:            rtu.OccurrenceCount,
+		NextTime:                   rtu.NextTime,
+		RecurringTransactionStatus: rtu.RecurringTransactionStatus,
+		UpdateTime:                 rtu.UpdateTime,
+	}
+}
+
+func ToRecurringTransactionEntity(rt *RecurringTransaction) (*entity.RecurringTransaction, error) {
+	if rt == nil {
+		return nil, nil
+	}
+
+	return entity.NewRecurringTransaction(
+		rt.GetUserID(),
+		entity.WithRecurringTransactionID(goutil.String(rt.GetRecurringTransactionID())),
+		entity.WithRecurringTransactionAccountID(rt.AccountID),
+		entity.WithRecurringTransactionCategoryID(rt.CategoryID),
+		entity.WithRecurringTransactionFromAccountID(rt.FromAccountID),
+		entity.WithRecurringTransactionToAccountID(rt.ToAccountID),
+		entity.WithRecurringTransactionAmount(rt.Amount),
+		entity.WithRecurringTransactionCurrency(rt.Currency),
+		entity.WithRecurringTransactionNote(rt.Note),
+		entity.WithRecurringTransactionType(rt.TransactionType),
+		entity.WithRecurringTransactionFrequency(rt.Frequency),
+		entity.WithRecurringTransactionInterval(rt.Interval),
+		entity.WithRecurringTransactionTimezone(rt.Timezone),
+		entity.WithRecurringTransactionStartTime(rt.StartTime),
+		entity.WithRecurringTransactionEndTime(rt.EndTime),
+		entity.WithRecurringTransactionMaxOccurrences(rt.MaxOccurrences),
+		entity.WithRecurringTransactionOccurrenceCount(rt.OccurrenceCount),
+		entity.WithRecurringTransactionStatus(rt.RecurringTransactionStatus),
+		entity.WithRecurringTransactionCreateTime(rt.CreateTime),
+		entity.WithRecurringTransactionUpdateTime(rt.UpdateTime),
+	)
+}
+
+func (rt *RecurringTransaction) GetRecurringTransactionID() string {
+	if rt != nil {
+		return rt.RecurringTransactionID.Hex()
+	}
+	return ""
+}
+
+func (rt *RecurringTransaction) GetUserID() string {
+	if rt != nil && rt.UserID != nil {
+		return *rt.UserID
+	}
+	return ""
+}
+
+func (rt *RecurringTransaction) GetNextTime() uint64 {
+	if rt != nil && rt.NextTime != nil {
+		return *rt.NextTime
+	}
+	return 0
+}
+
+func (rt *RecurringTransaction) GetRecurringTransactionStatus() uint32 {
+	if rt != nil && rt.RecurringTransactionStatus != nil {
+		return *rt.RecurringTransactionStatus
+	}
+	return 0
+}
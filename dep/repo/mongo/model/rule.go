@@ -0,0 +1,193 @@
+package model
+
+import (
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Rule struct {
+	UserID          *string            `bson:"user_id,omitempty"`
+	RuleID          primitive.ObjectID `bson:"_id,omitempty"`
+	RuleName        *string            `bson:"rule_name,omitempty"`
+	CategoryID      *string            `bson:"category_id,omitempty"`
+	Position        *uint32            `bson:"position,omitempty"`
+	NoteContains    *string            `bson:"note_contains,omitempty"`
+	AmountMin       *float64           `bson:"amount_min,omitempty"`
+	AmountMax       *float64           `bson:"amount_max,omitempty"`
+	AccountID       *string            `bson:"account_id,omitempty"`
+	Currency        *string            `bson:"currency,omitempty"`
+	TransactionType *uint32            `bson:"transaction_type,omitempty"`
+	RuleStatus      *uint32            `bson:"rule_status,omitempty"`
+	CreateTime      *uint64            `bson:"create_time,omitempty"`
+	UpdateTime      *uint64            `bson:"update_time,omitempty"`
+}
+
+func ToRuleModelFromEntity(r *entity.Rule) *Rule {
+	if r == nil {
+		return nil
+	}
+
+	objID := primitive.NilObjectID
+	if primitive.IsValidObjectID(r.GetRuleID()) {
+		objID, _ = primitive.ObjectIDFromHex(r.GetRuleID())
+	}
+
+	return &Rule{
+		RuleID:          objID,
+		UserID:          r.UserID,
+		RuleName:        r.RuleName,
+		CategoryID:      r.CategoryID,
+		Position:        r.Position,
+		NoteContains:    r.NoteContains,
+		AmountMin:       r.AmountMin,
+		AmountMax:       r.AmountMax,
+		AccountID:       r.AccountID,
+		Currency:        r.Currency,
+		TransactionType: r.TransactionType,
+		RuleStatus:      r.RuleStatus,
+		CreateTime:      r.CreateTime,
+		UpdateTime:      r.UpdateTime,
+	}
+}
+
+func ToRuleModelFromUpdate(ru *entity.RuleUpdate) *Rule {
+	if ru == nil {
+		return nil
+	}
+
+	return &Rule{
+		RuleName:        ru.RuleName,
+		CategoryID:      ru.CategoryID,
+		Position:        ru.Position,
+		NoteContains:    ru.NoteContains,
+		AmountMin:       ru.AmountMin,
+		AmountMax:       ru.AmountMax,
+		AccountID:       ru.AccountID,
+		Currency:        ru.Currency,
+		TransactionType: ru.TransactionType,
+		RuleStatus:      ru.RuleStatus,
+		UpdateTime:      ru.UpdateTime,
+	}
+}
+
+func ToRuleEntity(r *Rule) (*entity.Rule, error) {
+	if r == nil {
+		return nil, nil
+	}
+
+	return entity.NewRule(
+		r.GetUserID(),
+		r.GetRuleName(),
+		r.GetCategoryID(),
+		entity.WithRuleID(goutil.String(r.GetRuleID())),
+		entity.WithRulePosition(r.Position),
+		entity.WithRuleNoteContains(r.NoteContains),
+		entity.WithRuleAmountMin(r.AmountMin),
+		entity.WithRuleAmountMax(r.AmountMax),
+		entity.WithRuleAccountID(r.AccountID),
+		entity.WithRuleCurrency(r.Currency),
+		entity.WithRuleTransactionType(r.TransactionType),
+		entity.WithRuleStatus(r.RuleStatus),
+		entity.WithRuleCreateTime(r.CreateTime),
+		entity.WithRuleUpdateTime(r.UpdateTime),
+	)
+}
+
+func (r *Rule) GetUserID() string {
+	if r != nil && r.UserID != nil {
+		return *r.UserID
+	}
+	return ""
+}
+
+func (r *Rule) GetRuleID() string {
+	if r != nil {
+		return r.RuleID.Hex()
+	}
+	return ""
+}
+
+func (r *Rule) GetRuleName() string {
+	if r != nil && r.RuleName != nil {
+		return *r.RuleName
+	}
+	return ""
+}
+
+func (r *Rule) GetCategoryID() string {
+	if r != nil && r.CategoryID != nil {
+		return *r.CategoryID
+	}
+	return ""
+}
+
+func (r *Rule) GetPosition() uint32 {
+	if r != nil && r.Position != nil {
+		return *r.Position
+	}
+	return 0
+}
+
+func (r *Rule) GetNoteContains() string {
+	if r != nil && r.NoteContains != nil {
+		return *r.NoteContains
+	}
+	return ""
+}
+
+func (r *Rule) GetAmountMin() float64 {
+	if r != nil && r.AmountMin != nil {
+		return *r.AmountMin
+	}
+	return 0
+}
+
+func (r *Rule) GetAmountMax() float64 {
+	if r != nil && r.AmountMax != nil {
+		return *r.AmountMax
+	}
+	return 0
+}
+
+func (r *Rule) GetAccountID() string {
+	if r != nil && r.AccountID != nil {
+		return *r.AccountID
+	}
+	return ""
+}
+
+func (r *Rule) GetCurrency() string {
+	if r != nil && r.Currency != nil {
+		return *r.Currency
+	}
+	return ""
+}
+
+func (r *Rule) GetTransactionType() uint32 {
+	if r != nil && r.TransactionType != nil {
+		return *r.TransactionType
+	}
+	return 0
+}
+
+func (r *Rule) GetRuleStatus() uint32 {
+	if r != nil && r.RuleStatus != nil {
+		return *r.RuleStatus
+	}
+	return 0
+}
+
+func (r *Rule) GetCreateTime() uint64 {
+	if r != nil && r.CreateTime != nil {
+		return *r.CreateTime
+	}
+	return 0
+}
+
+func (r *Rule) GetUpdateTime() uint64 {
+	if r != nil && r.UpdateTime != nil {
+		return *r.UpdateTime
+	}
+	return 0
+}
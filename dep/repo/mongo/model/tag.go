@@ -0,0 +1,105 @@
+package model
+
+import (
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Tag struct {
+	UserID     *string            `bson:"user_id,omitempty"`
+	TagID      primitive.ObjectID `bson:"_id,omitempty"`
+	TagName    *string            `bson:"tag_name,omitempty"`
+	TagStatus  *uint32            `bson:"tag_status,omitempty"`
+	CreateTime *uint64            `bson:"create_time,omitempty"`
+	UpdateTime *uint64            `bson:"update_time,omitempty"`
+}
+
+func ToTagModelFromEntity(t *entity.Tag) *Tag {
+	if t == nil {
+		return nil
+	}
+
+	objID := primitive.NilObjectID
+	if primitive.IsValidObjectID(t.GetTagID()) {
+		objID, _ = primitive.ObjectIDFromHex(t.GetTagID())
+	}
+
+	return &Tag{
+		TagID:      objID,
+		UserID:     t.UserID,
+		TagName:    t.TagName,
+		TagStatus:  t.TagStatus,
+		CreateTime: t.CreateTime,
+		UpdateTime: t.UpdateTime,
+	}
+}
+
+func ToTagModelFromUpdate(tu *entity.TagUpdate) *Tag {
+	if tu == nil {
+		return nil
+	}
+
+	return &Tag{
+		TagName:    tu.TagName,
+		TagStatus:  tu.TagStatus,
+		UpdateTime: tu.UpdateTime,
+	}
+}
+
+func ToTagEntity(t *Tag) (*entity.Tag, error) {
+	if t == nil {
+		return nil, nil
+	}
+
+	return entity.NewTag(
+		t.GetUserID(),
+		t.GetTagName(),
+		entity.WithTagID(goutil.String(t.GetTagID())),
+		entity.WithTagStatus(t.TagStatus),
+		entity.WithTagCreateTime(t.CreateTime),
+		entity.WithTagUpdateTime(t.UpdateTime),
+	)
+}
+
+func (t *Tag) GetUserID() string {
+	if t != nil && t.UserID != nil {
+		return *t.UserID
+	}
+	return ""
+}
+
+func (t *Tag) GetTagID() string {
+	if t != nil {
+		return t.TagID.Hex()
+	}
+	return ""
+}
+
+func (t *Tag) GetTagName() string {
+	if t != nil && t.TagName != nil {
+		return *t.TagName
+	}
+	return ""
+}
+
+func (t *Tag) GetTagStatus() uint32 {
+	if t != nil && t.TagStatus != nil {
+		return *t.TagStatus
+	}
+	return 0
+}
+
+func (t *Tag) GetCreateTime() uint64 {
+	if t != nil && t.CreateTime != nil {
+		return *t.CreateTime
+	}
+	return 0
+}
+
+func (t *Tag) GetUpdateTime() uint64 {
+	if t != nil && t.UpdateTime != nil {
+		return *t.UpdateTime
+	}
+	return 0
+}
@@ -1,26 +1,86 @@
 package model
 
 import (
+	"github.com/jseow5177/pockteer-be/config"
 	"github.com/jseow5177/pockteer-be/entity"
 	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/jseow5177/pockteer-be/util"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+type TransactionSplit struct {
+	CategoryID *string  `bson:"category_id,omitempty"`
+	Amount     *float64 `bson:"amount,omitempty"`
+}
+
+func (ts *TransactionSplit) GetCategoryID() string {
+	if ts != nil && ts.CategoryID != nil {
+		return *ts.CategoryID
+	}
+	return ""
+}
+
+func (ts *TransactionSplit) GetAmount() float64 {
+	if ts != nil && ts.Amount != nil {
+		return *ts.Amount
+	}
+	return 0
+}
+
+func ToTransactionSplitModelsFromEntity(tss []*entity.TransactionSplit) []*TransactionSplit {
+	if tss == nil {
+		return nil
+	}
+
+	splits := make([]*TransactionSplit, 0, len(tss))
+	for _, ts := range tss {
+		splits = append(splits, &TransactionSplit{
+			CategoryID: ts.CategoryID,
+			Amount:     ts.Amount,
+		})
+	}
+	return splits
+}
+
+func ToTransactionSplitEntities(tss []*TransactionSplit) []*entity.TransactionSplit {
+	if tss == nil {
+		return nil
+	}
+
+	splits := make([]*entity.TransactionSplit, 0, len(tss))
+	for _, ts := range tss {
+		splits = append(splits, entity.NewTransactionSplit(ts.GetCategoryID(), ts.GetAmount()))
+	}
+	return splits
+}
+
 type Transaction struct {
-	TransactionID     primitive.ObjectID `bson:"_id,omitempty"`
-	UserID            *string            `bson:"user_id,omitempty"`
-	CategoryID        *string            `bson:"category_id,omitempty"`
-	AccountID         *string            `bson:"account_id,omitempty"`
-	FromAccountID     *string            `bson:"from_account_id,omitempty"`
-	ToAccountID       *string            `bson:"to_account_id,omitempty"`
-	Currency          *string            `bson:"currency,omitempty"`
-	Amount            *float64           `bson:"amount,omitempty"`
-	Note              *string            `bson:"note,omitempty"`
-	TransactionStatus *uint32            `bson:"transaction_status,omitempty"`
-	TransactionType   *uint32            `bson:"transaction_type,omitempty"`
-	TransactionTime   *uint64            `bson:"transaction_time,omitempty"`
-	CreateTime        *uint64            `bson:"create_time,omitempty"`
-	UpdateTime        *uint64            `bson:"update_time,omitempty"`
+	TransactionID          primitive.ObjectID  `bson:"_id,omitempty"`
+	UserID                 *string             `bson:"user_id,omitempty"`
+	CategoryID             *string             `bson:"category_id,omitempty"`
+	AccountID              *string             `bson:"account_id,omitempty"`
+	FromAccountID          *string             `bson:"from_account_id,omitempty"`
+	ToAccountID            *string             `bson:"to_account_id,omitempty"`
+	Currency               *string             `bson:"currency,omitempty"`
+	Amount                 *float64            `bson:"amount,omitempty"`
+	ReceivedCurrency       *string             `bson:"received_currency,omitempty"`
+	ReceivedAmount         *float64            `bson:"received_amount,omitempty"`
+	Fee                    *float64            `bson:"fee,omitempty"`
+	Note                   *string             `bson:"note,omitempty"`
+	NoteTokens             []string            `bson:"note_tokens,omitempty"` // word prefixes for text search
+	TransactionStatus      *uint32             `bson:"transaction_status,omitempty"`
+	ClearStatus            *uint32             `bson:"clear_status,omitempty"`
+	TransactionType        *uint32             `bson:"transaction_type,omitempty"`
+	TransactionTime        *uint64             `bson:"transaction_time,omitempty"`
+	CreateTime             *uint64             `bson:"create_time,omitempty"`
+	UpdateTime             *uint64             `bson:"update_time,omitempty"`
+	RecurringTransactionID *string             `bson:"recurring_transaction_id,omitempty"`
+	ExternalID             *string             `bson:"external_id,omitempty"`
+	RefundedTransactionID  *string             `bson:"refunded_transaction_id,omitempty"`
+	RepaymentTransactionID *string             `bson:"repayment_transaction_id,omitempty"`
+	PayeeID                *string             `bson:"payee_id,omitempty"`
+	Splits                 []*TransactionSplit `bson:"splits,omitempty"`
+	TagIDs                 []string            `bson:"tag_ids,omitempty"`
 }
 
 func ToTransactionModelFromEntity(t *entity.Transaction) *Transaction {
@@ -34,20 +94,32 @@ func ToTransactionModelFromEntity(t *entity.Transaction) *Transaction {
 	}
 
 	return &Transaction{
-		TransactionID:     objID,
-		UserID:            t.UserID,
-		CategoryID:        t.CategoryID,
-		AccountID:         t.AccountID,
-		FromAccountID:     t.FromAccountID,
-		ToAccountID:       t.ToAccountID,
-		Currency:          t.Currency,
-		Amount:            t.Amount,
-		Note:              t.Note,
-		TransactionStatus: t.TransactionStatus,
-		TransactionType:   t.TransactionType,
-		TransactionTime:   t.TransactionTime,
-		CreateTime:        t.CreateTime,
-		UpdateTime:        t.UpdateTime,
+		TransactionID:          objID,
+		UserID:                 t.UserID,
+		CategoryID:             t.CategoryID,
+		AccountID:              t.AccountID,
+		FromAccountID:          t.FromAccountID,
+		ToAccountID:            t.ToAccountID,
+		Currency:               t.Currency,
+		Amount:                 t.Amount,
+		ReceivedCurrency:       t.ReceivedCurrency,
+		ReceivedAmount:         t.ReceivedAmount,
+		Fee:                    t.Fee,
+		Note:                   t.Note,
+		NoteTokens:             toNoteTokens(t.Note),
+		TransactionStatus:      t.TransactionStatus,
+		ClearStatus:            t.ClearStatus,
+		TransactionType:        t.TransactionType,
+		TransactionTime:        t.TransactionTime,
+		CreateTime:             t.CreateTime,
+		UpdateTime:             t.UpdateTime,
+		RecurringTransactionID: t.RecurringTransactionID,
+		ExternalID:             t.ExternalID,
+		RefundedTransactionID:  t.RefundedTransactionID,
+		RepaymentTransactionID: t.RepaymentTransactionID,
+		PayeeID:                t.PayeeID,
+		Splits:                 ToTransactionSplitModelsFromEntity(t.Splits),
+		TagIDs:                 t.TagIDs,
 	}
 }
 
@@ -57,20 +129,38 @@ func ToTransactionModelFromUpdate(tu *entity.TransactionUpdate) *Transaction {
 	}
 
 	return &Transaction{
-		Amount:            tu.Amount,
-		Note:              tu.Note,
-		TransactionTime:   tu.TransactionTime,
-		TransactionStatus: tu.TransactionStatus,
-		UpdateTime:        tu.UpdateTime,
-		AccountID:         tu.AccountID,
-		CategoryID:        tu.CategoryID,
-		FromAccountID:     tu.FromAccountID,
-		ToAccountID:       tu.ToAccountID,
-		TransactionType:   tu.TransactionType,
-		Currency:          tu.Currency,
+		Amount:                tu.Amount,
+		Note:                  tu.Note,
+		NoteTokens:            toNoteTokens(tu.Note),
+		TransactionTime:       tu.TransactionTime,
+		TransactionStatus:     tu.TransactionStatus,
+		ClearStatus:           tu.ClearStatus,
+		UpdateTime:            tu.UpdateTime,
+		AccountID:             tu.AccountID,
+		CategoryID:            tu.CategoryID,
+		FromAccountID:         tu.FromAccountID,
+		ToAccountID:           tu.ToAccountID,
+		TransactionType:       tu.TransactionType,
+		Currency:              tu.Currency,
+		ReceivedCurrency:      tu.ReceivedCurrency,
+		ReceivedAmount:        tu.ReceivedAmount,
+		Fee:                   tu.Fee,
+		ExternalID:            tu.ExternalID,
+		RefundedTransactionID: tu.RefundedTransactionID,
+		PayeeID:               tu.PayeeID,
+		Splits:                ToTransactionSplitModelsFromEntity(tu.Splits),
+		TagIDs:                tu.TagIDs,
 	}
 }
 
+// toNoteTokens is nil when the note is not set, so an update without a note keeps the tokens.
+func toNoteTokens(note *string) []string {
+	if note == nil {
+		return nil
+	}
+	return util.GetWordPrefixes(*note, config.MinNoteTokenLength)
+}
+
 func ToTransactionEntity(t *Transaction) (*entity.Transaction, error) {
 	if t == nil {
 		return nil, nil
@@ -90,7 +180,18 @@ func ToTransactionEntity(t *Transaction) (*entity.Transaction, error) {
 		entity.WithTransactionCreateTime(t.CreateTime),
 		entity.WithTransactionUpdateTime(t.UpdateTime),
 		entity.WithTransactionStatus(t.TransactionStatus),
+		entity.WithTransactionClearStatus(t.ClearStatus),
 		entity.WithTransactionCurrency(t.Currency),
+		entity.WithTransactionReceivedCurrency(t.ReceivedCurrency),
+		entity.WithTransactionReceivedAmount(t.ReceivedAmount),
+		entity.WithTransactionFee(t.Fee),
+		entity.WithTransactionRecurringTransactionID(t.RecurringTransactionID),
+		entity.WithTransactionExternalID(t.ExternalID),
+		entity.WithTransactionRefundedTransactionID(t.RefundedTransactionID),
+		entity.WithTransactionRepaymentTransactionID(t.RepaymentTransactionID),
+		entity.WithTransactionPayeeID(t.PayeeID),
+		entity.WithTransactionSplits(ToTransactionSplitEntities(t.Splits)),
+		entity.WithTransactionTagIDs(t.TagIDs),
 	)
 }
 
@@ -150,6 +251,13 @@ func (t *Transaction) GetNote() string {
 	return ""
 }
 
+func (t *Transaction) GetClearStatus() uint32 {
+	if t != nil && t.ClearStatus != nil {
+		return *t.ClearStatus
+	}
+	return 0
+}
+
 func (t *Transaction) GetTransactionStatus() uint32 {
 	if t != nil && t.TransactionStatus != nil {
 		return *t.TransactionStatus
@@ -171,6 +279,34 @@ func (t *Transaction) GetTransactionTime() uint64 {
 	return 0
 }
 
+func (t *Transaction) GetRecurringTransactionID() string {
+	if t != nil && t.RecurringTransactionID != nil {
+		return *t.RecurringTransactionID
+	}
+	return ""
+}
+
+func (t *Transaction) GetExternalID() string {
+	if t != nil && t.ExternalID != nil {
+		return *t.ExternalID
+	}
+	return ""
+}
+
+func (t *Transaction) GetSplits() []*TransactionSplit {
+	if t != nil && t.Splits != nil {
+		return t.Splits
+	}
+	return nil
+}
+
+func (t *Transaction) GetTagIDs() []string {
+	if t != nil && t.TagIDs != nil {
+		return t.TagIDs
+	}
+	return nil
+}
+
 func (t *Transaction) GetCreateTime() uint64 {
 	if t != nil && t.CreateTime != nil {
 		return *t.CreateTime
@@ -52,6 +52,11 @@ func (m *Mongo) Close(ctx context.Context) error {
 }
 
 func (m *Mongo) WithTx(ctx context.Context, txFn func(txCtx context.Context) error) error {
+	// join the outer transaction if there is one
+	if mongo.SessionFromContext(ctx) != nil {
+		return txFn(ctx)
+	}
+
 	session, err := m.client.StartSession()
 	if err != nil {
 		return err
@@ -91,6 +96,18 @@ func NewMongoColl(m *Mongo, collName string) *MongoColl {
 	}
 }
 
+func (mc *MongoColl) createIndex(ctx context.Context, keys bson.D, opts *options.IndexOptions) error {
+	_, err := mc.coll.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    keys,
+		Options: opts,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (mc *MongoColl) create(ctx context.Context, doc interface{}) (string, error) {
 	res, err := mc.coll.InsertOne(ctx, doc)
 	if err != nil {
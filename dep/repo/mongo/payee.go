@@ -0,0 +1,80 @@
+package mongo
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/dep/repo"
+	"github.com/jseow5177/pockteer-be/dep/repo/mongo/model"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/jseow5177/pockteer-be/pkg/mongoutil"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const payeeCollName = "payee"
+
+type payeeMongo struct {
+	mColl *MongoColl
+}
+
+func NewPayeeMongo(mongo *Mongo) repo.PayeeRepo {
+	return &payeeMongo{
+		mColl: NewMongoColl(mongo, payeeCollName),
+	}
+}
+
+func (m *payeeMongo) Create(ctx context.Context, p *entity.Payee) (string, error) {
+	pm := model.ToPayeeModelFromEntity(p)
+	id, err := m.mColl.create(ctx, pm)
+	if err != nil {
+		return "", err
+	}
+	p.SetPayeeID(goutil.String(id))
+
+	return id, nil
+}
+
+func (m *payeeMongo) Update(ctx context.Context, pf *repo.PayeeFilter, pu *entity.PayeeUpdate) error {
+	f := mongoutil.BuildFilter(pf)
+
+	pm := model.ToPayeeModelFromUpdate(pu)
+	if err := m.mColl.update(ctx, f, pm); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *payeeMongo) Get(ctx context.Context, pf *repo.PayeeFilter) (*entity.Payee, error) {
+	f := mongoutil.BuildFilter(pf)
+
+	p := new(model.Payee)
+	if err := m.mColl.get(ctx, &p, f); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, repo.ErrPayeeNotFound
+		}
+		return nil, err
+	}
+
+	return model.ToPayeeEntity(p)
+}
+
+func (m *payeeMongo) GetMany(ctx context.Context, pf *repo.PayeeFilter) ([]*entity.Payee, error) {
+	f := mongoutil.BuildFilter(pf)
+
+	res, err := m.mColl.getMany(ctx, new(model.Payee), nil, f)
+	if err != nil {
+		return nil, err
+	}
+
+	eps := make([]*entity.Payee, 0, len(res))
+	for _, r := range res {
+		ep, err := model.ToPayeeEntity(r.(*model.Payee))
+		if err != nil {
+			return nil, err
+		}
+		eps = append(eps, ep)
+	}
+
+	return eps, nil
+}
@@ -0,0 +1,82 @@
+package mongo
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/dep/repo"
+	"github.com/jseow5177/pockteer-be/dep/repo/mongo/model"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/jseow5177/pockteer-be/pkg/mongoutil"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	recurringTransactionCollName = "recurring_transaction"
+)
+
+type recurringTransactionMongo struct {
+	mColl *MongoColl
+}
+
+func NewRecurringTransactionMongo(mongo *Mongo) repo.RecurringTransactionRepo {
+	return &recurringTransactionMongo{
+		mColl: NewMongoColl(mongo, recurringTransactionCollName),
+	}
+}
+
+func (m *recurringTransactionMongo) Create(ctx context.Context, rt *entity.RecurringTransaction) (string, error) {
+	rtm := model.ToRecurringTransactionModelFromEntity(rt)
+	id, err := m.mColl.create(ctx, rtm)
+	if err != nil {
+		return "", err
+	}
+	rt.SetRecurringTransactionID(goutil.String(id))
+
+	return id, nil
+}
+
+func (m *recurringTransactionMongo) Update(ctx context.Context, rtf *repo.RecurringTransactionFilter, rtu *entity.RecurringTransactionUpdate) error {
+	f := mongoutil.BuildFilter(rtf)
+
+	rtm := model.ToRecurringTransactionModelFromUpdate(rtu)
+	if err := m.mColl.update(ctx, f, rtm); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *recurringTransactionMongo) Get(ctx context.Context, rtf *repo.RecurringTransactionFilter) (*entity.RecurringTransaction, error) {
+	f := mongoutil.BuildFilter(rtf)
+
+	rt := new(model.RecurringTransaction)
+	if err := m.mColl.get(ctx, &rt, f); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, repo.ErrRecurringTransactionNotFound
+		}
+		return nil, err
+	}
+
+	return model.ToRecurringTransactionEntity(rt)
+}
+
+func (m *recurringTransactionMongo) GetMany(ctx context.Context, rtf *repo.RecurringTransactionFilter) ([]*entity.RecurringTransaction, error) {
+	f := mongoutil.BuildFilter(rtf)
+
+	res, err := m.mColl.getMany(ctx, new(model.RecurringTransaction), rtf.Paging, f)
+	if err != nil {
+		return nil, err
+	}
+
+	rts := make([]*entity.RecurringTransaction, 0, len(res))
+	for _, r := range res {
+		rt, err := model.ToRecurringTransactionEntity(r.(*model.RecurringTransaction))
+		if err != nil {
+			return nil, err
+		}
+		rts = append(rts, rt)
+	}
+
+	return rts, nil
+}
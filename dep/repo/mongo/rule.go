@@ -0,0 +1,80 @@
+package mongo
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/dep/repo"
+	"github.com/jseow5177/pockteer-be/dep/repo/mongo/model"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/jseow5177/pockteer-be/pkg/mongoutil"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const ruleCollName = "rule"
+
+type ruleMongo struct {
+	mColl *MongoColl
+}
+
+func NewRuleMongo(mongo *Mongo) repo.RuleRepo {
+	return &ruleMongo{
+		mColl: NewMongoColl(mongo, ruleCollName),
+	}
+}
+
+func (m *ruleMongo) Create(ctx context.Context, r *entity.Rule) (string, error) {
+	rm := model.ToRuleModelFromEntity(r)
+	id, err := m.mColl.create(ctx, rm)
+	if err != nil {
+		return "", err
+	}
+	r.SetRuleID(goutil.String(id))
+
+	return id, nil
+}
+
+func (m *ruleMongo) Update(ctx context.Context, rf *repo.RuleFilter, ru *entity.RuleUpdate) error {
+	f := mongoutil.BuildFilter(rf)
+
+	rm := model.ToRuleModelFromUpdate(ru)
+	if err := m.mColl.update(ctx, f, rm); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ruleMongo) Get(ctx context.Context, rf *repo.RuleFilter) (*entity.Rule, error) {
+	f := mongoutil.BuildFilter(rf)
+
+	r := new(model.Rule)
+	if err := m.mColl.get(ctx, &r, f); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, repo.ErrRuleNotFound
+		}
+		return nil, err
+	}
+
+	return model.ToRuleEntity(r)
+}
+
+func (m *ruleMongo) GetMany(ctx context.Context, rf *repo.RuleFilter) ([]*entity.Rule, error) {
+	f := mongoutil.BuildFilter(rf)
+
+	res, err := m.mColl.getMany(ctx, new(model.Rule), rf.Paging, f)
+	if err != nil {
+		return nil, err
+	}
+
+	ers := make([]*entity.Rule, 0, len(res))
+	for _, r := range res {
+		er, err := model.ToRuleEntity(r.(*model.Rule))
+		if err != nil {
+			return nil, err
+		}
+		ers = append(ers, er)
+	}
+
+	return ers, nil
+}
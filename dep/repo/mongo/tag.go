@@ -0,0 +1,80 @@
+package mongo
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/dep/repo"
+	"github.com/jseow5177/pockteer-be/dep/repo/mongo/model"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/jseow5177/pockteer-be/pkg/mongoutil"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const tagCollName = "tag"
+
+type tagMongo struct {
+	mColl *MongoColl
+}
+
+func NewTagMongo(mongo *Mongo) repo.TagRepo {
+	return &tagMongo{
+		mColl: NewMongoColl(mongo, tagCollName),
+	}
+}
+
+func (m *tagMongo) Create(ctx context.Context, t *entity.Tag) (string, error) {
+	tm := model.ToTagModelFromEntity(t)
+	id, err := m.mColl.create(ctx, tm)
+	if err != nil {
+		return "", err
+	}
+	t.SetTagID(goutil.String(id))
+
+	return id, nil
+}
+
+func (m *tagMongo) Update(ctx context.Context, tf *repo.TagFilter, tu *entity.TagUpdate) error {
+	f := mongoutil.BuildFilter(tf)
+
+	tm := model.ToTagModelFromUpdate(tu)
+	if err := m.mColl.update(ctx, f, tm); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *tagMongo) Get(ctx context.Context, tf *repo.TagFilter) (*entity.Tag, error) {
+	f := mongoutil.BuildFilter(tf)
+
+	t := new(model.Tag)
+	if err := m.mColl.get(ctx, &t, f); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, repo.ErrTagNotFound
+		}
+		return nil, err
+	}
+
+	return model.ToTagEntity(t)
+}
+
+func (m *tagMongo) GetMany(ctx context.Context, tf *repo.TagFilter) ([]*entity.Tag, error) {
+	f := mongoutil.BuildFilter(tf)
+
+	res, err := m.mColl.getMany(ctx, new(model.Tag), nil, f)
+	if err != nil {
+		return nil, err
+	}
+
+	ets := make([]*entity.Tag, 0, len(res))
+	for _, r := range res {
+		et, err := model.ToTagEntity(r.(*model.Tag))
+		if err != nil {
+			return nil, err
+		}
+		ets = append(ets, et)
+	}
+
+	return ets, nil
+}
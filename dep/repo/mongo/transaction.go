@@ -8,11 +8,15 @@ import (
 	"github.com/jseow5177/pockteer-be/entity"
 	"github.com/jseow5177/pockteer-be/pkg/goutil"
 	"github.com/jseow5177/pockteer-be/pkg/mongoutil"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 const (
 	transactionCollName = "transaction"
+
+	transactionNoteTokensIndex = "note_tokens_text"
 )
 
 type transactionMongo struct {
@@ -67,6 +71,10 @@ func (m *transactionMongo) GetMany(ctx context.Context, tq *repo.TransactionQuer
 		return nil, err
 	}
 
+	if len(tq.GetKeywords()) > 0 {
+		q = mongoutil.BuildTextSearch(q, tq.GetKeywords())
+	}
+
 	res, err := m.mColl.getMany(ctx, new(model.Transaction), tq.Paging, q)
 	if err != nil {
 		return nil, err
@@ -83,3 +91,19 @@ func (m *transactionMongo) GetMany(ctx context.Context, tq *repo.TransactionQuer
 
 	return ets, nil
 }
+
+func (m *transactionMongo) DeleteMany(ctx context.Context, tf *repo.TransactionFilter) error {
+	return m.mColl.deleteMany(ctx, tf)
+}
+
+// InitTransactionIndexes creates the text index for note search, if it does not exist yet.
+// Tokens are indexed as is, without stemming or stop words.
+func InitTransactionIndexes(ctx context.Context, mongo *Mongo) error {
+	mColl := NewMongoColl(mongo, transactionCollName)
+
+	return mColl.createIndex(ctx, bson.D{{Key: "note_tokens", Value: "text"}},
+		options.Index().
+			SetName(transactionNoteTokensIndex).
+			SetDefaultLanguage("none"),
+	)
+}
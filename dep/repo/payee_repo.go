@@ -0,0 +1,88 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/errutil"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+)
+
+var (
+	ErrPayeeNotFound = errutil.NotFoundError(errors.New("payee not found"))
+)
+
+type PayeeRepo interface {
+	Get(ctx context.Context, pf *PayeeFilter) (*entity.Payee, error)
+	GetMany(ctx context.Context, pf *PayeeFilter) ([]*entity.Payee, error)
+
+	Create(ctx context.Context, p *entity.Payee) (string, error)
+	Update(ctx context.Context, pf *PayeeFilter, p *entity.PayeeUpdate) error
+}
+
+type PayeeFilter struct {
+	UserID      *string  `filter:"user_id"`
+	PayeeID     *string  `filter:"_id"`
+	PayeeIDs    []string `filter:"_id__in"`
+	PayeeStatus *uint32  `filter:"payee_status"`
+}
+
+type PayeeFilterOption = func(pf *PayeeFilter)
+
+func WithPayeeID(payeeID *string) PayeeFilterOption {
+	return func(pf *PayeeFilter) {
+		pf.PayeeID = payeeID
+	}
+}
+
+func WithPayeeIDs(payeeIDs []string) PayeeFilterOption {
+	return func(pf *PayeeFilter) {
+		pf.PayeeIDs = payeeIDs
+	}
+}
+
+func WithPayeeStatus(payeeStatus *uint32) PayeeFilterOption {
+	return func(pf *PayeeFilter) {
+		pf.PayeeStatus = payeeStatus
+	}
+}
+
+func NewPayeeFilter(userID string, opts ...PayeeFilterOption) *PayeeFilter {
+	pf := &PayeeFilter{
+		UserID:      goutil.String(userID),
+		PayeeStatus: goutil.Uint32(uint32(entity.PayeeStatusNormal)),
+	}
+	for _, opt := range opts {
+		opt(pf)
+	}
+	return pf
+}
+
+func (f *PayeeFilter) GetUserID() string {
+	if f != nil && f.UserID != nil {
+		return *f.UserID
+	}
+	return ""
+}
+
+func (f *PayeeFilter) GetPayeeID() string {
+	if f != nil && f.PayeeID != nil {
+		return *f.PayeeID
+	}
+	return ""
+}
+
+func (f *PayeeFilter) GetPayeeIDs() []string {
+	if f != nil && f.PayeeIDs != nil {
+		return f.PayeeIDs
+	}
+	return nil
+}
+
+func (f *PayeeFilter) GetPayeeStatus() uint32 {
+	if f != nil && f.PayeeStatus != nil {
+		return *f.PayeeStatus
+	}
+	return 0
+}
@@ -0,0 +1,130 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/errutil"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+)
+
+var (
+	ErrRecurringTransactionNotFound = errutil.NotFoundError(errors.New("recurring transaction not found"))
+)
+
+type RecurringTransactionRepo interface {
+	Get(ctx context.Context, rtf *RecurringTransactionFilter) (*entity.RecurringTransaction, error)
+	GetMany(ctx context.Context, rtf *RecurringTransactionFilter) ([]*entity.RecurringTransaction, error)
+
+	Create(ctx context.Context, rt *entity.RecurringTransaction) (string, error)
+	Update(ctx context.Context, rtf *RecurringTransactionFilter, rtu *entity.RecurringTransactionUpdate) error
+}
+
+type RecurringTransactionFilter struct {
+	UserID                       *string  `filter:"user_id"`
+	RecurringTransactionID       *string  `filter:"_id"`
+	RecurringTransactionStatus   *uint32  `filter:"recurring_transaction_status"`
+	RecurringTransactionStatuses []uint32 `filter:"recurring_transaction_status__in"`
+	NextTimeLte                  *uint64  `filter:"next_time__lte"`
+	CategoryID                   *string  `filter:"category_id"`
+	Paging                       *Paging  `filter:"-"`
+}
+
+type RecurringTransactionFilterOption = func(rtf *RecurringTransactionFilter)
+
+func WithRecurringTransactionID(recurringTransactionID *string) RecurringTransactionFilterOption {
+	return func(rtf *RecurringTransactionFilter) {
+		rtf.RecurringTransactionID = recurringTransactionID
+	}
+}
+
+func WithRecurringTransactionStatus(recurringTransactionStatus *uint32) RecurringTransactionFilterOption {
+	return func(rtf *RecurringTransactionFilter) {
+		rtf.RecurringTransactionStatus = recurringTransactionStatus
+	}
+}
+
+func WithRecurringTransactionStatuses(recurringTransactionStatuses []uint32) RecurringTransactionFilterOption {
+	return func(rtf *RecurringTransactionFilter) {
+		rtf.RecurringTransactionStatuses = recurringTransactionStatuses
+	}
+}
+
+func WithRecurringTransactionNextTimeLte(nextTimeLte *uint64) RecurringTransactionFilterOption {
+	return func(rtf *RecurringTransactionFilter) {
+		rtf.NextTimeLte = nextTimeLte
+	}
+}
+
+func WithRecurringTransactionCategoryID(categoryID *string) RecurringTransactionFilterOption {
+	return func(rtf *RecurringTransactionFilter) {
+		rtf.CategoryID = categoryID
+	}
+}
+
+func WithRecurringTransactionPaging(paging *Paging) RecurringTransactionFilterOption {
+	return func(rtf *RecurringTransactionFilter) {
+		rtf.Paging = paging
+	}
+}
+
+func NewRecurringTransactionFilter(userID string, opts ...RecurringTransactionFilterOption) *RecurringTransactionFilter {
+	rtf := &RecurringTransactionFilter{
+		UserID:                     goutil.String(userID),
+		RecurringTransactionStatus: goutil.Uint32(uint32(entity.RecurringTransactionStatusNormal)),
+	}
+	for _, opt := range opts {
+		opt(rtf)
+	}
+	return rtf
+}
+
+func (f *RecurringTransactionFilter) GetUserID() string {
+	if f != nil && f.UserID != nil {
+		return *f.UserID
+	}
+	return ""
+}
+
+func (f *RecurringTransactionFilter) GetRecurringTransactionID() string {
+	if f != nil && f.RecurringTransactionID != nil {
+		return *f.RecurringTransactionID
+	}
+	return ""
+}
+
+func (f *RecurringTransactionFilter) GetRecurringTransactionStatus() uint32 {
+	if f != nil && f.RecurringTransactionStatus != nil {
+		return *f.RecurringTransactionStatus
+	}
+	return 0
+}
+
+func (f *RecurringTransactionFilter) GetRecurringTransactionStatuses() []uint32 {
+	if f != nil && f.RecurringTransactionStatuses != nil {
+		return f.RecurringTransactionStatuses
+	}
+	return nil
+}
+
+func (f *RecurringTransactionFilter) GetNextTimeLte() uint64 {
+	if f != nil && f.NextTimeLte != nil {
+		return *f.NextTimeLte
+	}
+	return 0
+}
+
+func (f *RecurringTransactionFilter) GetCategoryID() string {
+	if f != nil && f.CategoryID != nil {
+		return *f.CategoryID
+	}
+	return ""
+}
+
+func (f *RecurringTransactionFilter) GetPaging() *Paging {
+	if f != nil && f.Paging != nil {
+		return f.Paging
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/errutil"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+)
+
+var (
+	ErrRuleNotFound = errutil.NotFoundError(errors.New("rule not found"))
+)
+
+type RuleRepo interface {
+	Get(ctx context.Context, rf *RuleFilter) (*entity.Rule, error)
+	GetMany(ctx context.Context, rf *RuleFilter) ([]*entity.Rule, error)
+
+	Create(ctx context.Context, r *entity.Rule) (string, error)
+	Update(ctx context.Context, rf *RuleFilter, ru *entity.RuleUpdate) error
+}
+
+type RuleFilter struct {
+	UserID     *string `filter:"user_id"`
+	RuleID     *string `filter:"_id"`
+	RuleStatus *uint32 `filter:"rule_status"`
+	CategoryID *string `filter:"category_id"`
+	Paging     *Paging `filter:"-"`
+}
+
+type RuleFilterOption = func(rf *RuleFilter)
+
+func WithRuleID(ruleID *string) RuleFilterOption {
+	return func(rf *RuleFilter) {
+		rf.RuleID = ruleID
+	}
+}
+
+func WithRuleStatus(ruleStatus *uint32) RuleFilterOption {
+	return func(rf *RuleFilter) {
+		rf.RuleStatus = ruleStatus
+	}
+}
+
+func WithRuleCategoryID(categoryID *string) RuleFilterOption {
+	return func(rf *RuleFilter) {
+		rf.CategoryID = categoryID
+	}
+}
+
+func WithRulePaging(paging *Paging) RuleFilterOption {
+	return func(rf *RuleFilter) {
+		rf.Paging = paging
+	}
+}
+
+func NewRuleFilter(userID string, opts ...RuleFilterOption) *RuleFilter {
+	rf := &RuleFilter{
+		UserID:     goutil.String(userID),
+		RuleStatus: goutil.Uint32(uint32(entity.RuleStatusNormal)),
+	}
+	for _, opt := range opts {
+		opt(rf)
+	}
+	return rf
+}
+
+func (f *RuleFilter) GetUserID() string {
+	if f != nil && f.UserID != nil {
+		return *f.UserID
+	}
+	return ""
+}
+
+func (f *RuleFilter) GetRuleID() string {
+	if f != nil && f.RuleID != nil {
+		return *f.RuleID
+	}
+	return ""
+}
+
+func (f *RuleFilter) GetRuleStatus() uint32 {
+	if f != nil && f.RuleStatus != nil {
+		return *f.RuleStatus
+	}
+	return 0
+}
+
+func (f *RuleFilter) GetCategoryID() string {
+	if f != nil && f.CategoryID != nil {
+		return *f.CategoryID
+	}
+	return ""
+}
+
+func (f *RuleFilter) GetPaging() *Paging {
+	if f != nil && f.Paging != nil {
+		return f.Paging
+	}
+	return nil
+}
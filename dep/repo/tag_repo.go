@@ -0,0 +1,102 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/errutil"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+)
+
+var (
+	ErrTagNotFound = errutil.NotFoundError(errors.New("tag not found"))
+)
+
+type TagRepo interface {
+	Get(ctx context.Context, tf *TagFilter) (*entity.Tag, error)
+	GetMany(ctx context.Context, tf *TagFilter) ([]*entity.Tag, error)
+
+	Create(ctx context.Context, t *entity.Tag) (string, error)
+	Update(ctx context.Context, tf *TagFilter, t *entity.TagUpdate) error
+}
+
+type TagFilter struct {
+	UserID    *string  `filter:"user_id"`
+	TagID     *string  `filter:"_id"`
+	TagIDs    []string `filter:"_id__in"`
+	TagName   *string  `filter:"tag_name"`
+	TagStatus *uint32  `filter:"tag_status"`
+}
+
+type TagFilterOption = func(tf *TagFilter)
+
+func WithTagID(tagID *string) TagFilterOption {
+	return func(tf *TagFilter) {
+		tf.TagID = tagID
+	}
+}
+
+func WithTagIDs(tagIDs []string) TagFilterOption {
+	return func(tf *TagFilter) {
+		tf.TagIDs = tagIDs
+	}
+}
+
+func WithTagName(tagName *string) TagFilterOption {
+	return func(tf *TagFilter) {
+		tf.TagName = tagName
+	}
+}
+
+func WithTagStatus(tagStatus *uint32) TagFilterOption {
+	return func(tf *TagFilter) {
+		tf.TagStatus = tagStatus
+	}
+}
+
+func NewTagFilter(userID string, opts ...TagFilterOption) *TagFilter {
+	tf := &TagFilter{
+		UserID:    goutil.String(userID),
+		TagStatus: goutil.Uint32(uint32(entity.TagStatusNormal)),
+	}
+	for _, opt := range opts {
+		opt(tf)
+	}
+	return tf
+}
+
+func (f *TagFilter) GetUserID() string {
+	if f != nil && f.UserID != nil {
+		return *f.UserID
+	}
+	return ""
+}
+
+func (f *TagFilter) GetTagID() string {
+	if f != nil && f.TagID != nil {
+		return *f.TagID
+	}
+	return ""
+}
+
+func (f *TagFilter) GetTagIDs() []string {
+	if f != nil && f.TagIDs != nil {
+		return f.TagIDs
+	}
+	return nil
+}
+
+func (f *TagFilter) GetTagName() string {
+	if f != nil && f.TagName != nil {
+		return *f.TagName
+	}
+	return ""
+}
+
+func (f *TagFilter) GetTagStatus() uint32 {
+	if f != nil && f.TagStatus != nil {
+		return *f.TagStatus
+	}
+	return 0
+}
@@ -20,13 +20,16 @@ type TransactionRepo interface {
 
 	Create(ctx context.Context, t *entity.Transaction) (string, error)
 	Update(ctx context.Context, tf *TransactionFilter, t *entity.TransactionUpdate) error
+
+	DeleteMany(ctx context.Context, tf *TransactionFilter) error
 }
 
 type TransactionQuery struct {
-	Filters []*TransactionFilter
-	Queries []*TransactionQuery
-	Op      filter.BoolOp
-	Paging  *Paging
+	Filters  []*TransactionFilter
+	Queries  []*TransactionQuery
+	Op       filter.BoolOp
+	Paging   *Paging
+	Keywords []string // all must match a word prefix in the note
 }
 
 func (q *TransactionQuery) GetQueries() []filter.Query {
@@ -49,19 +52,43 @@ func (q *TransactionQuery) GetOp() filter.BoolOp {
 	return q.Op
 }
 
+func (q *TransactionQuery) GetKeywords() []string {
+	if q != nil && q.Keywords != nil {
+		return q.Keywords
+	}
+	return nil
+}
+
 type TransactionFilter struct {
-	UserID             *string  `filter:"user_id"`
-	TransactionID      *string  `filter:"_id"`
-	AccountID          *string  `filter:"account_id"`
-	FromAccountID      *string  `filter:"from_account_id"`
-	ToAccountID        *string  `filter:"to_account_id"`
-	CategoryID         *string  `filter:"category_id"`
-	CategoryIDs        []string `filter:"category_id__in"`
-	TransactionStatus  *uint32  `filter:"transaction_status"`
-	TransactionType    *uint32  `filter:"transaction_type"`
-	TransactionTypes   []uint32 `filter:"transaction_type__in"`
-	TransactionTimeGte *uint64  `filter:"transaction_time__gte"`
-	TransactionTimeLte *uint64  `filter:"transaction_time__lte"`
+	UserID                 *string  `filter:"user_id"`
+	TransactionID          *string  `filter:"_id"`
+	TransactionIDs         []string `filter:"_id__in"`
+	AccountID              *string  `filter:"account_id"`
+	AccountIDs             []string `filter:"account_id__in"`
+	FromAccountID          *string  `filter:"from_account_id"`
+	FromAccountIDs         []string `filter:"from_account_id__in"`
+	ToAccountID            *string  `filter:"to_account_id"`
+	ToAccountIDs           []string `filter:"to_account_id__in"`
+	CategoryID             *string  `filter:"category_id"`
+	CategoryIDs            []string `filter:"category_id__in"`
+	TransactionStatus      *uint32  `filter:"transaction_status"`
+	ClearStatuses          []uint32 `filter:"clear_status__in"`
+	TransactionType        *uint32  `filter:"transaction_type"`
+	TransactionTypes       []uint32 `filter:"transaction_type__in"`
+	TransactionTimeGte     *uint64  `filter:"transaction_time__gte"`
+	TransactionTimeLte     *uint64  `filter:"transaction_time__lte"`
+	TransactionTime        *uint64  `filter:"transaction_time"`
+	RecurringTransactionID *string  `filter:"recurring_transaction_id"`
+	ExternalIDs            []string `filter:"external_id__in"`
+	RefundedTransactionID  *string  `filter:"refunded_transaction_id"`
+	RepaymentTransactionID *string  `filter:"repayment_transaction_id"`
+	PayeeID                *string  `filter:"payee_id"`
+	PayeeIDs               []string `filter:"payee_id__in"`
+	SplitCategoryID        *string  `filter:"splits.category_id"`
+	SplitCategoryIDs       []string `filter:"splits.category_id__in"`
+	Tags                   []string `filter:"tag_ids__all"`
+	TagsIn                 []string `filter:"tag_ids__in"`
+	UpdateTimeLte          *uint64  `filter:"update_time__lte"`
 }
 
 type TransactionFilterOption = func(tf *TransactionFilter)
@@ -72,24 +99,48 @@ func WithTransactionID(transactionID *string) TransactionFilterOption {
 	}
 }
 
+func WithTransactionIDs(transactionIDs []string) TransactionFilterOption {
+	return func(tf *TransactionFilter) {
+		tf.TransactionIDs = transactionIDs
+	}
+}
+
 func WithTransactionAccountID(accountID *string) TransactionFilterOption {
 	return func(tf *TransactionFilter) {
 		tf.AccountID = accountID
 	}
 }
 
+func WithTransactionAccountIDs(accountIDs []string) TransactionFilterOption {
+	return func(tf *TransactionFilter) {
+		tf.AccountIDs = accountIDs
+	}
+}
+
 func WithTransactionFromAccountID(fromAccountID *string) TransactionFilterOption {
 	return func(tf *TransactionFilter) {
 		tf.FromAccountID = fromAccountID
 	}
 }
 
+func WithTransactionFromAccountIDs(fromAccountIDs []string) TransactionFilterOption {
+	return func(tf *TransactionFilter) {
+		tf.FromAccountIDs = fromAccountIDs
+	}
+}
+
 func WithTransactionToAccountID(toAccountID *string) TransactionFilterOption {
 	return func(tf *TransactionFilter) {
 		tf.ToAccountID = toAccountID
 	}
 }
 
+func WithTransactionToAccountIDs(toAccountIDs []string) TransactionFilterOption {
+	return func(tf *TransactionFilter) {
+		tf.ToAccountIDs = toAccountIDs
+	}
+}
+
 func WithTransactionCategoryID(categoryID *string) TransactionFilterOption {
 	return func(tf *TransactionFilter) {
 		tf.CategoryID = categoryID
@@ -108,6 +159,12 @@ func WithTransactionStatus(transactionStatus *uint32) TransactionFilterOption {
 	}
 }
 
+func WithTransactionClearStatuses(clearStatuses []uint32) TransactionFilterOption {
+	return func(tf *TransactionFilter) {
+		tf.ClearStatuses = clearStatuses
+	}
+}
+
 func WithTransactionType(transactionType *uint32) TransactionFilterOption {
 	return func(tf *TransactionFilter) {
 		tf.TransactionType = transactionType
@@ -132,6 +189,80 @@ func WithTransactionTimeLte(transactionTimeLte *uint64) TransactionFilterOption
 	}
 }
 
+func WithTransactionTime(transactionTime *uint64) TransactionFilterOption {
+	return func(tf *TransactionFilter) {
+		tf.TransactionTime = transactionTime
+	}
+}
+
+func WithTransactionRecurringTransactionID(recurringTransactionID *string) TransactionFilterOption {
+	return func(tf *TransactionFilter) {
+		tf.RecurringTransactionID = recurringTransactionID
+	}
+}
+
+func WithTransactionExternalIDs(externalIDs []string) TransactionFilterOption {
+	return func(tf *TransactionFilter) {
+		tf.ExternalIDs = externalIDs
+	}
+}
+
+func WithTransactionRefundedTransactionID(refundedTransactionID *string) TransactionFilterOption {
+	return func(tf *TransactionFilter) {
+		tf.RefundedTransactionID = refundedTransactionID
+	}
+}
+
+func WithTransactionRepaymentTransactionID(repaymentTransactionID *string) TransactionFilterOption {
+	return func(tf *TransactionFilter) {
+		tf.RepaymentTransactionID = repaymentTransactionID
+	}
+}
+
+func WithTransactionPayeeID(payeeID *string) TransactionFilterOption {
+	return func(tf *TransactionFilter) {
+		tf.PayeeID = payeeID
+	}
+}
+
+func WithTransactionPayeeIDs(payeeIDs []string) TransactionFilterOption {
+	return func(tf *TransactionFilter) {
+		tf.PayeeIDs = payeeIDs
+	}
+}
+
+func WithTransactionSplitCategoryID(splitCategoryID *string) TransactionFilterOption {
+	return func(tf *TransactionFilter) {
+		tf.SplitCategoryID = splitCategoryID
+	}
+}
+
+func WithTransactionSplitCategoryIDs(splitCategoryIDs []string) TransactionFilterOption {
+	return func(tf *TransactionFilter) {
+		tf.SplitCategoryIDs = splitCategoryIDs
+	}
+}
+
+// WithTransactionTags matches transactions that have all of the given tags.
+func WithTransactionTags(tagIDs []string) TransactionFilterOption {
+	return func(tf *TransactionFilter) {
+		tf.Tags = tagIDs
+	}
+}
+
+// WithTransactionTagsIn matches transactions that have any of the given tags.
+func WithTransactionTagsIn(tagIDs []string) TransactionFilterOption {
+	return func(tf *TransactionFilter) {
+		tf.TagsIn = tagIDs
+	}
+}
+
+func WithTransactionUpdateTimeLte(updateTimeLte *uint64) TransactionFilterOption {
+	return func(tf *TransactionFilter) {
+		tf.UpdateTimeLte = updateTimeLte
+	}
+}
+
 func NewTransactionFilter(userID string, opts ...TransactionFilterOption) *TransactionFilter {
 	tf := &TransactionFilter{
 		UserID:            goutil.String(userID),
@@ -178,6 +309,13 @@ func (f *TransactionFilter) GetCategoryIDs() []string {
 	return nil
 }
 
+func (f *TransactionFilter) GetClearStatuses() []uint32 {
+	if f != nil && f.ClearStatuses != nil {
+		return f.ClearStatuses
+	}
+	return nil
+}
+
 func (f *TransactionFilter) GetTransactionStatus() uint32 {
 	if f != nil && f.TransactionStatus != nil {
 		return *f.TransactionStatus
@@ -212,3 +350,73 @@ func (f *TransactionFilter) GetTransactionTimeLte() uint64 {
 	}
 	return 0
 }
+
+func (f *TransactionFilter) GetTransactionTime() uint64 {
+	if f != nil && f.TransactionTime != nil {
+		return *f.TransactionTime
+	}
+	return 0
+}
+
+func (f *TransactionFilter) GetRecurringTransactionID() string {
+	if f != nil && f.RecurringTransactionID != nil {
+		return *f.RecurringTransactionID
+	}
+	return ""
+}
+
+func (f *TransactionFilter) GetExternalIDs() []string {
+	if f != nil && f.ExternalIDs != nil {
+		return f.ExternalIDs
+	}
+	return nil
+}
+
+func (f *TransactionFilter) GetPayeeID() string {
+	if f != nil && f.PayeeID != nil {
+		return *f.PayeeID
+	}
+	return ""
+}
+
+func (f *TransactionFilter) GetPayeeIDs() []string {
+	if f != nil && f.PayeeIDs != nil {
+		return f.PayeeIDs
+	}
+	return nil
+}
+
+func (f *TransactionFilter) GetRefundedTransactionID() string {
+	if f != nil && f.RefundedTransactionID != nil {
+		return *f.RefundedTransactionID
+	}
+	return ""
+}
+
+func (f *TransactionFilter) GetSplitCategoryID() string {
+	if f != nil && f.SplitCategoryID != nil {
+		return *f.SplitCategoryID
+	}
+	return ""
+}
+
+func (f *TransactionFilter) GetSplitCategoryIDs() []string {
+	if f != nil && f.SplitCategoryIDs != nil {
+		return f.SplitCategoryIDs
+	}
+	return nil
+}
+
+func (f *TransactionFilter) GetTags() []string {
+	if f != nil && f.Tags != nil {
+		return f.Tags
+	}
+	return nil
+}
+
+func (f *TransactionFilter) GetTagsIn() []string {
+	if f != nil && f.TagsIn != nil {
+		return f.TagsIn
+	}
+	return nil
+}
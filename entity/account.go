@@ -2,6 +2,7 @@ package entity
 
 import (
 	"errors"
+	"math"
 	"time"
 
 	"github.com/jseow5177/pockteer-be/pkg/goutil"
@@ -12,6 +13,23 @@ var (
 	ErrSetBalanceForbidden       = errors.New("set balance forbidden")
 	ErrMustSetBalance            = errors.New("balance must be set")
 	ErrAccountCannotHaveHoldings = errors.New("account cannot have holdings")
+	ErrAccountNotCreditCard      = errors.New("account is not a credit card")
+	ErrInvalidStatementDay       = errors.New("invalid statement day")
+	ErrInvalidDueDay             = errors.New("invalid due day")
+	ErrInvalidCreditLimit        = errors.New("invalid credit limit")
+	ErrAccountNotLoan            = errors.New("account is not a loan or mortgage")
+	ErrInvalidPrincipal          = errors.New("invalid principal")
+	ErrInvalidInterestRate       = errors.New("invalid interest rate")
+	ErrInvalidLoanTerm           = errors.New("invalid loan term")
+	ErrAccountCannotEarnInterest = errors.New("account cannot earn interest")
+)
+
+// Statement and due days are capped at 28 so that every month has them
+const MaxCycleDay = 28
+
+const (
+	MaxInterestRate = 100
+	MaxLoanTerm     = 600 // months
 )
 
 type AccountStatus uint32
@@ -46,6 +64,23 @@ const (
 	DebtMortgage
 )
 
+type CompoundFrequency uint32
+
+const (
+	CompoundFrequencyInvalid CompoundFrequency = iota
+	CompoundFrequencyDaily
+	CompoundFrequencyMonthly
+	CompoundFrequencyQuarterly
+	CompoundFrequencyAnnually
+)
+
+var CompoundFrequencies = map[uint32]string{
+	uint32(CompoundFrequencyDaily):     "daily",
+	uint32(CompoundFrequencyMonthly):   "monthly",
+	uint32(CompoundFrequencyQuarterly): "quarterly",
+	uint32(CompoundFrequencyAnnually):  "annually",
+}
+
 var ParentAccountTypes = map[uint32]string{
 	uint32(AccountTypeAsset): "asset",
 	uint32(AccountTypeDebt):  "debt",
@@ -86,6 +121,110 @@ func WithUpdateAccountNote(note *string) AccountUpdateOption {
 	}
 }
 
+func WithUpdateAccountIcon(icon *string) AccountUpdateOption {
+	return func(ac *Account) {
+		if icon != nil {
+			ac.SetIcon(icon)
+		}
+	}
+}
+
+func WithUpdateAccountColor(color *string) AccountUpdateOption {
+	return func(ac *Account) {
+		if color != nil {
+			ac.SetColor(color)
+		}
+	}
+}
+
+func WithUpdateAccountPosition(position *uint32) AccountUpdateOption {
+	return func(ac *Account) {
+		if position != nil {
+			ac.SetPosition(position)
+		}
+	}
+}
+
+func WithUpdateAccountStatementDay(statementDay *uint32) AccountUpdateOption {
+	return func(ac *Account) {
+		if statementDay != nil {
+			ac.SetStatementDay(statementDay)
+		}
+	}
+}
+
+func WithUpdateAccountDueDay(dueDay *uint32) AccountUpdateOption {
+	return func(ac *Account) {
+		if dueDay != nil {
+			ac.SetDueDay(dueDay)
+		}
+	}
+}
+
+func WithUpdateAccountCreditLimit(creditLimit *float64) AccountUpdateOption {
+	return func(ac *Account) {
+		if creditLimit != nil {
+			ac.SetCreditLimit(creditLimit)
+		}
+	}
+}
+
+func WithUpdateAccountPrincipal(principal *float64) AccountUpdateOption {
+	return func(ac *Account) {
+		if principal != nil {
+			ac.SetPrincipal(principal)
+		}
+	}
+}
+
+func WithUpdateAccountInterestRate(interestRate *float64) AccountUpdateOption {
+	return func(ac *Account) {
+		if interestRate != nil {
+			ac.SetInterestRate(interestRate)
+		}
+	}
+}
+
+func WithUpdateAccountTerm(term *uint32) AccountUpdateOption {
+	return func(ac *Account) {
+		if term != nil {
+			ac.SetTerm(term)
+		}
+	}
+}
+
+func WithUpdateAccountStartTime(startTime *uint64) AccountUpdateOption {
+	return func(ac *Account) {
+		if startTime != nil {
+			ac.SetStartTime(startTime)
+		}
+	}
+}
+
+func WithUpdateAccountCompoundFrequency(compoundFrequency *uint32) AccountUpdateOption {
+	return func(ac *Account) {
+		if compoundFrequency != nil {
+			ac.SetCompoundFrequency(compoundFrequency)
+		}
+	}
+}
+
+func WithUpdateAccountInterestCategoryID(interestCategoryID *string) AccountUpdateOption {
+	return func(ac *Account) {
+		if interestCategoryID != nil {
+			ac.SetInterestCategoryID(interestCategoryID)
+		}
+	}
+}
+
+func WithUpdateAccountLastInterestTime(lastInterestTime *uint64) AccountUpdateOption {
+	return func(ac *Account) {
+		if lastInterestTime != nil {
+			ac.SetLastInterestTime(lastInterestTime)
+		}
+	}
+}
+
 func WithUpdateAccountStatus(accountStatus *uint32) AccountUpdateOption {
 	return func(ac *Account) {
 		if accountStatus != nil {
@@ -103,6 +242,9 @@ type Account struct {
 	AccountType   *uint32
 	AccountStatus *uint32
 	Note          *string
+	Icon          *string
+	Color         *string
+	Position      *uint32
 	CreateTime    *uint64
 	UpdateTime    *uint64
 
@@ -110,6 +252,24 @@ type Account struct {
 	Gain        *float64
 	PercentGain *float64
 	Holdings    []*Holding
+
+	// Credit card
+	StatementDay *uint32
+	DueDay       *uint32
+	CreditLimit  *float64
+
+	// Loan and bank account
+	InterestRate *float64 // annual, in percent
+
+	// Loan
+	Principal *float64
+	Term      *uint32 // months
+	StartTime *uint64
+
+	// Bank account
+	CompoundFrequency  *uint32
+	InterestCategoryID *string // income category of posted interest, can be empty
+	LastInterestTime   *uint64 // interest is accrued from this time
 }
 
 type AccountOption func(ac *Account)
@@ -170,6 +330,30 @@ func WithAccountNote(note *string) AccountOption {
 	}
 }
 
+func WithAccountIcon(icon *string) AccountOption {
+	return func(ac *Account) {
+		if icon != nil {
+			ac.SetIcon(icon)
+		}
+	}
+}
+
+func WithAccountColor(color *string) AccountOption {
+	return func(ac *Account) {
+		if color != nil {
+			ac.SetColor(color)
+		}
+	}
+}
+
+func WithAccountPosition(position *uint32) AccountOption {
+	return func(ac *Account) {
+		if position != nil {
+			ac.SetPosition(position)
+		}
+	}
+}
+
 func WithAccountCreateTime(createTime *uint64) AccountOption {
 	return func(ac *Account) {
 		if createTime != nil {
@@ -194,6 +378,86 @@ func WithAccountHoldings(holdings []*Holding) AccountOption {
 	}
 }
 
+func WithAccountStatementDay(statementDay *uint32) AccountOption {
+	return func(ac *Account) {
+		if statementDay != nil {
+			ac.SetStatementDay(statementDay)
+		}
+	}
+}
+
+func WithAccountDueDay(dueDay *uint32) AccountOption {
+	return func(ac *Account) {
+		if dueDay != nil {
+			ac.SetDueDay(dueDay)
+		}
+	}
+}
+
+func WithAccountCreditLimit(creditLimit *float64) AccountOption {
+	return func(ac *Account) {
+		if creditLimit != nil {
+			ac.SetCreditLimit(creditLimit)
+		}
+	}
+}
+
+func WithAccountPrincipal(principal *float64) AccountOption {
+	return func(ac *Account) {
+		if principal != nil {
+			ac.SetPrincipal(principal)
+		}
+	}
+}
+
+func WithAccountInterestRate(interestRate *float64) AccountOption {
+	return func(ac *Account) {
+		if interestRate != nil {
+			ac.SetInterestRate(interestRate)
+		}
+	}
+}
+
+func WithAccountTerm(term *uint32) AccountOption {
+	return func(ac *Account) {
+		if term != nil {
+			ac.SetTerm(term)
+		}
+	}
+}
+
+func WithAccountStartTime(startTime *uint64) AccountOption {
+	return func(ac *Account) {
+		if startTime != nil {
+			ac.SetStartTime(startTime)
+		}
+	}
+}
+
+func WithAccountCompoundFrequency(compoundFrequency *uint32) AccountOption {
+	return func(ac *Account) {
+		if compoundFrequency != nil {
+			ac.SetCompoundFrequency(compoundFrequency)
+		}
+	}
+}
+
+func WithAccountInterestCategoryID(interestCategoryID *string) AccountOption {
+	return func(ac *Account) {
+		if interestCategoryID != nil {
+			ac.SetInterestCategoryID(interestCategoryID)
+		}
+	}
+}
+
+func WithAccountLastInterestTime(lastInterestTime *uint64) AccountOption {
+	return func(ac *Account) {
+		if lastInterestTime != nil {
+			ac.SetLastInterestTime(lastInterestTime)
+		}
+	}
+}
+
 func (ac *Account) Clone() (*Account, error) {
 	return NewAccount(
 		ac.GetUserID(),
@@ -204,8 +468,21 @@ func (ac *Account) Clone() (*Account, error) {
 		WithAccountStatus(ac.AccountStatus),
 		WithAccountType(ac.AccountType),
 		WithAccountNote(ac.Note),
+		WithAccountIcon(ac.Icon),
+		WithAccountColor(ac.Color),
+		WithAccountPosition(ac.Position),
 		WithAccountCreateTime(ac.CreateTime),
 		WithAccountUpdateTime(ac.UpdateTime),
+		WithAccountStatementDay(ac.StatementDay),
+		WithAccountDueDay(ac.DueDay),
+		WithAccountCreditLimit(ac.CreditLimit),
+		WithAccountPrincipal(ac.Principal),
+		WithAccountInterestRate(ac.InterestRate),
+		WithAccountTerm(ac.Term),
+		WithAccountStartTime(ac.StartTime),
+		WithAccountCompoundFrequency(ac.CompoundFrequency),
+		WithAccountInterestCategoryID(ac.InterestCategoryID),
+		WithAccountLastInterestTime(ac.LastInterestTime),
 	)
 }
 
@@ -220,12 +497,16 @@ func NewAccount(userID, accountName string, opts ...AccountOption) (*Account, er
 		Currency:      goutil.String(string(CurrencySGD)),
 		AccountStatus: goutil.Uint32(uint32(AccountStatusNormal)),
 		Note:          goutil.String(""),
+		Icon:          goutil.String(""),
+		Color:         goutil.String(""),
+		Position:      goutil.Uint32(0),
 		CreateTime:    goutil.Uint64(now),
 		UpdateTime:    goutil.Uint64(now),
 	}
 	for _, opt := range opts {
 		opt(ac)
 	}
+	ac.initInterestAccrual(now)
 
 	if err := ac.validate(); err != nil {
 		return nil, err
@@ -234,6 +515,14 @@ func NewAccount(userID, accountName string, opts ...AccountOption) (*Account, er
 	return ac, nil
 }
 
+// initInterestAccrual starts accruing interest from now, so interest is not backdated
+// when a rate is first set.
+func (ac *Account) initInterestAccrual(now uint64) {
+	if ac.HasInterestAccrual() && ac.GetLastInterestTime() == 0 {
+		ac.SetLastInterestTime(goutil.Uint64(now))
+	}
+}
+
 func (ac *Account) validate() error {
 	if ac.CanSetBalance() && ac.Balance == nil {
 		return ErrMustSetBalance
@@ -247,6 +536,52 @@ func (ac *Account) validate() error {
 		return ErrAccountCannotHaveHoldings
 	}
 
+	if !ac.IsCreditCard() && (ac.GetStatementDay() > 0 || ac.GetDueDay() > 0 || ac.GetCreditLimit() > 0) {
+		return ErrAccountNotCreditCard
+	}
+
+	if ac.GetStatementDay() > MaxCycleDay {
+		return ErrInvalidStatementDay
+	}
+
+	if ac.GetDueDay() > MaxCycleDay {
+		return ErrInvalidDueDay
+	}
+
+	if ac.GetCreditLimit() < 0 {
+		return ErrInvalidCreditLimit
+	}
+
+	if !ac.IsLoan() && (ac.GetPrincipal() > 0 || ac.GetTerm() > 0 || ac.GetStartTime() > 0) {
+		return ErrAccountNotLoan
+	}
+
+	if !ac.IsLoan() && !ac.IsBankAccount() && ac.GetInterestRate() > 0 {
+		return ErrAccountCannotEarnInterest
+	}
+
+	if !ac.IsBankAccount() && (ac.GetCompoundFrequency() > 0 || ac.GetInterestCategoryID() != "") {
+		return ErrAccountCannotEarnInterest
+	}
+
+	if ac.IsBankAccount() && ac.GetInterestRate() > 0 {
+		if _, ok := CompoundFrequencies[ac.GetCompoundFrequency()]; !ok {
+			return ErrInvalidCompoundFrequency
+		}
+	}
+
+	if ac.GetPrincipal() < 0 {
+		return ErrInvalidPrincipal
+	}
+
+	if ac.GetInterestRate() < 0 || ac.GetInterestRate() > MaxInterestRate {
+		return ErrInvalidInterestRate
+	}
+
+	if ac.GetTerm() > MaxLoanTerm {
+		return ErrInvalidLoanTerm
+	}
+
 	return nil
 }
 
@@ -254,8 +589,22 @@ type AccountUpdate struct {
 	AccountName   *string
 	Balance       *float64
 	Note          *string
+	Icon          *string
+	Color         *string
+	Position      *uint32
 	UpdateTime    *uint64
 	AccountStatus *uint32
+	StatementDay  *uint32
+	DueDay        *uint32
+	CreditLimit   *float64
+	Principal     *float64
+	InterestRate  *float64
+	Term          *uint32
+	StartTime     *uint64
+
+	CompoundFrequency  *uint32
+	InterestCategoryID *string
+	LastInterestTime   *uint64
 }
 
 func (acu *AccountUpdate) GetAccountName() string {
@@ -293,6 +642,97 @@ func (acu *AccountUpdate) GetNote() string {
 	return ""
 }
 
+func (acu *AccountUpdate) GetIcon() string {
+	if acu != nil && acu.Icon != nil {
+		return *acu.Icon
+	}
+	return ""
+}
+
+func (acu *AccountUpdate) GetColor() string {
+	if acu != nil && acu.Color != nil {
+		return *acu.Color
+	}
+	return ""
+}
+
+func (acu *AccountUpdate) GetPosition() uint32 {
+	if acu != nil && acu.Position != nil {
+		return *acu.Position
+	}
+	return 0
+}
+
+func (acu *AccountUpdate) GetStatementDay() uint32 {
+	if acu != nil && acu.StatementDay != nil {
+		return *acu.StatementDay
+	}
+	return 0
+}
+
+func (acu *AccountUpdate) GetDueDay() uint32 {
+	if acu != nil && acu.DueDay != nil {
+		return *acu.DueDay
+	}
+	return 0
+}
+
+func (acu *AccountUpdate) GetCreditLimit() float64 {
+	if acu != nil && acu.CreditLimit != nil {
+		return *acu.CreditLimit
+	}
+	return 0
+}
+
+func (acu *AccountUpdate) GetPrincipal() float64 {
+	if acu != nil && acu.Principal != nil {
+		return *acu.Principal
+	}
+	return 0
+}
+
+func (acu *AccountUpdate) GetInterestRate() float64 {
+	if acu != nil && acu.InterestRate != nil {
+		return *acu.InterestRate
+	}
+	return 0
+}
+
+func (acu *AccountUpdate) GetTerm() uint32 {
+	if acu != nil && acu.Term != nil {
+		return *acu.Term
+	}
+	return 0
+}
+
+func (acu *AccountUpdate) GetStartTime() uint64 {
+	if acu != nil && acu.StartTime != nil {
+		return *acu.StartTime
+	}
+	return 0
+}
+
+func (acu *AccountUpdate) GetCompoundFrequency() uint32 {
+	if acu != nil && acu.CompoundFrequency != nil {
+		return *acu.CompoundFrequency
+	}
+	return 0
+}
+
+func (acu *AccountUpdate) GetInterestCategoryID() string {
+	if acu != nil && acu.InterestCategoryID != nil {
+		return *acu.InterestCategoryID
+	}
+	return ""
+}
+
+func (acu *AccountUpdate) GetLastInterestTime() uint64 {
+	if acu != nil && acu.LastInterestTime != nil {
+		return *acu.LastInterestTime
+	}
+	return 0
+}
+
 func (ac *Account) ToAccountUpdate(old *Account) *AccountUpdate {
 	var (
 		hasUpdate bool
@@ -317,11 +757,76 @@ func (ac *Account) ToAccountUpdate(old *Account) *AccountUpdate {
 		acu.Note = ac.Note
 	}
 
+	if old.GetIcon() != ac.GetIcon() {
+		hasUpdate = true
+		acu.Icon = ac.Icon
+	}
+
+	if old.GetColor() != ac.GetColor() {
+		hasUpdate = true
+		acu.Color = ac.Color
+	}
+
+	if old.GetPosition() != ac.GetPosition() {
+		hasUpdate = true
+		acu.Position = ac.Position
+	}
+
 	if old.GetAccountStatus() != ac.GetAccountStatus() {
 		hasUpdate = true
 		acu.AccountStatus = ac.AccountStatus
 	}
 
+	if old.GetStatementDay() != ac.GetStatementDay() {
+		hasUpdate = true
+		acu.StatementDay = ac.StatementDay
+	}
+
+	if old.GetDueDay() != ac.GetDueDay() {
+		hasUpdate = true
+		acu.DueDay = ac.DueDay
+	}
+
+	if old.GetCreditLimit() != ac.GetCreditLimit() {
+		hasUpdate = true
+		acu.CreditLimit = ac.CreditLimit
+	}
+
+	if old.GetPrincipal() != ac.GetPrincipal() {
+		hasUpdate = true
+		acu.Principal = ac.Principal
+	}
+
+	if old.GetInterestRate() != ac.GetInterestRate() {
+		hasUpdate = true
+		acu.InterestRate = ac.InterestRate
+	}
+
+	if old.GetTerm() != ac.GetTerm() {
+		hasUpdate = true
+		acu.Term = ac.Term
+	}
+
+	if old.GetStartTime() != ac.GetStartTime() {
+		hasUpdate = true
+		acu.StartTime = ac.StartTime
+	}
+
+	if old.GetCompoundFrequency() != ac.GetCompoundFrequency() {
+		hasUpdate = true
+		acu.CompoundFrequency = ac.CompoundFrequency
+	}
+
+	if old.GetInterestCategoryID() != ac.GetInterestCategoryID() {
+		hasUpdate = true
+		acu.InterestCategoryID = ac.InterestCategoryID
+	}
+
+	if old.GetLastInterestTime() != ac.GetLastInterestTime() {
+		hasUpdate = true
+		acu.LastInterestTime = ac.LastInterestTime
+	}
+
 	if hasUpdate {
 		return acu
 	}
@@ -343,13 +848,15 @@ func (ac *Account) Update(acus ...AccountUpdateOption) (*AccountUpdate, error) {
 		acu(ac)
 	}
 
+	now := uint64(time.Now().UnixMilli())
+	ac.initInterestAccrual(now)
+
 	// check
 	if err := ac.validate(); err != nil {
 		return nil, err
 	}
 
-	now := goutil.Uint64(uint64(time.Now().UnixMilli()))
-	ac.SetUpdateTime(now)
+	ac.SetUpdateTime(goutil.Uint64(now))
 
 	return ac.ToAccountUpdate(old), nil
 }
@@ -447,6 +954,39 @@ func (ac *Account) SetNote(note *string) {
 	ac.Note = note
 }
 
+func (ac *Account) GetIcon() string {
+	if ac != nil && ac.Icon != nil {
+		return *ac.Icon
+	}
+	return ""
+}
+
+func (ac *Account) SetIcon(icon *string) {
+	ac.Icon = icon
+}
+
+func (ac *Account) GetColor() string {
+	if ac != nil && ac.Color != nil {
+		return *ac.Color
+	}
+	return ""
+}
+
+func (ac *Account) SetColor(color *string) {
+	ac.Color = color
+}
+
+func (ac *Account) GetPosition() uint32 {
+	if ac != nil && ac.Position != nil {
+		return *ac.Position
+	}
+	return 0
+}
+
+func (ac *Account) SetPosition(position *uint32) {
+	ac.Position = position
+}
+
 func (ac *Account) GetCreateTime() uint64 {
 	if ac != nil && ac.CreateTime != nil {
 		return *ac.CreateTime
@@ -512,6 +1052,131 @@ func (ac *Account) SetHoldings(hs []*Holding) {
 	ac.Holdings = hs
 }
 
+func (ac *Account) GetStatementDay() uint32 {
+	if ac != nil && ac.StatementDay != nil {
+		return *ac.StatementDay
+	}
+	return 0
+}
+
+func (ac *Account) SetStatementDay(statementDay *uint32) {
+	ac.StatementDay = statementDay
+}
+
+func (ac *Account) GetDueDay() uint32 {
+	if ac != nil && ac.DueDay != nil {
+		return *ac.DueDay
+	}
+	return 0
+}
+
+func (ac *Account) SetDueDay(dueDay *uint32) {
+	ac.DueDay = dueDay
+}
+
+func (ac *Account) GetCreditLimit() float64 {
+	if ac != nil && ac.CreditLimit != nil {
+		return *ac.CreditLimit
+	}
+	return 0
+}
+
+func (ac *Account) SetCreditLimit(creditLimit *float64) {
+	ac.CreditLimit = creditLimit
+
+	if creditLimit != nil {
+		cl := util.RoundFloatToStandardDP(*creditLimit)
+		ac.CreditLimit = goutil.Float64(cl)
+	}
+}
+
+func (ac *Account) GetPrincipal() float64 {
+	if ac != nil && ac.Principal != nil {
+		return *ac.Principal
+	}
+	return 0
+}
+
+func (ac *Account) SetPrincipal(principal *float64) {
+	ac.Principal = principal
+
+	if principal != nil {
+		p := util.RoundFloatToStandardDP(*principal)
+		ac.Principal = goutil.Float64(p)
+	}
+}
+
+func (ac *Account) GetInterestRate() float64 {
+	if ac != nil && ac.InterestRate != nil {
+		return *ac.InterestRate
+	}
+	return 0
+}
+
+func (ac *Account) SetInterestRate(interestRate *float64) {
+	ac.InterestRate = interestRate
+
+	if interestRate != nil {
+		ir := util.RoundFloatToPreciseDP(*interestRate)
+		ac.InterestRate = goutil.Float64(ir)
+	}
+}
+
+func (ac *Account) GetTerm() uint32 {
+	if ac != nil && ac.Term != nil {
+		return *ac.Term
+	}
+	return 0
+}
+
+func (ac *Account) SetTerm(term *uint32) {
+	ac.Term = term
+}
+
+func (ac *Account) GetStartTime() uint64 {
+	if ac != nil && ac.StartTime != nil {
+		return *ac.StartTime
+	}
+	return 0
+}
+
+func (ac *Account) SetStartTime(startTime *uint64) {
+	ac.StartTime = startTime
+}
+
+func (ac *Account) GetCompoundFrequency() uint32 {
+	if ac != nil && ac.CompoundFrequency != nil {
+		return *ac.CompoundFrequency
+	}
+	return 0
+}
+
+func (ac *Account) SetCompoundFrequency(compoundFrequency *uint32) {
+	ac.CompoundFrequency = compoundFrequency
+}
+
+func (ac *Account) GetInterestCategoryID() string {
+	if ac != nil && ac.InterestCategoryID != nil {
+		return *ac.InterestCategoryID
+	}
+	return ""
+}
+
+func (ac *Account) SetInterestCategoryID(interestCategoryID *string) {
+	ac.InterestCategoryID = interestCategoryID
+}
+
+func (ac *Account) GetLastInterestTime() uint64 {
+	if ac != nil && ac.LastInterestTime != nil {
+		return *ac.LastInterestTime
+	}
+	return 0
+}
+
+func (ac *Account) SetLastInterestTime(lastInterestTime *uint64) {
+	ac.LastInterestTime = lastInterestTime
+}
+
 func (ac *Account) IsAsset() bool {
 	return (ac.GetAccountType() >> AccountTypeBitShift & uint32(AccountTypeAsset)) > 0
 }
@@ -524,6 +1189,204 @@ func (ac *Account) IsInvestment() bool {
 	return ac.GetAccountType() == uint32(AssetInvestment)
 }
 
+func (ac *Account) IsCreditCard() bool {
+	return ac.GetAccountType() == uint32(DebtCreditCard)
+}
+
+// HasStatementCycle reports if both the statement day and due day are configured.
+func (ac *Account) HasStatementCycle() bool {
+	return ac.IsCreditCard() && ac.GetStatementDay() > 0 && ac.GetDueDay() > 0
+}
+
+// StatementCycle is the open billing cycle of a credit card.
+type StatementCycle struct {
+	Start   time.Time // day after the last statement day
+	End     time.Time // day after the next statement day
+	DueDate time.Time // payment due date of the last statement
+}
+
+// GetStatementCycle returns the billing cycle that now falls in, in the location of now.
+func (ac *Account) GetStatementCycle(now time.Time) *StatementCycle {
+	if !ac.HasStatementCycle() {
+		return nil
+	}
+
+	var (
+		statementDay = int(ac.GetStatementDay())
+		dueDay       = int(ac.GetDueDay())
+	)
+
+	// statement days are at most 28, so they are never normalized into the next month
+	statement := time.Date(now.Year(), now.Month(), statementDay, 0, 0, 0, 0, now.Location())
+	if now.Before(statement.AddDate(0, 0, 1)) {
+		statement = time.Date(now.Year(), now.Month()-1, statementDay, 0, 0, 0, 0, now.Location())
+	}
+	next := time.Date(statement.Year(), statement.Month()+1, statementDay, 0, 0, 0, 0, now.Location())
+
+	dueDate := time.Date(statement.Year(), statement.Month(), dueDay, 0, 0, 0, 0, now.Location())
+	if dueDay <= statementDay {
+		dueDate = time.Date(statement.Year(), statement.Month()+1, dueDay, 0, 0, 0, 0, now.Location())
+	}
+
+	return &StatementCycle{
+		Start:   statement.AddDate(0, 0, 1),
+		End:     next.AddDate(0, 0, 1),
+		DueDate: dueDate,
+	}
+}
+
+func (ac *Account) IsBankAccount() bool {
+	return ac.GetAccountType() == uint32(AssetBankAccount)
+}
+
+// HasInterestAccrual reports if a bank account earns interest.
+func (ac *Account) HasInterestAccrual() bool {
+	return ac.IsBankAccount() && ac.GetInterestRate() > 0
+}
+
+// GetCompoundTime returns the end of the nth compounding period after from.
+func (ac *Account) GetCompoundTime(from time.Time, n int) time.Time {
+	switch ac.GetCompoundFrequency() {
+	case uint32(CompoundFrequencyDaily):
+		return from.AddDate(0, 0, n)
+	case uint32(CompoundFrequencyMonthly):
+		return util.AddMonths(from, n)
+	case uint32(CompoundFrequencyQuarterly):
+		return util.AddMonths(from, 3*n)
+	case uint32(CompoundFrequencyAnnually):
+		return util.AddMonths(from, 12*n)
+	}
+	return from
+}
+
+// GetCompoundPeriods counts the whole compounding periods from the last interest time until to,
+// and returns the end of the last period.
+func (ac *Account) GetCompoundPeriods(to uint64) (int, uint64) {
+	if !ac.HasInterestAccrual() {
+		return 0, ac.GetLastInterestTime()
+	}
+
+	var (
+		from    = time.UnixMilli(int64(ac.GetLastInterestTime())).UTC()
+		periods = 0
+		end     = from
+	)
+	for {
+		next := ac.GetCompoundTime(from, periods+1)
+		if uint64(next.UnixMilli()) > to {
+			break
+		}
+		periods++
+		end = next
+	}
+
+	return periods, uint64(end.UnixMilli())
+}
+
+// GetCompoundInterest returns the interest a balance earns over a number of compounding periods.
+func (ac *Account) GetCompoundInterest(balance float64, periods int) float64 {
+	if !ac.HasInterestAccrual() || balance <= 0 || periods <= 0 {
+		return 0
+	}
+
+	var n float64
+	switch ac.GetCompoundFrequency() {
+	case uint32(CompoundFrequencyDaily):
+		n = 365
+	case uint32(CompoundFrequencyMonthly):
+		n = 12
+	case uint32(CompoundFrequencyQuarterly):
+		n = 4
+	case uint32(CompoundFrequencyAnnually):
+		n = 1
+	default:
+		return 0
+	}
+
+	rate := ac.GetInterestRate() / 100 / n
+
+	return util.RoundFloatToStandardDP(balance * (math.Pow(1+rate, float64(periods)) - 1))
+}
+
+func (ac *Account) IsLoan() bool {
+	return ac.GetAccountType() == uint32(DebtLoan) || ac.GetAccountType() == uint32(DebtMortgage)
+}
+
+// HasAmortisation reports if the principal, term and start time of a loan are configured.
+func (ac *Account) HasAmortisation() bool {
+	return ac.IsLoan() && ac.GetPrincipal() > 0 && ac.GetTerm() > 0 && ac.GetStartTime() > 0
+}
+
+func (ac *Account) GetMonthlyInterestRate() float64 {
+	return ac.GetInterestRate() / 12 / 100
+}
+
+func (ac *Account) GetDailyInterestRate() float64 {
+	return ac.GetInterestRate() / 365 / 100
+}
+
+// GetLoanInterest returns the simple interest owed on a loan balance over a number of days.
+func (ac *Account) GetLoanInterest(balance, days float64) float64 {
+	// debt balances are negative
+	return util.RoundFloatToStandardDP(math.Max(0, -balance) * ac.GetDailyInterestRate() * days)
+}
+
+type AmortisationPeriod struct {
+	Period      uint32
+	PaymentTime uint64
+	Payment     float64
+	Interest    float64
+	Principal   float64
+	Balance     float64 // remaining after the payment
+}
+
+// GetAmortisationSchedule returns the monthly repayments of a loan with a fixed payment.
+// The first payment is a month after the start time, in loc.
+func (ac *Account) GetAmortisationSchedule(loc *time.Location) []*AmortisationPeriod {
+	if !ac.HasAmortisation() {
+		return nil
+	}
+
+	var (
+		balance = ac.GetPrincipal()
+		rate    = ac.GetMonthlyInterestRate()
+		term    = int(ac.GetTerm())
+		start   = time.UnixMilli(int64(ac.GetStartTime())).In(loc)
+		payment = balance / float64(term)
+	)
+	if rate > 0 {
+		payment = balance * rate / (1 - math.Pow(1+rate, -float64(term)))
+	}
+	payment = util.RoundFloatToStandardDP(payment)
+
+	periods := make([]*AmortisationPeriod, 0, term)
+	for i := 1; i <= term; i++ {
+		interest := util.RoundFloatToStandardDP(balance * rate)
+
+		principal := payment - interest
+		if i == term || principal > balance {
+			// last payment clears any rounding difference
+			principal = balance
+		}
+		balance = util.RoundFloatToStandardDP(balance - principal)
+
+		periods = append(periods, &AmortisationPeriod{
+			Period:      uint32(i),
+			PaymentTime: uint64(start.AddDate(0, i, 0).UnixMilli()),
+			Payment:     util.RoundFloatToStandardDP(principal + interest),
+			Interest:    interest,
+			Principal:   util.RoundFloatToStandardDP(principal),
+			Balance:     balance,
+		})
+
+		if balance == 0 {
+			break
+		}
+	}
+
+	return periods
+}
+
 func (ac *Account) CanSetBalance() bool {
 	return ac.GetAccountType() != uint32(AssetInvestment)
 }
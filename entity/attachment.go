@@ -0,0 +1,215 @@
+package entity
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/pkg/errutil"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+)
+
+var (
+	ErrInvalidAttachmentName = errutil.ValidationError(errors.New("invalid attachment file name"))
+	ErrInvalidAttachmentSize = errutil.ValidationError(errors.New("invalid attachment size"))
+	ErrInvalidAttachmentType = errutil.ValidationError(errors.New("attachment must be a jpeg, png, webp or pdf file"))
+)
+
+var AttachmentContentTypes = map[string]bool{
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/webp":      true,
+	"application/pdf": true,
+}
+
+type Attachment struct {
+	UserID        *string
+	AttachmentID  *string
+	TransactionID *string
+	FileName      *string
+	ContentType   *string
+	Size          *uint64
+	StorageKey    *string // key of the content in the blob store
+	CreateTime    *uint64
+	UpdateTime    *uint64
+}
+
+type AttachmentOption func(a *Attachment)
+
+func WithAttachmentID(attachmentID *string) AttachmentOption {
+	return func(a *Attachment) {
+		if attachmentID != nil {
+			a.SetAttachmentID(attachmentID)
+		}
+	}
+}
+
+func WithAttachmentStorageKey(storageKey *string) AttachmentOption {
+	return func(a *Attachment) {
+		if storageKey != nil {
+			a.SetStorageKey(storageKey)
+		}
+	}
+}
+
+func WithAttachmentCreateTime(createTime *uint64) AttachmentOption {
+	return func(a *Attachment) {
+		if createTime != nil {
+			a.SetCreateTime(createTime)
+		}
+	}
+}
+
+func WithAttachmentUpdateTime(updateTime *uint64) AttachmentOption {
+	return func(a *Attachment) {
+		if updateTime != nil {
+			a.SetUpdateTime(updateTime)
+		}
+	}
+}
+
+func NewAttachment(userID, transactionID, fileName, contentType string, size uint64, opts ...AttachmentOption) (*Attachment, error) {
+	now := uint64(time.Now().UnixMilli())
+	a := &Attachment{
+		AttachmentID:  goutil.String(""),
+		UserID:        goutil.String(userID),
+		TransactionID: goutil.String(transactionID),
+		FileName:      goutil.String(fileName),
+		ContentType:   goutil.String(contentType),
+		Size:          goutil.Uint64(size),
+		StorageKey:    goutil.String(userID + "/" + goutil.NextXID()),
+		CreateTime:    goutil.Uint64(now),
+		UpdateTime:    goutil.Uint64(now),
+	}
+
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	if err := a.validate(); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+func (a *Attachment) validate() error {
+	// keep only the base name, clients may send a full path
+	fileName := strings.TrimSpace(filepath.Base(filepath.ToSlash(a.GetFileName())))
+	a.SetFileName(goutil.String(fileName))
+
+	if fileName == "" || fileName == "." || fileName == "/" || len(fileName) > config.MaxAttachmentNameLength {
+		return ErrInvalidAttachmentName
+	}
+
+	if a.GetSize() == 0 || a.GetSize() > config.MaxAttachmentSize {
+		return ErrInvalidAttachmentSize
+	}
+
+	if !AttachmentContentTypes[a.GetContentType()] {
+		return ErrInvalidAttachmentType
+	}
+
+	return nil
+}
+
+func (a *Attachment) GetUserID() string {
+	if a != nil && a.UserID != nil {
+		return *a.UserID
+	}
+	return ""
+}
+
+func (a *Attachment) SetUserID(userID *string) {
+	a.UserID = userID
+}
+
+func (a *Attachment) GetAttachmentID() string {
+	if a != nil && a.AttachmentID != nil {
+		return *a.AttachmentID
+	}
+	return ""
+}
+
+func (a *Attachment) SetAttachmentID(attachmentID *string) {
+	a.AttachmentID = attachmentID
+}
+
+func (a *Attachment) GetTransactionID() string {
+	if a != nil && a.TransactionID != nil {
+		return *a.TransactionID
+	}
+	return ""
+}
+
+func (a *Attachment) SetTransactionID(transactionID *string) {
+	a.TransactionID = transactionID
+}
+
+func (a *Attachment) GetFileName() string {
+	if a != nil && a.FileName != nil {
+		return *a.FileName
+	}
+	return ""
+}
+
+func (a *Attachment) SetFileName(fileName *string) {
+	a.FileName = fileName
+}
+
+func (a *Attachment) GetContentType() string {
+	if a != nil && a.ContentType != nil {
+		return *a.ContentType
+	}
+	return ""
+}
+
+func (a *Attachment) SetContentType(contentType *string) {
+	a.ContentType = contentType
+}
+
+func (a *Attachment) GetSize() uint64 {
+	if a != nil && a.Size != nil {
+		return *a.Size
+	}
+	return 0
+}
+
+func (a *Attachment) SetSize(size *uint64) {
+	a.Size = size
+}
+
+func (a *Attachment) GetStorageKey() string {
+	if a != nil && a.StorageKey != nil {
+		return *a.StorageKey
+	}
+	return ""
+}
+
+func (a *Attachment) SetStorageKey(storageKey *string) {
+	a.StorageKey = storageKey
+}
+
+func (a *Attachment) GetCreateTime() uint64 {
+	if a != nil && a.CreateTime != nil {
+		return *a.CreateTime
+	}
+	return 0
+}
+
+func (a *Attachment) SetCreateTime(createTime *uint64) {
+	a.CreateTime = createTime
+}
+
+func (a *Attachment) GetUpdateTime() uint64 {
+	if a != nil && a.UpdateTime != nil {
+		return *a.UpdateTime
+	}
+	return 0
+}
+
+func (a *Attachment) SetUpdateTime(updateTime *uint64) {
+	a.UpdateTime = updateTime
+}
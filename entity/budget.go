@@ -3,6 +3,7 @@ package entity
 import (
 	"errors"
 	"math"
+	"strconv"
 	"time"
 
 	"github.com/jseow5177/pockteer-be/pkg/goutil"
@@ -14,6 +15,8 @@ var (
 	ErrBudgetDateEmpty         = errors.New("date cannot be empty")
 	ErrBudgetConflict          = errors.New("conflict in budget")
 	ErrBudgetMustRepeatAllTime = errors.New("budget must repeat all time")
+	ErrBudgetPeriodDaysEmpty   = errors.New("custom budget must have period days")
+	ErrBudgetAnchorDateEmpty   = errors.New("custom budget must have an anchor date")
 )
 
 type BudgetRepeat uint32
@@ -30,23 +33,92 @@ var BudgetRepeats = map[uint32]string{
 	uint32(BudgetRepeatNow):         "for a period only",
 }
 
+// BudgetConflict decides the budget kept when two categories with budgets are merged.
+type BudgetConflict uint32
+
+const (
+	BudgetConflictInvalid BudgetConflict = iota
+	BudgetConflictSum
+	BudgetConflictKeepTarget
+	BudgetConflictKeepSource
+)
+
+var BudgetConflicts = map[uint32]string{
+	uint32(BudgetConflictSum):        "sum the budgets",
+	uint32(BudgetConflictKeepTarget): "keep the budget of the target category",
+	uint32(BudgetConflictKeepSource): "keep the budget of the source category",
+}
+
 type BudgetType uint32
 
 const (
 	BudgetTypeMonth BudgetType = iota
 	BudgetTypeYear
+	BudgetTypeWeek
+	BudgetTypeQuarter
+	BudgetTypeCustom // every N days from an anchor date
 )
 
 var BudgetTypes = map[uint32]string{
-	uint32(BudgetTypeMonth): "month",
-	uint32(BudgetTypeYear):  "year",
+	uint32(BudgetTypeMonth):   "month",
+	uint32(BudgetTypeYear):    "year",
+	uint32(BudgetTypeWeek):    "week",
+	uint32(BudgetTypeQuarter): "quarter",
+	uint32(BudgetTypeCustom):  "custom",
 }
 
-type getDateRangeFn func(date, timezone string) (startDate, endDate uint64, err error)
+// average number of days in a budget period, used to convert amounts between budget types
+var budgetTypeDays = map[uint32]float64{
+	uint32(BudgetTypeMonth):   365.25 / 12,
+	uint32(BudgetTypeYear):    365.25,
+	uint32(BudgetTypeWeek):    7,
+	uint32(BudgetTypeQuarter): 365.25 / 4,
+}
+
+const (
+	DefaultBudgetWeekStartDay = time.Monday
+	MaxBudgetPeriodDays       = 366
+
+	// number of previous periods looked back when rolling over a budget
+	MaxBudgetRolloverPeriods = 24
+)
+
+type getDateRangeFn func(b *Budget, date, timezone string) (start, end uint64, err error)
 
 var dateRangeFuncs = map[uint32]getDateRangeFn{
-	uint32(BudgetTypeMonth): util.GetMonthRangeAsDate,
-	uint32(BudgetTypeYear):  util.GetYearRangeAsDate,
+	uint32(BudgetTypeMonth): func(_ *Budget, date, timezone string) (uint64, uint64, error) {
+		return util.GetMonthRangeAsDate(date, timezone)
+	},
+	uint32(BudgetTypeYear): func(_ *Budget, date, timezone string) (uint64, uint64, error) {
+		return util.GetYearRangeAsDate(date, timezone)
+	},
+	uint32(BudgetTypeWeek): func(b *Budget, date, timezone string) (uint64, uint64, error) {
+		return util.GetWeekRangeAsDate(date, timezone, time.Weekday(b.GetWeekStartDay()))
+	},
+	uint32(BudgetTypeQuarter): func(_ *Budget, date, timezone string) (uint64, uint64, error) {
+		return util.GetQuarterRangeAsDate(date, timezone)
+	},
+	uint32(BudgetTypeCustom): func(b *Budget, date, timezone string) (uint64, uint64, error) {
+		return util.GetPeriodRangeAsDate(date, timezone, b.getAnchorDateStr(), int(b.GetPeriodDays()))
+	},
+}
+
+var unixRangeFuncs = map[uint32]getDateRangeFn{
+	uint32(BudgetTypeMonth): func(_ *Budget, date, timezone string) (uint64, uint64, error) {
+		return util.GetMonthRangeAsUnix(date, timezone)
+	},
+	uint32(BudgetTypeYear): func(_ *Budget, date, timezone string) (uint64, uint64, error) {
+		return util.GetYearRangeAsUnix(date, timezone)
+	},
+	uint32(BudgetTypeWeek): func(b *Budget, date, timezone string) (uint64, uint64, error) {
+		return util.GetWeekRangeAsUnix(date, timezone, time.Weekday(b.GetWeekStartDay()))
+	},
+	uint32(BudgetTypeQuarter): func(_ *Budget, date, timezone string) (uint64, uint64, error) {
+		return util.GetQuarterRangeAsUnix(date, timezone)
+	},
+	uint32(BudgetTypeCustom): func(b *Budget, date, timezone string) (uint64, uint64, error) {
+		return util.GetPeriodRangeAsUnix(date, timezone, b.getAnchorDateStr(), int(b.GetPeriodDays()))
+	},
 }
 
 type BudgetStatus uint32
@@ -58,11 +130,15 @@ const (
 )
 
 type BudgetUpdate struct {
-	BudgetType *uint32
-	Amount     *float64
-	StartDate  *uint64
-	EndDate    *uint64
-	UpdateTime *uint64
+	BudgetType   *uint32
+	WeekStartDay *uint32
+	PeriodDays   *uint32
+	AnchorDate   *uint64
+	Rollover     *bool
+	Amount       *float64
+	StartDate    *uint64
+	EndDate      *uint64
+	UpdateTime   *uint64
 }
 
 func (bu *BudgetUpdate) GetBudgetType() uint32 {
@@ -76,6 +152,55 @@ func (bu *BudgetUpdate) SetBudgetType(budgetType *uint32) {
 	bu.BudgetType = budgetType
 }
 
+func (bu *BudgetUpdate) GetWeekStartDay() uint32 {
+	if bu != nil && bu.WeekStartDay != nil {
+		return *bu.WeekStartDay
+	}
+	return 0
+}
+
+func (bu *BudgetUpdate) SetWeekStartDay(weekStartDay *uint32) {
+	bu.WeekStartDay = weekStartDay
+}
+
+func (bu *BudgetUpdate) GetPeriodDays() uint32 {
+	if bu != nil && bu.PeriodDays != nil {
+		return *bu.PeriodDays
+	}
+	return 0
+}
+
+func (bu *BudgetUpdate) SetPeriodDays(periodDays *uint32) {
+	bu.PeriodDays = periodDays
+}
+
+func (bu *BudgetUpdate) GetAnchorDate() uint64 {
+	if bu != nil && bu.AnchorDate != nil {
+		return *bu.AnchorDate
+	}
+	return 0
+}
+
+func (bu *BudgetUpdate) SetAnchorDate(anchorDate *uint64) {
+	bu.AnchorDate = anchorDate
+}
+
+func (bu *BudgetUpdate) GetRollover() bool {
+	if bu != nil && bu.Rollover != nil {
+		return *bu.Rollover
+	}
+	return false
+}
+
+func (bu *BudgetUpdate) SetRollover(rollover *bool) {
+	bu.Rollover = rollover
+}
+
+// HasPeriodUpdate tells if the budget periods are no longer aligned with the old ones.
+func (bu *BudgetUpdate) HasPeriodUpdate() bool {
+	return bu.BudgetType != nil || bu.WeekStartDay != nil || bu.PeriodDays != nil || bu.AnchorDate != nil
+}
+
 func (bu *BudgetUpdate) GetAmount() float64 {
 	if bu != nil && bu.Amount != nil {
 		return *bu.Amount
@@ -136,6 +261,30 @@ func WithUpdateBudgetType(budgetType *uint32) BudgetUpdateOption {
 	}
 }
 
+func WithUpdateBudgetWeekStartDay(weekStartDay *uint32) BudgetUpdateOption {
+	return func(bu *BudgetUpdate) {
+		bu.SetWeekStartDay(weekStartDay)
+	}
+}
+
+func WithUpdateBudgetPeriodDays(periodDays *uint32) BudgetUpdateOption {
+	return func(bu *BudgetUpdate) {
+		bu.SetPeriodDays(periodDays)
+	}
+}
+
+func WithUpdateBudgetAnchorDate(anchorDate *uint64) BudgetUpdateOption {
+	return func(bu *BudgetUpdate) {
+		bu.SetAnchorDate(anchorDate)
+	}
+}
+
+func WithUpdateBudgetRollover(rollover *bool) BudgetUpdateOption {
+	return func(bu *BudgetUpdate) {
+		bu.SetRollover(rollover)
+	}
+}
+
 func WithUpdateBudgetAmount(amount *float64) BudgetUpdateOption {
 	return func(bu *BudgetUpdate) {
 		bu.SetAmount(amount)
@@ -167,14 +316,19 @@ type Budget struct {
 	Amount       *float64
 	Currency     *string
 	BudgetType   *uint32
+	WeekStartDay *uint32 // week budget only, as time.Weekday
+	PeriodDays   *uint32 // custom budget only
+	AnchorDate   *uint64 // custom budget only, first day of a period
+	Rollover     *bool   // carry the remaining amount of the previous period
 	BudgetStatus *uint32
 	StartDate    *uint64
 	EndDate      *uint64
 	CreateTime   *uint64
 	UpdateTime   *uint64
 
-	UsedAmount *float64
-	Remain     *float64
+	UsedAmount  *float64
+	CarriedOver *float64
+	Remain      *float64
 }
 
 type BudgetOption = func(b *Budget)
@@ -191,6 +345,30 @@ func WithBudgetType(budgetType *uint32) BudgetOption {
 	}
 }
 
+func WithBudgetWeekStartDay(weekStartDay *uint32) BudgetOption {
+	return func(b *Budget) {
+		b.SetWeekStartDay(weekStartDay)
+	}
+}
+
+func WithBudgetPeriodDays(periodDays *uint32) BudgetOption {
+	return func(b *Budget) {
+		b.SetPeriodDays(periodDays)
+	}
+}
+
+func WithBudgetAnchorDate(anchorDate *uint64) BudgetOption {
+	return func(b *Budget) {
+		b.SetAnchorDate(anchorDate)
+	}
+}
+
+func WithBudgetRollover(rollover *bool) BudgetOption {
+	return func(b *Budget) {
+		b.SetRollover(rollover)
+	}
+}
+
 func WithBudgetStatus(budgetStatus *uint32) BudgetOption {
 	return func(b *Budget) {
 		b.SetBudgetStatus(budgetStatus)
@@ -246,6 +424,7 @@ func NewBudget(userID, categoryID string, opts ...BudgetOption) (*Budget, error)
 		UpdateTime:   goutil.Uint64(now),
 		StartDate:    goutil.Uint64(0),
 		EndDate:      goutil.Uint64(0),
+		Rollover:     goutil.Bool(false),
 	}
 	for _, opt := range opts {
 		opt(b)
@@ -258,6 +437,26 @@ func NewBudget(userID, categoryID string, opts ...BudgetOption) (*Budget, error)
 	return b, nil
 }
 
+func (b *Budget) Clone() (*Budget, error) {
+	return NewBudget(
+		b.GetUserID(),
+		b.GetCategoryID(),
+		WithBudgetID(b.BudgetID),
+		WithBudgetCurrency(b.Currency),
+		WithBudgetAmount(b.Amount),
+		WithBudgetType(b.BudgetType),
+		WithBudgetWeekStartDay(b.WeekStartDay),
+		WithBudgetPeriodDays(b.PeriodDays),
+		WithBudgetAnchorDate(b.AnchorDate),
+		WithBudgetRollover(b.Rollover),
+		WithBudgetStatus(b.BudgetStatus),
+		WithBudgetStartDate(b.StartDate),
+		WithBudgetEndDate(b.EndDate),
+		WithBudgetCreateTime(b.CreateTime),
+		WithBudgetUpdateTime(b.UpdateTime),
+	)
+}
+
 func (b *Budget) Update(bu *BudgetUpdate) (*BudgetUpdate, error) {
 	var (
 		hasUpdate    bool
@@ -282,6 +481,42 @@ func (b *Budget) Update(bu *BudgetUpdate) (*BudgetUpdate, error) {
 		}()
 	}
 
+	if bu.WeekStartDay != nil && bu.GetWeekStartDay() != b.GetWeekStartDay() {
+		hasUpdate = true
+		b.WeekStartDay = bu.WeekStartDay
+
+		defer func() {
+			budgetUpdate.WeekStartDay = b.WeekStartDay
+		}()
+	}
+
+	if bu.PeriodDays != nil && bu.GetPeriodDays() != b.GetPeriodDays() {
+		hasUpdate = true
+		b.PeriodDays = bu.PeriodDays
+
+		defer func() {
+			budgetUpdate.PeriodDays = b.PeriodDays
+		}()
+	}
+
+	if bu.AnchorDate != nil && bu.GetAnchorDate() != b.GetAnchorDate() {
+		hasUpdate = true
+		b.AnchorDate = bu.AnchorDate
+
+		defer func() {
+			budgetUpdate.AnchorDate = b.AnchorDate
+		}()
+	}
+
+	if bu.Rollover != nil && bu.GetRollover() != b.GetRollover() {
+		hasUpdate = true
+		b.Rollover = bu.Rollover
+
+		defer func() {
+			budgetUpdate.Rollover = b.Rollover
+		}()
+	}
+
 	if bu.StartDate != nil && bu.GetStartDate() != b.GetStartDate() {
 		hasUpdate = true
 		b.StartDate = bu.StartDate
@@ -319,27 +554,95 @@ func (b *Budget) Update(bu *BudgetUpdate) (*BudgetUpdate, error) {
 func (b *Budget) checkOpts() error {
 	b.Amount = goutil.Float64(math.Abs(b.GetAmount()))
 
+	// period settings only apply to their budget type
+	if !b.IsWeek() {
+		b.WeekStartDay = nil
+	} else if b.WeekStartDay == nil {
+		b.WeekStartDay = goutil.Uint32(uint32(DefaultBudgetWeekStartDay))
+	}
+
+	if !b.IsCustom() {
+		b.PeriodDays = nil
+		b.AnchorDate = nil
+	} else {
+		if b.GetPeriodDays() == 0 {
+			return ErrBudgetPeriodDaysEmpty
+		}
+
+		if b.GetAnchorDate() == 0 {
+			return ErrBudgetAnchorDateEmpty
+		}
+	}
+
 	return nil
 }
 
-func GetBudgetStartEnd(date string, budgetType, budgetRepeat uint32) (startDate, endDate uint64, err error) {
-	fn := dateRangeFuncs[budgetType]
+// GetDateRange returns the first and last date (YYYYMMDD) of the budget period containing the date.
+func (b *Budget) GetDateRange(date, timezone string) (startDate, endDate uint64, err error) {
+	fn := dateRangeFuncs[b.GetBudgetType()]
 	if fn == nil {
 		return 0, 0, ErrInvalidBudgetType
 	}
+	return fn(b, date, timezone)
+}
 
+// GetUnixRange returns the budget period containing the date as unix milliseconds.
+func (b *Budget) GetUnixRange(date, timezone string) (start, end uint64, err error) {
+	fn := unixRangeFuncs[b.GetBudgetType()]
+	if fn == nil {
+		return 0, 0, ErrInvalidBudgetType
+	}
+	return fn(b, date, timezone)
+}
+
+// GetPrevPeriodDate returns the last date (YYYYMMDD) of the budget period before the one containing the date.
+func (b *Budget) GetPrevPeriodDate(date string) (string, error) {
+	startDate, _, err := b.GetDateRange(date, "")
+	if err != nil {
+		return "", err
+	}
+
+	t, err := util.ParseDate(strconv.FormatUint(startDate, 10))
+	if err != nil {
+		return "", err
+	}
+
+	return util.FormatDate(t.AddDate(0, 0, -1)), nil
+}
+
+// SetRepeat sets the dates covered by a budget set on the date.
+func (b *Budget) SetRepeat(date string, budgetRepeat uint32) error {
+	var (
+		startDate, endDate uint64
+		err                error
+	)
 	switch budgetRepeat {
 	case uint32(BudgetRepeatNow):
-		startDate, endDate, err = fn(date, "")
+		startDate, endDate, err = b.GetDateRange(date, "")
 	case uint32(BudgetRepeatNowToFuture):
-		startDate, _, err = fn(date, "")
+		startDate, _, err = b.GetDateRange(date, "")
 	case uint32(BudgetRepeatAllTime):
 	}
 	if err != nil {
-		return 0, 0, err
+		return err
 	}
 
-	return
+	b.SetStartDate(goutil.Uint64(startDate))
+	b.SetEndDate(goutil.Uint64(endDate))
+
+	return nil
+}
+
+// GetAveragePeriodDays returns the average number of days in a budget period.
+func (b *Budget) GetAveragePeriodDays() float64 {
+	if b.IsCustom() {
+		return float64(b.GetPeriodDays())
+	}
+	return budgetTypeDays[b.GetBudgetType()]
+}
+
+func (b *Budget) getAnchorDateStr() string {
+	return strconv.FormatUint(b.GetAnchorDate(), 10)
 }
 
 func (b *Budget) GetBudgetID() string {
@@ -397,6 +700,50 @@ func (b *Budget) SetBudgetType(budgetType *uint32) {
 	b.BudgetType = budgetType
 }
 
+func (b *Budget) GetWeekStartDay() uint32 {
+	if b != nil && b.WeekStartDay != nil {
+		return *b.WeekStartDay
+	}
+	return 0
+}
+
+func (b *Budget) SetWeekStartDay(weekStartDay *uint32) {
+	b.WeekStartDay = weekStartDay
+}
+
+func (b *Budget) GetPeriodDays() uint32 {
+	if b != nil && b.PeriodDays != nil {
+		return *b.PeriodDays
+	}
+	return 0
+}
+
+func (b *Budget) SetPeriodDays(periodDays *uint32) {
+	b.PeriodDays = periodDays
+}
+
+func (b *Budget) GetAnchorDate() uint64 {
+	if b != nil && b.AnchorDate != nil {
+		return *b.AnchorDate
+	}
+	return 0
+}
+
+func (b *Budget) SetAnchorDate(anchorDate *uint64) {
+	b.AnchorDate = anchorDate
+}
+
+func (b *Budget) GetRollover() bool {
+	if b != nil && b.Rollover != nil {
+		return *b.Rollover
+	}
+	return false
+}
+
+func (b *Budget) SetRollover(rollover *bool) {
+	b.Rollover = rollover
+}
+
 func (b *Budget) GetBudgetStatus() uint32 {
 	if b != nil && b.BudgetStatus != nil {
 		return *b.BudgetStatus
@@ -435,6 +782,22 @@ func (b *Budget) SetUsedAmount(usedAmount *float64) {
 	}
 }
 
+func (b *Budget) GetCarriedOver() float64 {
+	if b != nil && b.CarriedOver != nil {
+		return *b.CarriedOver
+	}
+	return 0
+}
+
+func (b *Budget) SetCarriedOver(carriedOver *float64) {
+	b.CarriedOver = carriedOver
+
+	if carriedOver != nil {
+		co := util.RoundFloatToStandardDP(*carriedOver)
+		b.CarriedOver = goutil.Float64(co)
+	}
+}
+
 func (b *Budget) GetRemain() float64 {
 	if b != nil && b.Remain != nil {
 		return *b.Remain
@@ -514,6 +877,27 @@ func (b *Budget) IsYear() bool {
 	return b.GetBudgetType() == uint32(BudgetTypeYear)
 }
 
+func (b *Budget) IsWeek() bool {
+	return b.GetBudgetType() == uint32(BudgetTypeWeek)
+}
+
+func (b *Budget) IsQuarter() bool {
+	return b.GetBudgetType() == uint32(BudgetTypeQuarter)
+}
+
+func (b *Budget) IsCustom() bool {
+	return b.GetBudgetType() == uint32(BudgetTypeCustom)
+}
+
+func (b *Budget) IsRollover() bool {
+	return b.GetRollover()
+}
+
+// CoversDate tells if the budget applies to the date (YYYYMMDD). Zero start or end dates are unbounded.
+func (b *Budget) CoversDate(date uint64) bool {
+	return b.GetStartDate() <= date && (b.GetEndDate() == 0 || b.GetEndDate() >= date)
+}
+
 func (b *Budget) IsRepeatAllTime() bool {
 	return b.GetStartDate() == 0 && b.GetEndDate() == 0
 }
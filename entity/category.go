@@ -1,11 +1,16 @@
 package entity
 
 import (
+	"errors"
 	"time"
 
 	"github.com/jseow5177/pockteer-be/pkg/goutil"
 )
 
+var (
+	ErrCategoryOwnParent = errors.New("category cannot be its own parent")
+)
+
 type CategoryStatus uint32
 
 const (
@@ -24,6 +29,40 @@ func WithUpdateCategoryName(categoryName *string) CategoryUpdateOption {
 	}
 }
 
+// WithUpdateCategoryParentCategoryID moves the category under another category.
+// An empty parent category ID moves it to the top level.
+func WithUpdateCategoryParentCategoryID(parentCategoryID *string) CategoryUpdateOption {
+	return func(c *Category) {
+		if parentCategoryID != nil {
+			c.SetParentCategoryID(parentCategoryID)
+		}
+	}
+}
+
+func WithUpdateCategoryIcon(icon *string) CategoryUpdateOption {
+	return func(c *Category) {
+		if icon != nil {
+			c.SetIcon(icon)
+		}
+	}
+}
+
+func WithUpdateCategoryColor(color *string) CategoryUpdateOption {
+	return func(c *Category) {
+		if color != nil {
+			c.SetColor(color)
+		}
+	}
+}
+
+func WithUpdateCategoryPosition(position *uint32) CategoryUpdateOption {
+	return func(c *Category) {
+		if position != nil {
+			c.SetPosition(position)
+		}
+	}
+}
+
 func WithUpdateCategoryStatus(categoryStatus *uint32) CategoryUpdateOption {
 	return func(c *Category) {
 		if categoryStatus != nil {
@@ -33,15 +72,20 @@ func WithUpdateCategoryStatus(categoryStatus *uint32) CategoryUpdateOption {
 }
 
 type Category struct {
-	UserID         *string
-	CategoryID     *string
-	CategoryName   *string
-	CategoryType   *uint32
-	CategoryStatus *uint32
-	CreateTime     *uint64
-	UpdateTime     *uint64
-
-	Budget *Budget
+	UserID           *string
+	CategoryID       *string
+	ParentCategoryID *string
+	CategoryName     *string
+	CategoryType     *uint32
+	CategoryStatus   *uint32
+	Icon             *string
+	Color            *string
+	Position         *uint32
+	CreateTime       *uint64
+	UpdateTime       *uint64
+
+	Budget   *Budget
+	Children []*Category
 }
 
 type CategoryOption func(c *Category)
@@ -54,6 +98,14 @@ func WithCategoryID(categoryID *string) CategoryOption {
 	}
 }
 
+func WithCategoryParentCategoryID(parentCategoryID *string) CategoryOption {
+	return func(c *Category) {
+		if parentCategoryID != nil {
+			c.SetParentCategoryID(parentCategoryID)
+		}
+	}
+}
+
 func WithCategoryType(categoryType *uint32) CategoryOption {
 	return func(c *Category) {
 		if categoryType != nil {
@@ -70,6 +122,30 @@ func WithCategoryStatus(categoryStatus *uint32) CategoryOption {
 	}
 }
 
+func WithCategoryIcon(icon *string) CategoryOption {
+	return func(c *Category) {
+		if icon != nil {
+			c.SetIcon(icon)
+		}
+	}
+}
+
+func WithCategoryColor(color *string) CategoryOption {
+	return func(c *Category) {
+		if color != nil {
+			c.SetColor(color)
+		}
+	}
+}
+
+func WithCategoryPosition(position *uint32) CategoryOption {
+	return func(c *Category) {
+		if position != nil {
+			c.SetPosition(position)
+		}
+	}
+}
+
 func WithCategoryCreateTime(createTime *uint64) CategoryOption {
 	return func(c *Category) {
 		if createTime != nil {
@@ -99,8 +175,12 @@ func (c *Category) Clone() (*Category, error) {
 		c.GetUserID(),
 		c.GetCategoryName(),
 		WithCategoryID(goutil.String(c.GetCategoryID())),
+		WithCategoryParentCategoryID(c.ParentCategoryID),
 		WithCategoryType(c.CategoryType),
 		WithCategoryStatus(c.CategoryStatus),
+		WithCategoryIcon(c.Icon),
+		WithCategoryColor(c.Color),
+		WithCategoryPosition(c.Position),
 		WithCategoryCreateTime(c.CreateTime),
 		WithCategoryUpdateTime(c.UpdateTime),
 	)
@@ -114,6 +194,9 @@ func NewCategory(UserID, categoryName string, opts ...CategoryOption) (*Category
 		CategoryName:   goutil.String(categoryName),
 		CategoryType:   goutil.Uint32(uint32(TransactionTypeExpense)),
 		CategoryStatus: goutil.Uint32(uint32(CategoryStatusNormal)),
+		Icon:           goutil.String(""),
+		Color:          goutil.String(""),
+		Position:       goutil.Uint32(0),
 		CreateTime:     goutil.Uint64(now),
 		UpdateTime:     goutil.Uint64(now),
 	}
@@ -134,13 +217,21 @@ func (c *Category) validate() error {
 		return ErrBudgetNotAllowed
 	}
 
+	if c.HasParent() && c.GetParentCategoryID() == c.GetCategoryID() {
+		return ErrCategoryOwnParent
+	}
+
 	return nil
 }
 
 type CategoryUpdate struct {
-	CategoryName   *string
-	CategoryStatus *uint32
-	UpdateTime     *uint64
+	CategoryName     *string
+	ParentCategoryID *string
+	CategoryStatus   *uint32
+	Icon             *string
+	Color            *string
+	Position         *uint32
+	UpdateTime       *uint64
 }
 
 func (c *Category) ToCategoryUpdate(old *Category) *CategoryUpdate {
@@ -157,11 +248,31 @@ func (c *Category) ToCategoryUpdate(old *Category) *CategoryUpdate {
 		cu.CategoryName = c.CategoryName
 	}
 
+	if old.GetParentCategoryID() != c.GetParentCategoryID() {
+		hasUpdate = true
+		cu.ParentCategoryID = goutil.String(c.GetParentCategoryID())
+	}
+
 	if old.GetCategoryStatus() != c.GetCategoryStatus() {
 		hasUpdate = true
 		cu.CategoryStatus = c.CategoryStatus
 	}
 
+	if old.GetIcon() != c.GetIcon() {
+		hasUpdate = true
+		cu.Icon = c.Icon
+	}
+
+	if old.GetColor() != c.GetColor() {
+		hasUpdate = true
+		cu.Color = c.Color
+	}
+
+	if old.GetPosition() != c.GetPosition() {
+		hasUpdate = true
+		cu.Position = c.Position
+	}
+
 	if hasUpdate {
 		return cu
 	}
@@ -216,6 +327,17 @@ func (c *Category) SetCategoryID(categoryID *string) {
 	c.CategoryID = categoryID
 }
 
+func (c *Category) GetParentCategoryID() string {
+	if c != nil && c.ParentCategoryID != nil {
+		return *c.ParentCategoryID
+	}
+	return ""
+}
+
+func (c *Category) SetParentCategoryID(parentCategoryID *string) {
+	c.ParentCategoryID = parentCategoryID
+}
+
 func (c *Category) GetCategoryName() string {
 	if c != nil && c.CategoryName != nil {
 		return *c.CategoryName
@@ -249,6 +371,39 @@ func (c *Category) SetCategoryStatus(categoryStatus *uint32) {
 	c.CategoryStatus = categoryStatus
 }
 
+func (c *Category) GetIcon() string {
+	if c != nil && c.Icon != nil {
+		return *c.Icon
+	}
+	return ""
+}
+
+func (c *Category) SetIcon(icon *string) {
+	c.Icon = icon
+}
+
+func (c *Category) GetColor() string {
+	if c != nil && c.Color != nil {
+		return *c.Color
+	}
+	return ""
+}
+
+func (c *Category) SetColor(color *string) {
+	c.Color = color
+}
+
+func (c *Category) GetPosition() uint32 {
+	if c != nil && c.Position != nil {
+		return *c.Position
+	}
+	return 0
+}
+
+func (c *Category) SetPosition(position *uint32) {
+	c.Position = position
+}
+
 func (c *Category) GetCreateTime() uint64 {
 	if c != nil && c.CreateTime != nil {
 		return *c.CreateTime
@@ -282,6 +437,21 @@ func (c *Category) SetBudget(b *Budget) {
 	c.Budget = b
 }
 
+func (c *Category) GetChildren() []*Category {
+	if c != nil && c.Children != nil {
+		return c.Children
+	}
+	return nil
+}
+
+func (c *Category) SetChildren(children []*Category) {
+	c.Children = children
+}
+
+func (c *Category) HasParent() bool {
+	return c.GetParentCategoryID() != ""
+}
+
 func (c *Category) CanAddBudget() bool {
 	return c.GetCategoryType() == uint32(TransactionTypeExpense)
 }
@@ -289,3 +459,57 @@ func (c *Category) CanAddBudget() bool {
 func (c *Category) IsDeleted() bool {
 	return c.GetCategoryStatus() == uint32(CategoryStatusDeleted)
 }
+
+// ToCategoryTree nests the categories under their parents and returns the top level ones.
+// A category whose parent is not in the list is treated as top level.
+func ToCategoryTree(cs []*Category) []*Category {
+	categoryMap := make(map[string]*Category)
+	for _, c := range cs {
+		c.SetChildren(nil)
+		categoryMap[c.GetCategoryID()] = c
+	}
+
+	roots := make([]*Category, 0)
+	for _, c := range cs {
+		parent, ok := categoryMap[c.GetParentCategoryID()]
+		if !c.HasParent() || !ok {
+			roots = append(roots, c)
+			continue
+		}
+		parent.SetChildren(append(parent.GetChildren(), c))
+	}
+
+	return roots
+}
+
+// GetCategoryDescendants returns all categories nested under the given category at any depth.
+func GetCategoryDescendants(cs []*Category, categoryID string) []*Category {
+	childrenMap := make(map[string][]*Category)
+	for _, c := range cs {
+		if c.HasParent() {
+			childrenMap[c.GetParentCategoryID()] = append(childrenMap[c.GetParentCategoryID()], c)
+		}
+	}
+
+	var (
+		descendants = make([]*Category, 0)
+		visited     = map[string]bool{categoryID: true}
+		queue       = []string{categoryID}
+	)
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		for _, child := range childrenMap[id] {
+			if visited[child.GetCategoryID()] {
+				continue
+			}
+			visited[child.GetCategoryID()] = true
+
+			descendants = append(descendants, child)
+			queue = append(queue, child.GetCategoryID())
+		}
+	}
+
+	return descendants
+}
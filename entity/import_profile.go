@@ -0,0 +1,695 @@
+package entity
+
+import (
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jseow5177/pockteer-be/pkg/errutil"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+)
+
+var (
+	ErrImportProfileNameEmpty     = errutil.ValidationError(errors.New("profile name cannot be empty"))
+	ErrImportProfileNoDateColumn  = errutil.ValidationError(errors.New("date column cannot be empty"))
+	ErrImportProfileNoAmount      = errutil.ValidationError(errors.New("must have amount column or debit/credit columns"))
+	ErrImportProfileInvalidDelim  = errutil.ValidationError(errors.New("delimiter must be a single character"))
+	ErrImportProfileAmountAndDrCr = errutil.ValidationError(errors.New("cannot have both amount column and debit/credit columns"))
+)
+
+type ImportFormat uint32
+
+const (
+	ImportFormatInvalid ImportFormat = iota
+	ImportFormatCSV
+	ImportFormatOFX // also QFX
+	ImportFormatQIF
+)
+
+var ImportFormats = map[uint32]string{
+	uint32(ImportFormatCSV): "csv",
+	uint32(ImportFormatOFX): "ofx",
+	uint32(ImportFormatQIF): "qif",
+}
+
+type ImportProfileStatus uint32
+
+const (
+	ImportProfileStatusInvalid ImportProfileStatus = iota
+	ImportProfileStatusNormal
+	ImportProfileStatusDeleted
+)
+
+type ImportDateFormat uint32
+
+const (
+	ImportDateFormatYYYYMMDDDash ImportDateFormat = iota
+	ImportDateFormatDDMMYYYYSlash
+	ImportDateFormatMMDDYYYYSlash
+	ImportDateFormatDDMMYYYYDash
+	ImportDateFormatYYYYMMDD
+	ImportDateFormatDDMonYYYY
+)
+
+// ImportDateFormats maps each date format to its time layout.
+// Day and month may or may not be zero padded.
+var ImportDateFormats = map[uint32]string{
+	uint32(ImportDateFormatYYYYMMDDDash):  "2006-1-2",
+	uint32(ImportDateFormatDDMMYYYYSlash): "2/1/2006",
+	uint32(ImportDateFormatMMDDYYYYSlash): "1/2/2006",
+	uint32(ImportDateFormatDDMMYYYYDash):  "2-1-2006",
+	uint32(ImportDateFormatYYYYMMDD):      "20060102",
+	uint32(ImportDateFormatDDMonYYYY):     "2 Jan 2006",
+}
+
+type ImportAmountSign uint32
+
+const (
+	ImportAmountSignNegativeExpense ImportAmountSign = iota
+	ImportAmountSignPositiveExpense
+)
+
+var ImportAmountSigns = map[uint32]string{
+	uint32(ImportAmountSignNegativeExpense): "negative amount is expense",
+	uint32(ImportAmountSignPositiveExpense): "positive amount is expense",
+}
+
+type ImportProfileUpdateOption func(ip *ImportProfile)
+
+func WithUpdateImportProfileName(profileName *string) ImportProfileUpdateOption {
+	return func(ip *ImportProfile) {
+		if profileName != nil {
+			ip.SetProfileName(profileName)
+		}
+	}
+}
+
+func WithUpdateImportProfileDelimiter(delimiter *string) ImportProfileUpdateOption {
+	return func(ip *ImportProfile) {
+		if delimiter != nil {
+			ip.SetDelimiter(delimiter)
+		}
+	}
+}
+
+func WithUpdateImportProfileSkipRows(skipRows *uint32) ImportProfileUpdateOption {
+	return func(ip *ImportProfile) {
+		if skipRows != nil {
+			ip.SetSkipRows(skipRows)
+		}
+	}
+}
+
+func WithUpdateImportProfileDateColumn(dateColumn *string) ImportProfileUpdateOption {
+	return func(ip *ImportProfile) {
+		if dateColumn != nil {
+			ip.SetDateColumn(dateColumn)
+		}
+	}
+}
+
+func WithUpdateImportProfileDateFormat(dateFormat *uint32) ImportProfileUpdateOption {
+	return func(ip *ImportProfile) {
+		if dateFormat != nil {
+			ip.SetDateFormat(dateFormat)
+		}
+	}
+}
+
+func WithUpdateImportProfileAmountColumn(amountColumn *string) ImportProfileUpdateOption {
+	return func(ip *ImportProfile) {
+		if amountColumn != nil {
+			ip.SetAmountColumn(amountColumn)
+		}
+	}
+}
+
+func WithUpdateImportProfileAmountSign(amountSign *uint32) ImportProfileUpdateOption {
+	return func(ip *ImportProfile) {
+		if amountSign != nil {
+			ip.SetAmountSign(amountSign)
+		}
+	}
+}
+
+func WithUpdateImportProfileDebitColumn(debitColumn *string) ImportProfileUpdateOption {
+	return func(ip *ImportProfile) {
+		if debitColumn != nil {
+			ip.SetDebitColumn(debitColumn)
+		}
+	}
+}
+
+func WithUpdateImportProfileCreditColumn(creditColumn *string) ImportProfileUpdateOption {
+	return func(ip *ImportProfile) {
+		if creditColumn != nil {
+			ip.SetCreditColumn(creditColumn)
+		}
+	}
+}
+
+func WithUpdateImportProfileNoteColumn(noteColumn *string) ImportProfileUpdateOption {
+	return func(ip *ImportProfile) {
+		if noteColumn != nil {
+			ip.SetNoteColumn(noteColumn)
+		}
+	}
+}
+
+func WithUpdateImportProfileCurrencyColumn(currencyColumn *string) ImportProfileUpdateOption {
+	return func(ip *ImportProfile) {
+		if currencyColumn != nil {
+			ip.SetCurrencyColumn(currencyColumn)
+		}
+	}
+}
+
+func WithUpdateImportProfileStatus(profileStatus *uint32) ImportProfileUpdateOption {
+	return func(ip *ImportProfile) {
+		if profileStatus != nil {
+			ip.SetProfileStatus(profileStatus)
+		}
+	}
+}
+
+// ImportProfile describes how to map the columns of a CSV bank statement
+// into transactions. Columns are matched by their header names.
+type ImportProfile struct {
+	ImportProfileID *string
+	UserID          *string
+	ProfileName     *string
+	Delimiter       *string
+	SkipRows        *uint32 // lines to skip before the header row
+	DateColumn      *string
+	DateFormat      *uint32
+	AmountColumn    *string
+	AmountSign      *uint32
+	DebitColumn     *string
+	CreditColumn    *string
+	NoteColumn      *string
+	CurrencyColumn  *string
+	ProfileStatus   *uint32
+	CreateTime      *uint64
+	UpdateTime      *uint64
+}
+
+type ImportProfileOption func(ip *ImportProfile)
+
+func WithImportProfileID(importProfileID *string) ImportProfileOption {
+	return func(ip *ImportProfile) {
+		if importProfileID != nil {
+			ip.SetImportProfileID(importProfileID)
+		}
+	}
+}
+
+func WithImportProfileDelimiter(delimiter *string) ImportProfileOption {
+	return func(ip *ImportProfile) {
+		if delimiter != nil {
+			ip.SetDelimiter(delimiter)
+		}
+	}
+}
+
+func WithImportProfileSkipRows(skipRows *uint32) ImportProfileOption {
+	return func(ip *ImportProfile) {
+		if skipRows != nil {
+			ip.SetSkipRows(skipRows)
+		}
+	}
+}
+
+func WithImportProfileDateColumn(dateColumn *string) ImportProfileOption {
+	return func(ip *ImportProfile) {
+		if dateColumn != nil {
+			ip.SetDateColumn(dateColumn)
+		}
+	}
+}
+
+func WithImportProfileDateFormat(dateFormat *uint32) ImportProfileOption {
+	return func(ip *ImportProfile) {
+		if dateFormat != nil {
+			ip.SetDateFormat(dateFormat)
+		}
+	}
+}
+
+func WithImportProfileAmountColumn(amountColumn *string) ImportProfileOption {
+	return func(ip *ImportProfile) {
+		if amountColumn != nil {
+			ip.SetAmountColumn(amountColumn)
+		}
+	}
+}
+
+func WithImportProfileAmountSign(amountSign *uint32) ImportProfileOption {
+	return func(ip *ImportProfile) {
+		if amountSign != nil {
+			ip.SetAmountSign(amountSign)
+		}
+	}
+}
+
+func WithImportProfileDebitColumn(debitColumn *string) ImportProfileOption {
+	return func(ip *ImportProfile) {
+		if debitColumn != nil {
+			ip.SetDebitColumn(debitColumn)
+		}
+	}
+}
+
+func WithImportProfileCreditColumn(creditColumn *string) ImportProfileOption {
+	return func(ip *ImportProfile) {
+		if creditColumn != nil {
+			ip.SetCreditColumn(creditColumn)
+		}
+	}
+}
+
+func WithImportProfileNoteColumn(noteColumn *string) ImportProfileOption {
+	return func(ip *ImportProfile) {
+		if noteColumn != nil {
+			ip.SetNoteColumn(noteColumn)
+		}
+	}
+}
+
+func WithImportProfileCurrencyColumn(currencyColumn *string) ImportProfileOption {
+	return func(ip *ImportProfile) {
+		if currencyColumn != nil {
+			ip.SetCurrencyColumn(currencyColumn)
+		}
+	}
+}
+
+func WithImportProfileStatus(profileStatus *uint32) ImportProfileOption {
+	return func(ip *ImportProfile) {
+		if profileStatus != nil {
+			ip.SetProfileStatus(profileStatus)
+		}
+	}
+}
+
+func WithImportProfileCreateTime(createTime *uint64) ImportProfileOption {
+	return func(ip *ImportProfile) {
+		if createTime != nil {
+			ip.SetCreateTime(createTime)
+		}
+	}
+}
+
+func WithImportProfileUpdateTime(updateTime *uint64) ImportProfileOption {
+	return func(ip *ImportProfile) {
+		if updateTime != nil {
+			ip.SetUpdateTime(updateTime)
+		}
+	}
+}
+
+func NewImportProfile(userID, profileName string, opts ...ImportProfileOption) (*ImportProfile, error) {
+	now := uint64(time.Now().UnixMilli())
+	ip := &ImportProfile{
+		ImportProfileID: goutil.String(""),
+		UserID:          goutil.String(userID),
+		ProfileName:     goutil.String(profileName),
+		Delimiter:       goutil.String(","),
+		SkipRows:        goutil.Uint32(0),
+		DateColumn:      goutil.String(""),
+		DateFormat:      goutil.Uint32(uint32(ImportDateFormatYYYYMMDDDash)),
+		AmountColumn:    goutil.String(""),
+		AmountSign:      goutil.Uint32(uint32(ImportAmountSignNegativeExpense)),
+		DebitColumn:     goutil.String(""),
+		CreditColumn:    goutil.String(""),
+		NoteColumn:      goutil.String(""),
+		CurrencyColumn:  goutil.String(""),
+		ProfileStatus:   goutil.Uint32(uint32(ImportProfileStatusNormal)),
+		CreateTime:      goutil.Uint64(now),
+		UpdateTime:      goutil.Uint64(now),
+	}
+	for _, opt := range opts {
+		opt(ip)
+	}
+
+	if err := ip.validate(); err != nil {
+		return nil, err
+	}
+
+	return ip, nil
+}
+
+func (ip *ImportProfile) validate() error {
+	if ip.GetProfileName() == "" {
+		return ErrImportProfileNameEmpty
+	}
+
+	if utf8.RuneCountInString(ip.GetDelimiter()) != 1 {
+		return ErrImportProfileInvalidDelim
+	}
+
+	if ip.GetDateColumn() == "" {
+		return ErrImportProfileNoDateColumn
+	}
+
+	if err := CheckImportDateFormat(ip.GetDateFormat()); err != nil {
+		return err
+	}
+
+	if err := CheckImportAmountSign(ip.GetAmountSign()); err != nil {
+		return err
+	}
+
+	hasDrCr := ip.GetDebitColumn() != "" || ip.GetCreditColumn() != ""
+
+	if ip.GetAmountColumn() == "" && !hasDrCr {
+		return ErrImportProfileNoAmount
+	}
+
+	if ip.GetAmountColumn() != "" && hasDrCr {
+		return ErrImportProfileAmountAndDrCr
+	}
+
+	return nil
+}
+
+func (ip *ImportProfile) Clone() (*ImportProfile, error) {
+	return NewImportProfile(
+		ip.GetUserID(),
+		ip.GetProfileName(),
+		WithImportProfileID(goutil.String(ip.GetImportProfileID())),
+		WithImportProfileDelimiter(ip.Delimiter),
+		WithImportProfileSkipRows(ip.SkipRows),
+		WithImportProfileDateColumn(ip.DateColumn),
+		WithImportProfileDateFormat(ip.DateFormat),
+		WithImportProfileAmountColumn(ip.AmountColumn),
+		WithImportProfileAmountSign(ip.AmountSign),
+		WithImportProfileDebitColumn(ip.DebitColumn),
+		WithImportProfileCreditColumn(ip.CreditColumn),
+		WithImportProfileNoteColumn(ip.NoteColumn),
+		WithImportProfileCurrencyColumn(ip.CurrencyColumn),
+		WithImportProfileStatus(ip.ProfileStatus),
+		WithImportProfileCreateTime(ip.CreateTime),
+		WithImportProfileUpdateTime(ip.UpdateTime),
+	)
+}
+
+type ImportProfileUpdate struct {
+	ProfileName    *string
+	Delimiter      *string
+	SkipRows       *uint32
+	DateColumn     *string
+	DateFormat     *uint32
+	AmountColumn   *string
+	AmountSign     *uint32
+	DebitColumn    *string
+	CreditColumn   *string
+	NoteColumn     *string
+	CurrencyColumn *string
+	ProfileStatus  *uint32
+	UpdateTime     *uint64
+}
+
+func (ip *ImportProfile) ToImportProfileUpdate(old *ImportProfile) *ImportProfileUpdate {
+	var (
+		hasUpdate bool
+
+		ipu = &ImportProfileUpdate{
+			UpdateTime: ip.UpdateTime,
+		}
+	)
+
+	if old.GetProfileName() != ip.GetProfileName() {
+		hasUpdate = true
+		ipu.ProfileName = ip.ProfileName
+	}
+
+	if old.GetDelimiter() != ip.GetDelimiter() {
+		hasUpdate = true
+		ipu.Delimiter = ip.Delimiter
+	}
+
+	if old.GetSkipRows() != ip.GetSkipRows() {
+		hasUpdate = true
+		ipu.SkipRows = ip.SkipRows
+	}
+
+	if old.GetDateColumn() != ip.GetDateColumn() {
+		hasUpdate = true
+		ipu.DateColumn = ip.DateColumn
+	}
+
+	if old.GetDateFormat() != ip.GetDateFormat() {
+		hasUpdate = true
+		ipu.DateFormat = ip.DateFormat
+	}
+
+	if old.GetAmountColumn() != ip.GetAmountColumn() {
+		hasUpdate = true
+		ipu.AmountColumn = ip.AmountColumn
+	}
+
+	if old.GetAmountSign() != ip.GetAmountSign() {
+		hasUpdate = true
+		ipu.AmountSign = ip.AmountSign
+	}
+
+	if old.GetDebitColumn() != ip.GetDebitColumn() {
+		hasUpdate = true
+		ipu.DebitColumn = ip.DebitColumn
+	}
+
+	if old.GetCreditColumn() != ip.GetCreditColumn() {
+		hasUpdate = true
+		ipu.CreditColumn = ip.CreditColumn
+	}
+
+	if old.GetNoteColumn() != ip.GetNoteColumn() {
+		hasUpdate = true
+		ipu.NoteColumn = ip.NoteColumn
+	}
+
+	if old.GetCurrencyColumn() != ip.GetCurrencyColumn() {
+		hasUpdate = true
+		ipu.CurrencyColumn = ip.CurrencyColumn
+	}
+
+	if old.GetProfileStatus() != ip.GetProfileStatus() {
+		hasUpdate = true
+		ipu.ProfileStatus = ip.ProfileStatus
+	}
+
+	if hasUpdate {
+		return ipu
+	}
+
+	return nil
+}
+
+func (ip *ImportProfile) Update(ipus ...ImportProfileUpdateOption) (*ImportProfileUpdate, error) {
+	if len(ipus) == 0 {
+		return nil, nil
+	}
+
+	old, err := ip.Clone()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ipu := range ipus {
+		ipu(ip)
+	}
+
+	// check
+	if err := ip.validate(); err != nil {
+		return nil, err
+	}
+
+	now := goutil.Uint64(uint64(time.Now().UnixMilli()))
+	ip.SetUpdateTime(now)
+
+	return ip.ToImportProfileUpdate(old), nil
+}
+
+func (ip *ImportProfile) GetImportProfileID() string {
+	if ip != nil && ip.ImportProfileID != nil {
+		return *ip.ImportProfileID
+	}
+	return ""
+}
+
+func (ip *ImportProfile) SetImportProfileID(importProfileID *string) {
+	ip.ImportProfileID = importProfileID
+}
+
+func (ip *ImportProfile) GetUserID() string {
+	if ip != nil && ip.UserID != nil {
+		return *ip.UserID
+	}
+	return ""
+}
+
+func (ip *ImportProfile) SetUserID(userID *string) {
+	ip.UserID = userID
+}
+
+func (ip *ImportProfile) GetProfileName() string {
+	if ip != nil && ip.ProfileName != nil {
+		return *ip.ProfileName
+	}
+	return ""
+}
+
+func (ip *ImportProfile) SetProfileName(profileName *string) {
+	ip.ProfileName = profileName
+}
+
+func (ip *ImportProfile) GetDelimiter() string {
+	if ip != nil && ip.Delimiter != nil {
+		return *ip.Delimiter
+	}
+	return ""
+}
+
+func (ip *ImportProfile) SetDelimiter(delimiter *string) {
+	ip.Delimiter = delimiter
+}
+
+func (ip *ImportProfile) GetSkipRows() uint32 {
+	if ip != nil && ip.SkipRows != nil {
+		return *ip.SkipRows
+	}
+	return 0
+}
+
+func (ip *ImportProfile) SetSkipRows(skipRows *uint32) {
+	ip.SkipRows = skipRows
+}
+
+func (ip *ImportProfile) GetDateColumn() string {
+	if ip != nil && ip.DateColumn != nil {
+		return *ip.DateColumn
+	}
+	return ""
+}
+
+func (ip *ImportProfile) SetDateColumn(dateColumn *string) {
+	ip.DateColumn = dateColumn
+}
+
+func (ip *ImportProfile) GetDateFormat() uint32 {
+	if ip != nil && ip.DateFormat != nil {
+		return *ip.DateFormat
+	}
+	return 0
+}
+
+func (ip *ImportProfile) SetDateFormat(dateFormat *uint32) {
+	ip.DateFormat = dateFormat
+}
+
+func (ip *ImportProfile) GetAmountColumn() string {
+	if ip != nil && ip.AmountColumn != nil {
+		return *ip.AmountColumn
+	}
+	return ""
+}
+
+func (ip *ImportProfile) SetAmountColumn(amountColumn *string) {
+	ip.AmountColumn = amountColumn
+}
+
+func (ip *ImportProfile) GetAmountSign() uint32 {
+	if ip != nil && ip.AmountSign != nil {
+		return *ip.AmountSign
+	}
+	return 0
+}
+
+func (ip *ImportProfile) SetAmountSign(amountSign *uint32) {
+	ip.AmountSign = amountSign
+}
+
+func (ip *ImportProfile) GetDebitColumn() string {
+	if ip != nil && ip.DebitColumn != nil {
+		return *ip.DebitColumn
+	}
+	return ""
+}
+
+func (ip *ImportProfile) SetDebitColumn(debitColumn *string) {
+	ip.DebitColumn = debitColumn
+}
+
+func (ip *ImportProfile) GetCreditColumn() string {
+	if ip != nil && ip.CreditColumn != nil {
+		return *ip.CreditColumn
+	}
+	return ""
+}
+
+func (ip *ImportProfile) SetCreditColumn(creditColumn *string) {
+	ip.CreditColumn = creditColumn
+}
+
+func (ip *ImportProfile) GetNoteColumn() string {
+	if ip != nil && ip.NoteColumn != nil {
+		return *ip.NoteColumn
+	}
+	return ""
+}
+
+func (ip *ImportProfile) SetNoteColumn(noteColumn *string) {
+	ip.NoteColumn = noteColumn
+}
+
+func (ip *ImportProfile) GetCurrencyColumn() string {
+	if ip != nil && ip.CurrencyColumn != nil {
+		return *ip.CurrencyColumn
+	}
+	return ""
+}
+
+func (ip *ImportProfile) SetCurrencyColumn(currencyColumn *string) {
+	ip.CurrencyColumn = currencyColumn
+}
+
+func (ip *ImportProfile) GetProfileStatus() uint32 {
+	if ip != nil && ip.ProfileStatus != nil {
+		return *ip.ProfileStatus
+	}
+	return 0
+}
+
+func (ip *ImportProfile) SetProfileStatus(profileStatus *uint32) {
+	ip.ProfileStatus = profileStatus
+}
+
+func (ip *ImportProfile) GetCreateTime() uint64 {
+	if ip != nil && ip.CreateTime != nil {
+		return *ip.CreateTime
+	}
+	return 0
+}
+
+func (ip *ImportProfile) SetCreateTime(createTime *uint64) {
+	ip.CreateTime = createTime
+}
+
+func (ip *ImportProfile) GetUpdateTime() uint64 {
+	if ip != nil && ip.UpdateTime != nil {
+		return *ip.UpdateTime
+	}
+	return 0
+}
+
+func (ip *ImportProfile) SetUpdateTime(updateTime *uint64) {
+	ip.UpdateTime = updateTime
+}
+
+func (ip *ImportProfile) IsDebitCredit() bool {
+	return ip.GetAmountColumn() == ""
+}
+
+func (ip *ImportProfile) GetDateLayout() string {
+	return ImportDateFormats[ip.GetDateFormat()]
+}
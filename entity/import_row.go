@@ -0,0 +1,63 @@
+package entity
+
+import "github.com/jseow5177/pockteer-be/pkg/goutil"
+
+// ImportRow is a statement line parsed into a transaction.
+// A row that fails to parse or validate carries an error instead.
+// A row that was imported before is marked as duplicate and skipped.
+type ImportRow struct {
+	Row         *uint32
+	Transaction *Transaction
+	Error       *string
+	Duplicate   *bool
+}
+
+func NewImportRow(row uint32, t *Transaction, err error) *ImportRow {
+	ir := &ImportRow{
+		Row:         goutil.Uint32(row),
+		Transaction: t,
+		Duplicate:   goutil.Bool(false),
+	}
+
+	if err != nil {
+		ir.Error = goutil.String(err.Error())
+	}
+
+	return ir
+}
+
+func (ir *ImportRow) GetRow() uint32 {
+	if ir != nil && ir.Row != nil {
+		return *ir.Row
+	}
+	return 0
+}
+
+func (ir *ImportRow) GetTransaction() *Transaction {
+	if ir != nil && ir.Transaction != nil {
+		return ir.Transaction
+	}
+	return nil
+}
+
+func (ir *ImportRow) GetError() string {
+	if ir != nil && ir.Error != nil {
+		return *ir.Error
+	}
+	return ""
+}
+
+func (ir *ImportRow) HasError() bool {
+	return ir.GetError() != ""
+}
+
+func (ir *ImportRow) GetDuplicate() bool {
+	if ir != nil && ir.Duplicate != nil {
+		return *ir.Duplicate
+	}
+	return false
+}
+
+func (ir *ImportRow) SetDuplicate(duplicate *bool) {
+	ir.Duplicate = duplicate
+}
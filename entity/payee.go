@@ -0,0 +1,380 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/pkg/errutil"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+)
+
+var (
+	ErrInvalidPayeeName    = errutil.ValidationError(errors.New("invalid payee name"))
+	ErrInvalidPayeeAliases = errutil.ValidationError(errors.New("invalid payee aliases"))
+)
+
+type PayeeStatus uint32
+
+const (
+	PayeeStatusInvalid PayeeStatus = iota
+	PayeeStatusNormal
+	PayeeStatusDeleted
+)
+
+type PayeeUpdateOption func(p *Payee)
+
+func WithUpdatePayeeName(payeeName *string) PayeeUpdateOption {
+	return func(p *Payee) {
+		if payeeName != nil {
+			p.SetPayeeName(payeeName)
+		}
+	}
+}
+
+// WithUpdatePayeeAliases replaces the aliases of a payee.
+// A nil slice means no update, while an empty slice removes all aliases.
+func WithUpdatePayeeAliases(aliases []string) PayeeUpdateOption {
+	return func(p *Payee) {
+		if aliases != nil {
+			p.SetAliases(aliases)
+		}
+	}
+}
+
+func WithUpdatePayeeStatus(payeeStatus *uint32) PayeeUpdateOption {
+	return func(p *Payee) {
+		if payeeStatus != nil {
+			p.SetPayeeStatus(payeeStatus)
+		}
+	}
+}
+
+type Payee struct {
+	UserID      *string
+	PayeeID     *string
+	PayeeName   *string
+	Aliases     []string // other names of the payee found in notes, case-insensitive
+	PayeeStatus *uint32
+	CreateTime  *uint64
+	UpdateTime  *uint64
+}
+
+type PayeeOption func(p *Payee)
+
+func WithPayeeID(payeeID *string) PayeeOption {
+	return func(p *Payee) {
+		if payeeID != nil {
+			p.SetPayeeID(payeeID)
+		}
+	}
+}
+
+func WithPayeeAliases(aliases []string) PayeeOption {
+	return func(p *Payee) {
+		if aliases != nil {
+			p.SetAliases(aliases)
+		}
+	}
+}
+
+func WithPayeeStatus(payeeStatus *uint32) PayeeOption {
+	return func(p *Payee) {
+		if payeeStatus != nil {
+			p.SetPayeeStatus(payeeStatus)
+		}
+	}
+}
+
+func WithPayeeCreateTime(createTime *uint64) PayeeOption {
+	return func(p *Payee) {
+		if createTime != nil {
+			p.SetCreateTime(createTime)
+		}
+	}
+}
+
+func WithPayeeUpdateTime(updateTime *uint64) PayeeOption {
+	return func(p *Payee) {
+		if updateTime != nil {
+			p.SetUpdateTime(updateTime)
+		}
+	}
+}
+
+func (p *Payee) Clone() (*Payee, error) {
+	return NewPayee(
+		p.GetUserID(),
+		p.GetPayeeName(),
+		WithPayeeID(goutil.String(p.GetPayeeID())),
+		WithPayeeAliases(p.cloneAliases()),
+		WithPayeeStatus(p.PayeeStatus),
+		WithPayeeCreateTime(p.CreateTime),
+		WithPayeeUpdateTime(p.UpdateTime),
+	)
+}
+
+func (p *Payee) cloneAliases() []string {
+	if p.Aliases == nil {
+		return nil
+	}
+
+	aliases := make([]string, len(p.Aliases))
+	copy(aliases, p.Aliases)
+
+	return aliases
+}
+
+func NewPayee(userID, payeeName string, opts ...PayeeOption) (*Payee, error) {
+	now := uint64(time.Now().UnixMilli())
+	p := &Payee{
+		PayeeID:     goutil.String(""),
+		UserID:      goutil.String(userID),
+		PayeeName:   goutil.String(payeeName),
+		Aliases:     make([]string, 0),
+		PayeeStatus: goutil.Uint32(uint32(PayeeStatusNormal)),
+		CreateTime:  goutil.Uint64(now),
+		UpdateTime:  goutil.Uint64(now),
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (p *Payee) validate() error {
+	p.SetPayeeName(goutil.String(strings.TrimSpace(p.GetPayeeName())))
+
+	if p.GetPayeeName() == "" || len(p.GetPayeeName()) > config.MaxPayeeNameLength {
+		return ErrInvalidPayeeName
+	}
+
+	// aliases are matched case-insensitively, so they are kept in lower case
+	aliases := make([]string, 0, len(p.Aliases))
+	for _, alias := range p.Aliases {
+		alias = strings.ToLower(strings.TrimSpace(alias))
+		if alias == "" || len(alias) > config.MaxPayeeNameLength {
+			return ErrInvalidPayeeAliases
+		}
+		aliases = append(aliases, alias)
+	}
+	aliases = goutil.RemoveDuplicateString(aliases)
+
+	if len(aliases) > config.MaxPayeeAliases {
+		return ErrInvalidPayeeAliases
+	}
+	p.SetAliases(aliases)
+
+	return nil
+}
+
+type PayeeUpdate struct {
+	PayeeName   *string
+	Aliases     []string
+	PayeeStatus *uint32
+	UpdateTime  *uint64
+}
+
+func (pu *PayeeUpdate) GetPayeeName() string {
+	if pu != nil && pu.PayeeName != nil {
+		return *pu.PayeeName
+	}
+	return ""
+}
+
+func (pu *PayeeUpdate) GetAliases() []string {
+	if pu != nil && pu.Aliases != nil {
+		return pu.Aliases
+	}
+	return nil
+}
+
+func (pu *PayeeUpdate) GetPayeeStatus() uint32 {
+	if pu != nil && pu.PayeeStatus != nil {
+		return *pu.PayeeStatus
+	}
+	return 0
+}
+
+func (pu *PayeeUpdate) GetUpdateTime() uint64 {
+	if pu != nil && pu.UpdateTime != nil {
+		return *pu.UpdateTime
+	}
+	return 0
+}
+
+func (p *Payee) ToPayeeUpdate(old *Payee) *PayeeUpdate {
+	var (
+		hasUpdate bool
+
+		pu = &PayeeUpdate{
+			UpdateTime: p.UpdateTime,
+		}
+	)
+
+	if old.GetPayeeName() != p.GetPayeeName() {
+		hasUpdate = true
+		pu.PayeeName = p.PayeeName
+	}
+
+	if !isSameTagIDs(old.Aliases, p.Aliases) {
+		hasUpdate = true
+		pu.Aliases = p.Aliases
+		if pu.Aliases == nil {
+			pu.Aliases = make([]string, 0)
+		}
+	}
+
+	if old.GetPayeeStatus() != p.GetPayeeStatus() {
+		hasUpdate = true
+		pu.PayeeStatus = p.PayeeStatus
+	}
+
+	if hasUpdate {
+		return pu
+	}
+
+	return nil
+}
+
+func (p *Payee) Update(pus ...PayeeUpdateOption) (*PayeeUpdate, error) {
+	if len(pus) == 0 {
+		return nil, nil
+	}
+
+	old, err := p.Clone()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pu := range pus {
+		pu(p)
+	}
+
+	// check
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	now := goutil.Uint64(uint64(time.Now().UnixMilli()))
+	p.SetUpdateTime(now)
+
+	return p.ToPayeeUpdate(old), nil
+}
+
+// GetMatchTerms returns the lower-cased name and aliases of the payee.
+func (p *Payee) GetMatchTerms() []string {
+	return append([]string{strings.ToLower(p.GetPayeeName())}, p.GetAliases()...)
+}
+
+// MatchPayees returns the payee whose name or alias is found in the note, or nil if none is found.
+// The payee with the longest matching term wins, as it is the most specific.
+func MatchPayees(payees []*Payee, note string) *Payee {
+	note = strings.ToLower(note)
+	if note == "" {
+		return nil
+	}
+
+	var (
+		match    *Payee
+		matchLen int
+	)
+	for _, p := range payees {
+		for _, term := range p.GetMatchTerms() {
+			if len(term) > matchLen && strings.Contains(note, term) {
+				match = p
+				matchLen = len(term)
+			}
+		}
+	}
+
+	return match
+}
+
+func (p *Payee) GetUserID() string {
+	if p != nil && p.UserID != nil {
+		return *p.UserID
+	}
+	return ""
+}
+
+func (p *Payee) SetUserID(userID *string) {
+	p.UserID = userID
+}
+
+func (p *Payee) GetPayeeID() string {
+	if p != nil && p.PayeeID != nil {
+		return *p.PayeeID
+	}
+	return ""
+}
+
+func (p *Payee) SetPayeeID(payeeID *string) {
+	p.PayeeID = payeeID
+}
+
+func (p *Payee) GetPayeeName() string {
+	if p != nil && p.PayeeName != nil {
+		return *p.PayeeName
+	}
+	return ""
+}
+
+func (p *Payee) SetPayeeName(payeeName *string) {
+	p.PayeeName = payeeName
+}
+
+func (p *Payee) GetAliases() []string {
+	if p != nil && p.Aliases != nil {
+		return p.Aliases
+	}
+	return nil
+}
+
+func (p *Payee) SetAliases(aliases []string) {
+	p.Aliases = aliases
+}
+
+func (p *Payee) GetPayeeStatus() uint32 {
+	if p != nil && p.PayeeStatus != nil {
+		return *p.PayeeStatus
+	}
+	return 0
+}
+
+func (p *Payee) SetPayeeStatus(payeeStatus *uint32) {
+	p.PayeeStatus = payeeStatus
+}
+
+func (p *Payee) GetCreateTime() uint64 {
+	if p != nil && p.CreateTime != nil {
+		return *p.CreateTime
+	}
+	return 0
+}
+
+func (p *Payee) SetCreateTime(createTime *uint64) {
+	p.CreateTime = createTime
+}
+
+func (p *Payee) GetUpdateTime() uint64 {
+	if p != nil && p.UpdateTime != nil {
+		return *p.UpdateTime
+	}
+	return 0
+}
+
+func (p *Payee) SetUpdateTime(updateTime *uint64) {
+	p.UpdateTime = updateTime
+}
+
+func (p *Payee) IsDeleted() bool {
+	return p.GetPayeeStatus() == uint32(PayeeStatusDeleted)
+}
@@ -0,0 +1,965 @@
+package entity
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/jseow5177/pockteer-be/util"
+)
+
+var (
+	ErrRecurringTransactionEnded = errors.New("recurring transaction has ended")
+)
+
+type RecurringTransactionStatus uint32
+
+const (
+	RecurringTransactionStatusInvalid RecurringTransactionStatus = iota
+	RecurringTransactionStatusNormal
+	RecurringTransactionStatusDeleted
+	RecurringTransactionStatusEnded
+)
+
+type RecurringTransactionFrequency uint32
+
+const (
+	RecurringTransactionFrequencyInvalid RecurringTransactionFrequency = iota
+	RecurringTransactionFrequencyDaily
+	RecurringTransactionFrequencyWeekly
+	RecurringTransactionFrequencyMonthly
+	RecurringTransactionFrequencyYearly
+)
+
+var RecurringTransactionFrequencies = map[uint32]string{
+	uint32(RecurringTransactionFrequencyDaily):   "daily",
+	uint32(RecurringTransactionFrequencyWeekly):  "weekly",
+	uint32(RecurringTransactionFrequencyMonthly): "monthly",
+	uint32(RecurringTransactionFrequencyYearly):  "yearly",
+}
+
+type RecurringTransactionUpdateOption func(rt *RecurringTransaction)
+
+func WithUpdateRecurringTransactionAccountID(accountID *string) RecurringTransactionUpdateOption {
+	return func(rt *RecurringTransaction) {
+		if accountID != nil {
+			rt.SetAccountID(accountID)
+		}
+	}
+}
+
+func WithUpdateRecurringTransactionCategoryID(categoryID *string) RecurringTransactionUpdateOption {
+	return func(rt *RecurringTransaction) {
+		if categoryID != nil {
+			rt.SetCategoryID(categoryID)
+		}
+	}
+}
+
+func WithUpdateRecurringTransactionFromAccountID(fromAccountID *string) RecurringTransactionUpdateOption {
+	return func(rt *RecurringTransaction) {
+		if fromAccountID != nil {
+			rt.SetFromAccountID(fromAccountID)
+		}
+	}
+}
+
+func WithUpdateRecurringTransactionToAccountID(toAccountID *string) RecurringTransactionUpdateOption {
+	return func(rt *RecurringTransaction) {
+		if toAccountID != nil {
+			rt.SetToAccountID(toAccountID)
+		}
+	}
+}
+
+func WithUpdateRecurringTransactionAmount(amount *float64) RecurringTransactionUpdateOption {
+	return func(rt *RecurringTransaction) {
+		if amount != nil {
+			rt.SetAmount(amount)
+		}
+	}
+}
+
+func WithUpdateRecurringTransactionCurrency(currency *string) RecurringTransactionUpdateOption {
+	return func(rt *RecurringTransaction) {
+		if currency != nil {
+			rt.SetCurrency(currency)
+		}
+	}
+}
+
+func WithUpdateRecurringTransactionNote(note *string) RecurringTransactionUpdateOption {
+	return func(rt *RecurringTransaction) {
+		if note != nil {
+			rt.SetNote(note)
+		}
+	}
+}
+
+func WithUpdateRecurringTransactionEndTime(endTime *uint64) RecurringTransactionUpdateOption {
+	return func(rt *RecurringTransaction) {
+		if endTime != nil {
+			rt.SetEndTime(endTime)
+		}
+	}
+}
+
+func WithUpdateRecurringTransactionMaxOccurrences(maxOccurrences *uint32) RecurringTransactionUpdateOption {
+	return func(rt *RecurringTransaction) {
+		if maxOccurrences != nil {
+			rt.SetMaxOccurrences(maxOccurrences)
+		}
+	}
+}
+
+func WithUpdateRecurringTransactionOccurrenceCount(occurrenceCount *uint32) RecurringTransactionUpdateOption {
+	return func(rt *RecurringTransaction) {
+		if occurrenceCount != nil {
+			rt.SetOccurrenceCount(occurrenceCount)
+		}
+	}
+}
+
+func WithUpdateRecurringTransactionStatus(recurringTransactionStatus *uint32) RecurringTransactionUpdateOption {
+	return func(rt *RecurringTransaction) {
+		if recurringTransactionStatus != nil {
+			rt.SetRecurringTransactionStatus(recurringTransactionStatus)
+		}
+	}
+}
+
+type RecurringTransactionUpdate struct {
+	AccountID                  *string
+	CategoryID                 *string
+	FromAccountID              *string
+	ToAccountID                *string
+	Amount                     *float64
+	Currency                   *string
+	Note                       *string
+	EndTime                    *uint64
+	MaxOccurrences             *uint32
+	OccurrenceCount            *uint32
+	NextTime                   *uint64
+	RecurringTransactionStatus *uint32
+	UpdateTime                 *uint64
+}
+
+func (rtu *RecurringTransactionUpdate) GetAccountID() string {
+	if rtu != nil && rtu.AccountID != nil {
+		return *rtu.AccountID
+	}
+	return ""
+}
+
+func (rtu *RecurringTransactionUpdate) GetCategoryID() string {
+	if rtu != nil && rtu.CategoryID != nil {
+		return *rtu.CategoryID
+	}
+	return ""
+}
+
+func (rtu *RecurringTransactionUpdate) GetFromAccountID() string {
+	if rtu != nil && rtu.FromAccountID != nil {
+		return *rtu.FromAccountID
+	}
+	return ""
+}
+
+func (rtu *RecurringTransactionUpdate) GetToAccountID() string {
+	if rtu != nil && rtu.ToAccountID != nil {
+		return *rtu.ToAccountID
+	}
+	return ""
+}
+
+func (rtu *RecurringTransactionUpdate) GetAmount() float64 {
+	if rtu != nil && rtu.Amount != nil {
+		return *rtu.Amount
+	}
+	return 0
+}
+
+func (rtu *RecurringTransactionUpdate) GetCurrency() string {
+	if rtu != nil && rtu.Currency != nil {
+		return *rtu.Currency
+	}
+	return ""
+}
+
+func (rtu *RecurringTransactionUpdate) GetNote() string {
+	if rtu != nil && rtu.Note != nil {
+		return *rtu.Note
+	}
+	return ""
+}
+
+func (rtu *RecurringTransactionUpdate) GetEndTime() uint64 {
+	if rtu != nil && rtu.EndTime != nil {
+		return *rtu.EndTime
+	}
+	return 0
+}
+
+func (rtu *RecurringTransactionUpdate) GetMaxOccurrences() uint32 {
+	if rtu != nil && rtu.MaxOccurrences != nil {
+		return *rtu.MaxOccurrences
+	}
+	return 0
+}
+
+func (rtu *RecurringTransactionUpdate) GetOccurrenceCount() uint32 {
+	if rtu != nil && rtu.OccurrenceCount != nil {
+		return *rtu.OccurrenceCount
+	}
+	return 0
+}
+
+func (rtu *RecurringTransactionUpdate) GetNextTime() uint64 {
+	if rtu != nil && rtu.NextTime != nil {
+		return *rtu.NextTime
+	}
+	return 0
+}
+
+func (rtu *RecurringTransactionUpdate) GetRecurringTransactionStatus() uint32 {
+	if rtu != nil && rtu.RecurringTransactionStatus != nil {
+		return *rtu.RecurringTransactionStatus
+	}
+	return 0
+}
+
+func (rtu *RecurringTransactionUpdate) GetUpdateTime() uint64 {
+	if rtu != nil && rtu.UpdateTime != nil {
+		return *rtu.UpdateTime
+	}
+	return 0
+}
+
+type RecurringTransaction struct {
+	RecurringTransactionID     *string
+	UserID                     *string
+	AccountID                  *string
+	CategoryID                 *string
+	FromAccountID              *string
+	ToAccountID                *string
+	Amount                     *float64
+	Currency                   *string
+	Note                       *string
+	TransactionType            *uint32
+	Frequency                  *uint32
+	Interval                   *uint32
+	Timezone                   *string
+	StartTime                  *uint64
+	EndTime                    *uint64 // 0 means no end time
+	MaxOccurrences             *uint32 // 0 means no limit
+	OccurrenceCount            *uint32
+	NextTime                   *uint64
+	RecurringTransactionStatus *uint32
+	CreateTime                 *uint64
+	UpdateTime                 *uint64
+}
+
+type RecurringTransactionOption func(rt *RecurringTransaction)
+
+func WithRecurringTransactionID(recurringTransactionID *string) RecurringTransactionOption {
+	return func(rt *RecurringTransaction) {
+		if recurringTransactionID != nil {
+			rt.SetRecurringTransactionID(recurringTransactionID)
+		}
+	}
+}
+
+func WithRecurringTransactionAccountID(accountID *string) RecurringTransactionOption {
+	return func(rt *RecurringTransaction) {
+		if accountID != nil {
+			rt.SetAccountID(accountID)
+		}
+	}
+}
+
+func WithRecurringTransactionCategoryID(categoryID *string) RecurringTransactionOption {
+	return func(rt *RecurringTransaction) {
+		if categoryID != nil {
+			rt.SetCategoryID(categoryID)
+		}
+	}
+}
+
+func WithRecurringTransactionFromAccountID(fromAccountID *string) RecurringTransactionOption {
+	return func(rt *RecurringTransaction) {
+		if fromAccountID != nil {
+			rt.SetFromAccountID(fromAccountID)
+		}
+	}
+}
+
+func WithRecurringTransactionToAccountID(toAccountID *string) RecurringTransactionOption {
+	return func(rt *RecurringTransaction) {
+		if toAccountID != nil {
+			rt.SetToAccountID(toAccountID)
+		}
+	}
+}
+
+func WithRecurringTransactionAmount(amount *float64) RecurringTransactionOption {
+	return func(rt *RecurringTransaction) {
+		if amount != nil {
+			rt.SetAmount(amount)
+		}
+	}
+}
+
+func WithRecurringTransactionCurrency(currency *string) RecurringTransactionOption {
+	return func(rt *RecurringTransaction) {
+		if currency != nil {
+			rt.SetCurrency(currency)
+		}
+	}
+}
+
+func WithRecurringTransactionNote(note *string) RecurringTransactionOption {
+	return func(rt *RecurringTransaction) {
+		if note != nil {
+			rt.SetNote(note)
+		}
+	}
+}
+
+func WithRecurringTransactionType(transactionType *uint32) RecurringTransactionOption {
+	return func(rt *RecurringTransaction) {
+		if transactionType != nil {
+			rt.SetTransactionType(transactionType)
+		}
+	}
+}
+
+func WithRecurringTransactionFrequency(frequency *uint32) RecurringTransactionOption {
+	return func(rt *RecurringTransaction) {
+		if frequency != nil {
+			rt.SetFrequency(frequency)
+		}
+	}
+}
+
+func WithRecurringTransactionInterval(interval *uint32) RecurringTransactionOption {
+	return func(rt *RecurringTransaction) {
+		if interval != nil {
+			rt.SetInterval(interval)
+		}
+	}
+}
+
+func WithRecurringTransactionTimezone(timezone *string) RecurringTransactionOption {
+	return func(rt *RecurringTransaction) {
+		if timezone != nil {
+			rt.SetTimezone(timezone)
+		}
+	}
+}
+
+func WithRecurringTransactionStartTime(startTime *uint64) RecurringTransactionOption {
+	return func(rt *RecurringTransaction) {
+		if startTime != nil {
+			rt.SetStartTime(startTime)
+		}
+	}
+}
+
+func WithRecurringTransactionEndTime(endTime *uint64) RecurringTransactionOption {
+	return func(rt *RecurringTransaction) {
+		if endTime != nil {
+			rt.SetEndTime(endTime)
+		}
+	}
+}
+
+func WithRecurringTransactionMaxOccurrences(maxOccurrences *uint32) RecurringTransactionOption {
+	return func(rt *RecurringTransaction) {
+		if maxOccurrences != nil {
+			rt.SetMaxOccurrences(maxOccurrences)
+		}
+	}
+}
+
+func WithRecurringTransactionOccurrenceCount(occurrenceCount *uint32) RecurringTransactionOption {
+	return func(rt *RecurringTransaction) {
+		if occurrenceCount != nil {
+			rt.SetOccurrenceCount(occurrenceCount)
+		}
+	}
+}
+
+func WithRecurringTransactionStatus(recurringTransactionStatus *uint32) RecurringTransactionOption {
+	return func(rt *RecurringTransaction) {
+		if recurringTransactionStatus != nil {
+			rt.SetRecurringTransactionStatus(recurringTransactionStatus)
+		}
+	}
+}
+
+func WithRecurringTransactionCreateTime(createTime *uint64) RecurringTransactionOption {
+	return func(rt *RecurringTransaction) {
+		if createTime != nil {
+			rt.SetCreateTime(createTime)
+		}
+	}
+}
+
+func WithRecurringTransactionUpdateTime(updateTime *uint64) RecurringTransactionOption {
+	return func(rt *RecurringTransaction) {
+		if updateTime != nil {
+			rt.SetUpdateTime(updateTime)
+		}
+	}
+}
+
+func NewRecurringTransaction(userID string, opts ...RecurringTransactionOption) (*RecurringTransaction, error) {
+	now := uint64(time.Now().UnixMilli())
+	rt := &RecurringTransaction{
+		RecurringTransactionID:     goutil.String(""),
+		UserID:                     goutil.String(userID),
+		AccountID:                  goutil.String(""),
+		CategoryID:                 goutil.String(""),
+		FromAccountID:              goutil.String(""),
+		ToAccountID:                goutil.String(""),
+		Amount:                     goutil.Float64(0),
+		Currency:                   goutil.String(string(CurrencySGD)),
+		Note:                       goutil.String(""),
+		TransactionType:            goutil.Uint32(uint32(TransactionTypeExpense)),
+		Frequency:                  goutil.Uint32(uint32(RecurringTransactionFrequencyMonthly)),
+		Interval:                   goutil.Uint32(1),
+		Timezone:                   goutil.String(""),
+		StartTime:                  goutil.Uint64(now),
+		EndTime:                    goutil.Uint64(0),
+		MaxOccurrences:             goutil.Uint32(0),
+		OccurrenceCount:            goutil.Uint32(0),
+		RecurringTransactionStatus: goutil.Uint32(uint32(RecurringTransactionStatusNormal)),
+		CreateTime:                 goutil.Uint64(now),
+		UpdateTime:                 goutil.Uint64(now),
+	}
+	for _, opt := range opts {
+		opt(rt)
+	}
+
+	if err := rt.validate(); err != nil {
+		return nil, err
+	}
+
+	rt.computeNextTime()
+
+	return rt, nil
+}
+
+func (rt *RecurringTransaction) validate() error {
+	if rt.GetInterval() == 0 {
+		rt.SetInterval(goutil.Uint32(1))
+	}
+
+	if rt.GetEndTime() != 0 && rt.GetEndTime() < rt.GetStartTime() {
+		return errors.New("end_time cannot be before start_time")
+	}
+
+	if rt.IsExpense() {
+		if rt.GetAmount() > 0 {
+			rt.SetAmount(goutil.Float64(-rt.GetAmount()))
+		}
+	}
+
+	if rt.IsIncome() {
+		rt.SetAmount(goutil.Float64(math.Abs(rt.GetAmount())))
+	}
+
+	if rt.IsTransfer() {
+		if rt.GetFromAccountID() == "" || rt.GetToAccountID() == "" {
+			return errors.New("transfer must have to_account_id and from_account_id")
+		}
+
+		if rt.GetFromAccountID() == rt.GetToAccountID() {
+			return errors.New("to_account_id cannot be same as from_account_id")
+		}
+
+		rt.SetAmount(goutil.Float64(math.Abs(rt.GetAmount()))) // always positive
+		rt.AccountID = goutil.String("")
+		rt.CategoryID = goutil.String("")
+	} else {
+		if rt.GetAccountID() == "" {
+			return errors.New("non-transfer must have account_id")
+		}
+
+		rt.FromAccountID = goutil.String("")
+		rt.ToAccountID = goutil.String("")
+	}
+
+	return nil
+}
+
+// computeNextTime sets the time of the next occurrence to be materialised,
+// and marks the recurring transaction as ended if there is none left.
+func (rt *RecurringTransaction) computeNextTime() {
+	if rt.IsDeleted() {
+		return
+	}
+
+	nextTime, ok := rt.GetOccurrenceTime(rt.GetOccurrenceCount())
+	if !ok {
+		rt.SetRecurringTransactionStatus(goutil.Uint32(uint32(RecurringTransactionStatusEnded)))
+		return
+	}
+
+	rt.SetNextTime(goutil.Uint64(nextTime))
+	rt.SetRecurringTransactionStatus(goutil.Uint32(uint32(RecurringTransactionStatusNormal)))
+}
+
+// GetOccurrenceTime returns the time of the n-th occurrence (0-indexed).
+// Occurrences are always computed from start_time so that month-end
+// anchors are kept, e.g. Jan 31 -> Feb 28 -> Mar 31.
+func (rt *RecurringTransaction) GetOccurrenceTime(n uint32) (uint64, bool) {
+	if rt.GetMaxOccurrences() != 0 && n >= rt.GetMaxOccurrences() {
+		return 0, false
+	}
+
+	loc, err := time.LoadLocation(rt.GetTimezone())
+	if err != nil {
+		loc = time.UTC
+	}
+
+	var (
+		start = time.UnixMilli(int64(rt.GetStartTime())).In(loc)
+		steps = int(n * rt.GetInterval())
+		t     time.Time
+	)
+	switch rt.GetFrequency() {
+	case uint32(RecurringTransactionFrequencyDaily):
+		t = start.AddDate(0, 0, steps)
+	case uint32(RecurringTransactionFrequencyWeekly):
+		t = start.AddDate(0, 0, 7*steps)
+	case uint32(RecurringTransactionFrequencyMonthly):
+		t = util.AddMonths(start, steps)
+	case uint32(RecurringTransactionFrequencyYearly):
+		t = util.AddMonths(start, 12*steps)
+	default:
+		return 0, false
+	}
+
+	occurrenceTime := uint64(t.UnixMilli())
+	if rt.GetEndTime() != 0 && occurrenceTime > rt.GetEndTime() {
+		return 0, false
+	}
+
+	return occurrenceTime, true
+}
+
+// IsDue returns true if the next occurrence is at or before the given time.
+func (rt *RecurringTransaction) IsDue(now uint64) bool {
+	return rt.IsNormal() && rt.GetNextTime() <= now
+}
+
+// Advance moves the recurring transaction to its next occurrence.
+func (rt *RecurringTransaction) Advance() (*RecurringTransactionUpdate, error) {
+	if !rt.IsNormal() {
+		return nil, ErrRecurringTransactionEnded
+	}
+
+	return rt.Update(
+		WithUpdateRecurringTransactionOccurrenceCount(goutil.Uint32(rt.GetOccurrenceCount() + 1)),
+	)
+}
+
+func (rt *RecurringTransaction) CanTransactionUnderCategory(c *Category) error {
+	if rt.GetTransactionType() != c.GetCategoryType() {
+		return ErrMismatchTransactionType
+	}
+	return nil
+}
+
+func (rt *RecurringTransaction) CanTransactionUnderAccount(ac *Account) error {
+	if !ac.CanSetBalance() {
+		return ErrInvalidTransactionAccount
+	}
+	return nil
+}
+
+func (rt *RecurringTransaction) Clone() (*RecurringTransaction, error) {
+	return NewRecurringTransaction(
+		rt.GetUserID(),
+		WithRecurringTransactionID(rt.RecurringTransactionID),
+		WithRecurringTransactionAccountID(rt.AccountID),
+		WithRecurringTransactionCategoryID(rt.CategoryID),
+		WithRecurringTransactionFromAccountID(rt.FromAccountID),
+		WithRecurringTransactionToAccountID(rt.ToAccountID),
+		WithRecurringTransactionAmount(rt.Amount),
+		WithRecurringTransactionCurrency(rt.Currency),
+		WithRecurringTransactionNote(rt.Note),
+		WithRecurringTransactionType(rt.TransactionType),
+		WithRecurringTransactionFrequency(rt.Frequency),
+		WithRecurringTransactionInterval(rt.Interval),
+		WithRecurringTransactionTimezone(rt.Timezone),
+		WithRecurringTransactionStartTime(rt.StartTime),
+		WithRecurringTransactionEndTime(rt.EndTime),
+		WithRecurringTransactionMaxOccurrences(rt.MaxOccurrences),
+		WithRecurringTransactionOccurrenceCount(rt.OccurrenceCount),
+		WithRecurringTransactionStatus(rt.RecurringTransactionStatus),
+		WithRecurringTransactionCreateTime(rt.CreateTime),
+		WithRecurringTransactionUpdateTime(rt.UpdateTime),
+	)
+}
+
+func (rt *RecurringTransaction) ToRecurringTransactionUpdate(old *RecurringTransaction) *RecurringTransactionUpdate {
+	var (
+		hasUpdate bool
+
+		rtu = &RecurringTransactionUpdate{
+			UpdateTime: rt.UpdateTime,
+		}
+	)
+
+	if old.GetAccountID() != rt.GetAccountID() {
+		hasUpdate = true
+		rtu.AccountID = rt.AccountID
+	}
+
+	if old.GetCategoryID() != rt.GetCategoryID() {
+		hasUpdate = true
+		rtu.CategoryID = rt.CategoryID
+	}
+
+	if old.GetFromAccountID() != rt.GetFromAccountID() {
+		hasUpdate = true
+		rtu.FromAccountID = rt.FromAccountID
+	}
+
+	if old.GetToAccountID() != rt.GetToAccountID() {
+		hasUpdate = true
+		rtu.ToAccountID = rt.ToAccountID
+	}
+
+	if old.GetAmount() != rt.GetAmount() {
+		hasUpdate = true
+		rtu.Amount = rt.Amount
+	}
+
+	if old.GetCurrency() != rt.GetCurrency() {
+		hasUpdate = true
+		rtu.Currency = rt.Currency
+	}
+
+	if old.GetNote() != rt.GetNote() {
+		hasUpdate = true
+		rtu.Note = rt.Note
+	}
+
+	if old.GetEndTime() != rt.GetEndTime() {
+		hasUpdate = true
+		rtu.EndTime = rt.EndTime
+	}
+
+	if old.GetMaxOccurrences() != rt.GetMaxOccurrences() {
+		hasUpdate = true
+		rtu.MaxOccurrences = rt.MaxOccurrences
+	}
+
+	if old.GetOccurrenceCount() != rt.GetOccurrenceCount() {
+		hasUpdate = true
+		rtu.OccurrenceCount = rt.OccurrenceCount
+	}
+
+	if old.GetNextTime() != rt.GetNextTime() {
+		hasUpdate = true
+		rtu.NextTime = rt.NextTime
+	}
+
+	if old.GetRecurringTransactionStatus() != rt.GetRecurringTransactionStatus() {
+		hasUpdate = true
+		rtu.RecurringTransactionStatus = rt.RecurringTransactionStatus
+	}
+
+	if hasUpdate {
+		return rtu
+	}
+
+	return nil
+}
+
+func (rt *RecurringTransaction) Update(rtus ...RecurringTransactionUpdateOption) (*RecurringTransactionUpdate, error) {
+	if len(rtus) == 0 {
+		return nil, nil
+	}
+
+	old, err := rt.Clone()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rtu := range rtus {
+		rtu(rt)
+	}
+
+	// check
+	if err := rt.validate(); err != nil {
+		return nil, err
+	}
+
+	rt.computeNextTime()
+
+	now := goutil.Uint64(uint64(time.Now().UnixMilli()))
+	rt.SetUpdateTime(now)
+
+	return rt.ToRecurringTransactionUpdate(old), nil
+}
+
+func (rt *RecurringTransaction) GetRecurringTransactionID() string {
+	if rt != nil && rt.RecurringTransactionID != nil {
+		return *rt.RecurringTransactionID
+	}
+	return ""
+}
+
+func (rt *RecurringTransaction) SetRecurringTransactionID(recurringTransactionID *string) {
+	rt.RecurringTransactionID = recurringTransactionID
+}
+
+func (rt *RecurringTransaction) GetUserID() string {
+	if rt != nil && rt.UserID != nil {
+		return *rt.UserID
+	}
+	return ""
+}
+
+func (rt *RecurringTransaction) SetUserID(userID *string) {
+	rt.UserID = userID
+}
+
+func (rt *RecurringTransaction) GetAccountID() string {
+	if rt != nil && rt.AccountID != nil {
+		return *rt.AccountID
+	}
+	return ""
+}
+
+func (rt *RecurringTransaction) SetAccountID(accountID *string) {
+	rt.AccountID = accountID
+}
+
+func (rt *RecurringTransaction) GetCategoryID() string {
+	if rt != nil && rt.CategoryID != nil {
+		return *rt.CategoryID
+	}
+	return ""
+}
+
+func (rt *RecurringTransaction) SetCategoryID(categoryID *string) {
+	rt.CategoryID = categoryID
+}
+
+func (rt *RecurringTransaction) GetFromAccountID() string {
+	if rt != nil && rt.FromAccountID != nil {
+		return *rt.FromAccountID
+	}
+	return ""
+}
+
+func (rt *RecurringTransaction) SetFromAccountID(fromAccountID *string) {
+	rt.FromAccountID = fromAccountID
+}
+
+func (rt *RecurringTransaction) GetToAccountID() string {
+	if rt != nil && rt.ToAccountID != nil {
+		return *rt.ToAccountID
+	}
+	return ""
+}
+
+func (rt *RecurringTransaction) SetToAccountID(toAccountID *string) {
+	rt.ToAccountID = toAccountID
+}
+
+func (rt *RecurringTransaction) GetAmount() float64 {
+	if rt != nil && rt.Amount != nil {
+		return *rt.Amount
+	}
+	return 0
+}
+
+func (rt *RecurringTransaction) SetAmount(amount *float64) {
+	rt.Amount = amount
+
+	if amount != nil {
+		am := util.RoundFloatToStandardDP(*amount)
+		rt.Amount = goutil.Float64(am)
+	}
+}
+
+func (rt *RecurringTransaction) GetCurrency() string {
+	if rt != nil && rt.Currency != nil {
+		return *rt.Currency
+	}
+	return ""
+}
+
+func (rt *RecurringTransaction) SetCurrency(currency *string) {
+	rt.Currency = currency
+}
+
+func (rt *RecurringTransaction) GetNote() string {
+	if rt != nil && rt.Note != nil {
+		return *rt.Note
+	}
+	return ""
+}
+
+func (rt *RecurringTransaction) SetNote(note *string) {
+	rt.Note = note
+}
+
+func (rt *RecurringTransaction) GetTransactionType() uint32 {
+	if rt != nil && rt.TransactionType != nil {
+		return *rt.TransactionType
+	}
+	return 0
+}
+
+func (rt *RecurringTransaction) SetTransactionType(transactionType *uint32) {
+	rt.TransactionType = transactionType
+}
+
+func (rt *RecurringTransaction) GetFrequency() uint32 {
+	if rt != nil && rt.Frequency != nil {
+		return *rt.Frequency
+	}
+	return 0
+}
+
+func (rt *RecurringTransaction) SetFrequency(frequency *uint32) {
+	rt.Frequency = frequency
+}
+
+func (rt *RecurringTransaction) GetInterval() uint32 {
+	if rt != nil && rt.Interval != nil {
+		return *rt.Interval
+	}
+	return 0
+}
+
+func (rt *RecurringTransaction) SetInterval(interval *uint32) {
+	rt.Interval = interval
+}
+
+func (rt *RecurringTransaction) GetTimezone() string {
+	if rt != nil && rt.Timezone != nil {
+		return *rt.Timezone
+	}
+	return ""
+}
+
+func (rt *RecurringTransaction) SetTimezone(timezone *string) {
+	rt.Timezone = timezone
+}
+
+func (rt *RecurringTransaction) GetStartTime() uint64 {
+	if rt != nil && rt.StartTime != nil {
+		return *rt.StartTime
+	}
+	return 0
+}
+
+func (rt *RecurringTransaction) SetStartTime(startTime *uint64) {
+	rt.StartTime = startTime
+}
+
+func (rt *RecurringTransaction) GetEndTime() uint64 {
+	if rt != nil && rt.EndTime != nil {
+		return *rt.EndTime
+	}
+	return 0
+}
+
+func (rt *RecurringTransaction) SetEndTime(endTime *uint64) {
+	rt.EndTime = endTime
+}
+
+func (rt *RecurringTransaction) GetMaxOccurrences() uint32 {
+	if rt != nil && rt.MaxOccurrences != nil {
+		return *rt.MaxOccurrences
+	}
+	return 0
+}
+
+func (rt *RecurringTransaction) SetMaxOccurrences(maxOccurrences *uint32) {
+	rt.MaxOccurrences = maxOccurrences
+}
+
+func (rt *RecurringTransaction) GetOccurrenceCount() uint32 {
+	if rt != nil && rt.OccurrenceCount != nil {
+		return *rt.OccurrenceCount
+	}
+	return 0
+}
+
+func (rt *RecurringTransaction) SetOccurrenceCount(occurrenceCount *uint32) {
+	rt.OccurrenceCount = occurrenceCount
+}
+
+func (rt *RecurringTransaction) GetNextTime() uint64 {
+	if rt != nil && rt.NextTime != nil {
+		return *rt.NextTime
+	}
+	return 0
+}
+
+func (rt *RecurringTransaction) SetNextTime(nextTime *uint64) {
+	rt.NextTime = nextTime
+}
+
+func (rt *RecurringTransaction) GetRecurringTransactionStatus() uint32 {
+	if rt != nil && rt.RecurringTransactionStatus != nil {
+		return *rt.RecurringTransactionStatus
+	}
+	return 0
+}
+
+func (rt *RecurringTransaction) SetRecurringTransactionStatus(recurringTransactionStatus *uint32) {
+	rt.RecurringTransactionStatus = recurringTransactionStatus
+}
+
+func (rt *RecurringTransaction) GetCreateTime() uint64 {
+	if rt != nil && rt.CreateTime != nil {
+		return *rt.CreateTime
+	}
+	return 0
+}
+
+func (rt *RecurringTransaction) SetCreateTime(createTime *uint64) {
+	rt.CreateTime = createTime
+}
+
+func (rt *RecurringTransaction) GetUpdateTime() uint64 {
+	if rt != nil && rt.UpdateTime != nil {
+		return *rt.UpdateTime
+	}
+	return 0
+}
+
+func (rt *RecurringTransaction) SetUpdateTime(updateTime *uint64) {
+	rt.UpdateTime = updateTime
+}
+
+func (rt *RecurringTransaction) IsExpense() bool {
+	return rt.GetTransactionType() == uint32(TransactionTypeExpense)
+}
+
+func (rt *RecurringTransaction) IsIncome() bool {
+	return rt.GetTransactionType() == uint32(TransactionTypeIncome)
+}
+
+func (rt *RecurringTransaction) IsTransfer() bool {
+	return rt.GetTransactionType() == uint32(TransactionTypeTransfer)
+}
+
+func (rt *RecurringTransaction) IsNormal() bool {
+	return rt.GetRecurringTransactionStatus() == uint32(RecurringTransactionStatusNormal)
+}
+
+func (rt *RecurringTransaction) IsDeleted() bool {
+	return rt.GetRecurringTransactionStatus() == uint32(RecurringTransactionStatusDeleted)
+}
@@ -0,0 +1,718 @@
+package entity
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/pkg/errutil"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+)
+
+var (
+	ErrInvalidRuleName        = errutil.ValidationError(errors.New("invalid rule name"))
+	ErrRuleNoCategory         = errutil.ValidationError(errors.New("rule must have category_id"))
+	ErrRuleNoCondition        = errutil.ValidationError(errors.New("rule must have at least one condition"))
+	ErrInvalidRuleAmountRange = errutil.ValidationError(errors.New("invalid rule amount range"))
+	ErrInvalidRuleType        = errutil.ValidationError(errors.New("rule can only match expense or income"))
+)
+
+type RuleStatus uint32
+
+const (
+	RuleStatusInvalid RuleStatus = iota
+	RuleStatusNormal
+	RuleStatusDeleted
+)
+
+type RuleUpdateOption func(r *Rule)
+
+func WithUpdateRuleName(ruleName *string) RuleUpdateOption {
+	return func(r *Rule) {
+		if ruleName != nil {
+			r.SetRuleName(ruleName)
+		}
+	}
+}
+
+func WithUpdateRuleCategoryID(categoryID *string) RuleUpdateOption {
+	return func(r *Rule) {
+		if categoryID != nil {
+			r.SetCategoryID(categoryID)
+		}
+	}
+}
+
+func WithUpdateRulePosition(position *uint32) RuleUpdateOption {
+	return func(r *Rule) {
+		if position != nil {
+			r.SetPosition(position)
+		}
+	}
+}
+
+func WithUpdateRuleNoteContains(noteContains *string) RuleUpdateOption {
+	return func(r *Rule) {
+		if noteContains != nil {
+			r.SetNoteContains(noteContains)
+		}
+	}
+}
+
+func WithUpdateRuleAmountMin(amountMin *float64) RuleUpdateOption {
+	return func(r *Rule) {
+		if amountMin != nil {
+			r.SetAmountMin(amountMin)
+		}
+	}
+}
+
+func WithUpdateRuleAmountMax(amountMax *float64) RuleUpdateOption {
+	return func(r *Rule) {
+		if amountMax != nil {
+			r.SetAmountMax(amountMax)
+		}
+	}
+}
+
+func WithUpdateRuleAccountID(accountID *string) RuleUpdateOption {
+	return func(r *Rule) {
+		if accountID != nil {
+			r.SetAccountID(accountID)
+		}
+	}
+}
+
+func WithUpdateRuleCurrency(currency *string) RuleUpdateOption {
+	return func(r *Rule) {
+		if currency != nil {
+			r.SetCurrency(currency)
+		}
+	}
+}
+
+func WithUpdateRuleTransactionType(transactionType *uint32) RuleUpdateOption {
+	return func(r *Rule) {
+		if transactionType != nil {
+			r.SetTransactionType(transactionType)
+		}
+	}
+}
+
+func WithUpdateRuleStatus(ruleStatus *uint32) RuleUpdateOption {
+	return func(r *Rule) {
+		if ruleStatus != nil {
+			r.SetRuleStatus(ruleStatus)
+		}
+	}
+}
+
+// Rule assigns its category to a transaction that meets all of its conditions.
+// An empty or zero condition matches any transaction.
+// Rules are evaluated by ascending position, and the first match wins.
+type Rule struct {
+	RuleID          *string
+	UserID          *string
+	RuleName        *string
+	CategoryID      *string
+	Position        *uint32
+	NoteContains    *string  // case-insensitive
+	AmountMin       *float64 // inclusive, on absolute amount
+	AmountMax       *float64 // inclusive, on absolute amount
+	AccountID       *string
+	Currency        *string
+	TransactionType *uint32
+	RuleStatus      *uint32
+	CreateTime      *uint64
+	UpdateTime      *uint64
+
+	Category *Category
+}
+
+type RuleOption func(r *Rule)
+
+func WithRuleID(ruleID *string) RuleOption {
+	return func(r *Rule) {
+		if ruleID != nil {
+			r.SetRuleID(ruleID)
+		}
+	}
+}
+
+func WithRulePosition(position *uint32) RuleOption {
+	return func(r *Rule) {
+		if position != nil {
+			r.SetPosition(position)
+		}
+	}
+}
+
+func WithRuleNoteContains(noteContains *string) RuleOption {
+	return func(r *Rule) {
+		if noteContains != nil {
+			r.SetNoteContains(noteContains)
+		}
+	}
+}
+
+func WithRuleAmountMin(amountMin *float64) RuleOption {
+	return func(r *Rule) {
+		if amountMin != nil {
+			r.SetAmountMin(amountMin)
+		}
+	}
+}
+
+func WithRuleAmountMax(amountMax *float64) RuleOption {
+	return func(r *Rule) {
+		if amountMax != nil {
+			r.SetAmountMax(amountMax)
+		}
+	}
+}
+
+func WithRuleAccountID(accountID *string) RuleOption {
+	return func(r *Rule) {
+		if accountID != nil {
+			r.SetAccountID(accountID)
+		}
+	}
+}
+
+func WithRuleCurrency(currency *string) RuleOption {
+	return func(r *Rule) {
+		if currency != nil {
+			r.SetCurrency(currency)
+		}
+	}
+}
+
+func WithRuleTransactionType(transactionType *uint32) RuleOption {
+	return func(r *Rule) {
+		if transactionType != nil {
+			r.SetTransactionType(transactionType)
+		}
+	}
+}
+
+func WithRuleStatus(ruleStatus *uint32) RuleOption {
+	return func(r *Rule) {
+		if ruleStatus != nil {
+			r.SetRuleStatus(ruleStatus)
+		}
+	}
+}
+
+func WithRuleCreateTime(createTime *uint64) RuleOption {
+	return func(r *Rule) {
+		if createTime != nil {
+			r.SetCreateTime(createTime)
+		}
+	}
+}
+
+func WithRuleUpdateTime(updateTime *uint64) RuleOption {
+	return func(r *Rule) {
+		if updateTime != nil {
+			r.SetUpdateTime(updateTime)
+		}
+	}
+}
+
+func NewRule(userID, ruleName, categoryID string, opts ...RuleOption) (*Rule, error) {
+	now := uint64(time.Now().UnixMilli())
+	r := &Rule{
+		RuleID:          goutil.String(""),
+		UserID:          goutil.String(userID),
+		RuleName:        goutil.String(ruleName),
+		CategoryID:      goutil.String(categoryID),
+		Position:        goutil.Uint32(0),
+		NoteContains:    goutil.String(""),
+		AmountMin:       goutil.Float64(0),
+		AmountMax:       goutil.Float64(0),
+		AccountID:       goutil.String(""),
+		Currency:        goutil.String(""),
+		TransactionType: goutil.Uint32(uint32(TransactionTypeInvalid)),
+		RuleStatus:      goutil.Uint32(uint32(RuleStatusNormal)),
+		CreateTime:      goutil.Uint64(now),
+		UpdateTime:      goutil.Uint64(now),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *Rule) Clone() (*Rule, error) {
+	return NewRule(
+		r.GetUserID(),
+		r.GetRuleName(),
+		r.GetCategoryID(),
+		WithRuleID(r.RuleID),
+		WithRulePosition(r.Position),
+		WithRuleNoteContains(r.NoteContains),
+		WithRuleAmountMin(r.AmountMin),
+		WithRuleAmountMax(r.AmountMax),
+		WithRuleAccountID(r.AccountID),
+		WithRuleCurrency(r.Currency),
+		WithRuleTransactionType(r.TransactionType),
+		WithRuleStatus(r.RuleStatus),
+		WithRuleCreateTime(r.CreateTime),
+		WithRuleUpdateTime(r.UpdateTime),
+	)
+}
+
+func (r *Rule) validate() error {
+	r.SetRuleName(goutil.String(strings.TrimSpace(r.GetRuleName())))
+	if r.GetRuleName() == "" || len(r.GetRuleName()) > config.MaxRuleNameLength {
+		return ErrInvalidRuleName
+	}
+
+	if r.GetCategoryID() == "" {
+		return ErrRuleNoCategory
+	}
+
+	r.SetNoteContains(goutil.String(strings.TrimSpace(r.GetNoteContains())))
+
+	if r.GetAmountMin() < 0 || r.GetAmountMax() < 0 {
+		return ErrInvalidRuleAmountRange
+	}
+
+	if r.GetAmountMax() > 0 && r.GetAmountMin() > r.GetAmountMax() {
+		return ErrInvalidRuleAmountRange
+	}
+
+	if r.GetCurrency() != "" {
+		if err := CheckCurrency(r.GetCurrency()); err != nil {
+			return err
+		}
+	}
+
+	if r.GetTransactionType() != uint32(TransactionTypeInvalid) &&
+		r.GetTransactionType() != uint32(TransactionTypeExpense) &&
+		r.GetTransactionType() != uint32(TransactionTypeIncome) {
+		return ErrInvalidRuleType
+	}
+
+	if !r.hasCondition() {
+		return ErrRuleNoCondition
+	}
+
+	return nil
+}
+
+func (r *Rule) hasCondition() bool {
+	return r.GetNoteContains() != "" ||
+		r.GetAmountMin() > 0 ||
+		r.GetAmountMax() > 0 ||
+		r.GetAccountID() != "" ||
+		r.GetCurrency() != "" ||
+		r.GetTransactionType() != uint32(TransactionTypeInvalid)
+}
+
+// MatchRules returns the first rule that matches the transaction, or nil if none matches.
+func MatchRules(rules []*Rule, t *Transaction) *Rule {
+	for _, r := range rules {
+		if r.Match(t) {
+			return r
+		}
+	}
+	return nil
+}
+
+// Match checks if the transaction meets all conditions of the rule.
+// Transfers, split transactions, refunds, which keep the category of the
+// refunded transaction, and transactions that cannot be under the category
+// of the rule are never matched.
+func (r *Rule) Match(t *Transaction) bool {
+	if t.IsTransfer() || t.IsSplit() || t.IsRefund() {
+		return false
+	}
+
+	if r.Category == nil || t.CanTransactionUnderCategory(r.Category) != nil {
+		return false
+	}
+
+	if r.GetNoteContains() != "" &&
+		!strings.Contains(strings.ToLower(t.GetNote()), strings.ToLower(r.GetNoteContains())) {
+		return false
+	}
+
+	amount := math.Abs(t.GetAmount())
+	if r.GetAmountMin() > 0 && amount < r.GetAmountMin() {
+		return false
+	}
+
+	if r.GetAmountMax() > 0 && amount > r.GetAmountMax() {
+		return false
+	}
+
+	if r.GetAccountID() != "" && r.GetAccountID() != t.GetAccountID() {
+		return false
+	}
+
+	if r.GetCurrency() != "" && r.GetCurrency() != t.GetCurrency() {
+		return false
+	}
+
+	if r.GetTransactionType() != uint32(TransactionTypeInvalid) &&
+		r.GetTransactionType() != t.GetTransactionType() {
+		return false
+	}
+
+	return true
+}
+
+type RuleUpdate struct {
+	RuleName        *string
+	CategoryID      *string
+	Position        *uint32
+	NoteContains    *string
+	AmountMin       *float64
+	AmountMax       *float64
+	AccountID       *string
+	Currency        *string
+	TransactionType *uint32
+	RuleStatus      *uint32
+	UpdateTime      *uint64
+}
+
+func (ru *RuleUpdate) GetRuleName() string {
+	if ru != nil && ru.RuleName != nil {
+		return *ru.RuleName
+	}
+	return ""
+}
+
+func (ru *RuleUpdate) GetCategoryID() string {
+	if ru != nil && ru.CategoryID != nil {
+		return *ru.CategoryID
+	}
+	return ""
+}
+
+func (ru *RuleUpdate) GetPosition() uint32 {
+	if ru != nil && ru.Position != nil {
+		return *ru.Position
+	}
+	return 0
+}
+
+func (ru *RuleUpdate) GetNoteContains() string {
+	if ru != nil && ru.NoteContains != nil {
+		return *ru.NoteContains
+	}
+	return ""
+}
+
+func (ru *RuleUpdate) GetAmountMin() float64 {
+	if ru != nil && ru.AmountMin != nil {
+		return *ru.AmountMin
+	}
+	return 0
+}
+
+func (ru *RuleUpdate) GetAmountMax() float64 {
+	if ru != nil && ru.AmountMax != nil {
+		return *ru.AmountMax
+	}
+	return 0
+}
+
+func (ru *RuleUpdate) GetAccountID() string {
+	if ru != nil && ru.AccountID != nil {
+		return *ru.AccountID
+	}
+	return ""
+}
+
+func (ru *RuleUpdate) GetCurrency() string {
+	if ru != nil && ru.Currency != nil {
+		return *ru.Currency
+	}
+	return ""
+}
+
+func (ru *RuleUpdate) GetTransactionType() uint32 {
+	if ru != nil && ru.TransactionType != nil {
+		return *ru.TransactionType
+	}
+	return 0
+}
+
+func (ru *RuleUpdate) GetRuleStatus() uint32 {
+	if ru != nil && ru.RuleStatus != nil {
+		return *ru.RuleStatus
+	}
+	return 0
+}
+
+func (ru *RuleUpdate) GetUpdateTime() uint64 {
+	if ru != nil && ru.UpdateTime != nil {
+		return *ru.UpdateTime
+	}
+	return 0
+}
+
+func (r *Rule) ToRuleUpdate(old *Rule) *RuleUpdate {
+	var (
+		hasUpdate bool
+
+		ru = &RuleUpdate{
+			UpdateTime: r.UpdateTime,
+		}
+	)
+
+	if old.GetRuleName() != r.GetRuleName() {
+		hasUpdate = true
+		ru.RuleName = r.RuleName
+	}
+
+	if old.GetCategoryID() != r.GetCategoryID() {
+		hasUpdate = true
+		ru.CategoryID = r.CategoryID
+	}
+
+	if old.GetPosition() != r.GetPosition() {
+		hasUpdate = true
+		ru.Position = r.Position
+	}
+
+	if old.GetNoteContains() != r.GetNoteContains() {
+		hasUpdate = true
+		ru.NoteContains = r.NoteContains
+	}
+
+	if old.GetAmountMin() != r.GetAmountMin() {
+		hasUpdate = true
+		ru.AmountMin = r.AmountMin
+	}
+
+	if old.GetAmountMax() != r.GetAmountMax() {
+		hasUpdate = true
+		ru.AmountMax = r.AmountMax
+	}
+
+	if old.GetAccountID() != r.GetAccountID() {
+		hasUpdate = true
+		ru.AccountID = r.AccountID
+	}
+
+	if old.GetCurrency() != r.GetCurrency() {
+		hasUpdate = true
+		ru.Currency = r.Currency
+	}
+
+	if old.GetTransactionType() != r.GetTransactionType() {
+		hasUpdate = true
+		ru.TransactionType = r.TransactionType
+	}
+
+	if old.GetRuleStatus() != r.GetRuleStatus() {
+		hasUpdate = true
+		ru.RuleStatus = r.RuleStatus
+	}
+
+	if hasUpdate {
+		return ru
+	}
+
+	return nil
+}
+
+func (r *Rule) Update(rus ...RuleUpdateOption) (*RuleUpdate, error) {
+	if len(rus) == 0 {
+		return nil, nil
+	}
+
+	old, err := r.Clone()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ru := range rus {
+		ru(r)
+	}
+
+	// check
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	now := goutil.Uint64(uint64(time.Now().UnixMilli()))
+	r.SetUpdateTime(now)
+
+	return r.ToRuleUpdate(old), nil
+}
+
+func (r *Rule) GetRuleID() string {
+	if r != nil && r.RuleID != nil {
+		return *r.RuleID
+	}
+	return ""
+}
+
+func (r *Rule) SetRuleID(ruleID *string) {
+	r.RuleID = ruleID
+}
+
+func (r *Rule) GetUserID() string {
+	if r != nil && r.UserID != nil {
+		return *r.UserID
+	}
+	return ""
+}
+
+func (r *Rule) SetUserID(userID *string) {
+	r.UserID = userID
+}
+
+func (r *Rule) GetRuleName() string {
+	if r != nil && r.RuleName != nil {
+		return *r.RuleName
+	}
+	return ""
+}
+
+func (r *Rule) SetRuleName(ruleName *string) {
+	r.RuleName = ruleName
+}
+
+func (r *Rule) GetCategoryID() string {
+	if r != nil && r.CategoryID != nil {
+		return *r.CategoryID
+	}
+	return ""
+}
+
+func (r *Rule) SetCategoryID(categoryID *string) {
+	r.CategoryID = categoryID
+}
+
+func (r *Rule) GetPosition() uint32 {
+	if r != nil && r.Position != nil {
+		return *r.Position
+	}
+	return 0
+}
+
+func (r *Rule) SetPosition(position *uint32) {
+	r.Position = position
+}
+
+func (r *Rule) GetNoteContains() string {
+	if r != nil && r.NoteContains != nil {
+		return *r.NoteContains
+	}
+	return ""
+}
+
+func (r *Rule) SetNoteContains(noteContains *string) {
+	r.NoteContains = noteContains
+}
+
+func (r *Rule) GetAmountMin() float64 {
+	if r != nil && r.AmountMin != nil {
+		return *r.AmountMin
+	}
+	return 0
+}
+
+func (r *Rule) SetAmountMin(amountMin *float64) {
+	r.AmountMin = amountMin
+}
+
+func (r *Rule) GetAmountMax() float64 {
+	if r != nil && r.AmountMax != nil {
+		return *r.AmountMax
+	}
+	return 0
+}
+
+func (r *Rule) SetAmountMax(amountMax *float64) {
+	r.AmountMax = amountMax
+}
+
+func (r *Rule) GetAccountID() string {
+	if r != nil && r.AccountID != nil {
+		return *r.AccountID
+	}
+	return ""
+}
+
+func (r *Rule) SetAccountID(accountID *string) {
+	r.AccountID = accountID
+}
+
+func (r *Rule) GetCurrency() string {
+	if r != nil && r.Currency != nil {
+		return *r.Currency
+	}
+	return ""
+}
+
+func (r *Rule) SetCurrency(currency *string) {
+	r.Currency = currency
+}
+
+func (r *Rule) GetTransactionType() uint32 {
+	if r != nil && r.TransactionType != nil {
+		return *r.TransactionType
+	}
+	return 0
+}
+
+func (r *Rule) SetTransactionType(transactionType *uint32) {
+	r.TransactionType = transactionType
+}
+
+func (r *Rule) GetRuleStatus() uint32 {
+	if r != nil && r.RuleStatus != nil {
+		return *r.RuleStatus
+	}
+	return 0
+}
+
+func (r *Rule) SetRuleStatus(ruleStatus *uint32) {
+	r.RuleStatus = ruleStatus
+}
+
+func (r *Rule) GetCreateTime() uint64 {
+	if r != nil && r.CreateTime != nil {
+		return *r.CreateTime
+	}
+	return 0
+}
+
+func (r *Rule) SetCreateTime(createTime *uint64) {
+	r.CreateTime = createTime
+}
+
+func (r *Rule) GetUpdateTime() uint64 {
+	if r != nil && r.UpdateTime != nil {
+		return *r.UpdateTime
+	}
+	return 0
+}
+
+func (r *Rule) SetUpdateTime(updateTime *uint64) {
+	r.UpdateTime = updateTime
+}
+
+func (r *Rule) GetCategory() *Category {
+	if r != nil && r.Category != nil {
+		return r.Category
+	}
+	return nil
+}
+
+func (r *Rule) SetCategory(c *Category) {
+	r.Category = c
+}
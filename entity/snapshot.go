@@ -11,10 +11,12 @@ type SnapshotUnit uint32
 const (
 	SnapshotUnitInvalid SnapshotUnit = iota
 	SnapshotUnitMonth
+	SnapshotUnitDay
 )
 
 var SnapshotUnits = map[uint32]string{
 	uint32(SnapshotUnitMonth): "month",
+	uint32(SnapshotUnitDay):   "day",
 }
 
 type SnapshotType uint32
@@ -0,0 +1,276 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/pkg/errutil"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+)
+
+var (
+	ErrInvalidTagName = errutil.ValidationError(errors.New("invalid tag name"))
+)
+
+type TagStatus uint32
+
+const (
+	TagStatusInvalid TagStatus = iota
+	TagStatusNormal
+	TagStatusDeleted
+)
+
+type TagUpdateOption func(t *Tag)
+
+func WithUpdateTagName(tagName *string) TagUpdateOption {
+	return func(t *Tag) {
+		if tagName != nil {
+			t.SetTagName(tagName)
+		}
+	}
+}
+
+func WithUpdateTagStatus(tagStatus *uint32) TagUpdateOption {
+	return func(t *Tag) {
+		if tagStatus != nil {
+			t.SetTagStatus(tagStatus)
+		}
+	}
+}
+
+type Tag struct {
+	UserID     *string
+	TagID      *string
+	TagName    *string
+	TagStatus  *uint32
+	CreateTime *uint64
+	UpdateTime *uint64
+}
+
+type TagOption func(t *Tag)
+
+func WithTagID(tagID *string) TagOption {
+	return func(t *Tag) {
+		if tagID != nil {
+			t.SetTagID(tagID)
+		}
+	}
+}
+
+func WithTagStatus(tagStatus *uint32) TagOption {
+	return func(t *Tag) {
+		if tagStatus != nil {
+			t.SetTagStatus(tagStatus)
+		}
+	}
+}
+
+func WithTagCreateTime(createTime *uint64) TagOption {
+	return func(t *Tag) {
+		if createTime != nil {
+			t.SetCreateTime(createTime)
+		}
+	}
+}
+
+func WithTagUpdateTime(updateTime *uint64) TagOption {
+	return func(t *Tag) {
+		if updateTime != nil {
+			t.SetUpdateTime(updateTime)
+		}
+	}
+}
+
+func (t *Tag) Clone() (*Tag, error) {
+	return NewTag(
+		t.GetUserID(),
+		t.GetTagName(),
+		WithTagID(goutil.String(t.GetTagID())),
+		WithTagStatus(t.TagStatus),
+		WithTagCreateTime(t.CreateTime),
+		WithTagUpdateTime(t.UpdateTime),
+	)
+}
+
+func NewTag(userID, tagName string, opts ...TagOption) (*Tag, error) {
+	now := uint64(time.Now().UnixMilli())
+	t := &Tag{
+		TagID:      goutil.String(""),
+		UserID:     goutil.String(userID),
+		TagName:    goutil.String(tagName),
+		TagStatus:  goutil.Uint32(uint32(TagStatusNormal)),
+		CreateTime: goutil.Uint64(now),
+		UpdateTime: goutil.Uint64(now),
+	}
+
+	for _, opt := range opts {
+		opt(t)
+	}
+
+	if err := t.validate(); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *Tag) validate() error {
+	// tags are case-insensitive and free of surrounding spaces
+	t.SetTagName(goutil.String(strings.ToLower(strings.TrimSpace(t.GetTagName()))))
+
+	if t.GetTagName() == "" || len(t.GetTagName()) > config.MaxTagNameLength {
+		return ErrInvalidTagName
+	}
+
+	return nil
+}
+
+type TagUpdate struct {
+	TagName    *string
+	TagStatus  *uint32
+	UpdateTime *uint64
+}
+
+func (tu *TagUpdate) GetTagName() string {
+	if tu != nil && tu.TagName != nil {
+		return *tu.TagName
+	}
+	return ""
+}
+
+func (tu *TagUpdate) GetTagStatus() uint32 {
+	if tu != nil && tu.TagStatus != nil {
+		return *tu.TagStatus
+	}
+	return 0
+}
+
+func (tu *TagUpdate) GetUpdateTime() uint64 {
+	if tu != nil && tu.UpdateTime != nil {
+		return *tu.UpdateTime
+	}
+	return 0
+}
+
+func (t *Tag) ToTagUpdate(old *Tag) *TagUpdate {
+	var (
+		hasUpdate bool
+
+		tu = &TagUpdate{
+			UpdateTime: t.UpdateTime,
+		}
+	)
+
+	if old.GetTagName() != t.GetTagName() {
+		hasUpdate = true
+		tu.TagName = t.TagName
+	}
+
+	if old.GetTagStatus() != t.GetTagStatus() {
+		hasUpdate = true
+		tu.TagStatus = t.TagStatus
+	}
+
+	if hasUpdate {
+		return tu
+	}
+
+	return nil
+}
+
+func (t *Tag) Update(tus ...TagUpdateOption) (*TagUpdate, error) {
+	if len(tus) == 0 {
+		return nil, nil
+	}
+
+	old, err := t.Clone()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tu := range tus {
+		tu(t)
+	}
+
+	// check
+	if err := t.validate(); err != nil {
+		return nil, err
+	}
+
+	now := goutil.Uint64(uint64(time.Now().UnixMilli()))
+	t.SetUpdateTime(now)
+
+	return t.ToTagUpdate(old), nil
+}
+
+func (t *Tag) GetUserID() string {
+	if t != nil && t.UserID != nil {
+		return *t.UserID
+	}
+	return ""
+}
+
+func (t *Tag) SetUserID(userID *string) {
+	t.UserID = userID
+}
+
+func (t *Tag) GetTagID() string {
+	if t != nil && t.TagID != nil {
+		return *t.TagID
+	}
+	return ""
+}
+
+func (t *Tag) SetTagID(tagID *string) {
+	t.TagID = tagID
+}
+
+func (t *Tag) GetTagName() string {
+	if t != nil && t.TagName != nil {
+		return *t.TagName
+	}
+	return ""
+}
+
+func (t *Tag) SetTagName(tagName *string) {
+	t.TagName = tagName
+}
+
+func (t *Tag) GetTagStatus() uint32 {
+	if t != nil && t.TagStatus != nil {
+		return *t.TagStatus
+	}
+	return 0
+}
+
+func (t *Tag) SetTagStatus(tagStatus *uint32) {
+	t.TagStatus = tagStatus
+}
+
+func (t *Tag) GetCreateTime() uint64 {
+	if t != nil && t.CreateTime != nil {
+		return *t.CreateTime
+	}
+	return 0
+}
+
+func (t *Tag) SetCreateTime(createTime *uint64) {
+	t.CreateTime = createTime
+}
+
+func (t *Tag) GetUpdateTime() uint64 {
+	if t != nil && t.UpdateTime != nil {
+		return *t.UpdateTime
+	}
+	return 0
+}
+
+func (t *Tag) SetUpdateTime(updateTime *uint64) {
+	t.UpdateTime = updateTime
+}
+
+func (t *Tag) IsDeleted() bool {
+	return t.GetTagStatus() == uint32(TagStatusDeleted)
+}
@@ -12,6 +12,8 @@ import (
 var (
 	ErrMismatchTransactionType   = errors.New("mismatch transaction type")
 	ErrInvalidTransactionAccount = errors.New("transaction not allowed under account")
+	ErrInvalidTransactionSplits  = errors.New("split amounts must sum to transaction amount")
+	ErrInvalidRefund             = errors.New("refund must be an income of an expense in the same currency")
 )
 
 type TransactionStatus uint32
@@ -22,6 +24,23 @@ const (
 	TransactionStatusDeleted
 )
 
+// ClearStatus tracks a transaction against bank statements.
+// Reconciled transactions are locked, they can only be set back to cleared before other edits.
+type ClearStatus uint32
+
+const (
+	ClearStatusInvalid ClearStatus = iota
+	ClearStatusPending
+	ClearStatusCleared
+	ClearStatusReconciled
+)
+
+var ClearStatuses = map[uint32]string{
+	uint32(ClearStatusPending):    "pending",
+	uint32(ClearStatusCleared):    "cleared",
+	uint32(ClearStatusReconciled): "reconciled",
+}
+
 type TransactionType uint32
 
 const (
@@ -37,6 +56,58 @@ var TransactionTypes = map[uint32]string{
 	uint32(TransactionTypeTransfer): "transfer",
 }
 
+type TransactionSplit struct {
+	CategoryID *string
+	Amount     *float64
+
+	Category *Category
+}
+
+func NewTransactionSplit(categoryID string, amount float64) *TransactionSplit {
+	ts := new(TransactionSplit)
+	ts.SetCategoryID(goutil.String(categoryID))
+	ts.SetAmount(goutil.Float64(amount))
+	return ts
+}
+
+func (ts *TransactionSplit) GetCategoryID() string {
+	if ts != nil && ts.CategoryID != nil {
+		return *ts.CategoryID
+	}
+	return ""
+}
+
+func (ts *TransactionSplit) SetCategoryID(categoryID *string) {
+	ts.CategoryID = categoryID
+}
+
+func (ts *TransactionSplit) GetAmount() float64 {
+	if ts != nil && ts.Amount != nil {
+		return *ts.Amount
+	}
+	return 0
+}
+
+func (ts *TransactionSplit) SetAmount(amount *float64) {
+	ts.Amount = amount
+
+	if amount != nil {
+		am := util.RoundFloatToStandardDP(*amount)
+		ts.Amount = goutil.Float64(am)
+	}
+}
+
+func (ts *TransactionSplit) GetCategory() *Category {
+	if ts != nil && ts.Category != nil {
+		return ts.Category
+	}
+	return nil
+}
+
+func (ts *TransactionSplit) SetCategory(c *Category) {
+	ts.Category = c
+}
+
 type TransactionUpdateOption func(t *Transaction)
 
 func WithUpdateTransactionAccountID(accountID *string) TransactionUpdateOption {
@@ -103,6 +174,14 @@ func WithUpdateTransactionStatus(transactionStatus *uint32) TransactionUpdateOpt
 	}
 }
 
+func WithUpdateTransactionClearStatus(clearStatus *uint32) TransactionUpdateOption {
+	return func(t *Transaction) {
+		if clearStatus != nil {
+			t.SetClearStatus(clearStatus)
+		}
+	}
+}
+
 func WithUpdateTransactionType(transactionType *uint32) TransactionUpdateOption {
 	return func(t *Transaction) {
 		if transactionType != nil {
@@ -119,18 +198,97 @@ func WithUpdateTransactionCurrency(currency *string) TransactionUpdateOption {
 	}
 }
 
+func WithUpdateTransactionReceivedAmount(receivedAmount *float64) TransactionUpdateOption {
+	return func(t *Transaction) {
+		if receivedAmount != nil {
+			t.SetReceivedAmount(receivedAmount)
+		}
+	}
+}
+
+func WithUpdateTransactionReceivedCurrency(receivedCurrency *string) TransactionUpdateOption {
+	return func(t *Transaction) {
+		if receivedCurrency != nil {
+			t.SetReceivedCurrency(receivedCurrency)
+		}
+	}
+}
+
+func WithUpdateTransactionFee(fee *float64) TransactionUpdateOption {
+	return func(t *Transaction) {
+		if fee != nil {
+			t.SetFee(fee)
+		}
+	}
+}
+
+func WithUpdateTransactionPayeeID(payeeID *string) TransactionUpdateOption {
+	return func(t *Transaction) {
+		if payeeID != nil {
+			t.SetPayeeID(payeeID)
+		}
+	}
+}
+
+// WithUpdateTransactionRefundedTransactionID links a transaction as a refund.
+// An empty string removes the link.
+func WithUpdateTransactionRefundedTransactionID(refundedTransactionID *string) TransactionUpdateOption {
+	return func(t *Transaction) {
+		if refundedTransactionID != nil {
+			t.SetRefundedTransactionID(refundedTransactionID)
+		}
+	}
+}
+
+func WithUpdateTransactionExternalID(externalID *string) TransactionUpdateOption {
+	return func(t *Transaction) {
+		if externalID != nil {
+			t.SetExternalID(externalID)
+		}
+	}
+}
+
+// WithUpdateTransactionSplits replaces the splits of a transaction.
+// A nil slice means no update, while an empty slice removes all splits.
+func WithUpdateTransactionSplits(splits []*TransactionSplit) TransactionUpdateOption {
+	return func(t *Transaction) {
+		if splits != nil {
+			t.SetSplits(splits)
+		}
+	}
+}
+
+// WithUpdateTransactionTagIDs replaces the tags of a transaction.
+// A nil slice means no update, while an empty slice removes all tags.
+func WithUpdateTransactionTagIDs(tagIDs []string) TransactionUpdateOption {
+	return func(t *Transaction) {
+		if tagIDs != nil {
+			t.SetTagIDs(tagIDs)
+		}
+	}
+}
+
 type TransactionUpdate struct {
-	Amount            *float64
-	TransactionTime   *uint64
-	Note              *string
-	TransactionStatus *uint32
-	AccountID         *string
-	FromAccountID     *string
-	ToAccountID       *string
-	CategoryID        *string
-	TransactionType   *uint32
-	Currency          *string
-	UpdateTime        *uint64
+	Amount                *float64
+	TransactionTime       *uint64
+	Note                  *string
+	TransactionStatus     *uint32
+	ClearStatus           *uint32
+	AccountID             *string
+	FromAccountID         *string
+	ToAccountID           *string
+	CategoryID            *string
+	TransactionType       *uint32
+	Currency              *string
+	ReceivedAmount        *float64
+	ReceivedCurrency      *string
+	Fee                   *float64
+	ExternalID            *string
+	RefundedTransactionID *string
+	PayeeID               *string
+	Splits                []*TransactionSplit
+	TagIDs                []string
+	UpdateTime            *uint64
 }
 
 func (tu *TransactionUpdate) GetAmount() float64 {
@@ -154,6 +312,13 @@ func (tu *TransactionUpdate) GetTransactionStatus() uint32 {
 	return 0
 }
 
+func (tu *TransactionUpdate) GetClearStatus() uint32 {
+	if tu != nil && tu.ClearStatus != nil {
+		return *tu.ClearStatus
+	}
+	return 0
+}
+
 func (tu *TransactionUpdate) GetNote() string {
 	if tu != nil && tu.Note != nil {
 		return *tu.Note
@@ -210,26 +375,108 @@ func (tu *TransactionUpdate) GetCurrency() string {
 	return ""
 }
 
+func (tu *TransactionUpdate) GetReceivedAmount() float64 {
+	if tu != nil && tu.ReceivedAmount != nil {
+		return *tu.ReceivedAmount
+	}
+	return 0
+}
+
+func (tu *TransactionUpdate) GetReceivedCurrency() string {
+	if tu != nil && tu.ReceivedCurrency != nil {
+		return *tu.ReceivedCurrency
+	}
+	return ""
+}
+
+func (tu *TransactionUpdate) GetFee() float64 {
+	if tu != nil && tu.Fee != nil {
+		return *tu.Fee
+	}
+	return 0
+}
+
+func (tu *TransactionUpdate) GetRefundedTransactionID() string {
+	if tu != nil && tu.RefundedTransactionID != nil {
+		return *tu.RefundedTransactionID
+	}
+	return ""
+}
+
+func (tu *TransactionUpdate) GetPayeeID() string {
+	if tu != nil && tu.PayeeID != nil {
+		return *tu.PayeeID
+	}
+	return ""
+}
+
+func (tu *TransactionUpdate) GetExternalID() string {
+	if tu != nil && tu.ExternalID != nil {
+		return *tu.ExternalID
+	}
+	return ""
+}
+
+func (tu *TransactionUpdate) GetSplits() []*TransactionSplit {
+	if tu != nil && tu.Splits != nil {
+		return tu.Splits
+	}
+	return nil
+}
+
+func (tu *TransactionUpdate) GetTagIDs() []string {
+	if tu != nil && tu.TagIDs != nil {
+		return tu.TagIDs
+	}
+	return nil
+}
+
+// IsClearStatusOnly is true if the update changes only the clear status,
+// which is the one change allowed on a reconciled transaction.
+func (tu *TransactionUpdate) IsClearStatusOnly() bool {
+	if tu == nil || tu.ClearStatus == nil {
+		return false
+	}
+
+	return !goutil.Any(tu.Amount, tu.TransactionTime, tu.Note, tu.TransactionStatus,
+		tu.AccountID, tu.FromAccountID, tu.ToAccountID, tu.CategoryID, tu.TransactionType,
+		tu.Currency, tu.ReceivedAmount, tu.ReceivedCurrency, tu.Fee, tu.ExternalID,
+		tu.RefundedTransactionID, tu.PayeeID, tu.Splits, tu.TagIDs)
+}
+
 type Transaction struct {
-	TransactionID     *string
-	UserID            *string
-	CategoryID        *string
-	AccountID         *string
-	FromAccountID     *string
-	ToAccountID       *string
-	Currency          *string
-	Amount            *float64
-	Note              *string
-	TransactionStatus *uint32
-	TransactionType   *uint32
-	TransactionTime   *uint64
-	CreateTime        *uint64
-	UpdateTime        *uint64
+	TransactionID          *string
+	UserID                 *string
+	CategoryID             *string
+	AccountID              *string
+	FromAccountID          *string
+	ToAccountID            *string
+	Currency               *string
+	Amount                 *float64
+	ReceivedCurrency       *string  // currency credited to the to_account of a transfer
+	ReceivedAmount         *float64 // amount credited to the to_account of a transfer
+	Fee                    *float64 // charged to the from_account of a transfer, in currency
+	Note                   *string
+	TransactionStatus      *uint32
+	ClearStatus            *uint32
+	TransactionType        *uint32
+	TransactionTime        *uint64
+	CreateTime             *uint64
+	UpdateTime             *uint64
+	RecurringTransactionID *string
+	ExternalID             *string
+	RefundedTransactionID  *string // expense refunded by this income, if any
+	RepaymentTransactionID *string // loan repayment this interest is split from, if any
+	PayeeID                *string
+	Splits                 []*TransactionSplit
+	TagIDs                 []string
 
 	Category    *Category
+	Payee       *Payee
 	Account     *Account
 	FromAccount *Account
 	ToAccount   *Account
+	Tags        []*Tag
 }
 
 type TransactionOption func(t *Transaction)
@@ -290,6 +537,30 @@ func WithTransactionCurrency(currency *string) TransactionOption {
 	}
 }
 
+func WithTransactionReceivedAmount(receivedAmount *float64) TransactionOption {
+	return func(t *Transaction) {
+		if receivedAmount != nil {
+			t.SetReceivedAmount(receivedAmount)
+		}
+	}
+}
+
+func WithTransactionReceivedCurrency(receivedCurrency *string) TransactionOption {
+	return func(t *Transaction) {
+		if receivedCurrency != nil {
+			t.SetReceivedCurrency(receivedCurrency)
+		}
+	}
+}
+
+func WithTransactionFee(fee *float64) TransactionOption {
+	return func(t *Transaction) {
+		if fee != nil {
+			t.SetFee(fee)
+		}
+	}
+}
+
 func WithTransactionNote(note *string) TransactionOption {
 	return func(t *Transaction) {
 		if note != nil {
@@ -306,6 +577,14 @@ func WithTransactionStatus(transactionStatus *uint32) TransactionOption {
 	}
 }
 
+func WithTransactionClearStatus(clearStatus *uint32) TransactionOption {
+	return func(t *Transaction) {
+		if clearStatus != nil {
+			t.SetClearStatus(clearStatus)
+		}
+	}
+}
+
 func WithTransactionType(transactionType *uint32) TransactionOption {
 	return func(t *Transaction) {
 		if transactionType != nil {
@@ -322,6 +601,62 @@ func WithTransactionTime(transactionTime *uint64) TransactionOption {
 	}
 }
 
+func WithTransactionRecurringTransactionID(recurringTransactionID *string) TransactionOption {
+	return func(t *Transaction) {
+		if recurringTransactionID != nil {
+			t.SetRecurringTransactionID(recurringTransactionID)
+		}
+	}
+}
+
+func WithTransactionPayeeID(payeeID *string) TransactionOption {
+	return func(t *Transaction) {
+		if payeeID != nil {
+			t.SetPayeeID(payeeID)
+		}
+	}
+}
+
+func WithTransactionRefundedTransactionID(refundedTransactionID *string) TransactionOption {
+	return func(t *Transaction) {
+		if refundedTransactionID != nil {
+			t.SetRefundedTransactionID(refundedTransactionID)
+		}
+	}
+}
+
+func WithTransactionRepaymentTransactionID(repaymentTransactionID *string) TransactionOption {
+	return func(t *Transaction) {
+		if repaymentTransactionID != nil {
+			t.SetRepaymentTransactionID(repaymentTransactionID)
+		}
+	}
+}
+
+func WithTransactionExternalID(externalID *string) TransactionOption {
+	return func(t *Transaction) {
+		if externalID != nil {
+			t.SetExternalID(externalID)
+		}
+	}
+}
+
+func WithTransactionSplits(splits []*TransactionSplit) TransactionOption {
+	return func(t *Transaction) {
+		if splits != nil {
+			t.SetSplits(splits)
+		}
+	}
+}
+
+func WithTransactionTagIDs(tagIDs []string) TransactionOption {
+	return func(t *Transaction) {
+		if tagIDs != nil {
+			t.SetTagIDs(tagIDs)
+		}
+	}
+}
+
 func WithTransactionCreateTime(createTime *uint64) TransactionOption {
 	return func(t *Transaction) {
 		if createTime != nil {
@@ -353,10 +688,48 @@ func (t *Transaction) Clone() (*Transaction, error) {
 		WithTransactionCreateTime(t.CreateTime),
 		WithTransactionUpdateTime(t.UpdateTime),
 		WithTransactionStatus(t.TransactionStatus),
+		WithTransactionClearStatus(t.ClearStatus),
 		WithTransactionCurrency(t.Currency),
+		WithTransactionReceivedAmount(t.ReceivedAmount),
+		WithTransactionReceivedCurrency(t.ReceivedCurrency),
+		WithTransactionFee(t.Fee),
+		WithTransactionRecurringTransactionID(t.RecurringTransactionID),
+		WithTransactionExternalID(t.ExternalID),
+		WithTransactionRefundedTransactionID(t.RefundedTransactionID),
+		WithTransactionRepaymentTransactionID(t.RepaymentTransactionID),
+		WithTransactionPayeeID(t.PayeeID),
+		WithTransactionSplits(t.cloneSplits()),
+		WithTransactionTagIDs(t.cloneTagIDs()),
 	)
 }
 
+func (t *Transaction) cloneSplits() []*TransactionSplit {
+	if t.Splits == nil {
+		return nil
+	}
+
+	splits := make([]*TransactionSplit, 0, len(t.Splits))
+	for _, split := range t.Splits {
+		splits = append(splits, &TransactionSplit{
+			CategoryID: split.CategoryID,
+			Amount:     split.Amount,
+			Category:   split.Category,
+		})
+	}
+	return splits
+}
+
+func (t *Transaction) cloneTagIDs() []string {
+	if t.TagIDs == nil {
+		return nil
+	}
+
+	tagIDs := make([]string, len(t.TagIDs))
+	copy(tagIDs, t.TagIDs)
+
+	return tagIDs
+}
+
 func NewTransaction(userID string, opts ...TransactionOption) (*Transaction, error) {
 	now := uint64(time.Now().UnixMilli())
 	t := &Transaction{
@@ -368,8 +741,12 @@ func NewTransaction(userID string, opts ...TransactionOption) (*Transaction, err
 		FromAccountID:     goutil.String(""),
 		ToAccountID:       goutil.String(""),
 		Amount:            goutil.Float64(0),
+		ReceivedAmount:    goutil.Float64(0),
+		ReceivedCurrency:  goutil.String(""),
+		Fee:               goutil.Float64(0),
 		Note:              goutil.String(""),
 		TransactionStatus: goutil.Uint32(uint32(TransactionStatusNormal)),
+		ClearStatus:       goutil.Uint32(uint32(ClearStatusPending)),
 		TransactionType:   goutil.Uint32(uint32(TransactionTypeExpense)),
 		TransactionTime:   goutil.Uint64(now),
 		CreateTime:        goutil.Uint64(now),
@@ -387,6 +764,10 @@ func NewTransaction(userID string, opts ...TransactionOption) (*Transaction, err
 }
 
 func (t *Transaction) validate() error {
+	if _, ok := ClearStatuses[t.GetClearStatus()]; !ok {
+		return ErrInvalidClearStatus
+	}
+
 	if t.IsExpense() {
 		if t.GetAmount() > 0 {
 			t.SetAmount(goutil.Float64(-t.GetAmount()))
@@ -407,6 +788,15 @@ func (t *Transaction) validate() error {
 		}
 
 		t.SetAmount(goutil.Float64(math.Abs(t.GetAmount()))) // always positive
+		t.SetReceivedAmount(goutil.Float64(math.Abs(t.GetReceivedAmount())))
+		t.SetFee(goutil.Float64(math.Abs(t.GetFee())))
+
+		if t.GetReceivedAmount() == 0 {
+			t.ReceivedCurrency = goutil.String("")
+		} else if t.GetReceivedCurrency() == "" {
+			t.ReceivedCurrency = t.Currency
+		}
+
 		t.AccountID = goutil.String("")
 		t.CategoryID = goutil.String("")
 	} else {
@@ -417,11 +807,69 @@ func (t *Transaction) validate() error {
 
 		t.FromAccountID = goutil.String("")
 		t.ToAccountID = goutil.String("")
+		t.ReceivedAmount = goutil.Float64(0)
+		t.ReceivedCurrency = goutil.String("")
+		t.Fee = goutil.Float64(0)
+	}
+
+	if t.IsSplit() {
+		if err := t.validateSplits(); err != nil {
+			return err
+		}
+	}
+
+	if t.GetRefundedTransactionID() != "" {
+		if !t.IsIncome() {
+			return ErrInvalidRefund
+		}
+
+		if t.IsSplit() {
+			return errors.New("refund cannot be split")
+		}
+	}
+
+	if t.TagIDs != nil {
+		t.SetTagIDs(goutil.RemoveDuplicateString(t.TagIDs))
 	}
 
 	return nil
 }
 
+func (t *Transaction) validateSplits() error {
+	if t.IsTransfer() {
+		return errors.New("transfer cannot be split")
+	}
+
+	if len(t.Splits) < 2 {
+		return errors.New("split transaction must have at least 2 splits")
+	}
+
+	var sum float64
+	for _, split := range t.Splits {
+		if split.GetCategoryID() == "" {
+			return errors.New("split must have category_id")
+		}
+
+		// split amounts follow the sign of the transaction
+		amount := math.Abs(split.GetAmount())
+		if t.IsExpense() {
+			amount = -amount
+		}
+		split.SetAmount(goutil.Float64(amount))
+
+		sum += amount
+	}
+
+	if util.RoundFloatToStandardDP(sum) != t.GetAmount() {
+		return ErrInvalidTransactionSplits
+	}
+
+	// category of a split transaction is kept in its splits
+	t.CategoryID = goutil.String("")
+
+	return nil
+}
+
 func (t *Transaction) ToTransactionUpdate(old *Transaction) *TransactionUpdate {
 	var (
 		hasUpdate bool
@@ -451,6 +899,11 @@ func (t *Transaction) ToTransactionUpdate(old *Transaction) *TransactionUpdate {
 		tu.TransactionStatus = t.TransactionStatus
 	}
 
+	if old.GetClearStatus() != t.GetClearStatus() {
+		hasUpdate = true
+		tu.ClearStatus = t.ClearStatus
+	}
+
 	if old.GetAccountID() != t.GetAccountID() {
 		hasUpdate = true
 		tu.AccountID = t.AccountID
@@ -481,6 +934,52 @@ func (t *Transaction) ToTransactionUpdate(old *Transaction) *TransactionUpdate {
 		tu.Currency = t.Currency
 	}
 
+	if old.GetReceivedAmount() != t.GetReceivedAmount() {
+		hasUpdate = true
+		tu.ReceivedAmount = t.ReceivedAmount
+	}
+
+	if old.GetReceivedCurrency() != t.GetReceivedCurrency() {
+		hasUpdate = true
+		tu.ReceivedCurrency = t.ReceivedCurrency
+	}
+
+	if old.GetFee() != t.GetFee() {
+		hasUpdate = true
+		tu.Fee = t.Fee
+	}
+
+	if old.GetExternalID() != t.GetExternalID() {
+		hasUpdate = true
+		tu.ExternalID = t.ExternalID
+	}
+
+	if old.GetRefundedTransactionID() != t.GetRefundedTransactionID() {
+		hasUpdate = true
+		tu.RefundedTransactionID = t.RefundedTransactionID
+	}
+
+	if old.GetPayeeID() != t.GetPayeeID() {
+		hasUpdate = true
+		tu.PayeeID = t.PayeeID
+	}
+
+	if !isSameSplits(old.Splits, t.Splits) {
+		hasUpdate = true
+		tu.Splits = t.Splits
+		if tu.Splits == nil {
+			tu.Splits = make([]*TransactionSplit, 0)
+		}
+	}
+
+	if !isSameTagIDs(old.TagIDs, t.TagIDs) {
+		hasUpdate = true
+		tu.TagIDs = t.TagIDs
+		if tu.TagIDs == nil {
+			tu.TagIDs = make([]string, 0)
+		}
+	}
+
 	if hasUpdate {
 		return tu
 	}
@@ -513,13 +1012,57 @@ func (t *Transaction) Update(tus ...TransactionUpdateOption) (*TransactionUpdate
 	return t.ToTransactionUpdate(old), nil
 }
 
+func isSameSplits(a, b []*TransactionSplit) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i].GetCategoryID() != b[i].GetCategoryID() || a[i].GetAmount() != b[i].GetAmount() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isSameTagIDs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (t *Transaction) CanTransactionUnderCategory(c *Category) error {
+	// a refund is netted against the expense category it refunds
+	if t.IsRefund() {
+		if c.GetCategoryType() != uint32(TransactionTypeExpense) {
+			return ErrMismatchTransactionType
+		}
+		return nil
+	}
+
 	if t.GetTransactionType() != c.GetCategoryType() {
 		return ErrMismatchTransactionType
 	}
 	return nil
 }
 
+// CanRefund checks if the transaction can be a refund of the original transaction.
+func (t *Transaction) CanRefund(original *Transaction) error {
+	if !t.IsIncome() || !original.IsExpense() || t.GetCurrency() != original.GetCurrency() {
+		return ErrInvalidRefund
+	}
+	return nil
+}
+
 func (t *Transaction) CanTransactionUnderAccount(ac *Account) error {
 	if !ac.CanSetBalance() {
 		return ErrInvalidTransactionAccount
@@ -620,6 +1163,68 @@ func (t *Transaction) SetAmount(amount *float64) {
 	}
 }
 
+func (t *Transaction) GetReceivedAmount() float64 {
+	if t != nil && t.ReceivedAmount != nil {
+		return *t.ReceivedAmount
+	}
+	return 0
+}
+
+func (t *Transaction) SetReceivedAmount(receivedAmount *float64) {
+	t.ReceivedAmount = receivedAmount
+
+	if receivedAmount != nil {
+		am := util.RoundFloatToStandardDP(*receivedAmount)
+		t.ReceivedAmount = goutil.Float64(am)
+	}
+}
+
+func (t *Transaction) GetReceivedCurrency() string {
+	if t != nil && t.ReceivedCurrency != nil {
+		return *t.ReceivedCurrency
+	}
+	return ""
+}
+
+func (t *Transaction) SetReceivedCurrency(receivedCurrency *string) {
+	t.ReceivedCurrency = receivedCurrency
+}
+
+func (t *Transaction) GetFee() float64 {
+	if t != nil && t.Fee != nil {
+		return *t.Fee
+	}
+	return 0
+}
+
+func (t *Transaction) SetFee(fee *float64) {
+	t.Fee = fee
+
+	if fee != nil {
+		am := util.RoundFloatToStandardDP(*fee)
+		t.Fee = goutil.Float64(am)
+	}
+}
+
+// GetSentAmount is the amount debited from the from_account of a transfer, fee included.
+func (t *Transaction) GetSentAmount() float64 {
+	return util.RoundFloatToStandardDP(t.GetAmount() + t.GetFee())
+}
+
+// HasReceivedAmount tells if a transfer records the amount credited to its to_account.
+// Otherwise, the to_account is credited the amount at the stored exchange rate.
+func (t *Transaction) HasReceivedAmount() bool {
+	return t.IsTransfer() && t.GetReceivedAmount() > 0
+}
+
+// GetImpliedRate is the received amount per unit of amount sent, excluding fee.
+func (t *Transaction) GetImpliedRate() float64 {
+	if !t.HasReceivedAmount() || t.GetAmount() == 0 {
+		return 0
+	}
+	return util.RoundFloatToPreciseDP(t.GetReceivedAmount() / t.GetAmount())
+}
+
 func (t *Transaction) GetNote() string {
 	if t != nil && t.Note != nil {
 		return *t.Note
@@ -642,6 +1247,26 @@ func (t *Transaction) SetTransactionStatus(transactionStatus *uint32) {
 	t.TransactionStatus = transactionStatus
 }
 
+func (t *Transaction) GetClearStatus() uint32 {
+	if t != nil && t.ClearStatus != nil {
+		return *t.ClearStatus
+	}
+	return 0
+}
+
+func (t *Transaction) SetClearStatus(clearStatus *uint32) {
+	t.ClearStatus = clearStatus
+}
+
+// IsCleared is true for cleared and reconciled transactions.
+func (t *Transaction) IsCleared() bool {
+	return t.GetClearStatus() == uint32(ClearStatusCleared) || t.IsReconciled()
+}
+
+func (t *Transaction) IsReconciled() bool {
+	return t.GetClearStatus() == uint32(ClearStatusReconciled)
+}
+
 func (t *Transaction) GetTransactionType() uint32 {
 	if t != nil && t.TransactionType != nil {
 		return *t.TransactionType
@@ -686,6 +1311,137 @@ func (t *Transaction) SetUpdateTime(updateTime *uint64) {
 	t.UpdateTime = updateTime
 }
 
+func (t *Transaction) GetSplits() []*TransactionSplit {
+	if t != nil && t.Splits != nil {
+		return t.Splits
+	}
+	return nil
+}
+
+func (t *Transaction) SetSplits(splits []*TransactionSplit) {
+	t.Splits = splits
+}
+
+func (t *Transaction) IsSplit() bool {
+	return len(t.Splits) > 0
+}
+
+// GetSplitCategoryIDs returns the category IDs of the splits.
+func (t *Transaction) GetSplitCategoryIDs() []string {
+	categoryIDs := make([]string, 0)
+	for _, split := range t.Splits {
+		categoryIDs = append(categoryIDs, split.GetCategoryID())
+	}
+	return categoryIDs
+}
+
+// GetCategoryAmounts returns the amount attributed to each category.
+// A split transaction contributes each split to its own category,
+// otherwise the whole amount goes to the transaction category.
+func (t *Transaction) GetCategoryAmounts() map[string]float64 {
+	amounts := make(map[string]float64)
+
+	if !t.IsSplit() {
+		amounts[t.GetCategoryID()] = t.GetAmount()
+		return amounts
+	}
+
+	for _, split := range t.Splits {
+		amounts[split.GetCategoryID()] += split.GetAmount()
+	}
+
+	return amounts
+}
+
+// GetAmountByCategories returns the portion of the amount under the given categories.
+func (t *Transaction) GetAmountByCategories(categoryIDs ...string) float64 {
+	var (
+		amount  float64
+		amounts = t.GetCategoryAmounts()
+	)
+	for _, categoryID := range goutil.RemoveDuplicateString(categoryIDs) {
+		amount += amounts[categoryID]
+	}
+	return amount
+}
+
+func (t *Transaction) GetTagIDs() []string {
+	if t != nil && t.TagIDs != nil {
+		return t.TagIDs
+	}
+	return nil
+}
+
+func (t *Transaction) SetTagIDs(tagIDs []string) {
+	t.TagIDs = tagIDs
+}
+
+func (t *Transaction) GetTags() []*Tag {
+	if t != nil && t.Tags != nil {
+		return t.Tags
+	}
+	return nil
+}
+
+func (t *Transaction) SetTags(tags []*Tag) {
+	t.Tags = tags
+}
+
+func (t *Transaction) GetRecurringTransactionID() string {
+	if t != nil && t.RecurringTransactionID != nil {
+		return *t.RecurringTransactionID
+	}
+	return ""
+}
+
+func (t *Transaction) SetRecurringTransactionID(recurringTransactionID *string) {
+	t.RecurringTransactionID = recurringTransactionID
+}
+
+func (t *Transaction) GetRefundedTransactionID() string {
+	if t != nil && t.RefundedTransactionID != nil {
+		return *t.RefundedTransactionID
+	}
+	return ""
+}
+
+func (t *Transaction) SetRefundedTransactionID(refundedTransactionID *string) {
+	t.RefundedTransactionID = refundedTransactionID
+}
+
+func (t *Transaction) GetRepaymentTransactionID() string {
+	if t != nil && t.RepaymentTransactionID != nil {
+		return *t.RepaymentTransactionID
+	}
+	return ""
+}
+
+func (t *Transaction) SetRepaymentTransactionID(repaymentTransactionID *string) {
+	t.RepaymentTransactionID = repaymentTransactionID
+}
+
+func (t *Transaction) GetPayeeID() string {
+	if t != nil && t.PayeeID != nil {
+		return *t.PayeeID
+	}
+	return ""
+}
+
+func (t *Transaction) SetPayeeID(payeeID *string) {
+	t.PayeeID = payeeID
+}
+
+func (t *Transaction) GetExternalID() string {
+	if t != nil && t.ExternalID != nil {
+		return *t.ExternalID
+	}
+	return ""
+}
+
+func (t *Transaction) SetExternalID(externalID *string) {
+	t.ExternalID = externalID
+}
+
 func (t *Transaction) GetCategory() *Category {
 	if t != nil && t.Category != nil {
 		return t.Category
@@ -697,6 +1453,17 @@ func (t *Transaction) SetCategory(c *Category) {
 	t.Category = c
 }
 
+func (t *Transaction) GetPayee() *Payee {
+	if t != nil && t.Payee != nil {
+		return t.Payee
+	}
+	return nil
+}
+
+func (t *Transaction) SetPayee(p *Payee) {
+	t.Payee = p
+}
+
 func (t *Transaction) GetAccount() *Account {
 	if t != nil && t.Account != nil {
 		return t.Account
@@ -742,6 +1509,10 @@ func (t *Transaction) IsTransfer() bool {
 	return t.GetTransactionType() == uint32(TransactionTypeTransfer)
 }
 
+func (t *Transaction) IsRefund() bool {
+	return t.IsIncome() && t.GetRefundedTransactionID() != ""
+}
+
 func GetTransactionTypeByAmount(amount float64) TransactionType {
 	if amount <= 0 {
 		return TransactionTypeExpense
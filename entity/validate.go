@@ -3,6 +3,7 @@ package entity
 import (
 	"errors"
 	"net/mail"
+	"regexp"
 	"time"
 
 	"github.com/jseow5177/pockteer-be/config"
@@ -13,23 +14,32 @@ import (
 )
 
 var (
-	ErrInvalidBudgetRepeat     = errutil.ValidationError(errors.New("invalid budget repeat"))
-	ErrInvalidCurrency         = errutil.ValidationError(errors.New("invalid currency"))
-	ErrInvalidEmail            = errutil.ValidationError(errors.New("invalid email"))
-	ErrInvalidDate             = errutil.ValidationError(errors.New("invalid date"))
-	ErrInvalidTimezone         = errutil.ValidationError(errors.New("invalid timezone"))
-	ErrInvalidHoldingType      = errutil.ValidationError(errors.New("invalid holding type"))
-	ErrInvalidChildAccountType = errutil.ValidationError(errors.New("invalid child account type"))
-	ErrInvalidAccountType      = errutil.ValidationError(errors.New("invalid account type"))
-	ErrInvalidTransactionType  = errutil.ValidationError(errors.New("invalid transaction type"))
-	ErrInvalidCategoryType     = errutil.ValidationError(errors.New("invalid category type"))
-	ErrInvalidBudgetType       = errutil.ValidationError(errors.New("invalid budget type"))
-	ErrInvalidMetricType       = errutil.ValidationError(errors.New("invalid metric type"))
-	ErrInvalidMonetaryStr      = errutil.ValidationError(errors.New("invalid monetary str"))
-	ErrInvalidTransactionSumBy = errutil.ValidationError(errors.New("invalid transactions sum by"))
-	ErrInvalidSnapshotUnit     = errutil.ValidationError(errors.New("invalid snapshot unit"))
-	ErrInvalidSnapshotType     = errutil.ValidationError(errors.New("invalid snapshot type"))
-	ErrMustBePositive          = errutil.ValidationError(errors.New("must be positive"))
+	ErrInvalidBudgetRepeat                  = errutil.ValidationError(errors.New("invalid budget repeat"))
+	ErrInvalidBudgetConflict                = errutil.ValidationError(errors.New("invalid budget conflict"))
+	ErrInvalidCurrency                      = errutil.ValidationError(errors.New("invalid currency"))
+	ErrInvalidEmail                         = errutil.ValidationError(errors.New("invalid email"))
+	ErrInvalidDate                          = errutil.ValidationError(errors.New("invalid date"))
+	ErrInvalidTimezone                      = errutil.ValidationError(errors.New("invalid timezone"))
+	ErrInvalidHoldingType                   = errutil.ValidationError(errors.New("invalid holding type"))
+	ErrInvalidChildAccountType              = errutil.ValidationError(errors.New("invalid child account type"))
+	ErrInvalidAccountType                   = errutil.ValidationError(errors.New("invalid account type"))
+	ErrInvalidTransactionType               = errutil.ValidationError(errors.New("invalid transaction type"))
+	ErrInvalidCategoryType                  = errutil.ValidationError(errors.New("invalid category type"))
+	ErrInvalidBudgetType                    = errutil.ValidationError(errors.New("invalid budget type"))
+	ErrInvalidMetricType                    = errutil.ValidationError(errors.New("invalid metric type"))
+	ErrInvalidMonetaryStr                   = errutil.ValidationError(errors.New("invalid monetary str"))
+	ErrInvalidTransactionSumBy              = errutil.ValidationError(errors.New("invalid transactions sum by"))
+	ErrInvalidSnapshotUnit                  = errutil.ValidationError(errors.New("invalid snapshot unit"))
+	ErrInvalidSnapshotType                  = errutil.ValidationError(errors.New("invalid snapshot type"))
+	ErrMustBePositive                       = errutil.ValidationError(errors.New("must be positive"))
+	ErrInvalidRecurringTransactionFrequency = errutil.ValidationError(errors.New("invalid recurring transaction frequency"))
+	ErrInvalidImportDateFormat              = errutil.ValidationError(errors.New("invalid import date format"))
+	ErrInvalidImportAmountSign              = errutil.ValidationError(errors.New("invalid import amount sign"))
+	ErrInvalidImportFormat                  = errutil.ValidationError(errors.New("invalid import format"))
+	ErrInvalidClearStatus                   = errutil.ValidationError(errors.New("invalid clear status"))
+	ErrInvalidCompoundFrequency             = errutil.ValidationError(errors.New("invalid compound frequency"))
+	ErrInvalidColor                         = errutil.ValidationError(errors.New("invalid color"))
+	ErrInvalidWeekday                       = errutil.ValidationError(errors.New("invalid weekday"))
 )
 
 func CheckMetricType(metricType uint32) error {
@@ -60,6 +70,16 @@ func CheckCurrency(currency string) error {
 	return nil
 }
 
+var colorRegex = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// CheckColor accepts a hex colour code such as #1e90ff.
+func CheckColor(color string) error {
+	if !colorRegex.MatchString(color) {
+		return ErrInvalidColor
+	}
+	return nil
+}
+
 func CheckEmail(email string) error {
 	if _, err := mail.ParseAddress(email); err != nil {
 		return ErrInvalidEmail
@@ -74,6 +94,20 @@ func CheckBudgetRepeat(budgetRepeat uint32) error {
 	return nil
 }
 
+func CheckBudgetConflict(budgetConflict uint32) error {
+	if _, ok := BudgetConflicts[budgetConflict]; !ok {
+		return ErrInvalidBudgetConflict
+	}
+	return nil
+}
+
+func CheckWeekday(weekday uint32) error {
+	if weekday > uint32(time.Saturday) {
+		return ErrInvalidWeekday
+	}
+	return nil
+}
+
 func CheckDateStr(date string) error {
 	if _, err := util.ParseDate(date); err != nil {
 		return ErrInvalidDate
@@ -125,6 +159,14 @@ func CheckTransactionType(transactionType uint32) error {
 	return nil
 }
 
+// CheckClearStatus allows pending and cleared only, transactions are reconciled by reconciling the account.
+func CheckClearStatus(clearStatus uint32) error {
+	if clearStatus != uint32(ClearStatusPending) && clearStatus != uint32(ClearStatusCleared) {
+		return ErrInvalidClearStatus
+	}
+	return nil
+}
+
 func CheckBudgetType(budgetType uint32) error {
 	if _, ok := BudgetTypes[budgetType]; !ok {
 		return ErrInvalidTransactionType
@@ -132,6 +174,20 @@ func CheckBudgetType(budgetType uint32) error {
 	return nil
 }
 
+func CheckRecurringTransactionFrequency(frequency uint32) error {
+	if _, ok := RecurringTransactionFrequencies[frequency]; !ok {
+		return ErrInvalidRecurringTransactionFrequency
+	}
+	return nil
+}
+
+func CheckCompoundFrequency(frequency uint32) error {
+	if _, ok := CompoundFrequencies[frequency]; !ok {
+		return ErrInvalidCompoundFrequency
+	}
+	return nil
+}
+
 func CheckMonetaryStr(str string) error {
 	if _, err := util.MonetaryStrToFloat(str); err != nil {
 		return ErrInvalidMonetaryStr
@@ -176,3 +232,24 @@ func AppMetaValidator() validator.Validator {
 		},
 	})
 }
+
+func CheckImportDateFormat(dateFormat uint32) error {
+	if _, ok := ImportDateFormats[dateFormat]; !ok {
+		return ErrInvalidImportDateFormat
+	}
+	return nil
+}
+
+func CheckImportAmountSign(amountSign uint32) error {
+	if _, ok := ImportAmountSigns[amountSign]; !ok {
+		return ErrInvalidImportAmountSign
+	}
+	return nil
+}
+
+func CheckImportFormat(format uint32) error {
+	if _, ok := ImportFormats[format]; !ok {
+		return ErrInvalidImportFormat
+	}
+	return nil
+}
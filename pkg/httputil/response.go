@@ -3,6 +3,7 @@ package httputil
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/jseow5177/pockteer-be/pkg/errutil"
@@ -34,3 +35,23 @@ func ReturnServerResponse(w http.ResponseWriter, res interface{}, resErr error)
 		fmt.Printf("fail to return server response, err: %v\n", err)
 	}
 }
+
+// StreamResponse is a response written as is to the client instead of wrapped in json.
+type StreamResponse interface {
+	ContentType() string
+	FileName() string
+	Stream(w io.Writer) error
+}
+
+func ReturnStreamResponse(w http.ResponseWriter, res StreamResponse) {
+	w.Header().Set("Content-Type", res.ContentType())
+	if res.FileName() != "" {
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", res.FileName()))
+	}
+	w.WriteHeader(http.StatusOK)
+
+	// headers are sent, an error can only be logged
+	if err := res.Stream(w); err != nil {
+		fmt.Printf("fail to stream server response, err: %v\n", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/iancoleman/strcase"
@@ -38,6 +39,7 @@ var filterOps = map[string]string{
 	"lte":        "less than equal",
 	"in":         "in",
 	"nin":        "not in",
+	"all":        "all",
 	"bitsAllSet": "bit all set",
 	"regex":      "regex",
 }
@@ -143,8 +145,14 @@ func BuildFilter(filter interface{}) bson.D {
 		// filter value
 		v := fv.Interface()
 
+		// handle nested field, e.g. splits.category_id
+		fns := strings.Split(parts[0], ".")
+		for i, fn := range fns {
+			fns[i] = strcase.ToSnake(fn)
+		}
+
 		// handle _id field
-		fn := strcase.ToSnake(parts[0])
+		fn := strings.Join(fns, ".")
 		if fn == "_id" {
 			if fk == reflect.Slice {
 				ids := make([]primitive.ObjectID, 0)
@@ -223,3 +231,19 @@ func BuildQuery(query filter.Query) (bson.D, error) {
 
 	return composedQuery, nil
 }
+
+// BuildTextSearch matches documents containing every term in the text index.
+// Each term is quoted as a phrase, since unquoted terms are matched with or.
+func BuildTextSearch(query bson.D, terms []string) bson.D {
+	phrases := make([]string, 0, len(terms))
+	for _, term := range terms {
+		phrases = append(phrases, strconv.Quote(term))
+	}
+
+	search := bson.D{{Key: Prefix("text"), Value: bson.D{{Key: Prefix("search"), Value: strings.Join(phrases, " ")}}}}
+	if len(query) == 0 {
+		return search
+	}
+
+	return bson.D{{Key: Prefix("and"), Value: bson.A{query, search}}}
+}
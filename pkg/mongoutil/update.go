@@ -32,6 +32,11 @@ func buildUpdate(update interface{}) map[string]interface{} {
 			continue
 		}
 
+		// handle nil slice, an empty slice is still set
+		if fk == reflect.Slice && fv.IsNil() {
+			continue
+		}
+
 		parts := strings.SplitN(fn, ",", 2)
 		if len(parts) > 1 {
 			f := parts[0]
@@ -77,5 +77,11 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	err := h.HandleFunc(r.Context(), req, res)
+
+	if sr, ok := res.(httputil.StreamResponse); ok && err == nil {
+		httputil.ReturnStreamResponse(w, sr)
+		return
+	}
+
 	httputil.ReturnServerResponse(w, res, err)
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/jseow5177/pockteer-be/config"
 	"github.com/jseow5177/pockteer-be/dep/repo"
 	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/errutil"
 	"github.com/jseow5177/pockteer-be/pkg/filter"
 	"github.com/jseow5177/pockteer-be/pkg/goutil"
 	"github.com/jseow5177/pockteer-be/usecase/common"
@@ -18,6 +19,11 @@ import (
 
 var (
 	ErrInvalidUpdateMode = errors.New("invalid update mode")
+	ErrNotLoan           = errutil.ValidationError(errors.New("account is not a loan or mortgage"))
+	ErrNoAmortisation    = errutil.ValidationError(errors.New("principal, term and start_time of the loan are not set"))
+	ErrNoInterestAccrual = errutil.ValidationError(errors.New("account does not earn interest"))
+	ErrInterestCategory  = errutil.ValidationError(errors.New("interest category must be an income category"))
+	ErrProjectTimeInPast = errutil.ValidationError(errors.New("project_time must be in the future"))
 )
 
 type UseCase interface {
@@ -28,6 +34,14 @@ type UseCase interface {
 	CreateAccount(ctx context.Context, req *CreateAccountRequest) (*CreateAccountResponse, error)
 	UpdateAccount(ctx context.Context, req *UpdateAccountRequest) (*UpdateAccountResponse, error)
 	DeleteAccount(ctx context.Context, req *DeleteAccountRequest) (*DeleteAccountResponse, error)
+
+	GetDeletedAccounts(ctx context.Context, req *GetDeletedAccountsRequest) (*GetDeletedAccountsResponse, error)
+	RestoreAccount(ctx context.Context, req *RestoreAccountRequest) (*RestoreAccountResponse, error)
+
+	GetAmortisationSchedule(ctx context.Context, req *GetAmortisationScheduleRequest) (*GetAmortisationScheduleResponse, error)
+
+	PostInterest(ctx context.Context, req *PostInterestRequest) (*PostInterestResponse, error)
+	GetInterestProjection(ctx context.Context, req *GetInterestProjectionRequest) (*GetInterestProjectionResponse, error)
 }
 
 type GetAccountRequest struct {
@@ -103,6 +117,19 @@ func (m *GetAccountsRequest) ToAccountFilter() *repo.AccountFilter {
 	return repo.NewAccountFilter(
 		m.GetUserID(),
 		repo.WitAccountType(m.AccountType),
+		repo.WithAccountPaging(&repo.Paging{
+			Sorts: []filter.Sort{
+				// in the order set by the user, then by creation
+				&repo.Sort{
+					Field: goutil.String("position"),
+					Order: goutil.String(config.OrderAsc),
+				},
+				&repo.Sort{
+					Field: goutil.String("create_time"),
+					Order: goutil.String(config.OrderAsc),
+				},
+			},
+		}),
 	)
 }
 
@@ -191,6 +218,25 @@ type CreateAccountRequest struct {
 	Note        *string
 	AccountType *uint32
 	Currency    *string
+	Icon        *string
+	Color       *string
+
+	// Credit card
+	StatementDay *uint32
+	DueDay       *uint32
+	CreditLimit  *float64
+
+	// Loan and bank account
+	InterestRate *float64
+
+	// Loan
+	Principal *float64
+	Term      *uint32
+	StartTime *uint64
+
+	// Bank account
+	CompoundFrequency  *uint32
+	InterestCategoryID *string
 
 	Holdings []*holding.CreateHoldingRequest // only for InitUser
 }
@@ -230,6 +276,20 @@ func (m *CreateAccountRequest) GetNote() string {
 	return ""
 }
 
+func (m *CreateAccountRequest) GetIcon() string {
+	if m != nil && m.Icon != nil {
+		return *m.Icon
+	}
+	return ""
+}
+
+func (m *CreateAccountRequest) GetColor() string {
+	if m != nil && m.Color != nil {
+		return *m.Color
+	}
+	return ""
+}
+
 func (m *CreateAccountRequest) GetAccountType() uint32 {
 	if m != nil && m.AccountType != nil {
 		return *m.AccountType
@@ -237,6 +297,69 @@ func (m *CreateAccountRequest) GetAccountType() uint32 {
 	return 0
 }
 
+func (m *CreateAccountRequest) GetStatementDay() uint32 {
+	if m != nil && m.StatementDay != nil {
+		return *m.StatementDay
+	}
+	return 0
+}
+
+func (m *CreateAccountRequest) GetDueDay() uint32 {
+	if m != nil && m.DueDay != nil {
+		return *m.DueDay
+	}
+	return 0
+}
+
+func (m *CreateAccountRequest) GetCreditLimit() float64 {
+	if m != nil && m.CreditLimit != nil {
+		return *m.CreditLimit
+	}
+	return 0
+}
+
+func (m *CreateAccountRequest) GetPrincipal() float64 {
+	if m != nil && m.Principal != nil {
+		return *m.Principal
+	}
+	return 0
+}
+
+func (m *CreateAccountRequest) GetInterestRate() float64 {
+	if m != nil && m.InterestRate != nil {
+		return *m.InterestRate
+	}
+	return 0
+}
+
+func (m *CreateAccountRequest) GetTerm() uint32 {
+	if m != nil && m.Term != nil {
+		return *m.Term
+	}
+	return 0
+}
+
+func (m *CreateAccountRequest) GetStartTime() uint64 {
+	if m != nil && m.StartTime != nil {
+		return *m.StartTime
+	}
+	return 0
+}
+
+func (m *CreateAccountRequest) GetCompoundFrequency() uint32 {
+	if m != nil && m.CompoundFrequency != nil {
+		return *m.CompoundFrequency
+	}
+	return 0
+}
+
+func (m *CreateAccountRequest) GetInterestCategoryID() string {
+	if m != nil && m.InterestCategoryID != nil {
+		return *m.InterestCategoryID
+	}
+	return ""
+}
+
 func (m *CreateAccountRequest) GetHoldings() []*holding.CreateHoldingRequest {
 	if m != nil && m.Holdings != nil {
 		return m.Holdings
@@ -257,7 +380,30 @@ func (m *CreateAccountRequest) ToAccountEntity() (*entity.Account, error) {
 		entity.WithAccountType(m.AccountType),
 		entity.WithAccountNote(m.Note),
 		entity.WithAccountCurrency(m.Currency),
+		entity.WithAccountIcon(m.Icon),
+		entity.WithAccountColor(m.Color),
 		entity.WithAccountHoldings(hs),
+		entity.WithAccountStatementDay(m.StatementDay),
+		entity.WithAccountDueDay(m.DueDay),
+		entity.WithAccountCreditLimit(m.CreditLimit),
+		entity.WithAccountPrincipal(m.Principal),
+		entity.WithAccountInterestRate(m.InterestRate),
+		entity.WithAccountTerm(m.Term),
+		entity.WithAccountStartTime(m.StartTime),
+		entity.WithAccountCompoundFrequency(m.CompoundFrequency),
+		entity.WithAccountInterestCategoryID(m.InterestCategoryID),
+	)
+}
+
+// ToAccountsFilter gets all accounts of the user to place the new account last.
+func (m *CreateAccountRequest) ToAccountsFilter() *repo.AccountFilter {
+	return repo.NewAccountFilter(m.GetUserID())
+}
+
+func (m *CreateAccountRequest) ToInterestCategoryFilter() *repo.CategoryFilter {
+	return repo.NewCategoryFilter(
+		m.GetUserID(),
+		repo.WithCategoryID(m.InterestCategoryID),
 	)
 }
 
@@ -314,7 +460,27 @@ type UpdateAccountRequest struct {
 	AccountName *string
 	Balance     *float64
 	Note        *string
+	Icon        *string
+	Color       *string
+	Position    *uint32
 	UpdateMode  *uint32
+
+	// Credit card
+	StatementDay *uint32
+	DueDay       *uint32
+	CreditLimit  *float64
+
+	// Loan and bank account
+	InterestRate *float64
+
+	// Loan
+	Principal *float64
+	Term      *uint32
+	StartTime *uint64
+
+	// Bank account
+	CompoundFrequency  *uint32
+	InterestCategoryID *string
 }
 
 func (m *UpdateAccountRequest) GetUserID() string {
@@ -352,6 +518,90 @@ func (m *UpdateAccountRequest) GetNote() string {
 	return ""
 }
 
+func (m *UpdateAccountRequest) GetIcon() string {
+	if m != nil && m.Icon != nil {
+		return *m.Icon
+	}
+	return ""
+}
+
+func (m *UpdateAccountRequest) GetColor() string {
+	if m != nil && m.Color != nil {
+		return *m.Color
+	}
+	return ""
+}
+
+func (m *UpdateAccountRequest) GetPosition() uint32 {
+	if m != nil && m.Position != nil {
+		return *m.Position
+	}
+	return 0
+}
+
+func (m *UpdateAccountRequest) GetStatementDay() uint32 {
+	if m != nil && m.StatementDay != nil {
+		return *m.StatementDay
+	}
+	return 0
+}
+
+func (m *UpdateAccountRequest) GetDueDay() uint32 {
+	if m != nil && m.DueDay != nil {
+		return *m.DueDay
+	}
+	return 0
+}
+
+func (m *UpdateAccountRequest) GetCreditLimit() float64 {
+	if m != nil && m.CreditLimit != nil {
+		return *m.CreditLimit
+	}
+	return 0
+}
+
+func (m *UpdateAccountRequest) GetPrincipal() float64 {
+	if m != nil && m.Principal != nil {
+		return *m.Principal
+	}
+	return 0
+}
+
+func (m *UpdateAccountRequest) GetInterestRate() float64 {
+	if m != nil && m.InterestRate != nil {
+		return *m.InterestRate
+	}
+	return 0
+}
+
+func (m *UpdateAccountRequest) GetTerm() uint32 {
+	if m != nil && m.Term != nil {
+		return *m.Term
+	}
+	return 0
+}
+
+func (m *UpdateAccountRequest) GetStartTime() uint64 {
+	if m != nil && m.StartTime != nil {
+		return *m.StartTime
+	}
+	return 0
+}
+
+func (m *UpdateAccountRequest) GetCompoundFrequency() uint32 {
+	if m != nil && m.CompoundFrequency != nil {
+		return *m.CompoundFrequency
+	}
+	return 0
+}
+
+func (m *UpdateAccountRequest) GetInterestCategoryID() string {
+	if m != nil && m.InterestCategoryID != nil {
+		return *m.InterestCategoryID
+	}
+	return ""
+}
+
 func (m *UpdateAccountRequest) GetUpdateMode() uint32 {
 	if m != nil && m.UpdateMode != nil {
 		return *m.UpdateMode
@@ -366,6 +616,13 @@ func (m *UpdateAccountRequest) ToAccountFilter() *repo.AccountFilter {
 	)
 }
 
+func (m *UpdateAccountRequest) ToInterestCategoryFilter() *repo.CategoryFilter {
+	return repo.NewCategoryFilter(
+		m.GetUserID(),
+		repo.WithCategoryID(m.InterestCategoryID),
+	)
+}
+
 func (m *UpdateAccountRequest) NeedOffsetTransaction() bool {
 	return m.GetUpdateMode()&uint32(UpdateModeOffsetTransaction) > 0
 }
@@ -423,6 +680,100 @@ func (m *DeleteAccountRequest) ToLotFilter(holdingIDs []string) *repo.LotFilter
 
 type DeleteAccountResponse struct{}
 
+type GetDeletedAccountsRequest struct {
+	UserID *string
+}
+
+func (m *GetDeletedAccountsRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *GetDeletedAccountsRequest) ToAccountFilter() *repo.AccountFilter {
+	return repo.NewAccountFilter(
+		m.GetUserID(),
+		repo.WithAccountStatus(goutil.Uint32(uint32(entity.AccountStatusDeleted))),
+		repo.WithAccountPaging(&repo.Paging{
+			Sorts: []filter.Sort{
+				// most recently deleted first
+				&repo.Sort{
+					Field: goutil.String("update_time"),
+					Order: goutil.String(config.OrderDesc),
+				},
+			},
+		}),
+	)
+}
+
+type GetDeletedAccountsResponse struct {
+	Accounts []*entity.Account
+}
+
+func (m *GetDeletedAccountsResponse) GetAccounts() []*entity.Account {
+	if m != nil && m.Accounts != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+type RestoreAccountRequest struct {
+	UserID    *string
+	AccountID *string
+}
+
+func (m *RestoreAccountRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *RestoreAccountRequest) GetAccountID() string {
+	if m != nil && m.AccountID != nil {
+		return *m.AccountID
+	}
+	return ""
+}
+
+func (m *RestoreAccountRequest) ToAccountFilter() *repo.AccountFilter {
+	return repo.NewAccountFilter(
+		m.GetUserID(),
+		repo.WithAccountID(m.AccountID),
+		repo.WithAccountStatus(goutil.Uint32(uint32(entity.AccountStatusDeleted))),
+	)
+}
+
+func (m *RestoreAccountRequest) ToHoldingFilter(deleteTime uint64) *repo.HoldingFilter {
+	return repo.NewHoldingFilter(
+		repo.WithHoldingUserID(m.UserID),
+		repo.WithHoldingAccountID(m.AccountID),
+		repo.WithHoldingStatus(goutil.Uint32(uint32(entity.HoldingStatusDeleted))),
+		repo.WithHoldingUpdateTime(goutil.Uint64(deleteTime)),
+	)
+}
+
+func (m *RestoreAccountRequest) ToLotFilter(holdingIDs []string, deleteTime uint64) *repo.LotFilter {
+	return repo.NewLotFilter(
+		m.GetUserID(),
+		repo.WithLotHoldingIDs(holdingIDs),
+		repo.WithLotStatus(goutil.Uint32(uint32(entity.LotStatusDeleted))),
+		repo.WithLotUpdateTime(goutil.Uint64(deleteTime)),
+	)
+}
+
+type RestoreAccountResponse struct {
+	Account *entity.Account
+}
+
+func (m *RestoreAccountResponse) GetAccount() *entity.Account {
+	if m != nil && m.Account != nil {
+		return m.Account
+	}
+	return nil
+}
+
 type GetAccountsSummaryRequest struct {
 	AppMeta  *common.AppMeta
 	User     *entity.User
@@ -490,3 +841,185 @@ type GetAccountsSummaryResponse struct {
 	AssetValue []*common.Summary
 	DebtValue  []*common.Summary
 }
+
+type GetAmortisationScheduleRequest struct {
+	UserID    *string
+	AccountID *string
+	AppMeta   *common.AppMeta
+}
+
+func (m *GetAmortisationScheduleRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *GetAmortisationScheduleRequest) GetAccountID() string {
+	if m != nil && m.AccountID != nil {
+		return *m.AccountID
+	}
+	return ""
+}
+
+func (m *GetAmortisationScheduleRequest) GetAppMeta() *common.AppMeta {
+	if m != nil && m.AppMeta != nil {
+		return m.AppMeta
+	}
+	return nil
+}
+
+func (m *GetAmortisationScheduleRequest) ToAccountFilter() *repo.AccountFilter {
+	return repo.NewAccountFilter(
+		m.GetUserID(),
+		repo.WithAccountID(m.AccountID),
+	)
+}
+
+type GetAmortisationScheduleResponse struct {
+	Account       *entity.Account
+	Periods       []*entity.AmortisationPeriod
+	TotalPayment  *float64
+	TotalInterest *float64
+}
+
+func (m *GetAmortisationScheduleResponse) GetAccount() *entity.Account {
+	if m != nil && m.Account != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *GetAmortisationScheduleResponse) GetPeriods() []*entity.AmortisationPeriod {
+	if m != nil && m.Periods != nil {
+		return m.Periods
+	}
+	return nil
+}
+
+func (m *GetAmortisationScheduleResponse) GetTotalPayment() float64 {
+	if m != nil && m.TotalPayment != nil {
+		return *m.TotalPayment
+	}
+	return 0
+}
+
+func (m *GetAmortisationScheduleResponse) GetTotalInterest() float64 {
+	if m != nil && m.TotalInterest != nil {
+		return *m.TotalInterest
+	}
+	return 0
+}
+
+type PostInterestRequest struct {
+	UserID *string
+	Now    *uint64
+}
+
+func (m *PostInterestRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *PostInterestRequest) GetNow() uint64 {
+	if m != nil && m.Now != nil {
+		return *m.Now
+	}
+	return 0
+}
+
+func (m *PostInterestRequest) ToAccountFilter() *repo.AccountFilter {
+	return repo.NewAccountFilter(
+		m.GetUserID(),
+		repo.WitAccountType(goutil.Uint32(uint32(entity.AssetBankAccount))),
+	)
+}
+
+func (m *PostInterestRequest) ToAccountUpdateFilter(accountID string) *repo.AccountFilter {
+	return repo.NewAccountFilter(
+		m.GetUserID(),
+		repo.WithAccountID(goutil.String(accountID)),
+	)
+}
+
+type PostInterestResponse struct {
+	Transactions []*entity.Transaction
+}
+
+func (m *PostInterestResponse) GetTransactions() []*entity.Transaction {
+	if m != nil && m.Transactions != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+type GetInterestProjectionRequest struct {
+	UserID      *string
+	AccountID   *string
+	ProjectTime *uint64
+}
+
+func (m *GetInterestProjectionRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *GetInterestProjectionRequest) GetAccountID() string {
+	if m != nil && m.AccountID != nil {
+		return *m.AccountID
+	}
+	return ""
+}
+
+func (m *GetInterestProjectionRequest) GetProjectTime() uint64 {
+	if m != nil && m.ProjectTime != nil {
+		return *m.ProjectTime
+	}
+	return 0
+}
+
+func (m *GetInterestProjectionRequest) ToAccountFilter() *repo.AccountFilter {
+	return repo.NewAccountFilter(
+		m.GetUserID(),
+		repo.WithAccountID(m.AccountID),
+	)
+}
+
+type GetInterestProjectionResponse struct {
+	Account          *entity.Account
+	ProjectedBalance *float64
+	Interest         *float64 // interest earned from the last posting until the project time
+	Periods          *uint32  // compounding periods until the project time
+}
+
+func (m *GetInterestProjectionResponse) GetAccount() *entity.Account {
+	if m != nil && m.Account != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *GetInterestProjectionResponse) GetProjectedBalance() float64 {
+	if m != nil && m.ProjectedBalance != nil {
+		return *m.ProjectedBalance
+	}
+	return 0
+}
+
+func (m *GetInterestProjectionResponse) GetInterest() float64 {
+	if m != nil && m.Interest != nil {
+		return *m.Interest
+	}
+	return 0
+}
+
+func (m *GetInterestProjectionResponse) GetPeriods() uint32 {
+	if m != nil && m.Periods != nil {
+		return *m.Periods
+	}
+	return 0
+}
@@ -27,6 +27,7 @@ type accountUseCase struct {
 	securityRepo     repo.SecurityRepo
 	exchangeRateRepo repo.ExchangeRateRepo
 	snapshotRepo     repo.SnapshotRepo
+	categoryRepo     repo.CategoryRepo
 }
 
 func NewAccountUseCase(
@@ -39,6 +40,7 @@ func NewAccountUseCase(
 	securityRepo repo.SecurityRepo,
 	exchangeRateRepo repo.ExchangeRateRepo,
 	snapshotRepo repo.SnapshotRepo,
+	categoryRepo repo.CategoryRepo,
 ) UseCase {
 	return &accountUseCase{
 		txMgr,
@@ -50,6 +52,7 @@ func NewAccountUseCase(
 		securityRepo,
 		exchangeRateRepo,
 		snapshotRepo,
+		categoryRepo,
 	}
 }
 
@@ -146,6 +149,19 @@ func (uc *accountUseCase) CreateAccount(ctx context.Context, req *CreateAccountR
 		return nil, err
 	}
 
+	if req.GetInterestCategoryID() != "" {
+		if err := uc.checkInterestCategory(ctx, req.ToInterestCategoryFilter()); err != nil {
+			return nil, err
+		}
+	}
+
+	acs, err := uc.accountRepo.GetMany(ctx, req.ToAccountsFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get accounts from repo, err: %v", err)
+		return nil, err
+	}
+	ac.SetPosition(goutil.Uint32(getNextAccountPosition(acs)))
+
 	if _, err := uc.accountRepo.Create(ctx, ac); err != nil {
 		log.Ctx(ctx).Error().Msgf("fail to save new account to repo, err: %v", err)
 		return nil, err
@@ -172,11 +188,29 @@ func (uc *accountUseCase) UpdateAccount(ctx context.Context, req *UpdateAccountR
 		entity.WithUpdateAccountBalance(req.Balance),
 		entity.WithUpdateAccountName(req.AccountName),
 		entity.WithUpdateAccountNote(req.Note),
+		entity.WithUpdateAccountIcon(req.Icon),
+		entity.WithUpdateAccountColor(req.Color),
+		entity.WithUpdateAccountPosition(req.Position),
+		entity.WithUpdateAccountStatementDay(req.StatementDay),
+		entity.WithUpdateAccountDueDay(req.DueDay),
+		entity.WithUpdateAccountCreditLimit(req.CreditLimit),
+		entity.WithUpdateAccountPrincipal(req.Principal),
+		entity.WithUpdateAccountInterestRate(req.InterestRate),
+		entity.WithUpdateAccountTerm(req.Term),
+		entity.WithUpdateAccountStartTime(req.StartTime),
+		entity.WithUpdateAccountCompoundFrequency(req.CompoundFrequency),
+		entity.WithUpdateAccountInterestCategoryID(req.InterestCategoryID),
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	if req.GetInterestCategoryID() != "" {
+		if err := uc.checkInterestCategory(ctx, req.ToInterestCategoryFilter()); err != nil {
+			return nil, err
+		}
+	}
+
 	if acu == nil {
 		log.Ctx(ctx).Info().Msg("acount has no updates")
 		return &UpdateAccountResponse{
@@ -252,20 +286,22 @@ func (uc *accountUseCase) DeleteAccount(ctx context.Context, req *DeleteAccountR
 			holdingIDs = append(holdingIDs, h.GetHoldingID())
 		}
 
-		// mark holdings as deleted
+		// mark holdings as deleted, stamped with the account deletion time for restore
 		hu := &entity.HoldingUpdate{
 			HoldingStatus: goutil.Uint32(uint32(entity.HoldingStatusDeleted)),
+			UpdateTime:    acu.UpdateTime,
 		}
-		if err := uc.holdingRepo.UpdateMany(ctx, req.ToHoldingFilter(), hu); err != nil {
+		if err := uc.holdingRepo.UpdateMany(txCtx, req.ToHoldingFilter(), hu); err != nil {
 			log.Ctx(txCtx).Error().Msgf("fail to mark account holdings as deleted, err: %v", err)
 			return err
 		}
 
 		// mark lots as deleted
 		lu := &entity.LotUpdate{
-			LotStatus: goutil.Uint32(uint32(entity.LotStatusDeleted)),
+			LotStatus:  goutil.Uint32(uint32(entity.LotStatusDeleted)),
+			UpdateTime: acu.UpdateTime,
 		}
-		if err := uc.lotRepo.UpdateMany(ctx, req.ToLotFilter(holdingIDs), lu); err != nil {
+		if err := uc.lotRepo.UpdateMany(txCtx, req.ToLotFilter(holdingIDs), lu); err != nil {
 			log.Ctx(txCtx).Error().Msgf("fail to mark lots as deleted, err: %v", err)
 			return err
 		}
@@ -278,6 +314,247 @@ func (uc *accountUseCase) DeleteAccount(ctx context.Context, req *DeleteAccountR
 	return new(DeleteAccountResponse), nil
 }
 
+func (uc *accountUseCase) GetDeletedAccounts(ctx context.Context, req *GetDeletedAccountsRequest) (*GetDeletedAccountsResponse, error) {
+	acs, err := uc.accountRepo.GetMany(ctx, req.ToAccountFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get deleted accounts from repo, err: %v", err)
+		return nil, err
+	}
+
+	return &GetDeletedAccountsResponse{
+		Accounts: acs,
+	}, nil
+}
+
+// RestoreAccount flips a deleted account back to normal. Holdings and lots deleted together
+// with the account carry its deletion time and are restored with it.
+func (uc *accountUseCase) RestoreAccount(ctx context.Context, req *RestoreAccountRequest) (*RestoreAccountResponse, error) {
+	acf := req.ToAccountFilter()
+
+	ac, err := uc.accountRepo.Get(ctx, acf)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get deleted account from repo, err: %v", err)
+		return nil, err
+	}
+	deleteTime := ac.GetUpdateTime()
+
+	if err := uc.txMgr.WithTx(ctx, func(txCtx context.Context) error {
+		acu, err := ac.Update(
+			entity.WithUpdateAccountStatus(goutil.Uint32(uint32(entity.AccountStatusNormal))),
+		)
+		if err != nil {
+			return err
+		}
+
+		if err = uc.accountRepo.Update(txCtx, acf, acu); err != nil {
+			log.Ctx(txCtx).Error().Msgf("fail to mark account as normal, err: %v", err)
+			return err
+		}
+
+		if !ac.IsInvestment() {
+			return nil
+		}
+
+		hf := req.ToHoldingFilter(deleteTime)
+
+		hs, err := uc.holdingRepo.GetMany(txCtx, hf)
+		if err != nil {
+			log.Ctx(txCtx).Error().Msgf("fail get holdings from repo, err: %v", err)
+			return err
+		}
+
+		if len(hs) == 0 {
+			return nil
+		}
+
+		holdingIDs := make([]string, 0)
+		for _, h := range hs {
+			holdingIDs = append(holdingIDs, h.GetHoldingID())
+		}
+
+		// mark lots as normal
+		lu := &entity.LotUpdate{
+			LotStatus:  goutil.Uint32(uint32(entity.LotStatusNormal)),
+			UpdateTime: acu.UpdateTime,
+		}
+		if err := uc.lotRepo.UpdateMany(txCtx, req.ToLotFilter(holdingIDs, deleteTime), lu); err != nil {
+			log.Ctx(txCtx).Error().Msgf("fail to mark lots as normal, err: %v", err)
+			return err
+		}
+
+		// mark holdings as normal
+		hu := &entity.HoldingUpdate{
+			HoldingStatus: goutil.Uint32(uint32(entity.HoldingStatusNormal)),
+			UpdateTime:    acu.UpdateTime,
+		}
+		if err := uc.holdingRepo.UpdateMany(txCtx, hf, hu); err != nil {
+			log.Ctx(txCtx).Error().Msgf("fail to mark account holdings as normal, err: %v", err)
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := uc.computeAccountCostGainAndBalance(ctx, ac); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to compute account cost, gain, and balance, err: %v", err)
+		return nil, err
+	}
+
+	return &RestoreAccountResponse{
+		Account: ac,
+	}, nil
+}
+
+func (uc *accountUseCase) GetAmortisationSchedule(ctx context.Context, req *GetAmortisationScheduleRequest) (*GetAmortisationScheduleResponse, error) {
+	loc, err := time.LoadLocation(req.GetAppMeta().GetTimezone())
+	if err != nil {
+		return nil, entity.ErrInvalidTimezone
+	}
+
+	ac, err := uc.accountRepo.Get(ctx, req.ToAccountFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get account from repo, err: %v", err)
+		return nil, err
+	}
+
+	if !ac.IsLoan() {
+		return nil, ErrNotLoan
+	}
+
+	if !ac.HasAmortisation() {
+		return nil, ErrNoAmortisation
+	}
+
+	var (
+		periods                     = ac.GetAmortisationSchedule(loc)
+		totalPayment, totalInterest float64
+	)
+	for _, p := range periods {
+		totalPayment += p.Payment
+		totalInterest += p.Interest
+	}
+
+	return &GetAmortisationScheduleResponse{
+		Account:       ac,
+		Periods:       periods,
+		TotalPayment:  goutil.Float64(util.RoundFloatToStandardDP(totalPayment)),
+		TotalInterest: goutil.Float64(util.RoundFloatToStandardDP(totalInterest)),
+	}, nil
+}
+
+func (uc *accountUseCase) PostInterest(ctx context.Context, req *PostInterestRequest) (*PostInterestResponse, error) {
+	acs, err := uc.accountRepo.GetMany(ctx, req.ToAccountFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get bank accounts from repo, err: %v", err)
+		return nil, err
+	}
+
+	ts := make([]*entity.Transaction, 0)
+	for _, ac := range acs {
+		periods, end := ac.GetCompoundPeriods(req.GetNow())
+		if periods == 0 {
+			continue
+		}
+
+		var t *entity.Transaction
+		if interest := ac.GetCompoundInterest(ac.GetBalance(), periods); interest > 0 {
+			t, err = uc.newInterestTransaction(ac, interest, end)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		acu, err := ac.Update(
+			entity.WithUpdateAccountBalance(goutil.Float64(ac.GetBalance()+t.GetAmount())),
+			entity.WithUpdateAccountLastInterestTime(goutil.Uint64(end)),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := uc.txMgr.WithTx(ctx, func(txCtx context.Context) error {
+			if t != nil {
+				if _, err := uc.transactionRepo.Create(txCtx, t); err != nil {
+					log.Ctx(txCtx).Error().Msgf("fail to save interest transaction to repo, err: %v", err)
+					return err
+				}
+			}
+
+			if err := uc.accountRepo.Update(txCtx, req.ToAccountUpdateFilter(ac.GetAccountID()), acu); err != nil {
+				log.Ctx(txCtx).Error().Msgf("fail to save account updates to repo, err: %v", err)
+				return err
+			}
+
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+
+		if t != nil {
+			ts = append(ts, t)
+		}
+	}
+
+	return &PostInterestResponse{
+		Transactions: ts,
+	}, nil
+}
+
+func (uc *accountUseCase) GetInterestProjection(ctx context.Context, req *GetInterestProjectionRequest) (*GetInterestProjectionResponse, error) {
+	if req.GetProjectTime() <= uint64(time.Now().UnixMilli()) {
+		return nil, ErrProjectTimeInPast
+	}
+
+	ac, err := uc.accountRepo.Get(ctx, req.ToAccountFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get account from repo, err: %v", err)
+		return nil, err
+	}
+
+	if !ac.HasInterestAccrual() {
+		return nil, ErrNoInterestAccrual
+	}
+
+	periods, _ := ac.GetCompoundPeriods(req.GetProjectTime())
+	interest := ac.GetCompoundInterest(ac.GetBalance(), periods)
+
+	return &GetInterestProjectionResponse{
+		Account:          ac,
+		ProjectedBalance: goutil.Float64(util.RoundFloatToStandardDP(ac.GetBalance() + interest)),
+		Interest:         goutil.Float64(interest),
+		Periods:          goutil.Uint32(uint32(periods)),
+	}, nil
+}
+
+func (uc *accountUseCase) checkInterestCategory(ctx context.Context, cf *repo.CategoryFilter) error {
+	c, err := uc.categoryRepo.Get(ctx, cf)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get interest category from repo, err: %v", err)
+		return err
+	}
+
+	if c.GetCategoryType() != uint32(entity.TransactionTypeIncome) {
+		return ErrInterestCategory
+	}
+
+	return nil
+}
+
+func (uc *accountUseCase) newInterestTransaction(account *entity.Account, interest float64, transactionTime uint64) (*entity.Transaction, error) {
+	return entity.NewTransaction(
+		account.GetUserID(),
+		entity.WithTransactionAccountID(account.AccountID),
+		entity.WithTransactionCategoryID(account.InterestCategoryID),
+		entity.WithTransactionAmount(goutil.Float64(interest)),
+		entity.WithTransactionCurrency(account.Currency),
+		entity.WithTransactionType(goutil.Uint32(uint32(entity.TransactionTypeIncome))),
+		entity.WithTransactionNote(goutil.String("Interest")),
+		entity.WithTransactionTime(goutil.Uint64(transactionTime)),
+	)
+}
+
 func (uc *accountUseCase) newUnrecordedTransaction(account *entity.Account, amount float64) (*entity.Transaction, error) {
 	tt := uint32(entity.GetTransactionTypeByAmount(amount))
 
@@ -291,6 +568,8 @@ func (uc *accountUseCase) newUnrecordedTransaction(account *entity.Account, amou
 		entity.WithTransactionType(goutil.Uint32(tt)),
 		entity.WithTransactionNote(goutil.String(note)),
 		entity.WithTransactionTime(goutil.Uint64(uint64(time.Now().UnixMilli()))),
+		// the user entered the new balance, so the offset is cleared
+		entity.WithTransactionClearStatus(goutil.Uint32(uint32(entity.ClearStatusCleared))),
 	)
 }
 
@@ -603,3 +882,14 @@ func (uc *accountUseCase) GetAccountsSummary(ctx context.Context, req *GetAccoun
 
 	return resp, nil
 }
+
+// getNextAccountPosition places a new account after all existing accounts.
+func getNextAccountPosition(acs []*entity.Account) uint32 {
+	var next uint32
+	for _, ac := range acs {
+		if ac.GetPosition() >= next {
+			next = ac.GetPosition() + 1
+		}
+	}
+	return next
+}
@@ -0,0 +1,211 @@
+package attachment
+
+import (
+	"context"
+	"io"
+
+	"github.com/jseow5177/pockteer-be/dep/repo"
+	"github.com/jseow5177/pockteer-be/entity"
+)
+
+type UseCase interface {
+	GetAttachments(ctx context.Context, req *GetAttachmentsRequest) (*GetAttachmentsResponse, error)
+	DownloadAttachment(ctx context.Context, req *DownloadAttachmentRequest) (*DownloadAttachmentResponse, error)
+
+	UploadAttachment(ctx context.Context, req *UploadAttachmentRequest) (*UploadAttachmentResponse, error)
+	DeleteAttachment(ctx context.Context, req *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error)
+}
+
+type UploadAttachmentRequest struct {
+	UserID        *string
+	TransactionID *string
+	FileName      *string
+	Content       []byte
+}
+
+func (m *UploadAttachmentRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *UploadAttachmentRequest) GetTransactionID() string {
+	if m != nil && m.TransactionID != nil {
+		return *m.TransactionID
+	}
+	return ""
+}
+
+func (m *UploadAttachmentRequest) GetFileName() string {
+	if m != nil && m.FileName != nil {
+		return *m.FileName
+	}
+	return ""
+}
+
+func (m *UploadAttachmentRequest) GetContent() []byte {
+	if m != nil && m.Content != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (m *UploadAttachmentRequest) ToTransactionFilter() *repo.TransactionFilter {
+	return repo.NewTransactionFilter(
+		m.GetUserID(),
+		repo.WithTransactionID(m.TransactionID),
+	)
+}
+
+func (m *UploadAttachmentRequest) ToAttachmentFilter() *repo.AttachmentFilter {
+	return repo.NewAttachmentFilter(
+		m.GetUserID(),
+		repo.WithAttachmentTransactionID(m.TransactionID),
+	)
+}
+
+func (m *UploadAttachmentRequest) ToAttachmentEntity(contentType string) (*entity.Attachment, error) {
+	return entity.NewAttachment(
+		m.GetUserID(),
+		m.GetTransactionID(),
+		m.GetFileName(),
+		contentType,
+		uint64(len(m.Content)),
+	)
+}
+
+type UploadAttachmentResponse struct {
+	Attachment *entity.Attachment
+}
+
+func (m *UploadAttachmentResponse) GetAttachment() *entity.Attachment {
+	if m != nil && m.Attachment != nil {
+		return m.Attachment
+	}
+	return nil
+}
+
+type GetAttachmentsRequest struct {
+	UserID        *string
+	TransactionID *string
+}
+
+func (m *GetAttachmentsRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *GetAttachmentsRequest) GetTransactionID() string {
+	if m != nil && m.TransactionID != nil {
+		return *m.TransactionID
+	}
+	return ""
+}
+
+func (m *GetAttachmentsRequest) ToTransactionFilter() *repo.TransactionFilter {
+	return repo.NewTransactionFilter(
+		m.GetUserID(),
+		repo.WithTransactionID(m.TransactionID),
+	)
+}
+
+func (m *GetAttachmentsRequest) ToAttachmentFilter() *repo.AttachmentFilter {
+	return repo.NewAttachmentFilter(
+		m.GetUserID(),
+		repo.WithAttachmentTransactionID(m.TransactionID),
+	)
+}
+
+type GetAttachmentsResponse struct {
+	Attachments []*entity.Attachment
+}
+
+func (m *GetAttachmentsResponse) GetAttachments() []*entity.Attachment {
+	if m != nil && m.Attachments != nil {
+		return m.Attachments
+	}
+	return nil
+}
+
+type DownloadAttachmentRequest struct {
+	UserID       *string
+	AttachmentID *string
+}
+
+func (m *DownloadAttachmentRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *DownloadAttachmentRequest) GetAttachmentID() string {
+	if m != nil && m.AttachmentID != nil {
+		return *m.AttachmentID
+	}
+	return ""
+}
+
+func (m *DownloadAttachmentRequest) ToAttachmentFilter() *repo.AttachmentFilter {
+	return repo.NewAttachmentFilter(
+		m.GetUserID(),
+		repo.WithAttachmentID(m.AttachmentID),
+	)
+}
+
+func (m *DownloadAttachmentRequest) ToTransactionFilter(transactionID string) *repo.TransactionFilter {
+	return repo.NewTransactionFilter(
+		m.GetUserID(),
+		repo.WithTransactionID(&transactionID),
+	)
+}
+
+type DownloadAttachmentResponse struct {
+	Attachment *entity.Attachment
+	Content    io.ReadCloser // must be closed by the caller
+}
+
+func (m *DownloadAttachmentResponse) GetAttachment() *entity.Attachment {
+	if m != nil && m.Attachment != nil {
+		return m.Attachment
+	}
+	return nil
+}
+
+func (m *DownloadAttachmentResponse) GetContent() io.ReadCloser {
+	if m != nil && m.Content != nil {
+		return m.Content
+	}
+	return nil
+}
+
+type DeleteAttachmentRequest struct {
+	UserID       *string
+	AttachmentID *string
+}
+
+func (m *DeleteAttachmentRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *DeleteAttachmentRequest) GetAttachmentID() string {
+	if m != nil && m.AttachmentID != nil {
+		return *m.AttachmentID
+	}
+	return ""
+}
+
+func (m *DeleteAttachmentRequest) ToAttachmentFilter() *repo.AttachmentFilter {
+	return repo.NewAttachmentFilter(
+		m.GetUserID(),
+		repo.WithAttachmentID(m.AttachmentID),
+	)
+}
+
+type DeleteAttachmentResponse struct{}
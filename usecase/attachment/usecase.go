@@ -0,0 +1,152 @@
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"mime"
+	"net/http"
+
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/dep/blob"
+	"github.com/jseow5177/pockteer-be/dep/repo"
+	"github.com/jseow5177/pockteer-be/pkg/errutil"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	ErrTooManyAttachments = errutil.ValidationError(errors.New("transaction has too many attachments"))
+)
+
+type attachmentUseCase struct {
+	attachmentRepo  repo.AttachmentRepo
+	transactionRepo repo.TransactionRepo
+	blobStore       blob.Store
+}
+
+func NewAttachmentUseCase(
+	attachmentRepo repo.AttachmentRepo,
+	transactionRepo repo.TransactionRepo,
+	blobStore blob.Store,
+) UseCase {
+	return &attachmentUseCase{
+		attachmentRepo,
+		transactionRepo,
+		blobStore,
+	}
+}
+
+func (uc *attachmentUseCase) UploadAttachment(ctx context.Context, req *UploadAttachmentRequest) (*UploadAttachmentResponse, error) {
+	if _, err := uc.transactionRepo.Get(ctx, req.ToTransactionFilter()); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get transaction from repo, err: %v", err)
+		return nil, err
+	}
+
+	as, err := uc.attachmentRepo.GetMany(ctx, req.ToAttachmentFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get attachments from repo, err: %v", err)
+		return nil, err
+	}
+
+	if len(as) >= config.MaxAttachmentsPerTxn {
+		return nil, ErrTooManyAttachments
+	}
+
+	// the content type is sniffed from the content, the file extension is not trusted
+	contentType, _, err := mime.ParseMediaType(http.DetectContentType(req.GetContent()))
+	if err != nil {
+		return nil, errutil.ValidationError(err)
+	}
+
+	a, err := req.ToAttachmentEntity(contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.blobStore.Put(ctx, a.GetStorageKey(), bytes.NewReader(req.GetContent())); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to save attachment to blob store, err: %v", err)
+		return nil, err
+	}
+
+	if _, err := uc.attachmentRepo.Create(ctx, a); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to save new attachment to repo, err: %v", err)
+		uc.deleteBlob(ctx, a.GetStorageKey())
+		return nil, err
+	}
+
+	return &UploadAttachmentResponse{
+		Attachment: a,
+	}, nil
+}
+
+func (uc *attachmentUseCase) GetAttachments(ctx context.Context, req *GetAttachmentsRequest) (*GetAttachmentsResponse, error) {
+	if _, err := uc.transactionRepo.Get(ctx, req.ToTransactionFilter()); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get transaction from repo, err: %v", err)
+		return nil, err
+	}
+
+	as, err := uc.attachmentRepo.GetMany(ctx, req.ToAttachmentFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get attachments from repo, err: %v", err)
+		return nil, err
+	}
+
+	return &GetAttachmentsResponse{
+		Attachments: as,
+	}, nil
+}
+
+func (uc *attachmentUseCase) DownloadAttachment(ctx context.Context, req *DownloadAttachmentRequest) (*DownloadAttachmentResponse, error) {
+	a, err := uc.attachmentRepo.Get(ctx, req.ToAttachmentFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get attachment from repo, err: %v", err)
+		return nil, err
+	}
+
+	// attachments of deleted transactions are hidden until the transaction is restored or purged
+	if _, err := uc.transactionRepo.Get(ctx, req.ToTransactionFilter(a.GetTransactionID())); err != nil {
+		if err == repo.ErrTransactionNotFound {
+			return nil, repo.ErrAttachmentNotFound
+		}
+		log.Ctx(ctx).Error().Msgf("fail to get transaction from repo, err: %v", err)
+		return nil, err
+	}
+
+	rc, err := uc.blobStore.Get(ctx, a.GetStorageKey())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get attachment from blob store, key: %v, err: %v", a.GetStorageKey(), err)
+		if err == blob.ErrBlobNotFound {
+			return nil, repo.ErrAttachmentNotFound
+		}
+		return nil, err
+	}
+
+	return &DownloadAttachmentResponse{
+		Attachment: a,
+		Content:    rc,
+	}, nil
+}
+
+func (uc *attachmentUseCase) DeleteAttachment(ctx context.Context, req *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error) {
+	a, err := uc.attachmentRepo.Get(ctx, req.ToAttachmentFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get attachment from repo, err: %v", err)
+		return nil, err
+	}
+
+	if err := uc.attachmentRepo.DeleteMany(ctx, req.ToAttachmentFilter()); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to delete attachment, err: %v", err)
+		return nil, err
+	}
+
+	// the record is gone, a leftover blob is unreachable and harmless
+	uc.deleteBlob(ctx, a.GetStorageKey())
+
+	return new(DeleteAttachmentResponse), nil
+}
+
+func (uc *attachmentUseCase) deleteBlob(ctx context.Context, key string) {
+	if err := uc.blobStore.Delete(ctx, key); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to delete attachment from blob store, key: %v, err: %v", key, err)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/jseow5177/pockteer-be/dep/repo"
 	"github.com/jseow5177/pockteer-be/entity"
 	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/jseow5177/pockteer-be/util"
 )
 
 type UseCase interface {
@@ -22,6 +23,9 @@ type CreateBudgetRequest struct {
 	BudgetDate   *string
 	BudgetType   *uint32
 	BudgetRepeat *uint32
+	WeekStartDay *uint32
+	PeriodDays   *uint32
+	Rollover     *bool
 	Amount       *float64
 	Currency     *string
 }
@@ -68,6 +72,27 @@ func (m *CreateBudgetRequest) GetBudgetRepeat() uint32 {
 	return 0
 }
 
+func (m *CreateBudgetRequest) GetWeekStartDay() uint32 {
+	if m != nil && m.WeekStartDay != nil {
+		return *m.WeekStartDay
+	}
+	return 0
+}
+
+func (m *CreateBudgetRequest) GetPeriodDays() uint32 {
+	if m != nil && m.PeriodDays != nil {
+		return *m.PeriodDays
+	}
+	return 0
+}
+
+func (m *CreateBudgetRequest) GetRollover() bool {
+	if m != nil && m.Rollover != nil {
+		return *m.Rollover
+	}
+	return false
+}
+
 func (m *CreateBudgetRequest) GetAmount() float64 {
 	if m != nil && m.Amount != nil {
 		return *m.Amount
@@ -76,24 +101,32 @@ func (m *CreateBudgetRequest) GetAmount() float64 {
 }
 
 func (m *CreateBudgetRequest) ToBudgetEntity() (*entity.Budget, error) {
-	startDate, endDate, err := entity.GetBudgetStartEnd(
-		m.GetBudgetDate(),
-		m.GetBudgetType(),
-		m.GetBudgetRepeat(),
-	)
+	// custom periods start from the budget date
+	anchorDate, err := util.ParseDateToInt(m.GetBudgetDate())
 	if err != nil {
 		return nil, err
 	}
 
-	return entity.NewBudget(
+	b, err := entity.NewBudget(
 		m.GetUserID(),
 		m.GetCategoryID(),
 		entity.WithBudgetCurrency(m.Currency),
 		entity.WithBudgetAmount(m.Amount),
 		entity.WithBudgetType(goutil.Uint32(m.GetBudgetType())),
-		entity.WithBudgetStartDate(goutil.Uint64(startDate)),
-		entity.WithBudgetEndDate(goutil.Uint64(endDate)),
+		entity.WithBudgetWeekStartDay(m.WeekStartDay),
+		entity.WithBudgetPeriodDays(m.PeriodDays),
+		entity.WithBudgetAnchorDate(goutil.Uint64(anchorDate)),
+		entity.WithBudgetRollover(goutil.Bool(m.GetRollover())),
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.SetRepeat(m.GetBudgetDate(), m.GetBudgetRepeat()); err != nil {
+		return nil, err
+	}
+
+	return b, nil
 }
 
 func (m *CreateBudgetRequest) ToCategoryFilter() *repo.CategoryFilter {
@@ -165,13 +198,16 @@ func (m *DeleteBudgetRequest) ToGetBudgetFilter() *repo.GetBudgetFilter {
 	}
 }
 
-func (m *DeleteBudgetRequest) ToDeleteBudgetFilter(budgetType uint32) *repo.DeleteBudgetFilter {
+func (m *DeleteBudgetRequest) ToDeleteBudgetFilter(b *entity.Budget) *repo.DeleteBudgetFilter {
 	return &repo.DeleteBudgetFilter{
 		UserID:       m.UserID,
 		CategoryID:   m.CategoryID,
 		BudgetDate:   m.BudgetDate,
 		BudgetRepeat: m.BudgetRepeat,
-		BudgetType:   goutil.Uint32(uint32(budgetType)),
+		BudgetType:   b.BudgetType,
+		WeekStartDay: b.WeekStartDay,
+		PeriodDays:   b.PeriodDays,
+		AnchorDate:   b.AnchorDate,
 	}
 }
 
@@ -229,6 +265,9 @@ type UpdateBudgetRequest struct {
 	BudgetDate   *string
 	BudgetType   *uint32
 	BudgetRepeat *uint32
+	WeekStartDay *uint32
+	PeriodDays   *uint32
+	Rollover     *bool
 	Amount       *float64
 }
 
@@ -267,6 +306,27 @@ func (m *UpdateBudgetRequest) GetBudgetRepeat() uint32 {
 	return 0
 }
 
+func (m *UpdateBudgetRequest) GetWeekStartDay() uint32 {
+	if m != nil && m.WeekStartDay != nil {
+		return *m.WeekStartDay
+	}
+	return 0
+}
+
+func (m *UpdateBudgetRequest) GetPeriodDays() uint32 {
+	if m != nil && m.PeriodDays != nil {
+		return *m.PeriodDays
+	}
+	return 0
+}
+
+func (m *UpdateBudgetRequest) GetRollover() bool {
+	if m != nil && m.Rollover != nil {
+		return *m.Rollover
+	}
+	return false
+}
+
 func (m *UpdateBudgetRequest) GetAmount() float64 {
 	if m != nil && m.Amount != nil {
 		return *m.Amount
@@ -282,30 +342,73 @@ func (m *UpdateBudgetRequest) ToGetBudgetFilter() *repo.GetBudgetFilter {
 	}
 }
 
-func (m *UpdateBudgetRequest) ToDeleteBudgetFilter(budgetType uint32) *repo.DeleteBudgetFilter {
+func (m *UpdateBudgetRequest) ToDeleteBudgetFilter(b *entity.Budget) *repo.DeleteBudgetFilter {
 	return &repo.DeleteBudgetFilter{
 		UserID:       m.UserID,
 		CategoryID:   m.CategoryID,
 		BudgetDate:   m.BudgetDate,
 		BudgetRepeat: goutil.Uint32(uint32(entity.BudgetRepeatAllTime)),
-		BudgetType:   goutil.Uint32(uint32(budgetType)),
+		BudgetType:   b.BudgetType,
+		WeekStartDay: b.WeekStartDay,
+		PeriodDays:   b.PeriodDays,
+		AnchorDate:   b.AnchorDate,
 	}
 }
 
-func (m *UpdateBudgetRequest) ToBudgetUpdate() (*entity.BudgetUpdate, error) {
-	startDate, endDate, err := entity.GetBudgetStartEnd(
-		m.GetBudgetDate(),
-		m.GetBudgetType(),
-		m.GetBudgetRepeat(),
+// ToBudgetUpdate computes the dates covered from the periods of the updated budget.
+// Unset period fields are kept from the existing budget b.
+func (m *UpdateBudgetRequest) ToBudgetUpdate(b *entity.Budget) (*entity.BudgetUpdate, error) {
+	var (
+		budgetType   = b.BudgetType
+		weekStartDay = b.WeekStartDay
+		periodDays   = b.PeriodDays
+		anchorDate   = b.AnchorDate
+	)
+	if m.BudgetType != nil {
+		budgetType = m.BudgetType
+	}
+	if m.WeekStartDay != nil {
+		weekStartDay = m.WeekStartDay
+	}
+	if m.PeriodDays != nil {
+		periodDays = m.PeriodDays
+	}
+
+	// custom periods restart from the budget date when they are redefined
+	if (m.BudgetType != nil && m.GetBudgetType() != b.GetBudgetType()) ||
+		(m.PeriodDays != nil && m.GetPeriodDays() != b.GetPeriodDays()) {
+		d, err := util.ParseDateToInt(m.GetBudgetDate())
+		if err != nil {
+			return nil, err
+		}
+		anchorDate = goutil.Uint64(d)
+	}
+
+	nb, err := entity.NewBudget(
+		b.GetUserID(),
+		b.GetCategoryID(),
+		entity.WithBudgetType(budgetType),
+		entity.WithBudgetWeekStartDay(weekStartDay),
+		entity.WithBudgetPeriodDays(periodDays),
+		entity.WithBudgetAnchorDate(anchorDate),
 	)
 	if err != nil {
 		return nil, err
 	}
+
+	if err := nb.SetRepeat(m.GetBudgetDate(), m.GetBudgetRepeat()); err != nil {
+		return nil, err
+	}
+
 	return entity.NewBudgetUpdate(
 		entity.WithUpdateBudgetAmount(m.Amount),
 		entity.WithUpdateBudgetType(m.BudgetType),
-		entity.WithUpdateBudgetStartDate(goutil.Uint64(startDate)),
-		entity.WithUpdateBudgetEndDate(goutil.Uint64(endDate)),
+		entity.WithUpdateBudgetWeekStartDay(nb.WeekStartDay),
+		entity.WithUpdateBudgetPeriodDays(nb.PeriodDays),
+		entity.WithUpdateBudgetAnchorDate(nb.AnchorDate),
+		entity.WithUpdateBudgetRollover(m.Rollover),
+		entity.WithUpdateBudgetStartDate(nb.StartDate),
+		entity.WithUpdateBudgetEndDate(nb.EndDate),
 	), nil
 }
 
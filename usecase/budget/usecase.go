@@ -76,9 +76,12 @@ func (uc *budgetUseCase) UpdateBudget(ctx context.Context, req *UpdateBudgetRequ
 		log.Ctx(ctx).Error().Msgf("fail to get budget from repo, err: %v", err)
 		return nil, err
 	}
-	obt := b.GetBudgetType() // old budget type
+	ob, err := b.Clone() // old budget
+	if err != nil {
+		return nil, err
+	}
 
-	bu, err := req.ToBudgetUpdate()
+	bu, err := req.ToBudgetUpdate(b)
 	if err != nil {
 		return nil, err
 	}
@@ -96,10 +99,10 @@ func (uc *budgetUseCase) UpdateBudget(ctx context.Context, req *UpdateBudgetRequ
 	}
 
 	if err = uc.txMgr.WithTx(ctx, func(txCtx context.Context) error {
-		// if there is a change in budget type, wipe all old records
+		// if the budget periods change, wipe all old records
 		// delete time must be before update time
-		if bu.BudgetType != nil {
-			if err := uc.budgetRepo.Delete(txCtx, req.ToDeleteBudgetFilter(obt)); err != nil {
+		if bu.HasPeriodUpdate() {
+			if err := uc.budgetRepo.Delete(txCtx, req.ToDeleteBudgetFilter(ob)); err != nil {
 				log.Ctx(txCtx).Error().Msgf("fail to delete budget from repo, err: %v", err)
 				return err
 			}
@@ -145,7 +148,7 @@ func (uc *budgetUseCase) DeleteBudget(ctx context.Context, req *DeleteBudgetRequ
 		return nil, err
 	}
 
-	df := req.ToDeleteBudgetFilter(b.GetBudgetType())
+	df := req.ToDeleteBudgetFilter(b)
 
 	if err := uc.budgetRepo.Delete(ctx, df); err != nil {
 		log.Ctx(ctx).Error().Msgf("fail to delete budget from repo, err: %v", err)
@@ -3,6 +3,7 @@ package category
 import (
 	"context"
 
+	"github.com/jseow5177/pockteer-be/config"
 	"github.com/jseow5177/pockteer-be/dep/repo"
 	"github.com/jseow5177/pockteer-be/entity"
 	"github.com/jseow5177/pockteer-be/pkg/filter"
@@ -14,6 +15,7 @@ import (
 type UseCase interface {
 	GetCategory(ctx context.Context, req *GetCategoryRequest) (*GetCategoryResponse, error)
 	GetCategories(ctx context.Context, req *GetCategoriesRequest) (*GetCategoriesResponse, error)
+	GetCategoryTree(ctx context.Context, req *GetCategoryTreeRequest) (*GetCategoryTreeResponse, error)
 
 	GetCategoryBudget(ctx context.Context, req *GetCategoryBudgetRequest) (*GetCategoryBudgetResponse, error)
 	GetCategoriesBudget(ctx context.Context, req *GetCategoriesBudgetRequest) (*GetCategoriesBudgetResponse, error)
@@ -22,6 +24,11 @@ type UseCase interface {
 	UpdateCategory(ctx context.Context, req *UpdateCategoryRequest) (*UpdateCategoryResponse, error)
 	DeleteCategory(ctx context.Context, req *DeleteCategoryRequest) (*DeleteCategoryResponse, error)
 
+	GetDeletedCategories(ctx context.Context, req *GetDeletedCategoriesRequest) (*GetDeletedCategoriesResponse, error)
+	RestoreCategory(ctx context.Context, req *RestoreCategoryRequest) (*RestoreCategoryResponse, error)
+	MergeCategories(ctx context.Context, req *MergeCategoriesRequest) (*MergeCategoriesResponse, error)
+	ReorderCategories(ctx context.Context, req *ReorderCategoriesRequest) (*ReorderCategoriesResponse, error)
+
 	SumCategoryTransactions(ctx context.Context, req *SumCategoryTransactionsRequest) (*SumCategoryTransactionsResponse, error)
 }
 
@@ -67,6 +74,11 @@ func (m *GetCategoryBudgetRequest) ToCategoryFilter() *repo.CategoryFilter {
 	)
 }
 
+// ToCategoriesFilter gets all categories of the user to find the subcategories under the budget.
+func (m *GetCategoryBudgetRequest) ToCategoriesFilter() *repo.CategoryFilter {
+	return repo.NewCategoryFilter(m.GetUserID())
+}
+
 func (m *GetCategoryBudgetRequest) ToGetBudgetFilter() *repo.GetBudgetFilter {
 	return &repo.GetBudgetFilter{
 		UserID:     m.UserID,
@@ -75,17 +87,32 @@ func (m *GetCategoryBudgetRequest) ToGetBudgetFilter() *repo.GetBudgetFilter {
 	}
 }
 
-func (m *GetCategoryBudgetRequest) ToTransactionQuery(userID string, start, end uint64) *repo.TransactionQuery {
+func (m *GetCategoryBudgetRequest) ToGetBudgetFilterByDate(budgetDate string) *repo.GetBudgetFilter {
+	return &repo.GetBudgetFilter{
+		UserID:     m.UserID,
+		CategoryID: m.CategoryID,
+		BudgetDate: goutil.String(budgetDate),
+	}
+}
+
+func (m *GetCategoryBudgetRequest) ToTransactionQuery(categoryIDs []string, start, end uint64) *repo.TransactionQuery {
 	return &repo.TransactionQuery{
 		Filters: []*repo.TransactionFilter{
 			repo.NewTransactionFilter(
 				m.GetUserID(),
-				repo.WithTransactionCategoryID(m.CategoryID),
+				repo.WithTransactionCategoryIDs(categoryIDs),
+				repo.WithTransactionTimeGte(goutil.Uint64(start)),
+				repo.WithTransactionTimeLte(goutil.Uint64(end)),
+			),
+			// split transactions
+			repo.NewTransactionFilter(
+				m.GetUserID(),
+				repo.WithTransactionSplitCategoryIDs(categoryIDs),
 				repo.WithTransactionTimeGte(goutil.Uint64(start)),
 				repo.WithTransactionTimeLte(goutil.Uint64(end)),
 			),
 		},
-		Op: filter.And,
+		Op: filter.Or,
 	}
 }
 
@@ -146,9 +173,12 @@ func (m *GetCategoryResponse) GetCategory() *entity.Category {
 }
 
 type CreateCategoryRequest struct {
-	UserID       *string
-	CategoryName *string
-	CategoryType *uint32
+	UserID           *string
+	CategoryName     *string
+	CategoryType     *uint32
+	ParentCategoryID *string
+	Icon             *string
+	Color            *string
 
 	Budget *budget.CreateBudgetRequest // only for InitUser
 }
@@ -174,6 +204,39 @@ func (m *CreateCategoryRequest) GetCategoryType() uint32 {
 	return 0
 }
 
+func (m *CreateCategoryRequest) GetParentCategoryID() string {
+	if m != nil && m.ParentCategoryID != nil {
+		return *m.ParentCategoryID
+	}
+	return ""
+}
+
+func (m *CreateCategoryRequest) GetIcon() string {
+	if m != nil && m.Icon != nil {
+		return *m.Icon
+	}
+	return ""
+}
+
+func (m *CreateCategoryRequest) GetColor() string {
+	if m != nil && m.Color != nil {
+		return *m.Color
+	}
+	return ""
+}
+
+// ToCategoriesFilter gets all categories of the user to place the new category last.
+func (m *CreateCategoryRequest) ToCategoriesFilter() *repo.CategoryFilter {
+	return repo.NewCategoryFilter(m.GetUserID())
+}
+
+func (m *CreateCategoryRequest) ToParentCategoryFilter() *repo.CategoryFilter {
+	return repo.NewCategoryFilter(
+		m.GetUserID(),
+		repo.WithCategoryID(m.ParentCategoryID),
+	)
+}
+
 func (m *CreateCategoryRequest) ToCategoryEntity() (*entity.Category, error) {
 	var b *entity.Budget
 	if m.Budget != nil {
@@ -188,6 +251,9 @@ func (m *CreateCategoryRequest) ToCategoryEntity() (*entity.Category, error) {
 		m.GetUserID(),
 		m.GetCategoryName(),
 		entity.WithCategoryType(m.CategoryType),
+		entity.WithCategoryParentCategoryID(m.ParentCategoryID),
+		entity.WithCategoryIcon(m.Icon),
+		entity.WithCategoryColor(m.Color),
 		entity.WithCategoryBudget(b),
 	)
 }
@@ -204,9 +270,12 @@ func (m *CreateCategoryResponse) GetCategory() *entity.Category {
 }
 
 type UpdateCategoryRequest struct {
-	UserID       *string
-	CategoryID   *string
-	CategoryName *string
+	UserID           *string
+	CategoryID       *string
+	CategoryName     *string
+	ParentCategoryID *string // empty to move to the top level
+	Icon             *string
+	Color            *string
 }
 
 func (m *UpdateCategoryRequest) GetUserID() string {
@@ -230,6 +299,27 @@ func (m *UpdateCategoryRequest) GetCategoryName() string {
 	return ""
 }
 
+func (m *UpdateCategoryRequest) GetParentCategoryID() string {
+	if m != nil && m.ParentCategoryID != nil {
+		return *m.ParentCategoryID
+	}
+	return ""
+}
+
+func (m *UpdateCategoryRequest) GetIcon() string {
+	if m != nil && m.Icon != nil {
+		return *m.Icon
+	}
+	return ""
+}
+
+func (m *UpdateCategoryRequest) GetColor() string {
+	if m != nil && m.Color != nil {
+		return *m.Color
+	}
+	return ""
+}
+
 func (m *UpdateCategoryRequest) ToCategoryFilter() *repo.CategoryFilter {
 	return repo.NewCategoryFilter(
 		m.GetUserID(),
@@ -237,6 +327,10 @@ func (m *UpdateCategoryRequest) ToCategoryFilter() *repo.CategoryFilter {
 	)
 }
 
+func (m *UpdateCategoryRequest) ToCategoriesFilter() *repo.CategoryFilter {
+	return repo.NewCategoryFilter(m.GetUserID())
+}
+
 type UpdateCategoryResponse struct {
 	Category *entity.Category
 }
@@ -249,9 +343,10 @@ func (m *UpdateCategoryResponse) GetCategory() *entity.Category {
 }
 
 type GetCategoriesRequest struct {
-	UserID       *string
-	CategoryType *uint32
-	CategoryIDs  []string
+	UserID           *string
+	CategoryType     *uint32
+	CategoryIDs      []string
+	ParentCategoryID *string
 }
 
 func (m *GetCategoriesRequest) GetUserID() string {
@@ -275,11 +370,20 @@ func (m *GetCategoriesRequest) GetCategoryIDs() []string {
 	return nil
 }
 
+func (m *GetCategoriesRequest) GetParentCategoryID() string {
+	if m != nil && m.ParentCategoryID != nil {
+		return *m.ParentCategoryID
+	}
+	return ""
+}
+
 func (m *GetCategoriesRequest) ToCategoryFilter() *repo.CategoryFilter {
 	return repo.NewCategoryFilter(
 		m.GetUserID(),
 		repo.WithCategoryIDs(m.CategoryIDs),
 		repo.WithCategoryType(m.CategoryType),
+		repo.WithCategoryParentCategoryID(m.ParentCategoryID),
+		repo.WithCategoryPaging(toCategoryPositionPaging()),
 	)
 }
 
@@ -294,6 +398,44 @@ func (m *GetCategoriesResponse) GetCategories() []*entity.Category {
 	return nil
 }
 
+type GetCategoryTreeRequest struct {
+	UserID       *string
+	CategoryType *uint32
+}
+
+func (m *GetCategoryTreeRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *GetCategoryTreeRequest) GetCategoryType() uint32 {
+	if m != nil && m.CategoryType != nil {
+		return *m.CategoryType
+	}
+	return 0
+}
+
+func (m *GetCategoryTreeRequest) ToCategoryFilter() *repo.CategoryFilter {
+	return repo.NewCategoryFilter(
+		m.GetUserID(),
+		repo.WithCategoryType(m.CategoryType),
+		repo.WithCategoryPaging(toCategoryPositionPaging()),
+	)
+}
+
+type GetCategoryTreeResponse struct {
+	Categories []*entity.Category // top level categories with their children nested
+}
+
+func (m *GetCategoryTreeResponse) GetCategories() []*entity.Category {
+	if m != nil && m.Categories != nil {
+		return m.Categories
+	}
+	return nil
+}
+
 type GetCategoriesBudgetRequest struct {
 	AppMeta     *common.AppMeta
 	UserID      *string
@@ -333,9 +475,14 @@ func (m *GetCategoriesBudgetRequest) ToCategoryFilter() *repo.CategoryFilter {
 	return repo.NewCategoryFilter(
 		m.GetUserID(),
 		repo.WithCategoryIDs(m.CategoryIDs),
+		repo.WithCategoryPaging(toCategoryPositionPaging()),
 	)
 }
 
+func (m *GetCategoriesBudgetRequest) ToCategoriesFilter() *repo.CategoryFilter {
+	return repo.NewCategoryFilter(m.GetUserID())
+}
+
 func (m *GetCategoriesBudgetRequest) ToGetCategoryBudgetRequest(categoryID string) *GetCategoryBudgetRequest {
 	return &GetCategoryBudgetRequest{
 		UserID:     m.UserID,
@@ -382,19 +529,114 @@ func (m *DeleteCategoryRequest) ToCategoryFilter() *repo.CategoryFilter {
 	)
 }
 
-func (m *DeleteCategoryRequest) ToBudgetFilter() *repo.BudgetFilter {
+func (m *DeleteCategoryRequest) ToCategoriesFilter() *repo.CategoryFilter {
+	return repo.NewCategoryFilter(m.GetUserID())
+}
+
+func (m *DeleteCategoryRequest) ToSubcategoriesFilter(categoryIDs []string) *repo.CategoryFilter {
+	return repo.NewCategoryFilter(
+		m.GetUserID(),
+		repo.WithCategoryIDs(categoryIDs),
+	)
+}
+
+func (m *DeleteCategoryRequest) ToBudgetFilter(categoryIDs []string) *repo.BudgetFilter {
 	return &repo.BudgetFilter{
-		UserID:     m.UserID,
-		CategoryID: m.CategoryID,
+		UserID:      m.UserID,
+		CategoryIDs: categoryIDs,
 	}
 }
 
 type DeleteCategoryResponse struct{}
 
+type GetDeletedCategoriesRequest struct {
+	UserID *string
+}
+
+func (m *GetDeletedCategoriesRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *GetDeletedCategoriesRequest) ToCategoryFilter() *repo.CategoryFilter {
+	return repo.NewCategoryFilter(
+		m.GetUserID(),
+		repo.WithCategoryStatus(goutil.Uint32(uint32(entity.CategoryStatusDeleted))),
+		repo.WithCategoryPaging(&repo.Paging{
+			Sorts: []filter.Sort{
+				// most recently deleted first
+				&repo.Sort{
+					Field: goutil.String("update_time"),
+					Order: goutil.String(config.OrderDesc),
+				},
+			},
+		}),
+	)
+}
+
+type GetDeletedCategoriesResponse struct {
+	Categories []*entity.Category
+}
+
+func (m *GetDeletedCategoriesResponse) GetCategories() []*entity.Category {
+	if m != nil && m.Categories != nil {
+		return m.Categories
+	}
+	return nil
+}
+
+type RestoreCategoryRequest struct {
+	UserID     *string
+	CategoryID *string
+}
+
+func (m *RestoreCategoryRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *RestoreCategoryRequest) GetCategoryID() string {
+	if m != nil && m.CategoryID != nil {
+		return *m.CategoryID
+	}
+	return ""
+}
+
+func (m *RestoreCategoryRequest) ToCategoryFilter() *repo.CategoryFilter {
+	return repo.NewCategoryFilter(
+		m.GetUserID(),
+		repo.WithCategoryID(m.CategoryID),
+		repo.WithCategoryStatus(goutil.Uint32(uint32(entity.CategoryStatusDeleted))),
+	)
+}
+
+func (m *RestoreCategoryRequest) ToParentCategoryFilter(parentCategoryID string) *repo.CategoryFilter {
+	return repo.NewCategoryFilter(
+		m.GetUserID(),
+		repo.WithCategoryID(goutil.String(parentCategoryID)),
+	)
+}
+
+type RestoreCategoryResponse struct {
+	Category *entity.Category
+}
+
+func (m *RestoreCategoryResponse) GetCategory() *entity.Category {
+	if m != nil && m.Category != nil {
+		return m.Category
+	}
+	return nil
+}
+
 type SumCategoryTransactionsRequest struct {
-	UserID          *string
-	TransactionTime *common.RangeFilter
-	TransactionType *uint32
+	UserID           *string
+	TransactionTime  *common.RangeFilter
+	TransactionType  *uint32
+	ParentCategoryID *string // sum the subcategories of a category instead of the top level
 }
 
 func (m *SumCategoryTransactionsRequest) GetUserID() string {
@@ -418,6 +660,13 @@ func (m *SumCategoryTransactionsRequest) GetTransactionType() uint32 {
 	return 0
 }
 
+func (m *SumCategoryTransactionsRequest) GetParentCategoryID() string {
+	if m != nil && m.ParentCategoryID != nil {
+		return *m.ParentCategoryID
+	}
+	return ""
+}
+
 func (m *SumCategoryTransactionsRequest) ToTransactionQuery(categoryIDs []string) *repo.TransactionQuery {
 	tt := m.TransactionTime
 	if tt == nil {
@@ -426,15 +675,24 @@ func (m *SumCategoryTransactionsRequest) ToTransactionQuery(categoryIDs []string
 
 	return &repo.TransactionQuery{
 		Filters: []*repo.TransactionFilter{
+			// categories are already of the transaction type,
+			// so refunds are summed under the expense categories they refund
 			repo.NewTransactionFilter(
 				m.GetUserID(),
 				repo.WithTransactionTimeGte(tt.Gte),
 				repo.WithTransactionTimeLte(tt.Lte),
-				repo.WithTransactionType(m.TransactionType),
 				repo.WithTransactionCategoryIDs(categoryIDs),
 			),
+			// split transactions
+			repo.NewTransactionFilter(
+				m.GetUserID(),
+				repo.WithTransactionTimeGte(tt.Gte),
+				repo.WithTransactionTimeLte(tt.Lte),
+				repo.WithTransactionType(m.TransactionType),
+				repo.WithTransactionSplitCategoryIDs(categoryIDs),
+			),
 		},
-		Op: filter.And,
+		Op: filter.Or,
 	}
 }
 
@@ -464,3 +722,252 @@ func (m *SumCategoryTransactionsResponse) GetSums() []*common.Summary {
 	}
 	return nil
 }
+
+type MergeCategoriesRequest struct {
+	AppMeta          *common.AppMeta
+	UserID           *string
+	SourceCategoryID *string
+	TargetCategoryID *string
+	BudgetConflict   *uint32
+}
+
+func (m *MergeCategoriesRequest) GetAppMeta() *common.AppMeta {
+	if m != nil && m.AppMeta != nil {
+		return m.AppMeta
+	}
+	return nil
+}
+
+func (m *MergeCategoriesRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *MergeCategoriesRequest) GetSourceCategoryID() string {
+	if m != nil && m.SourceCategoryID != nil {
+		return *m.SourceCategoryID
+	}
+	return ""
+}
+
+func (m *MergeCategoriesRequest) GetTargetCategoryID() string {
+	if m != nil && m.TargetCategoryID != nil {
+		return *m.TargetCategoryID
+	}
+	return ""
+}
+
+func (m *MergeCategoriesRequest) GetBudgetConflict() uint32 {
+	if m != nil && m.BudgetConflict != nil {
+		return *m.BudgetConflict
+	}
+	return uint32(entity.BudgetConflictKeepTarget)
+}
+
+func (m *MergeCategoriesRequest) ToCategoriesFilter() *repo.CategoryFilter {
+	return repo.NewCategoryFilter(m.GetUserID())
+}
+
+func (m *MergeCategoriesRequest) ToCategoryFilter(categoryID string) *repo.CategoryFilter {
+	return repo.NewCategoryFilter(
+		m.GetUserID(),
+		repo.WithCategoryID(goutil.String(categoryID)),
+	)
+}
+
+// ToTransactionQuery gets all transactions under the source category, including deleted
+// ones so that they stay categorized when restored.
+func (m *MergeCategoriesRequest) ToTransactionQuery() *repo.TransactionQuery {
+	return &repo.TransactionQuery{
+		Filters: []*repo.TransactionFilter{
+			repo.NewTransactionFilter(
+				m.GetUserID(),
+				repo.WithTransactionCategoryID(m.SourceCategoryID),
+				repo.WithTransactionStatus(nil),
+			),
+			// split transactions
+			repo.NewTransactionFilter(
+				m.GetUserID(),
+				repo.WithTransactionSplitCategoryID(m.SourceCategoryID),
+				repo.WithTransactionStatus(nil),
+			),
+		},
+		Op: filter.Or,
+	}
+}
+
+func (m *MergeCategoriesRequest) ToTransactionFilter(transactionID string) *repo.TransactionFilter {
+	return repo.NewTransactionFilter(
+		m.GetUserID(),
+		repo.WithTransactionID(goutil.String(transactionID)),
+		repo.WithTransactionStatus(nil),
+	)
+}
+
+func (m *MergeCategoriesRequest) ToBudgetQuery(categoryID string) *repo.BudgetQuery {
+	return &repo.BudgetQuery{
+		Filters: []*repo.BudgetFilter{
+			{
+				UserID:     m.UserID,
+				CategoryID: goutil.String(categoryID),
+			},
+		},
+	}
+}
+
+func (m *MergeCategoriesRequest) ToGetBudgetFilter(categoryID, budgetDate string) *repo.GetBudgetFilter {
+	return &repo.GetBudgetFilter{
+		UserID:     m.UserID,
+		CategoryID: goutil.String(categoryID),
+		BudgetDate: goutil.String(budgetDate),
+	}
+}
+
+func (m *MergeCategoriesRequest) ToBudgetFilter(categoryID string) *repo.BudgetFilter {
+	return &repo.BudgetFilter{
+		UserID:     m.UserID,
+		CategoryID: goutil.String(categoryID),
+	}
+}
+
+func (m *MergeCategoriesRequest) ToRulesFilter() *repo.RuleFilter {
+	return repo.NewRuleFilter(
+		m.GetUserID(),
+		repo.WithRuleCategoryID(m.SourceCategoryID),
+	)
+}
+
+func (m *MergeCategoriesRequest) ToRuleFilter(ruleID string) *repo.RuleFilter {
+	return repo.NewRuleFilter(
+		m.GetUserID(),
+		repo.WithRuleID(goutil.String(ruleID)),
+	)
+}
+
+func (m *MergeCategoriesRequest) ToRecurringTransactionsFilter() *repo.RecurringTransactionFilter {
+	return repo.NewRecurringTransactionFilter(
+		m.GetUserID(),
+		repo.WithRecurringTransactionCategoryID(m.SourceCategoryID),
+		repo.WithRecurringTransactionStatus(nil),
+		repo.WithRecurringTransactionStatuses([]uint32{
+			uint32(entity.RecurringTransactionStatusNormal),
+			uint32(entity.RecurringTransactionStatusEnded),
+		}),
+	)
+}
+
+func (m *MergeCategoriesRequest) ToRecurringTransactionFilter(recurringTransactionID string) *repo.RecurringTransactionFilter {
+	return repo.NewRecurringTransactionFilter(
+		m.GetUserID(),
+		repo.WithRecurringTransactionID(goutil.String(recurringTransactionID)),
+		repo.WithRecurringTransactionStatus(nil),
+	)
+}
+
+// ToAccountsFilter gets all accounts posting interest under the source category, including
+// deleted ones so that they keep a valid interest category when restored.
+func (m *MergeCategoriesRequest) ToAccountsFilter() *repo.AccountFilter {
+	return repo.NewAccountFilter(
+		m.GetUserID(),
+		repo.WithAccountInterestCategoryID(m.SourceCategoryID),
+		repo.WithAccountStatus(nil),
+	)
+}
+
+func (m *MergeCategoriesRequest) ToAccountFilter(accountID string) *repo.AccountFilter {
+	return repo.NewAccountFilter(
+		m.GetUserID(),
+		repo.WithAccountID(goutil.String(accountID)),
+		repo.WithAccountStatus(nil),
+	)
+}
+
+func (m *MergeCategoriesRequest) ToExchangeRateFilter(to, from string, timestamp uint64) *repo.ExchangeRateFilter {
+	return repo.NewExchangeRateFilter(
+		repo.WithExchangeRateTo(goutil.String(to)),
+		repo.WithExchangeRateFrom(goutil.String(from)),
+		repo.WithExchangeRateTimestamp(goutil.Uint64(timestamp)),
+	)
+}
+
+type MergeCategoriesResponse struct {
+	Category     *entity.Category // target category
+	Transactions *uint32          // number of transactions moved
+}
+
+func (m *MergeCategoriesResponse) GetCategory() *entity.Category {
+	if m != nil && m.Category != nil {
+		return m.Category
+	}
+	return nil
+}
+
+func (m *MergeCategoriesResponse) GetTransactions() uint32 {
+	if m != nil && m.Transactions != nil {
+		return *m.Transactions
+	}
+	return 0
+}
+
+type ReorderCategoriesRequest struct {
+	UserID      *string
+	CategoryIDs []string // in the new order
+}
+
+func (m *ReorderCategoriesRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *ReorderCategoriesRequest) GetCategoryIDs() []string {
+	if m != nil && m.CategoryIDs != nil {
+		return m.CategoryIDs
+	}
+	return nil
+}
+
+func (m *ReorderCategoriesRequest) ToCategoriesFilter() *repo.CategoryFilter {
+	return repo.NewCategoryFilter(
+		m.GetUserID(),
+		repo.WithCategoryPaging(toCategoryPositionPaging()),
+	)
+}
+
+func (m *ReorderCategoriesRequest) ToCategoryFilter(categoryID string) *repo.CategoryFilter {
+	return repo.NewCategoryFilter(
+		m.GetUserID(),
+		repo.WithCategoryID(goutil.String(categoryID)),
+	)
+}
+
+type ReorderCategoriesResponse struct {
+	Categories []*entity.Category
+}
+
+func (m *ReorderCategoriesResponse) GetCategories() []*entity.Category {
+	if m != nil && m.Categories != nil {
+		return m.Categories
+	}
+	return nil
+}
+
+// toCategoryPositionPaging sorts categories in the order set by the user. Categories with
+// the same position, such as those created before positions were kept, fall back to creation order.
+func toCategoryPositionPaging() *repo.Paging {
+	return &repo.Paging{
+		Sorts: []filter.Sort{
+			&repo.Sort{
+				Field: goutil.String("position"),
+				Order: goutil.String(config.OrderAsc),
+			},
+			&repo.Sort{
+				Field: goutil.String("create_time"),
+				Order: goutil.String(config.OrderAsc),
+			},
+		},
+	}
+}
@@ -2,10 +2,14 @@ package category
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/jseow5177/pockteer-be/dep/repo"
 	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/errutil"
 	"github.com/jseow5177/pockteer-be/pkg/goutil"
 	"github.com/jseow5177/pockteer-be/usecase/budget"
 	"github.com/jseow5177/pockteer-be/usecase/common"
@@ -13,12 +17,23 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
+var (
+	ErrParentCategoryType = errutil.ValidationError(errors.New("parent category must be of the same type"))
+	ErrCategoryCycle      = errutil.ValidationError(errors.New("category cannot be moved under itself or its subcategories"))
+	ErrMergeSameCategory  = errutil.ValidationError(errors.New("cannot merge a category into itself"))
+	ErrMergeCategoryType  = errutil.ValidationError(errors.New("categories to merge must be of the same type"))
+	ErrDuplicateCategory  = errutil.ValidationError(errors.New("category is listed more than once"))
+)
+
 type categoryUseCase struct {
-	txMgr            repo.TxMgr
-	categoryRepo     repo.CategoryRepo
-	transactionRepo  repo.TransactionRepo
-	budgetRepo       repo.BudgetRepo
-	exchangeRateRepo repo.ExchangeRateRepo
+	txMgr                    repo.TxMgr
+	categoryRepo             repo.CategoryRepo
+	transactionRepo          repo.TransactionRepo
+	budgetRepo               repo.BudgetRepo
+	exchangeRateRepo         repo.ExchangeRateRepo
+	ruleRepo                 repo.RuleRepo
+	recurringTransactionRepo repo.RecurringTransactionRepo
+	accountRepo              repo.AccountRepo
 }
 
 func NewCategoryUseCase(
@@ -28,6 +43,9 @@ func NewCategoryUseCase(
 	budgetUseCase budget.UseCase,
 	budgetRepo repo.BudgetRepo,
 	exchangeRateRepo repo.ExchangeRateRepo,
+	ruleRepo repo.RuleRepo,
+	recurringTransactionRepo repo.RecurringTransactionRepo,
+	accountRepo repo.AccountRepo,
 ) UseCase {
 	return &categoryUseCase{
 		txMgr,
@@ -35,6 +53,9 @@ func NewCategoryUseCase(
 		transactionRepo,
 		budgetRepo,
 		exchangeRateRepo,
+		ruleRepo,
+		recurringTransactionRepo,
+		accountRepo,
 	}
 }
 
@@ -63,7 +84,13 @@ func (uc *categoryUseCase) GetCategoryBudget(ctx context.Context, req *GetCatego
 		}, nil
 	}
 
-	b, err := uc.getBudgetWithUsage(ctx, req)
+	cs, err := uc.categoryRepo.GetMany(ctx, req.ToCategoriesFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get categories from repo, err: %v", err)
+		return nil, err
+	}
+
+	b, err := uc.getBudgetWithUsage(ctx, req, cs)
 	if err != nil {
 		log.Ctx(ctx).Error().Msgf("fail to get budget usage, err: %v", err)
 		return nil, err
@@ -82,6 +109,25 @@ func (uc *categoryUseCase) CreateCategory(ctx context.Context, req *CreateCatego
 		return nil, err
 	}
 
+	if c.HasParent() {
+		parent, err := uc.categoryRepo.Get(ctx, req.ToParentCategoryFilter())
+		if err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to get parent category from repo, err: %v", err)
+			return nil, err
+		}
+
+		if err := checkParentCategory(c, parent, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	cs, err := uc.categoryRepo.GetMany(ctx, req.ToCategoriesFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get categories from repo, err: %v", err)
+		return nil, err
+	}
+	c.SetPosition(goutil.Uint32(getNextCategoryPosition(cs)))
+
 	_, err = uc.categoryRepo.Create(ctx, c)
 	if err != nil {
 		log.Ctx(ctx).Error().Msgf("fail to save new category to repo, err: %v", err)
@@ -100,8 +146,35 @@ func (uc *categoryUseCase) UpdateCategory(ctx context.Context, req *UpdateCatego
 		return nil, err
 	}
 
+	if req.GetParentCategoryID() != "" && req.GetParentCategoryID() != c.GetParentCategoryID() {
+		cs, err := uc.categoryRepo.GetMany(ctx, req.ToCategoriesFilter())
+		if err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to get categories from repo, err: %v", err)
+			return nil, err
+		}
+
+		var parent *entity.Category
+		for _, pc := range cs {
+			if pc.GetCategoryID() == req.GetParentCategoryID() {
+				parent = pc
+				break
+			}
+		}
+
+		if parent == nil {
+			return nil, repo.ErrCategoryNotFound
+		}
+
+		if err := checkParentCategory(c, parent, cs); err != nil {
+			return nil, err
+		}
+	}
+
 	cu, err := c.Update(
 		entity.WithUpdateCategoryName(req.CategoryName),
+		entity.WithUpdateCategoryParentCategoryID(req.ParentCategoryID),
+		entity.WithUpdateCategoryIcon(req.Icon),
+		entity.WithUpdateCategoryColor(req.Color),
 	)
 	if err != nil {
 		return nil, err
@@ -125,14 +198,24 @@ func (uc *categoryUseCase) UpdateCategory(ctx context.Context, req *UpdateCatego
 }
 
 func (uc *categoryUseCase) DeleteCategory(ctx context.Context, req *DeleteCategoryRequest) (*DeleteCategoryResponse, error) {
-	cf := req.ToCategoryFilter()
-
-	c, err := uc.categoryRepo.Get(ctx, cf)
+	c, err := uc.categoryRepo.Get(ctx, req.ToCategoryFilter())
 	if err != nil {
 		log.Ctx(ctx).Error().Msgf("fail to get category from repo, err: %v", err)
 		return nil, err
 	}
 
+	cs, err := uc.categoryRepo.GetMany(ctx, req.ToCategoriesFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get categories from repo, err: %v", err)
+		return nil, err
+	}
+
+	// subcategories are deleted together with the category
+	categoryIDs := []string{c.GetCategoryID()}
+	for _, sc := range entity.GetCategoryDescendants(cs, c.GetCategoryID()) {
+		categoryIDs = append(categoryIDs, sc.GetCategoryID())
+	}
+
 	if err := uc.txMgr.WithTx(ctx, func(txCtx context.Context) error {
 		cu, err := c.Update(
 			entity.WithUpdateCategoryStatus(goutil.Uint32(uint32(entity.CategoryStatusDeleted))),
@@ -141,15 +224,15 @@ func (uc *categoryUseCase) DeleteCategory(ctx context.Context, req *DeleteCatego
 			return err
 		}
 
-		// mark category as deleted
-		if err := uc.categoryRepo.Update(txCtx, cf, cu); err != nil {
+		// mark categories as deleted
+		if err := uc.categoryRepo.UpdateMany(txCtx, req.ToSubcategoriesFilter(categoryIDs), cu); err != nil {
 			log.Ctx(txCtx).Error().Msgf("fail to save category updates to repo, err: %v", err)
 			return err
 		}
 
 		// hard delete budgets
-		bf := req.ToBudgetFilter()
-		if err := uc.budgetRepo.DeleteMany(ctx, bf); err != nil {
+		bf := req.ToBudgetFilter(categoryIDs)
+		if err := uc.budgetRepo.DeleteMany(txCtx, bf); err != nil {
 			log.Ctx(txCtx).Error().Msgf("fail to delete category budgets, err: %v", err)
 			return err
 		}
@@ -162,6 +245,480 @@ func (uc *categoryUseCase) DeleteCategory(ctx context.Context, req *DeleteCatego
 	return new(DeleteCategoryResponse), nil
 }
 
+func (uc *categoryUseCase) GetDeletedCategories(ctx context.Context, req *GetDeletedCategoriesRequest) (*GetDeletedCategoriesResponse, error) {
+	cs, err := uc.categoryRepo.GetMany(ctx, req.ToCategoryFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get deleted categories from repo, err: %v", err)
+		return nil, err
+	}
+
+	return &GetDeletedCategoriesResponse{
+		Categories: cs,
+	}, nil
+}
+
+// RestoreCategory flips a deleted category back to normal. Budgets were hard deleted
+// together with the category and are not restored. A subcategory whose parent is still
+// deleted is restored to the top level.
+func (uc *categoryUseCase) RestoreCategory(ctx context.Context, req *RestoreCategoryRequest) (*RestoreCategoryResponse, error) {
+	cf := req.ToCategoryFilter()
+
+	c, err := uc.categoryRepo.Get(ctx, cf)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get deleted category from repo, err: %v", err)
+		return nil, err
+	}
+
+	var parentCategoryID *string
+	if c.HasParent() {
+		_, err := uc.categoryRepo.Get(ctx, req.ToParentCategoryFilter(c.GetParentCategoryID()))
+		if err != nil && err != repo.ErrCategoryNotFound {
+			log.Ctx(ctx).Error().Msgf("fail to get parent category from repo, err: %v", err)
+			return nil, err
+		}
+
+		if err == repo.ErrCategoryNotFound {
+			parentCategoryID = goutil.String("")
+		}
+	}
+
+	cu, err := c.Update(
+		entity.WithUpdateCategoryStatus(goutil.Uint32(uint32(entity.CategoryStatusNormal))),
+		entity.WithUpdateCategoryParentCategoryID(parentCategoryID),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.categoryRepo.Update(ctx, cf, cu); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to save category updates to repo, err: %v", err)
+		return nil, err
+	}
+
+	return &RestoreCategoryResponse{
+		Category: c,
+	}, nil
+}
+
+// MergeCategories moves all transactions, budgets, rules, recurring transactions, interest
+// postings and subcategories of the source category to the target category, then deletes
+// the source category.
+func (uc *categoryUseCase) MergeCategories(ctx context.Context, req *MergeCategoriesRequest) (*MergeCategoriesResponse, error) {
+	if req.GetSourceCategoryID() == req.GetTargetCategoryID() {
+		return nil, ErrMergeSameCategory
+	}
+
+	loc, err := time.LoadLocation(req.GetAppMeta().GetTimezone())
+	if err != nil {
+		return nil, entity.ErrInvalidTimezone
+	}
+
+	source, err := uc.categoryRepo.Get(ctx, req.ToCategoryFilter(req.GetSourceCategoryID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get source category from repo, err: %v", err)
+		return nil, err
+	}
+
+	target, err := uc.categoryRepo.Get(ctx, req.ToCategoryFilter(req.GetTargetCategoryID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get target category from repo, err: %v", err)
+		return nil, err
+	}
+
+	if source.GetCategoryType() != target.GetCategoryType() {
+		return nil, ErrMergeCategoryType
+	}
+
+	cs, err := uc.categoryRepo.GetMany(ctx, req.ToCategoriesFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get categories from repo, err: %v", err)
+		return nil, err
+	}
+
+	// subcategories of the source are moved under the target
+	for _, sc := range entity.GetCategoryDescendants(cs, source.GetCategoryID()) {
+		if sc.GetCategoryID() == target.GetCategoryID() {
+			return nil, ErrCategoryCycle
+		}
+	}
+
+	var moved int
+	if err := uc.txMgr.WithTx(ctx, func(txCtx context.Context) error {
+		// read in the transaction, so none created meanwhile is left under the source
+		ts, err := uc.transactionRepo.GetMany(txCtx, req.ToTransactionQuery())
+		if err != nil {
+			log.Ctx(txCtx).Error().Msgf("fail to get transactions from repo, err: %v", err)
+			return err
+		}
+		moved = len(ts)
+
+		for _, t := range ts {
+			tu, err := t.Update(toMergedCategoryUpdates(t, source.GetCategoryID(), target.GetCategoryID())...)
+			if err != nil {
+				return err
+			}
+
+			if tu == nil {
+				continue
+			}
+
+			if err := uc.transactionRepo.Update(txCtx, req.ToTransactionFilter(t.GetTransactionID()), tu); err != nil {
+				log.Ctx(txCtx).Error().Msgf("fail to save transaction updates to repo, err: %v", err)
+				return err
+			}
+		}
+
+		if err := uc.mergeBudgets(txCtx, req, time.Now().In(loc)); err != nil {
+			log.Ctx(txCtx).Error().Msgf("fail to merge budgets, err: %v", err)
+			return err
+		}
+
+		if err := uc.mergeReferences(txCtx, req, target.CategoryID); err != nil {
+			log.Ctx(txCtx).Error().Msgf("fail to merge category references, err: %v", err)
+			return err
+		}
+
+		for _, c := range cs {
+			if c.GetParentCategoryID() != source.GetCategoryID() {
+				continue
+			}
+
+			cu, err := c.Update(
+				entity.WithUpdateCategoryParentCategoryID(target.CategoryID),
+			)
+			if err != nil {
+				return err
+			}
+
+			if err := uc.categoryRepo.Update(txCtx, req.ToCategoryFilter(c.GetCategoryID()), cu); err != nil {
+				log.Ctx(txCtx).Error().Msgf("fail to save subcategory updates to repo, err: %v", err)
+				return err
+			}
+		}
+
+		cu, err := source.Update(
+			entity.WithUpdateCategoryStatus(goutil.Uint32(uint32(entity.CategoryStatusDeleted))),
+		)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.categoryRepo.Update(txCtx, req.ToCategoryFilter(source.GetCategoryID()), cu); err != nil {
+			log.Ctx(txCtx).Error().Msgf("fail to save category updates to repo, err: %v", err)
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &MergeCategoriesResponse{
+		Category:     target,
+		Transactions: goutil.Uint32(uint32(moved)),
+	}, nil
+}
+
+// mergeReferences points the rules, recurring transactions and interest postings of
+// the source category to the target category.
+func (uc *categoryUseCase) mergeReferences(ctx context.Context, req *MergeCategoriesRequest, targetCategoryID *string) error {
+	rs, err := uc.ruleRepo.GetMany(ctx, req.ToRulesFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get rules from repo, err: %v", err)
+		return err
+	}
+
+	for _, r := range rs {
+		ru, err := r.Update(entity.WithUpdateRuleCategoryID(targetCategoryID))
+		if err != nil {
+			return err
+		}
+
+		if ru == nil {
+			continue
+		}
+
+		if err := uc.ruleRepo.Update(ctx, req.ToRuleFilter(r.GetRuleID()), ru); err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to save rule updates to repo, err: %v", err)
+			return err
+		}
+	}
+
+	rts, err := uc.recurringTransactionRepo.GetMany(ctx, req.ToRecurringTransactionsFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get recurring transactions from repo, err: %v", err)
+		return err
+	}
+
+	for _, rt := range rts {
+		rtu, err := rt.Update(entity.WithUpdateRecurringTransactionCategoryID(targetCategoryID))
+		if err != nil {
+			return err
+		}
+
+		if rtu == nil {
+			continue
+		}
+
+		if err := uc.recurringTransactionRepo.Update(ctx, req.ToRecurringTransactionFilter(rt.GetRecurringTransactionID()), rtu); err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to save recurring transaction updates to repo, err: %v", err)
+			return err
+		}
+	}
+
+	acs, err := uc.accountRepo.GetMany(ctx, req.ToAccountsFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get accounts from repo, err: %v", err)
+		return err
+	}
+
+	for _, ac := range acs {
+		acu, err := ac.Update(entity.WithUpdateAccountInterestCategoryID(targetCategoryID))
+		if err != nil {
+			return err
+		}
+
+		if acu == nil {
+			continue
+		}
+
+		if err := uc.accountRepo.Update(ctx, req.ToAccountFilter(ac.GetAccountID()), acu); err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to save account updates to repo, err: %v", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mergeBudgets moves the budget records of the source category to the target category.
+// If both categories have budgets, the conflict is resolved by keeping either side, or
+// by adding the source budget of today to the target budget from the current period on.
+func (uc *categoryUseCase) mergeBudgets(ctx context.Context, req *MergeCategoriesRequest, now time.Time) error {
+	sbs, err := uc.budgetRepo.GetMany(ctx, req.ToBudgetQuery(req.GetSourceCategoryID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get source budgets from repo, err: %v", err)
+		return err
+	}
+
+	if len(sbs) == 0 {
+		return nil
+	}
+
+	tbs, err := uc.budgetRepo.GetMany(ctx, req.ToBudgetQuery(req.GetTargetCategoryID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get target budgets from repo, err: %v", err)
+		return err
+	}
+
+	hasConflict := len(tbs) > 0
+
+	switch {
+	case !hasConflict || req.GetBudgetConflict() == uint32(entity.BudgetConflictKeepSource):
+		if hasConflict {
+			if err := uc.budgetRepo.DeleteMany(ctx, req.ToBudgetFilter(req.GetTargetCategoryID())); err != nil {
+				log.Ctx(ctx).Error().Msgf("fail to delete target budgets, err: %v", err)
+				return err
+			}
+		}
+
+		for _, b := range sbs {
+			b.SetBudgetID(nil)
+			b.SetCategoryID(req.TargetCategoryID)
+		}
+
+		if _, err := uc.budgetRepo.CreateMany(ctx, sbs); err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to save moved budgets to repo, err: %v", err)
+			return err
+		}
+	case req.GetBudgetConflict() == uint32(entity.BudgetConflictSum):
+		bs, err := uc.getSummedBudgets(ctx, req, sbs, tbs, now)
+		if err != nil {
+			return err
+		}
+
+		if len(bs) > 0 {
+			if _, err := uc.budgetRepo.CreateMany(ctx, bs); err != nil {
+				log.Ctx(ctx).Error().Msgf("fail to save summed budgets to repo, err: %v", err)
+				return err
+			}
+		}
+	case req.GetBudgetConflict() == uint32(entity.BudgetConflictKeepTarget):
+		// source budgets are dropped
+	default:
+		return entity.ErrInvalidBudgetConflict
+	}
+
+	if err := uc.budgetRepo.DeleteMany(ctx, req.ToBudgetFilter(req.GetSourceCategoryID())); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to delete source budgets, err: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// getSummedBudgets adds the source budgets to the target budgets over their whole history. The history is split
+// into date ranges where the budgets of neither category change, and each range with a source budget gets a budget
+// summing both. The summed budgets are newer, so they take over the target budgets in their ranges.
+func (uc *categoryUseCase) getSummedBudgets(ctx context.Context, req *MergeCategoriesRequest, sbs, tbs []*entity.Budget, now time.Time) ([]*entity.Budget, error) {
+	// start dates of the ranges, 0 is unbounded
+	starts := []uint64{0}
+	for _, bs := range [][]*entity.Budget{sbs, tbs} {
+		for _, b := range bs {
+			if b.GetStartDate() != 0 {
+				starts = append(starts, b.GetStartDate())
+			}
+
+			if b.GetEndDate() != 0 {
+				d, err := util.AddDaysToDate(b.GetEndDate(), 1)
+				if err != nil {
+					return nil, err
+				}
+				starts = append(starts, d)
+			}
+		}
+	}
+	sort.Slice(starts, func(i, j int) bool {
+		return starts[i] < starts[j]
+	})
+
+	bs := make([]*entity.Budget, 0)
+	for i, start := range starts {
+		if i > 0 && start == starts[i-1] {
+			continue
+		}
+
+		sb := getEffectiveBudget(sbs, start)
+		if sb == nil {
+			continue
+		}
+		tb := getEffectiveBudget(tbs, start)
+
+		b, err := uc.getSummedBudget(ctx, req, sb, tb, now)
+		if err != nil {
+			return nil, err
+		}
+
+		// 0 is unbounded
+		var end uint64
+		if i+1 < len(starts) {
+			end, err = util.AddDaysToDate(starts[i+1], -1)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		b.SetStartDate(goutil.Uint64(start))
+		b.SetEndDate(goutil.Uint64(end))
+
+		bs = append(bs, b)
+	}
+
+	return bs, nil
+}
+
+// getSummedBudget adds the source budget to the target budget, in the type and currency of the target.
+// The source budget is moved as it is if there is no target budget.
+func (uc *categoryUseCase) getSummedBudget(ctx context.Context, req *MergeCategoriesRequest, sb, tb *entity.Budget, now time.Time) (*entity.Budget, error) {
+	var (
+		amount     = sb.GetAmount()
+		period     = sb // budget type and period of the summed budget
+		createTime = sb.GetCreateTime()
+	)
+	if tb != nil {
+		period = tb
+		if tb.GetCreateTime() < createTime {
+			createTime = tb.GetCreateTime()
+		}
+
+		// convert to the period length of the target
+		amount *= tb.GetAveragePeriodDays() / sb.GetAveragePeriodDays()
+
+		if sb.GetCurrency() != tb.GetCurrency() {
+			erf := req.ToExchangeRateFilter(tb.GetCurrency(), sb.GetCurrency(), uint64(now.UnixMilli()))
+			er, err := uc.exchangeRateRepo.Get(ctx, erf)
+			if err != nil {
+				log.Ctx(ctx).Error().Msgf("fail to get exchange rate from repo, err: %v", err)
+				return nil, err
+			}
+
+			amount *= er.GetRate()
+		}
+
+		amount += tb.GetAmount()
+	}
+
+	return entity.NewBudget(
+		req.GetUserID(),
+		req.GetTargetCategoryID(),
+		entity.WithBudgetType(period.BudgetType),
+		entity.WithBudgetWeekStartDay(period.WeekStartDay),
+		entity.WithBudgetPeriodDays(period.PeriodDays),
+		entity.WithBudgetAnchorDate(period.AnchorDate),
+		entity.WithBudgetRollover(period.Rollover),
+		entity.WithBudgetCurrency(period.Currency),
+		entity.WithBudgetAmount(goutil.Float64(util.RoundFloatToStandardDP(amount))),
+		entity.WithBudgetCreateTime(goutil.Uint64(createTime)), // history of the budgets is kept
+	)
+}
+
+// getEffectiveBudget returns the budget in force on the date, the latest record covering it, like budgetRepo.Get.
+func getEffectiveBudget(bs []*entity.Budget, date uint64) *entity.Budget {
+	var eb *entity.Budget
+	for _, b := range bs {
+		if b.CoversDate(date) && (eb == nil || b.GetUpdateTime() > eb.GetUpdateTime()) {
+			eb = b
+		}
+	}
+
+	if eb == nil || eb.IsDeleted() {
+		return nil
+	}
+
+	return eb
+}
+
+func (uc *categoryUseCase) ReorderCategories(ctx context.Context, req *ReorderCategoriesRequest) (*ReorderCategoriesResponse, error) {
+	cs, err := uc.categoryRepo.GetMany(ctx, req.ToCategoriesFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get categories from repo, err: %v", err)
+		return nil, err
+	}
+
+	cs, err = toReorderedCategories(cs, req.GetCategoryIDs())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.txMgr.WithTx(ctx, func(txCtx context.Context) error {
+		for i, c := range cs {
+			cu, err := c.Update(
+				entity.WithUpdateCategoryPosition(goutil.Uint32(uint32(i))),
+			)
+			if err != nil {
+				return err
+			}
+
+			if cu == nil {
+				continue
+			}
+
+			if err := uc.categoryRepo.Update(txCtx, req.ToCategoryFilter(c.GetCategoryID()), cu); err != nil {
+				log.Ctx(txCtx).Error().Msgf("fail to save category position to repo, err: %v", err)
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &ReorderCategoriesResponse{
+		Categories: cs,
+	}, nil
+}
+
 func (uc *categoryUseCase) GetCategories(ctx context.Context, req *GetCategoriesRequest) (*GetCategoriesResponse, error) {
 	cs, err := uc.categoryRepo.GetMany(ctx, req.ToCategoryFilter())
 	if err != nil {
@@ -174,6 +731,18 @@ func (uc *categoryUseCase) GetCategories(ctx context.Context, req *GetCategories
 	}, nil
 }
 
+func (uc *categoryUseCase) GetCategoryTree(ctx context.Context, req *GetCategoryTreeRequest) (*GetCategoryTreeResponse, error) {
+	cs, err := uc.categoryRepo.GetMany(ctx, req.ToCategoryFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get categories from repo, err: %v", err)
+		return nil, err
+	}
+
+	return &GetCategoryTreeResponse{
+		Categories: entity.ToCategoryTree(cs),
+	}, nil
+}
+
 func (uc *categoryUseCase) GetCategoriesBudget(ctx context.Context, req *GetCategoriesBudgetRequest) (*GetCategoriesBudgetResponse, error) {
 	cs, err := uc.categoryRepo.GetMany(ctx, req.ToCategoryFilter())
 	if err != nil {
@@ -202,11 +771,18 @@ func (uc *categoryUseCase) GetCategoriesBudget(ctx context.Context, req *GetCate
 		}, nil
 	}
 
+	// all categories to roll up the subcategories
+	allCs, err := uc.categoryRepo.GetMany(ctx, req.ToCategoriesFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get categories from repo, err: %v", err)
+		return nil, err
+	}
+
 	if err := goutil.ParallelizeWork(ctx, len(catIDs), 10, func(ctx context.Context, workNum int) error {
 		catID := catIDs[workNum]
 		gReq := req.ToGetCategoryBudgetRequest(catID)
 
-		b, err := uc.getBudgetWithUsage(ctx, gReq)
+		b, err := uc.getBudgetWithUsage(ctx, gReq, allCs)
 		if err != nil {
 			return err
 		}
@@ -223,7 +799,9 @@ func (uc *categoryUseCase) GetCategoriesBudget(ctx context.Context, req *GetCate
 	}, nil
 }
 
-func (uc *categoryUseCase) getBudgetWithUsage(ctx context.Context, req *GetCategoryBudgetRequest) (*entity.Budget, error) {
+// getBudgetWithUsage gets the budget of the category in the budget date, with the usage
+// rolled up from all its subcategories in cs and the amount carried over if it rolls over.
+func (uc *categoryUseCase) getBudgetWithUsage(ctx context.Context, req *GetCategoryBudgetRequest, cs []*entity.Category) (*entity.Budget, error) {
 	bf := req.ToGetBudgetFilter()
 
 	b, err := uc.budgetRepo.Get(ctx, bf)
@@ -236,29 +814,51 @@ func (uc *categoryUseCase) getBudgetWithUsage(ctx context.Context, req *GetCateg
 		return nil, nil
 	}
 
-	// get budget date range
-	var start, end uint64
-	if b.IsMonth() {
-		start, end, err = util.GetMonthRangeAsUnix(req.GetBudgetDate(), req.AppMeta.GetTimezone())
-	} else if b.IsYear() {
-		start, end, err = util.GetYearRangeAsUnix(req.GetBudgetDate(), req.AppMeta.GetTimezone())
-	} else {
-		return nil, fmt.Errorf("invalid budget type, budget ID: %v", b.GetBudgetID())
+	categoryIDs := []string{req.GetCategoryID()}
+	for _, c := range entity.GetCategoryDescendants(cs, req.GetCategoryID()) {
+		categoryIDs = append(categoryIDs, c.GetCategoryID())
 	}
+
+	usedAmount, err := uc.getBudgetUsedAmount(ctx, req, b, req.GetBudgetDate(), categoryIDs)
 	if err != nil {
 		return nil, err
 	}
+	b.SetUsedAmount(goutil.Float64(usedAmount))
+
+	if b.IsRollover() {
+		carriedOver, err := uc.getBudgetCarriedOver(ctx, req, b, categoryIDs)
+		if err != nil {
+			return nil, err
+		}
+		b.SetCarriedOver(goutil.Float64(carriedOver))
+	}
+
+	remain := b.GetAmount() + b.GetCarriedOver() + b.GetUsedAmount()
+	b.SetRemain(goutil.Float64(remain))
+
+	return b, nil
+}
+
+// getBudgetUsedAmount sums the transactions of the categories in the budget period containing the date,
+// in the currency of the budget.
+func (uc *categoryUseCase) getBudgetUsedAmount(ctx context.Context, req *GetCategoryBudgetRequest, b *entity.Budget, date string, categoryIDs []string) (float64, error) {
+	// get budget date range
+	start, end, err := b.GetUnixRange(date, req.AppMeta.GetTimezone())
+	if err != nil {
+		return 0, fmt.Errorf("fail to get budget range, budget ID: %v, err: %v", b.GetBudgetID(), err)
+	}
 
-	tq := req.ToTransactionQuery(req.GetUserID(), start, end)
+	tq := req.ToTransactionQuery(categoryIDs, start, end)
 	ts, err := uc.transactionRepo.GetMany(ctx, tq)
 	if err != nil {
 		log.Ctx(ctx).Error().Msgf("fail to get transactions from repo, err: %v", err)
-		return nil, err
+		return 0, err
 	}
 
 	var usedAmount float64
 	for _, t := range ts {
-		amount := t.GetAmount()
+		// only the split under the category and its subcategories is used
+		amount := t.GetAmountByCategories(categoryIDs...)
 
 		if t.GetCurrency() != b.GetCurrency() {
 			erf := req.ToExchangeRateFilter(
@@ -269,7 +869,7 @@ func (uc *categoryUseCase) getBudgetWithUsage(ctx context.Context, req *GetCateg
 			er, err := uc.exchangeRateRepo.Get(ctx, erf)
 			if err != nil {
 				log.Ctx(ctx).Error().Msgf("fail to get exchange rate from repo, err: %v", err)
-				return nil, err
+				return 0, err
 			}
 
 			amount *= er.GetRate()
@@ -278,12 +878,83 @@ func (uc *categoryUseCase) getBudgetWithUsage(ctx context.Context, req *GetCateg
 		usedAmount += amount
 	}
 
-	b.SetUsedAmount(goutil.Float64(usedAmount))
+	return usedAmount, nil
+}
 
-	remain := b.GetAmount() + b.GetUsedAmount()
-	b.SetRemain(goutil.Float64(remain))
+// getBudgetCarriedOver computes the remaining amount, positive or negative, carried into the budget date.
+// Previous periods are walked back until one without rollover, without a budget, in another currency,
+// or before the budget starts. The remains are then chained from the earliest period.
+func (uc *categoryUseCase) getBudgetCarriedOver(ctx context.Context, req *GetCategoryBudgetRequest, b *entity.Budget, categoryIDs []string) (float64, error) {
+	var (
+		date  = req.GetBudgetDate()
+		pbs   = make([]*entity.Budget, 0) // budgets of the previous periods, latest first
+		dates = make([]string, 0)
+	)
+	for cb := b; cb.IsRollover() && len(pbs) < entity.MaxBudgetRolloverPeriods; {
+		isFirstPeriod, err := uc.isFirstBudgetPeriod(req, cb, date)
+		if err != nil {
+			return 0, err
+		}
 
-	return b, nil
+		// nothing to roll over from before the budget starts
+		if isFirstPeriod {
+			break
+		}
+
+		date, err = cb.GetPrevPeriodDate(date)
+		if err != nil {
+			return 0, err
+		}
+
+		pb, err := uc.budgetRepo.Get(ctx, req.ToGetBudgetFilterByDate(date))
+		if err != nil {
+			if err == repo.ErrBudgetNotFound {
+				break
+			}
+			log.Ctx(ctx).Error().Msgf("fail to get previous budget from repo, err: %v", err)
+			return 0, err
+		}
+
+		if pb.GetCurrency() != b.GetCurrency() {
+			break
+		}
+
+		pbs = append(pbs, pb)
+		dates = append(dates, date)
+
+		cb = pb
+	}
+
+	var carriedOver float64
+	for i := len(pbs) - 1; i >= 0; i-- {
+		usedAmount, err := uc.getBudgetUsedAmount(ctx, req, pbs[i], dates[i], categoryIDs)
+		if err != nil {
+			return 0, err
+		}
+
+		// the remain of a period is carried into the next
+		carriedOver += pbs[i].GetAmount() + usedAmount
+	}
+
+	return carriedOver, nil
+}
+
+// isFirstBudgetPeriod checks if the budget period containing the date is the first one of the budget.
+// Budgets without a start date, which cover all time, start from the period they are created in.
+func (uc *categoryUseCase) isFirstBudgetPeriod(req *GetCategoryBudgetRequest, b *entity.Budget, date string) (bool, error) {
+	if b.GetStartDate() != 0 {
+		startDate, _, err := b.GetDateRange(date, "")
+		if err != nil {
+			return false, fmt.Errorf("fail to get budget range, budget ID: %v, err: %v", b.GetBudgetID(), err)
+		}
+		return startDate <= b.GetStartDate(), nil
+	}
+
+	start, _, err := b.GetUnixRange(date, req.AppMeta.GetTimezone())
+	if err != nil {
+		return false, fmt.Errorf("fail to get budget range, budget ID: %v, err: %v", b.GetBudgetID(), err)
+	}
+	return start <= b.GetCreateTime(), nil
 }
 
 func (uc *categoryUseCase) SumCategoryTransactions(ctx context.Context, req *SumCategoryTransactionsRequest) (*SumCategoryTransactionsResponse, error) {
@@ -294,17 +965,27 @@ func (uc *categoryUseCase) SumCategoryTransactions(ctx context.Context, req *Sum
 	}
 
 	var (
-		categoryIDs   = make([]string, 0)
-		categoryMap   = make(map[string]*entity.Category)
-		sumByCategory = make(map[*entity.Category]float64)
+		parentCategoryID = req.GetParentCategoryID()
+		categoryIDs      = make([]string, 0)
+		categoryMap      = make(map[string]*entity.Category)
+		sumByCategory    = make(map[*entity.Category]float64)
 	)
 	for _, c := range cs {
 		categoryIDs = append(categoryIDs, c.GetCategoryID())
 		categoryMap[c.GetCategoryID()] = c
+	}
 
+	if parent, ok := categoryMap[parentCategoryID]; parentCategoryID != "" && (!ok || parent.IsDeleted()) {
+		return nil, repo.ErrCategoryNotFound
+	}
+
+	for _, c := range cs {
 		if c.IsDeleted() {
-			sumByCategory[nil] = 0
-		} else {
+			// deleted categories are only shown as uncategorized at the top level
+			if parentCategoryID == "" {
+				sumByCategory[nil] = 0
+			}
+		} else if getSumCategory(c, categoryMap, parentCategoryID) == c {
 			sumByCategory[c] = 0
 		}
 	}
@@ -319,7 +1000,7 @@ func (uc *categoryUseCase) SumCategoryTransactions(ctx context.Context, req *Sum
 	u := entity.GetUserFromCtx(ctx)
 
 	for _, t := range ts {
-		amount := t.GetAmount()
+		rate := 1.0
 
 		if t.GetCurrency() != u.Meta.GetCurrency() {
 			erf := req.ToExchangeRateFilter(
@@ -333,13 +1014,32 @@ func (uc *categoryUseCase) SumCategoryTransactions(ctx context.Context, req *Sum
 				return nil, err
 			}
 
-			amount *= er.GetRate()
+			rate = er.GetRate()
 		}
 
-		if c := categoryMap[t.GetCategoryID()]; c.IsDeleted() {
-			sumByCategory[nil] += amount
-		} else {
-			sumByCategory[c] += amount
+		// a split transaction is summed under each of its categories
+		for categoryID, amount := range t.GetCategoryAmounts() {
+			c, ok := categoryMap[categoryID]
+			if !ok {
+				continue
+			}
+
+			if c.IsDeleted() {
+				if parentCategoryID == "" {
+					sumByCategory[nil] += amount * rate
+				}
+				continue
+			}
+
+			// subcategories roll up to the category at the summed level
+			sc := getSumCategory(c, categoryMap, parentCategoryID)
+			switch {
+			case sc == nil:
+			case sc.IsDeleted():
+				sumByCategory[nil] += amount * rate
+			default:
+				sumByCategory[sc] += amount * rate
+			}
 		}
 	}
 
@@ -361,3 +1061,135 @@ func (uc *categoryUseCase) SumCategoryTransactions(ctx context.Context, req *Sum
 		Sums: sums,
 	}, nil
 }
+
+// getSumCategory returns the category under parentCategoryID that c rolls up to, or nil if c
+// is not nested under it. An empty parentCategoryID sums the top level categories, and the
+// parent category itself holds the amount not under any of its subcategories.
+func getSumCategory(c *entity.Category, categoryMap map[string]*entity.Category, parentCategoryID string) *entity.Category {
+	if parentCategoryID != "" && c.GetCategoryID() == parentCategoryID {
+		return c
+	}
+
+	// bounded in case of a corrupted cycle
+	for i := 0; i < len(categoryMap); i++ {
+		parent, ok := categoryMap[c.GetParentCategoryID()]
+		if !c.HasParent() || !ok {
+			if parentCategoryID == "" {
+				return c
+			}
+			return nil
+		}
+
+		if parent.GetCategoryID() == parentCategoryID {
+			return c
+		}
+		c = parent
+	}
+
+	return nil
+}
+
+// getNextCategoryPosition places a new category after all existing categories.
+func getNextCategoryPosition(cs []*entity.Category) uint32 {
+	var next uint32
+	for _, c := range cs {
+		if c.GetPosition() >= next {
+			next = c.GetPosition() + 1
+		}
+	}
+	return next
+}
+
+// toReorderedCategories puts the listed categories first in the given order, followed by
+// the unlisted categories in their existing order.
+func toReorderedCategories(cs []*entity.Category, categoryIDs []string) ([]*entity.Category, error) {
+	categoryMap := make(map[string]*entity.Category)
+	for _, c := range cs {
+		categoryMap[c.GetCategoryID()] = c
+	}
+
+	var (
+		reordered = make([]*entity.Category, 0, len(cs))
+		listed    = make(map[string]bool)
+	)
+	for _, categoryID := range categoryIDs {
+		c, ok := categoryMap[categoryID]
+		if !ok {
+			return nil, repo.ErrCategoryNotFound
+		}
+
+		if listed[categoryID] {
+			return nil, ErrDuplicateCategory
+		}
+		listed[categoryID] = true
+
+		reordered = append(reordered, c)
+	}
+
+	for _, c := range cs {
+		if !listed[c.GetCategoryID()] {
+			reordered = append(reordered, c)
+		}
+	}
+
+	return reordered, nil
+}
+
+func checkParentCategory(c, parent *entity.Category, cs []*entity.Category) error {
+	if parent.GetCategoryType() != c.GetCategoryType() {
+		return ErrParentCategoryType
+	}
+
+	if parent.GetCategoryID() == c.GetCategoryID() {
+		return ErrCategoryCycle
+	}
+
+	for _, sc := range entity.GetCategoryDescendants(cs, c.GetCategoryID()) {
+		if sc.GetCategoryID() == parent.GetCategoryID() {
+			return ErrCategoryCycle
+		}
+	}
+
+	return nil
+}
+
+// toMergedCategoryUpdates moves a transaction from the source category to the target category.
+// Splits under both categories are combined, and a split left with one category is no longer a split.
+func toMergedCategoryUpdates(t *entity.Transaction, sourceCategoryID, targetCategoryID string) []entity.TransactionUpdateOption {
+	if !t.IsSplit() {
+		return []entity.TransactionUpdateOption{
+			entity.WithUpdateTransactionCategoryID(goutil.String(targetCategoryID)),
+		}
+	}
+
+	var (
+		splits   = make([]*entity.TransactionSplit, 0)
+		splitMap = make(map[string]*entity.TransactionSplit)
+	)
+	for _, split := range t.GetSplits() {
+		categoryID := split.GetCategoryID()
+		if categoryID == sourceCategoryID {
+			categoryID = targetCategoryID
+		}
+
+		if ms, ok := splitMap[categoryID]; ok {
+			ms.SetAmount(goutil.Float64(ms.GetAmount() + split.GetAmount()))
+			continue
+		}
+
+		ms := entity.NewTransactionSplit(categoryID, split.GetAmount())
+		splitMap[categoryID] = ms
+		splits = append(splits, ms)
+	}
+
+	if len(splits) == 1 {
+		return []entity.TransactionUpdateOption{
+			entity.WithUpdateTransactionSplits(make([]*entity.TransactionSplit, 0)),
+			entity.WithUpdateTransactionCategoryID(goutil.String(targetCategoryID)),
+		}
+	}
+
+	return []entity.TransactionUpdateOption{
+		entity.WithUpdateTransactionSplits(splits),
+	}
+}
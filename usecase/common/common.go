@@ -59,6 +59,8 @@ type Summary struct {
 	Date            *string
 	Category        *entity.Category
 	Account         *entity.Account
+	Tag             *entity.Tag
+	Payee           *entity.Payee
 	TransactionType *uint32
 	Sum             *float64
 	TotalExpense    *float64
@@ -95,6 +97,22 @@ func WithSummaryAccount(ac *entity.Account) SummaryOption {
 	}
 }
 
+func WithSummaryTag(t *entity.Tag) SummaryOption {
+	return func(s *Summary) {
+		if t != nil {
+			s.SetTag(t)
+		}
+	}
+}
+
+func WithSummaryPayee(p *entity.Payee) SummaryOption {
+	return func(s *Summary) {
+		if p != nil {
+			s.SetPayee(p)
+		}
+	}
+}
+
 func WithSummaryPercentChange(percentChange *float64) SummaryOption {
 	return func(s *Summary) {
 		if percentChange != nil {
@@ -202,6 +220,28 @@ func (m *Summary) SetCategory(c *entity.Category) {
 	m.Category = c
 }
 
+func (m *Summary) GetTag() *entity.Tag {
+	if m != nil && m.Tag != nil {
+		return m.Tag
+	}
+	return nil
+}
+
+func (m *Summary) SetTag(t *entity.Tag) {
+	m.Tag = t
+}
+
+func (m *Summary) GetPayee() *entity.Payee {
+	if m != nil && m.Payee != nil {
+		return m.Payee
+	}
+	return nil
+}
+
+func (m *Summary) SetPayee(p *entity.Payee) {
+	m.Payee = p
+}
+
 func (m *Summary) GetSum() float64 {
 	if m != nil && m.Sum != nil {
 		return *m.Sum
@@ -0,0 +1,253 @@
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/dep/repo"
+	"github.com/jseow5177/pockteer-be/pkg/filter"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+)
+
+// dataset is a collection written to the archive, once as csv and once as json.
+// Each row returned by getPage is aligned with the columns.
+type dataset struct {
+	name    string
+	columns []string
+	getPage func(ctx context.Context, userID string, paging *repo.Paging) ([][]interface{}, error)
+}
+
+// list is a multi-value cell, joined in csv and kept as an array in json
+type list []string
+
+func (l list) String() string {
+	return strings.Join(l, "|")
+}
+
+type split struct {
+	CategoryID string  `json:"category_id"`
+	Amount     float64 `json:"amount"`
+}
+
+type splits []*split
+
+func (s splits) String() string {
+	ss := make([]string, 0, len(s))
+	for _, sp := range s {
+		ss = append(ss, fmt.Sprintf("%s:%v", sp.CategoryID, sp.Amount))
+	}
+	return strings.Join(ss, "|")
+}
+
+func newPaging(page int) *repo.Paging {
+	return &repo.Paging{
+		Limit: goutil.Uint32(config.ExportPageLimit),
+		Page:  goutil.Uint32(uint32(page)),
+		// stable order across pages
+		Sorts: []filter.Sort{
+			&repo.Sort{
+				Field: goutil.String("_id"),
+				Order: goutil.String(config.OrderAsc),
+			},
+		},
+	}
+}
+
+func (uc *exporterUseCase) datasets() []*dataset {
+	return []*dataset{
+		{
+			name: "accounts",
+			columns: []string{
+				"account_id", "account_name", "account_type", "currency",
+				"balance", "note", "create_time", "update_time",
+			},
+			getPage: func(ctx context.Context, userID string, paging *repo.Paging) ([][]interface{}, error) {
+				acs, err := uc.accountRepo.GetMany(ctx, repo.NewAccountFilter(
+					userID,
+					repo.WithAccountPaging(paging),
+				))
+				if err != nil {
+					return nil, err
+				}
+
+				rows := make([][]interface{}, 0, len(acs))
+				for _, ac := range acs {
+					rows = append(rows, []interface{}{
+						ac.GetAccountID(), ac.GetAccountName(), ac.GetAccountType(), ac.GetCurrency(),
+						ac.GetBalance(), ac.GetNote(), ac.GetCreateTime(), ac.GetUpdateTime(),
+					})
+				}
+				return rows, nil
+			},
+		},
+		{
+			name: "transactions",
+			columns: []string{
+				"transaction_id", "transaction_type", "transaction_time", "account_id",
+				"from_account_id", "to_account_id", "category_id", "splits", "currency",
+				"amount", "received_currency", "received_amount", "fee", "note", "tag_ids",
+				"recurring_transaction_id", "external_id", "refunded_transaction_id", "payee_id",
+				"clear_status", "create_time", "update_time",
+			},
+			getPage: func(ctx context.Context, userID string, paging *repo.Paging) ([][]interface{}, error) {
+				ts, err := uc.transactionRepo.GetMany(ctx, &repo.TransactionQuery{
+					Filters: []*repo.TransactionFilter{
+						repo.NewTransactionFilter(userID),
+					},
+					Paging: paging,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				rows := make([][]interface{}, 0, len(ts))
+				for _, t := range ts {
+					sps := make(splits, 0)
+					for _, sp := range t.GetSplits() {
+						sps = append(sps, &split{
+							CategoryID: sp.GetCategoryID(),
+							Amount:     sp.GetAmount(),
+						})
+					}
+
+					rows = append(rows, []interface{}{
+						t.GetTransactionID(), t.GetTransactionType(), t.GetTransactionTime(), t.GetAccountID(),
+						t.GetFromAccountID(), t.GetToAccountID(), t.GetCategoryID(), sps, t.GetCurrency(),
+						t.GetAmount(), t.GetReceivedCurrency(), t.GetReceivedAmount(), t.GetFee(), t.GetNote(),
+						append(list{}, t.GetTagIDs()...), t.GetRecurringTransactionID(), t.GetExternalID(),
+						t.GetRefundedTransactionID(), t.GetPayeeID(), t.GetClearStatus(), t.GetCreateTime(), t.GetUpdateTime(),
+					})
+				}
+				return rows, nil
+			},
+		},
+		{
+			name: "categories",
+			columns: []string{
+				"category_id", "category_name", "category_type", "create_time", "update_time",
+			},
+			getPage: func(ctx context.Context, userID string, paging *repo.Paging) ([][]interface{}, error) {
+				cs, err := uc.categoryRepo.GetMany(ctx, repo.NewCategoryFilter(
+					userID,
+					repo.WithCategoryPaging(paging),
+				))
+				if err != nil {
+					return nil, err
+				}
+
+				rows := make([][]interface{}, 0, len(cs))
+				for _, c := range cs {
+					rows = append(rows, []interface{}{
+						c.GetCategoryID(), c.GetCategoryName(), c.GetCategoryType(), c.GetCreateTime(), c.GetUpdateTime(),
+					})
+				}
+				return rows, nil
+			},
+		},
+		{
+			// budgets are versioned by date range, every version is exported
+			name: "budgets",
+			columns: []string{
+				"budget_id", "category_id", "budget_type", "week_start_day", "period_days", "anchor_date", "rollover",
+				"budget_status", "currency", "amount", "start_date", "end_date", "create_time", "update_time",
+			},
+			getPage: func(ctx context.Context, userID string, paging *repo.Paging) ([][]interface{}, error) {
+				bs, err := uc.budgetRepo.GetMany(ctx, &repo.BudgetQuery{
+					Filters: []*repo.BudgetFilter{
+						{
+							UserID: goutil.String(userID),
+						},
+					},
+					Paging: paging,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				rows := make([][]interface{}, 0, len(bs))
+				for _, b := range bs {
+					rows = append(rows, []interface{}{
+						b.GetBudgetID(), b.GetCategoryID(), b.GetBudgetType(), b.GetWeekStartDay(), b.GetPeriodDays(), b.GetAnchorDate(), b.GetRollover(),
+						b.GetBudgetStatus(), b.GetCurrency(), b.GetAmount(), b.GetStartDate(), b.GetEndDate(), b.GetCreateTime(), b.GetUpdateTime(),
+					})
+				}
+				return rows, nil
+			},
+		},
+		{
+			name: "holdings",
+			columns: []string{
+				"holding_id", "account_id", "symbol", "holding_type", "currency",
+				"total_cost", "latest_value", "create_time", "update_time",
+			},
+			getPage: func(ctx context.Context, userID string, paging *repo.Paging) ([][]interface{}, error) {
+				hs, err := uc.holdingRepo.GetMany(ctx, repo.NewHoldingFilter(
+					repo.WithHoldingUserID(goutil.String(userID)),
+					repo.WithHoldingPaging(paging),
+				))
+				if err != nil {
+					return nil, err
+				}
+
+				rows := make([][]interface{}, 0, len(hs))
+				for _, h := range hs {
+					rows = append(rows, []interface{}{
+						h.GetHoldingID(), h.GetAccountID(), h.GetSymbol(), h.GetHoldingType(), h.GetCurrency(),
+						h.GetTotalCost(), h.GetLatestValue(), h.GetCreateTime(), h.GetUpdateTime(),
+					})
+				}
+				return rows, nil
+			},
+		},
+		{
+			name: "lots",
+			columns: []string{
+				"lot_id", "holding_id", "shares", "cost_per_share", "currency",
+				"trade_date", "create_time", "update_time",
+			},
+			getPage: func(ctx context.Context, userID string, paging *repo.Paging) ([][]interface{}, error) {
+				ls, err := uc.lotRepo.GetMany(ctx, repo.NewLotFilter(
+					userID,
+					repo.WithLotPaging(paging),
+				))
+				if err != nil {
+					return nil, err
+				}
+
+				rows := make([][]interface{}, 0, len(ls))
+				for _, l := range ls {
+					rows = append(rows, []interface{}{
+						l.GetLotID(), l.GetHoldingID(), l.GetShares(), l.GetCostPerShare(), l.GetCurrency(),
+						l.GetTradeDate(), l.GetCreateTime(), l.GetUpdateTime(),
+					})
+				}
+				return rows, nil
+			},
+		},
+		{
+			name: "snapshots",
+			columns: []string{
+				"snapshot_id", "snapshot_type", "timestamp", "record", "create_time",
+			},
+			getPage: func(ctx context.Context, userID string, paging *repo.Paging) ([][]interface{}, error) {
+				sps, err := uc.snapshotRepo.GetMany(ctx, repo.NewSnapshotFilter(
+					userID,
+					repo.WithSnapshotPaging(paging),
+				))
+				if err != nil {
+					return nil, err
+				}
+
+				rows := make([][]interface{}, 0, len(sps))
+				for _, sp := range sps {
+					rows = append(rows, []interface{}{
+						sp.GetSnapshotID(), sp.GetSnapshotType(), sp.GetTimestamp(), sp.GetRecord(), sp.GetCreateTime(),
+					})
+				}
+				return rows, nil
+			},
+		},
+	}
+}
@@ -0,0 +1,49 @@
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/jseow5177/pockteer-be/util"
+)
+
+type UseCase interface {
+	ExportData(ctx context.Context, req *ExportDataRequest) (*ExportDataResponse, error)
+}
+
+type ExportDataRequest struct {
+	UserID *string
+	Writer io.Writer
+}
+
+func (m *ExportDataRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *ExportDataRequest) GetWriter() io.Writer {
+	if m != nil && m.Writer != nil {
+		return m.Writer
+	}
+	return nil
+}
+
+type ExportDataResponse struct {
+	Records *uint32
+}
+
+func (m *ExportDataResponse) GetRecords() uint32 {
+	if m != nil && m.Records != nil {
+		return *m.Records
+	}
+	return 0
+}
+
+// ArchiveName is the file name of an archive exported at the given time.
+func ArchiveName(t time.Time) string {
+	return fmt.Sprintf("pocketeer_%s.zip", util.FormatDate(t))
+}
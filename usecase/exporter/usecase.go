@@ -0,0 +1,183 @@
+package exporter
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/dep/repo"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/rs/zerolog/log"
+)
+
+type exporterUseCase struct {
+	accountRepo     repo.AccountRepo
+	transactionRepo repo.TransactionRepo
+	categoryRepo    repo.CategoryRepo
+	budgetRepo      repo.BudgetRepo
+	holdingRepo     repo.HoldingRepo
+	lotRepo         repo.LotRepo
+	snapshotRepo    repo.SnapshotRepo
+}
+
+func NewExporterUseCase(
+	accountRepo repo.AccountRepo,
+	transactionRepo repo.TransactionRepo,
+	categoryRepo repo.CategoryRepo,
+	budgetRepo repo.BudgetRepo,
+	holdingRepo repo.HoldingRepo,
+	lotRepo repo.LotRepo,
+	snapshotRepo repo.SnapshotRepo,
+) UseCase {
+	return &exporterUseCase{
+		accountRepo,
+		transactionRepo,
+		categoryRepo,
+		budgetRepo,
+		holdingRepo,
+		lotRepo,
+		snapshotRepo,
+	}
+}
+
+// ExportData writes a zip archive of the user data to the writer.
+// Each dataset is read page by page, so the archive is never held in memory.
+func (uc *exporterUseCase) ExportData(ctx context.Context, req *ExportDataRequest) (*ExportDataResponse, error) {
+	zw := zip.NewWriter(req.GetWriter())
+
+	var records uint32
+	for _, ds := range uc.datasets() {
+		w, err := zw.Create(fmt.Sprintf("%s.csv", ds.name))
+		if err != nil {
+			return nil, err
+		}
+
+		n, err := uc.writeCSV(ctx, req.GetUserID(), ds, w)
+		if err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to export %s as csv, err: %v", ds.name, err)
+			return nil, err
+		}
+		records += n
+
+		w, err = zw.Create(fmt.Sprintf("%s.json", ds.name))
+		if err != nil {
+			return nil, err
+		}
+
+		if err := uc.writeJSON(ctx, req.GetUserID(), ds, w); err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to export %s as json, err: %v", ds.name, err)
+			return nil, err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return &ExportDataResponse{
+		Records: goutil.Uint32(records),
+	}, nil
+}
+
+func (uc *exporterUseCase) writeCSV(ctx context.Context, userID string, ds *dataset, w io.Writer) (uint32, error) {
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write(ds.columns); err != nil {
+		return 0, err
+	}
+
+	var count uint32
+	if err := uc.eachPage(ctx, userID, ds, func(rows [][]interface{}) error {
+		for _, row := range rows {
+			record := make([]string, len(row))
+			for i, v := range row {
+				record[i] = fmt.Sprint(v)
+			}
+
+			if err := cw.Write(record); err != nil {
+				return err
+			}
+			count++
+		}
+
+		cw.Flush()
+		return cw.Error()
+	}); err != nil {
+		return 0, err
+	}
+
+	cw.Flush()
+
+	return count, cw.Error()
+}
+
+// writeJSON writes the dataset as an array of objects, keys follow the column order.
+func (uc *exporterUseCase) writeJSON(ctx context.Context, userID string, ds *dataset, w io.Writer) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+
+	first := true
+	if err := uc.eachPage(ctx, userID, ds, func(rows [][]interface{}) error {
+		for _, row := range rows {
+			if !first {
+				if _, err := io.WriteString(w, ","); err != nil {
+					return err
+				}
+			}
+			first = false
+
+			if _, err := io.WriteString(w, "\n{"); err != nil {
+				return err
+			}
+
+			for i, v := range row {
+				b, err := json.Marshal(v)
+				if err != nil {
+					return err
+				}
+
+				sep := ","
+				if i == 0 {
+					sep = ""
+				}
+
+				if _, err := fmt.Fprintf(w, "%s%q:%s", sep, ds.columns[i], b); err != nil {
+					return err
+				}
+			}
+
+			if _, err := io.WriteString(w, "}"); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n]\n")
+
+	return err
+}
+
+func (uc *exporterUseCase) eachPage(ctx context.Context, userID string, ds *dataset, fn func(rows [][]interface{}) error) error {
+	for page := 1; ; page++ {
+		rows, err := ds.getPage(ctx, userID, newPaging(page))
+		if err != nil {
+			return err
+		}
+
+		if err := fn(rows); err != nil {
+			return err
+		}
+
+		if len(rows) < config.ExportPageLimit {
+			return nil
+		}
+	}
+}
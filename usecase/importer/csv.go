@@ -0,0 +1,203 @@
+package importer
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/errutil"
+)
+
+var (
+	ErrDebitAndCredit   = errors.New("row cannot have both debit and credit")
+	ErrMissingDrCrValue = errors.New("row has neither debit nor credit")
+)
+
+// parseCSV reads a delimited statement with the column mapping of the import profile.
+// Lines before the header are skipped, and columns are matched by header name.
+func parseCSV(ip *entity.ImportProfile, data string, loc *time.Location) ([]*statementLine, error) {
+	br := bufio.NewReader(strings.NewReader(data))
+
+	// banks often put account details above the header
+	for i := uint32(0); i < ip.GetSkipRows(); i++ {
+		if _, err := br.ReadString('\n'); err != nil {
+			return nil, ErrEmptyStatement
+		}
+	}
+
+	delim, _ := utf8.DecodeRuneInString(ip.GetDelimiter())
+
+	r := csv.NewReader(br)
+	r.Comma = delim
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, ErrEmptyStatement
+		}
+		return nil, errutil.ValidationError(fmt.Errorf("invalid statement header, err: %v", err))
+	}
+
+	cols := make(map[string]int)
+	for i, h := range header {
+		cols[normalizeColumn(h)] = i
+	}
+
+	colIdx := func(name string) (int, error) {
+		if name == "" {
+			return -1, nil
+		}
+		idx, ok := cols[normalizeColumn(name)]
+		if !ok {
+			return -1, errutil.ValidationError(fmt.Errorf("column %q not found in statement", name))
+		}
+		return idx, nil
+	}
+
+	var (
+		idxs    = make(map[string]int)
+		colName = map[string]string{
+			"date":     ip.GetDateColumn(),
+			"amount":   ip.GetAmountColumn(),
+			"debit":    ip.GetDebitColumn(),
+			"credit":   ip.GetCreditColumn(),
+			"note":     ip.GetNoteColumn(),
+			"currency": ip.GetCurrencyColumn(),
+		}
+	)
+	for k, name := range colName {
+		idx, err := colIdx(name)
+		if err != nil {
+			return nil, err
+		}
+		idxs[k] = idx
+	}
+
+	lines := make([]*statementLine, 0)
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			var lineNo uint32
+			if pe, ok := err.(*csv.ParseError); ok {
+				lineNo = ip.GetSkipRows() + uint32(pe.StartLine)
+			}
+			lines = append(lines, &statementLine{row: lineNo, err: err})
+			continue
+		}
+
+		// rows are numbered by their line in the statement
+		line, _ := r.FieldPos(0)
+		lineNo := ip.GetSkipRows() + uint32(line)
+
+		if isBlankRecord(record) {
+			continue
+		}
+
+		if len(lines) >= config.MaxImportRows {
+			return nil, ErrTooManyRows
+		}
+
+		lines = append(lines, parseCSVRecord(ip, record, idxs, lineNo, loc))
+	}
+
+	if len(lines) == 0 {
+		return nil, ErrEmptyStatement
+	}
+
+	return lines, nil
+}
+
+func parseCSVRecord(ip *entity.ImportProfile, record []string, idxs map[string]int, lineNo uint32, loc *time.Location) *statementLine {
+	field := func(k string) string {
+		idx := idxs[k]
+		if idx < 0 || idx >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[idx])
+	}
+
+	sl := &statementLine{
+		row:      lineNo,
+		note:     field("note"),
+		currency: strings.ToUpper(field("currency")),
+	}
+
+	date, err := time.ParseInLocation(ip.GetDateLayout(), field("date"), loc)
+	if err != nil {
+		sl.err = ErrInvalidDate
+		return sl
+	}
+	sl.transactionTime = uint64(date.UnixMilli())
+
+	if ip.IsDebitCredit() {
+		debit, credit := field("debit"), field("credit")
+
+		switch {
+		case debit != "" && credit != "":
+			sl.err = ErrDebitAndCredit
+			return sl
+		case debit != "":
+			amount, err := parseAmount(debit)
+			if err != nil {
+				sl.err = err
+				return sl
+			}
+			sl.amount = -math.Abs(amount)
+		case credit != "":
+			amount, err := parseAmount(credit)
+			if err != nil {
+				sl.err = err
+				return sl
+			}
+			sl.amount = math.Abs(amount)
+		default:
+			sl.err = ErrMissingDrCrValue
+			return sl
+		}
+	} else {
+		amount, err := parseAmount(field("amount"))
+		if err != nil {
+			sl.err = err
+			return sl
+		}
+
+		if ip.GetAmountSign() == uint32(entity.ImportAmountSignPositiveExpense) {
+			amount = -amount
+		}
+		sl.amount = amount
+	}
+
+	if sl.amount == 0 {
+		sl.err = ErrZeroAmount
+	}
+
+	return sl
+}
+
+func normalizeColumn(name string) string {
+	return strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+}
+
+func isBlankRecord(record []string) bool {
+	for _, v := range record {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
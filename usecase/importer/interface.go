@@ -0,0 +1,483 @@
+package importer
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/dep/repo"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/jseow5177/pockteer-be/usecase/common"
+)
+
+type UseCase interface {
+	GetImportProfile(ctx context.Context, req *GetImportProfileRequest) (*GetImportProfileResponse, error)
+	GetImportProfiles(ctx context.Context, req *GetImportProfilesRequest) (*GetImportProfilesResponse, error)
+
+	CreateImportProfile(ctx context.Context, req *CreateImportProfileRequest) (*CreateImportProfileResponse, error)
+	UpdateImportProfile(ctx context.Context, req *UpdateImportProfileRequest) (*UpdateImportProfileResponse, error)
+	DeleteImportProfile(ctx context.Context, req *DeleteImportProfileRequest) (*DeleteImportProfileResponse, error)
+
+	ImportTransactions(ctx context.Context, req *ImportTransactionsRequest) (*ImportTransactionsResponse, error)
+}
+
+type GetImportProfileRequest struct {
+	UserID          *string
+	ImportProfileID *string
+}
+
+func (m *GetImportProfileRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *GetImportProfileRequest) GetImportProfileID() string {
+	if m != nil && m.ImportProfileID != nil {
+		return *m.ImportProfileID
+	}
+	return ""
+}
+
+func (m *GetImportProfileRequest) ToImportProfileFilter() *repo.ImportProfileFilter {
+	return repo.NewImportProfileFilter(
+		m.GetUserID(),
+		repo.WithImportProfileID(m.ImportProfileID),
+	)
+}
+
+type GetImportProfileResponse struct {
+	ImportProfile *entity.ImportProfile
+}
+
+func (m *GetImportProfileResponse) GetImportProfile() *entity.ImportProfile {
+	if m != nil && m.ImportProfile != nil {
+		return m.ImportProfile
+	}
+	return nil
+}
+
+type GetImportProfilesRequest struct {
+	UserID *string
+}
+
+func (m *GetImportProfilesRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *GetImportProfilesRequest) ToImportProfileFilter() *repo.ImportProfileFilter {
+	return repo.NewImportProfileFilter(m.GetUserID())
+}
+
+type GetImportProfilesResponse struct {
+	ImportProfiles []*entity.ImportProfile
+}
+
+func (m *GetImportProfilesResponse) GetImportProfiles() []*entity.ImportProfile {
+	if m != nil && m.ImportProfiles != nil {
+		return m.ImportProfiles
+	}
+	return nil
+}
+
+type CreateImportProfileRequest struct {
+	UserID         *string
+	ProfileName    *string
+	Delimiter      *string
+	SkipRows       *uint32
+	DateColumn     *string
+	DateFormat     *uint32
+	AmountColumn   *string
+	AmountSign     *uint32
+	DebitColumn    *string
+	CreditColumn   *string
+	NoteColumn     *string
+	CurrencyColumn *string
+}
+
+func (m *CreateImportProfileRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *CreateImportProfileRequest) GetProfileName() string {
+	if m != nil && m.ProfileName != nil {
+		return *m.ProfileName
+	}
+	return ""
+}
+
+func (m *CreateImportProfileRequest) GetDelimiter() string {
+	if m != nil && m.Delimiter != nil {
+		return *m.Delimiter
+	}
+	return ""
+}
+
+func (m *CreateImportProfileRequest) GetSkipRows() uint32 {
+	if m != nil && m.SkipRows != nil {
+		return *m.SkipRows
+	}
+	return 0
+}
+
+func (m *CreateImportProfileRequest) GetDateColumn() string {
+	if m != nil && m.DateColumn != nil {
+		return *m.DateColumn
+	}
+	return ""
+}
+
+func (m *CreateImportProfileRequest) GetDateFormat() uint32 {
+	if m != nil && m.DateFormat != nil {
+		return *m.DateFormat
+	}
+	return 0
+}
+
+func (m *CreateImportProfileRequest) GetAmountColumn() string {
+	if m != nil && m.AmountColumn != nil {
+		return *m.AmountColumn
+	}
+	return ""
+}
+
+func (m *CreateImportProfileRequest) GetAmountSign() uint32 {
+	if m != nil && m.AmountSign != nil {
+		return *m.AmountSign
+	}
+	return 0
+}
+
+func (m *CreateImportProfileRequest) GetDebitColumn() string {
+	if m != nil && m.DebitColumn != nil {
+		return *m.DebitColumn
+	}
+	return ""
+}
+
+func (m *CreateImportProfileRequest) GetCreditColumn() string {
+	if m != nil && m.CreditColumn != nil {
+		return *m.CreditColumn
+	}
+	return ""
+}
+
+func (m *CreateImportProfileRequest) GetNoteColumn() string {
+	if m != nil && m.NoteColumn != nil {
+		return *m.NoteColumn
+	}
+	return ""
+}
+
+func (m *CreateImportProfileRequest) GetCurrencyColumn() string {
+	if m != nil && m.CurrencyColumn != nil {
+		return *m.CurrencyColumn
+	}
+	return ""
+}
+
+func (m *CreateImportProfileRequest) ToImportProfileEntity() (*entity.ImportProfile, error) {
+	return entity.NewImportProfile(
+		m.GetUserID(),
+		m.GetProfileName(),
+		entity.WithImportProfileDelimiter(m.Delimiter),
+		entity.WithImportProfileSkipRows(m.SkipRows),
+		entity.WithImportProfileDateColumn(m.DateColumn),
+		entity.WithImportProfileDateFormat(m.DateFormat),
+		entity.WithImportProfileAmountColumn(m.AmountColumn),
+		entity.WithImportProfileAmountSign(m.AmountSign),
+		entity.WithImportProfileDebitColumn(m.DebitColumn),
+		entity.WithImportProfileCreditColumn(m.CreditColumn),
+		entity.WithImportProfileNoteColumn(m.NoteColumn),
+		entity.WithImportProfileCurrencyColumn(m.CurrencyColumn),
+	)
+}
+
+type CreateImportProfileResponse struct {
+	ImportProfile *entity.ImportProfile
+}
+
+func (m *CreateImportProfileResponse) GetImportProfile() *entity.ImportProfile {
+	if m != nil && m.ImportProfile != nil {
+		return m.ImportProfile
+	}
+	return nil
+}
+
+type UpdateImportProfileRequest struct {
+	UserID          *string
+	ImportProfileID *string
+	ProfileName     *string
+	Delimiter       *string
+	SkipRows        *uint32
+	DateColumn      *string
+	DateFormat      *uint32
+	AmountColumn    *string
+	AmountSign      *uint32
+	DebitColumn     *string
+	CreditColumn    *string
+	NoteColumn      *string
+	CurrencyColumn  *string
+}
+
+func (m *UpdateImportProfileRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *UpdateImportProfileRequest) GetImportProfileID() string {
+	if m != nil && m.ImportProfileID != nil {
+		return *m.ImportProfileID
+	}
+	return ""
+}
+
+func (m *UpdateImportProfileRequest) GetProfileName() string {
+	if m != nil && m.ProfileName != nil {
+		return *m.ProfileName
+	}
+	return ""
+}
+
+func (m *UpdateImportProfileRequest) GetDelimiter() string {
+	if m != nil && m.Delimiter != nil {
+		return *m.Delimiter
+	}
+	return ""
+}
+
+func (m *UpdateImportProfileRequest) GetSkipRows() uint32 {
+	if m != nil && m.SkipRows != nil {
+		return *m.SkipRows
+	}
+	return 0
+}
+
+func (m *UpdateImportProfileRequest) GetDateColumn() string {
+	if m != nil && m.DateColumn != nil {
+		return *m.DateColumn
+	}
+	return ""
+}
+
+func (m *UpdateImportProfileRequest) GetDateFormat() uint32 {
+	if m != nil && m.DateFormat != nil {
+		return *m.DateFormat
+	}
+	return 0
+}
+
+func (m *UpdateImportProfileRequest) GetAmountColumn() string {
+	if m != nil && m.AmountColumn != nil {
+		return *m.AmountColumn
+	}
+	return ""
+}
+
+func (m *UpdateImportProfileRequest) GetAmountSign() uint32 {
+	if m != nil && m.AmountSign != nil {
+		return *m.AmountSign
+	}
+	return 0
+}
+
+func (m *UpdateImportProfileRequest) GetDebitColumn() string {
+	if m != nil && m.DebitColumn != nil {
+		return *m.DebitColumn
+	}
+	return ""
+}
+
+func (m *UpdateImportProfileRequest) GetCreditColumn() string {
+	if m != nil && m.CreditColumn != nil {
+		return *m.CreditColumn
+	}
+	return ""
+}
+
+func (m *UpdateImportProfileRequest) GetNoteColumn() string {
+	if m != nil && m.NoteColumn != nil {
+		return *m.NoteColumn
+	}
+	return ""
+}
+
+func (m *UpdateImportProfileRequest) GetCurrencyColumn() string {
+	if m != nil && m.CurrencyColumn != nil {
+		return *m.CurrencyColumn
+	}
+	return ""
+}
+
+func (m *UpdateImportProfileRequest) ToImportProfileFilter() *repo.ImportProfileFilter {
+	return repo.NewImportProfileFilter(
+		m.GetUserID(),
+		repo.WithImportProfileID(m.ImportProfileID),
+	)
+}
+
+type UpdateImportProfileResponse struct {
+	ImportProfile *entity.ImportProfile
+}
+
+func (m *UpdateImportProfileResponse) GetImportProfile() *entity.ImportProfile {
+	if m != nil && m.ImportProfile != nil {
+		return m.ImportProfile
+	}
+	return nil
+}
+
+type DeleteImportProfileRequest struct {
+	UserID          *string
+	ImportProfileID *string
+}
+
+func (m *DeleteImportProfileRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *DeleteImportProfileRequest) GetImportProfileID() string {
+	if m != nil && m.ImportProfileID != nil {
+		return *m.ImportProfileID
+	}
+	return ""
+}
+
+func (m *DeleteImportProfileRequest) ToImportProfileFilter() *repo.ImportProfileFilter {
+	return repo.NewImportProfileFilter(
+		m.GetUserID(),
+		repo.WithImportProfileID(m.ImportProfileID),
+	)
+}
+
+type DeleteImportProfileResponse struct{}
+
+type ImportTransactionsRequest struct {
+	UserID            *string
+	AppMeta           *common.AppMeta
+	AccountID         *string
+	ImportProfileID   *string
+	Format            *uint32
+	Data              *string
+	ExpenseCategoryID *string
+	IncomeCategoryID  *string
+	DryRun            *bool
+}
+
+func (m *ImportTransactionsRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *ImportTransactionsRequest) GetAppMeta() *common.AppMeta {
+	if m != nil && m.AppMeta != nil {
+		return m.AppMeta
+	}
+	return nil
+}
+
+func (m *ImportTransactionsRequest) GetAccountID() string {
+	if m != nil && m.AccountID != nil {
+		return *m.AccountID
+	}
+	return ""
+}
+
+func (m *ImportTransactionsRequest) GetImportProfileID() string {
+	if m != nil && m.ImportProfileID != nil {
+		return *m.ImportProfileID
+	}
+	return ""
+}
+
+func (m *ImportTransactionsRequest) GetFormat() uint32 {
+	if m != nil && m.Format != nil {
+		return *m.Format
+	}
+	return 0
+}
+
+func (m *ImportTransactionsRequest) GetData() string {
+	if m != nil && m.Data != nil {
+		return *m.Data
+	}
+	return ""
+}
+
+func (m *ImportTransactionsRequest) GetExpenseCategoryID() string {
+	if m != nil && m.ExpenseCategoryID != nil {
+		return *m.ExpenseCategoryID
+	}
+	return ""
+}
+
+func (m *ImportTransactionsRequest) GetIncomeCategoryID() string {
+	if m != nil && m.IncomeCategoryID != nil {
+		return *m.IncomeCategoryID
+	}
+	return ""
+}
+
+func (m *ImportTransactionsRequest) GetDryRun() bool {
+	if m != nil && m.DryRun != nil {
+		return *m.DryRun
+	}
+	return false
+}
+
+func (m *ImportTransactionsRequest) ToImportProfileFilter() *repo.ImportProfileFilter {
+	return repo.NewImportProfileFilter(
+		m.GetUserID(),
+		repo.WithImportProfileID(m.ImportProfileID),
+	)
+}
+
+func (m *ImportTransactionsRequest) ToAccountFilter() *repo.AccountFilter {
+	return repo.NewAccountFilter(
+		m.GetUserID(),
+		repo.WithAccountID(m.AccountID),
+	)
+}
+
+func (m *ImportTransactionsRequest) ToCategoryFilter(categoryID string) *repo.CategoryFilter {
+	return repo.NewCategoryFilter(
+		m.GetUserID(),
+		repo.WithCategoryID(goutil.String(categoryID)),
+	)
+}
+
+func (m *ImportTransactionsRequest) ToTransactionQuery(externalIDs []string) *repo.TransactionQuery {
+	return &repo.TransactionQuery{
+		Filters: []*repo.TransactionFilter{
+			repo.NewTransactionFilter(
+				m.GetUserID(),
+				repo.WithTransactionAccountID(m.AccountID),
+				repo.WithTransactionExternalIDs(externalIDs),
+			),
+		},
+	}
+}
+
+type ImportTransactionsResponse struct {
+	Rows []*entity.ImportRow
+}
+
+func (m *ImportTransactionsResponse) GetRows() []*entity.ImportRow {
+	if m != nil && m.Rows != nil {
+		return m.Rows
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package importer
+
+import (
+	"html"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/jseow5177/pockteer-be/config"
+)
+
+// matches both SGML (OFX 1.x) and XML (OFX 2.x) tags, closing tags are optional in SGML
+var ofxTagRegex = regexp.MustCompile(`<(/?[A-Za-z0-9.]+)>([^<]*)`)
+
+// parseOFX reads the STMTTRN records of an OFX or QFX statement.
+// FITID is kept as the external reference of each transaction.
+func parseOFX(data string, loc *time.Location) ([]*statementLine, error) {
+	var (
+		currency string
+		curr     *ofxTransaction
+		lines    = make([]*statementLine, 0)
+	)
+
+	for _, m := range ofxTagRegex.FindAllStringSubmatchIndex(data, -1) {
+		tag := strings.ToUpper(data[m[2]:m[3]])
+		value := strings.TrimSpace(html.UnescapeString(data[m[4]:m[5]]))
+
+		switch tag {
+		case "CURDEF":
+			currency = strings.ToUpper(value)
+		case "STMTTRN":
+			curr = &ofxTransaction{
+				row:    uint32(strings.Count(data[:m[0]], "\n") + 1),
+				fields: make(map[string]string),
+			}
+		case "/STMTTRN":
+			if curr == nil {
+				continue
+			}
+
+			if len(lines) >= config.MaxImportRows {
+				return nil, ErrTooManyRows
+			}
+
+			lines = append(lines, curr.toStatementLine(currency, loc))
+			curr = nil
+		default:
+			if curr != nil && !strings.HasPrefix(tag, "/") {
+				curr.fields[tag] = value
+			}
+		}
+	}
+
+	if len(lines) == 0 {
+		return nil, ErrEmptyStatement
+	}
+
+	return lines, nil
+}
+
+type ofxTransaction struct {
+	row    uint32
+	fields map[string]string
+}
+
+func (ot *ofxTransaction) toStatementLine(currency string, loc *time.Location) *statementLine {
+	sl := &statementLine{
+		row:        ot.row,
+		currency:   currency,
+		externalID: ot.fields["FITID"],
+		note:       ot.fields["NAME"],
+	}
+
+	if memo := ot.fields["MEMO"]; memo != "" && memo != sl.note {
+		sl.note = strings.TrimSpace(sl.note + " " + memo)
+	}
+
+	// DTPOSTED is YYYYMMDD with an optional time and timezone, only the date is kept
+	dtPosted := ot.fields["DTPOSTED"]
+	if len(dtPosted) < 8 {
+		sl.err = ErrInvalidDate
+		return sl
+	}
+
+	date, err := time.ParseInLocation("20060102", dtPosted[:8], loc)
+	if err != nil {
+		sl.err = ErrInvalidDate
+		return sl
+	}
+	sl.transactionTime = uint64(date.UnixMilli())
+
+	// TRNAMT is signed, negative for debits
+	amount, err := parseAmount(ot.fields["TRNAMT"])
+	if err != nil {
+		sl.err = err
+		return sl
+	}
+	sl.amount = amount
+
+	if sl.amount == 0 {
+		sl.err = ErrZeroAmount
+	}
+
+	return sl
+}
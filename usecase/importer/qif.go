@@ -0,0 +1,125 @@
+package importer
+
+import (
+	"bufio"
+	"strings"
+	"time"
+
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/entity"
+)
+
+// parseQIF reads the records of a QIF bank statement, each record ends with ^.
+// QIF has no transaction reference, and its dates are month first unless
+// the import profile says otherwise.
+func parseQIF(ip *entity.ImportProfile, data string, loc *time.Location) ([]*statementLine, error) {
+	layouts := []string{"1/2/2006", "1/2/06", "2006-1-2"}
+	if ip != nil {
+		switch ip.GetDateFormat() {
+		case uint32(entity.ImportDateFormatDDMMYYYYSlash), uint32(entity.ImportDateFormatDDMMYYYYDash):
+			layouts = []string{"2/1/2006", "2/1/06", "2006-1-2"}
+		}
+	}
+
+	var (
+		lineNo uint32
+		curr   *qifRecord
+		lines  = make([]*statementLine, 0)
+	)
+
+	sc := bufio.NewScanner(strings.NewReader(data))
+	for sc.Scan() {
+		lineNo++
+
+		text := strings.TrimSpace(sc.Text())
+		if text == "" || strings.HasPrefix(text, "!") {
+			continue
+		}
+
+		if text == "^" {
+			if curr == nil {
+				continue
+			}
+
+			if len(lines) >= config.MaxImportRows {
+				return nil, ErrTooManyRows
+			}
+
+			lines = append(lines, curr.toStatementLine(layouts, loc))
+			curr = nil
+			continue
+		}
+
+		if curr == nil {
+			curr = &qifRecord{
+				row:    lineNo,
+				fields: make(map[byte]string),
+			}
+		}
+
+		curr.fields[text[0]] = strings.TrimSpace(text[1:])
+	}
+
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(lines) == 0 {
+		return nil, ErrEmptyStatement
+	}
+
+	return lines, nil
+}
+
+type qifRecord struct {
+	row    uint32
+	fields map[byte]string
+}
+
+func (qr *qifRecord) toStatementLine(layouts []string, loc *time.Location) *statementLine {
+	sl := &statementLine{
+		row:  qr.row,
+		note: qr.fields['P'],
+	}
+
+	if memo := qr.fields['M']; memo != "" && memo != sl.note {
+		sl.note = strings.TrimSpace(sl.note + " " + memo)
+	}
+
+	// Quicken writes years after 2000 as 1/5'24
+	d := strings.ReplaceAll(strings.ReplaceAll(qr.fields['D'], "'", "/"), " ", "")
+
+	var (
+		date time.Time
+		err  = ErrInvalidDate
+	)
+	for _, layout := range layouts {
+		if date, err = time.ParseInLocation(layout, d, loc); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		sl.err = ErrInvalidDate
+		return sl
+	}
+	sl.transactionTime = uint64(date.UnixMilli())
+
+	// T and U both hold the signed amount
+	amt := qr.fields['T']
+	if amt == "" {
+		amt = qr.fields['U']
+	}
+
+	amount, err := parseAmount(amt)
+	if err != nil {
+		sl.err = err
+		return sl
+	}
+	sl.amount = amount
+
+	if sl.amount == 0 {
+		sl.err = ErrZeroAmount
+	}
+
+	return sl
+}
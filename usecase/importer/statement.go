@@ -0,0 +1,73 @@
+package importer
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/pkg/errutil"
+)
+
+var (
+	ErrEmptyStatement = errutil.ValidationError(errors.New("statement has no rows"))
+	ErrTooManyRows    = errutil.ValidationError(fmt.Errorf("statement cannot have more than %d rows", config.MaxImportRows))
+	ErrInvalidDate    = errors.New("invalid date")
+	ErrInvalidAmount  = errors.New("invalid amount")
+	ErrZeroAmount     = errors.New("amount cannot be zero")
+)
+
+// statementLine is a single line of a bank statement.
+// Amount is negative for money out and positive for money in.
+type statementLine struct {
+	row             uint32
+	transactionTime uint64
+	amount          float64
+	note            string
+	currency        string
+	externalID      string
+	err             error
+}
+
+// setExternalIDs gives lines without a bank reference, e.g. CSV and QIF, a reference
+// derived from their content so that re-importing an overlapping statement is idempotent.
+// Identical lines in a statement are told apart by their order of occurrence.
+func setExternalIDs(lines []*statementLine) {
+	seen := make(map[string]int)
+	for _, line := range lines {
+		if line.err != nil || line.externalID != "" {
+			continue
+		}
+
+		key := fmt.Sprintf("%d|%v|%s|%s", line.transactionTime, line.amount, line.currency, line.note)
+		seen[key]++
+
+		sum := sha1.Sum([]byte(fmt.Sprintf("%s|%d", key, seen[key])))
+		line.externalID = hex.EncodeToString(sum[:])
+	}
+}
+
+// parseAmount accepts thousands separators and accounting style negatives, e.g. (1,234.50).
+func parseAmount(s string) (float64, error) {
+	s = strings.ReplaceAll(strings.TrimSpace(s), ",", "")
+
+	var neg bool
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		neg = true
+		s = s[1 : len(s)-1]
+	}
+
+	amount, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, ErrInvalidAmount
+	}
+
+	if neg {
+		amount = -amount
+	}
+
+	return amount, nil
+}
@@ -0,0 +1,411 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/dep/repo"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/errutil"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/jseow5177/pockteer-be/usecase/rule"
+	"github.com/jseow5177/pockteer-be/usecase/transaction"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	ErrInvalidImportRows    = errutil.ValidationError(errors.New("statement has invalid rows"))
+	ErrMissingCategory      = errors.New("no category for transaction type and no matching rule")
+	ErrCategoryTypeInvalid  = errutil.ValidationError(errors.New("category does not match transaction type"))
+	ErrMissingImportProfile = errutil.ValidationError(errors.New("csv import requires an import profile"))
+)
+
+type importerUseCase struct {
+	txMgr              repo.TxMgr
+	importProfileRepo  repo.ImportProfileRepo
+	accountRepo        repo.AccountRepo
+	categoryRepo       repo.CategoryRepo
+	transactionRepo    repo.TransactionRepo
+	transactionUseCase transaction.UseCase
+	ruleUseCase        rule.UseCase
+}
+
+func NewImporterUseCase(
+	txMgr repo.TxMgr,
+	importProfileRepo repo.ImportProfileRepo,
+	accountRepo repo.AccountRepo,
+	categoryRepo repo.CategoryRepo,
+	transactionRepo repo.TransactionRepo,
+	transactionUseCase transaction.UseCase,
+	ruleUseCase rule.UseCase,
+) UseCase {
+	return &importerUseCase{
+		txMgr,
+		importProfileRepo,
+		accountRepo,
+		categoryRepo,
+		transactionRepo,
+		transactionUseCase,
+		ruleUseCase,
+	}
+}
+
+func (uc *importerUseCase) GetImportProfile(ctx context.Context, req *GetImportProfileRequest) (*GetImportProfileResponse, error) {
+	ip, err := uc.importProfileRepo.Get(ctx, req.ToImportProfileFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get import profile from repo, err: %v", err)
+		return nil, err
+	}
+
+	return &GetImportProfileResponse{
+		ImportProfile: ip,
+	}, nil
+}
+
+func (uc *importerUseCase) GetImportProfiles(ctx context.Context, req *GetImportProfilesRequest) (*GetImportProfilesResponse, error) {
+	ips, err := uc.importProfileRepo.GetMany(ctx, req.ToImportProfileFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get import profiles from repo, err: %v", err)
+		return nil, err
+	}
+
+	return &GetImportProfilesResponse{
+		ImportProfiles: ips,
+	}, nil
+}
+
+func (uc *importerUseCase) CreateImportProfile(ctx context.Context, req *CreateImportProfileRequest) (*CreateImportProfileResponse, error) {
+	ip, err := req.ToImportProfileEntity()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.importProfileRepo.Create(ctx, ip); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to save new import profile to repo, err: %v", err)
+		return nil, err
+	}
+
+	return &CreateImportProfileResponse{
+		ImportProfile: ip,
+	}, nil
+}
+
+func (uc *importerUseCase) UpdateImportProfile(ctx context.Context, req *UpdateImportProfileRequest) (*UpdateImportProfileResponse, error) {
+	ipf := req.ToImportProfileFilter()
+
+	ip, err := uc.importProfileRepo.Get(ctx, ipf)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get import profile from repo, err: %v", err)
+		return nil, err
+	}
+
+	ipu, err := ip.Update(
+		entity.WithUpdateImportProfileName(req.ProfileName),
+		entity.WithUpdateImportProfileDelimiter(req.Delimiter),
+		entity.WithUpdateImportProfileSkipRows(req.SkipRows),
+		entity.WithUpdateImportProfileDateColumn(req.DateColumn),
+		entity.WithUpdateImportProfileDateFormat(req.DateFormat),
+		entity.WithUpdateImportProfileAmountColumn(req.AmountColumn),
+		entity.WithUpdateImportProfileAmountSign(req.AmountSign),
+		entity.WithUpdateImportProfileDebitColumn(req.DebitColumn),
+		entity.WithUpdateImportProfileCreditColumn(req.CreditColumn),
+		entity.WithUpdateImportProfileNoteColumn(req.NoteColumn),
+		entity.WithUpdateImportProfileCurrencyColumn(req.CurrencyColumn),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if ipu == nil {
+		log.Ctx(ctx).Info().Msg("import profile has no updates")
+		return &UpdateImportProfileResponse{
+			ImportProfile: ip,
+		}, nil
+	}
+
+	if err := uc.importProfileRepo.Update(ctx, ipf, ipu); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to save import profile updates to repo, err: %v", err)
+		return nil, err
+	}
+
+	return &UpdateImportProfileResponse{
+		ImportProfile: ip,
+	}, nil
+}
+
+func (uc *importerUseCase) DeleteImportProfile(ctx context.Context, req *DeleteImportProfileRequest) (*DeleteImportProfileResponse, error) {
+	ipf := req.ToImportProfileFilter()
+
+	ip, err := uc.importProfileRepo.Get(ctx, ipf)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get import profile from repo, err: %v", err)
+		return nil, err
+	}
+
+	ipu, err := ip.Update(
+		entity.WithUpdateImportProfileStatus(goutil.Uint32(uint32(entity.ImportProfileStatusDeleted))),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.importProfileRepo.Update(ctx, ipf, ipu); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to save import profile updates to repo, err: %v", err)
+		return nil, err
+	}
+
+	return new(DeleteImportProfileResponse), nil
+}
+
+func (uc *importerUseCase) ImportTransactions(ctx context.Context, req *ImportTransactionsRequest) (*ImportTransactionsResponse, error) {
+	loc, err := time.LoadLocation(req.AppMeta.GetTimezone())
+	if err != nil {
+		return nil, entity.ErrInvalidTimezone
+	}
+
+	format := req.GetFormat()
+	if format == uint32(entity.ImportFormatInvalid) {
+		format = uint32(entity.ImportFormatCSV)
+	}
+
+	var ip *entity.ImportProfile
+	if req.GetImportProfileID() != "" {
+		ip, err = uc.importProfileRepo.Get(ctx, req.ToImportProfileFilter())
+		if err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to get import profile from repo, err: %v", err)
+			return nil, err
+		}
+	} else if format == uint32(entity.ImportFormatCSV) {
+		return nil, ErrMissingImportProfile
+	}
+
+	ac, err := uc.accountRepo.Get(ctx, req.ToAccountFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get account from repo, err: %v", err)
+		return nil, err
+	}
+
+	if !ac.CanSetBalance() {
+		return nil, entity.ErrInvalidTransactionAccount
+	}
+
+	categoryIDs, err := uc.getImportCategories(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// rules categorise the rows of a transaction type without a category
+	grRes, err := uc.ruleUseCase.GetRules(ctx, &rule.GetRulesRequest{
+		UserID: req.UserID,
+	})
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get rules, err: %v", err)
+		return nil, err
+	}
+
+	var lines []*statementLine
+	switch format {
+	case uint32(entity.ImportFormatOFX):
+		lines, err = parseOFX(req.GetData(), loc)
+	case uint32(entity.ImportFormatQIF):
+		lines, err = parseQIF(ip, req.GetData(), loc)
+	default:
+		lines, err = parseCSV(ip, req.GetData(), loc)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	setExternalIDs(lines)
+
+	rows, ctrs := uc.toImportRows(req.GetUserID(), ac, categoryIDs, grRes.Rules, lines)
+
+	if err := uc.markDuplicates(ctx, req, rows, ctrs); err != nil {
+		return nil, err
+	}
+
+	if req.GetDryRun() {
+		return &ImportTransactionsResponse{
+			Rows: rows,
+		}, nil
+	}
+
+	for _, row := range rows {
+		if row.HasError() && !row.GetDuplicate() {
+			return nil, ErrInvalidImportRows
+		}
+	}
+
+	// all or nothing, CreateTransaction joins the outer transaction
+	if err := uc.txMgr.WithTx(ctx, func(txCtx context.Context) error {
+		for i, ctr := range ctrs {
+			if rows[i].GetDuplicate() {
+				continue
+			}
+
+			res, err := uc.transactionUseCase.CreateTransaction(txCtx, ctr)
+			if err != nil {
+				log.Ctx(txCtx).Error().Msgf("fail to create imported transaction, row: %v, err: %v",
+					rows[i].GetRow(), err)
+				return err
+			}
+			rows[i].Transaction = res.Transaction
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &ImportTransactionsResponse{
+		Rows: rows,
+	}, nil
+}
+
+// getImportCategories returns the category of each transaction type given in the request.
+func (uc *importerUseCase) getImportCategories(ctx context.Context, req *ImportTransactionsRequest) (map[uint32]string, error) {
+	categoryIDs := map[uint32]string{
+		uint32(entity.TransactionTypeExpense): req.GetExpenseCategoryID(),
+		uint32(entity.TransactionTypeIncome):  req.GetIncomeCategoryID(),
+	}
+
+	for transactionType, categoryID := range categoryIDs {
+		if categoryID == "" {
+			delete(categoryIDs, transactionType)
+			continue
+		}
+
+		c, err := uc.categoryRepo.Get(ctx, req.ToCategoryFilter(categoryID))
+		if err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to get category from repo, category_id: %v, err: %v", categoryID, err)
+			return nil, err
+		}
+
+		if c.GetCategoryType() != transactionType {
+			return nil, ErrCategoryTypeInvalid
+		}
+	}
+
+	return categoryIDs, nil
+}
+
+// markDuplicates flags rows already imported into the account, or repeated in the statement.
+// Duplicate rows are skipped on commit so an overlapping statement is not double counted.
+func (uc *importerUseCase) markDuplicates(
+	ctx context.Context,
+	req *ImportTransactionsRequest,
+	rows []*entity.ImportRow,
+	ctrs []*transaction.CreateTransactionRequest,
+) error {
+	externalIDs := make([]string, 0)
+	for _, ctr := range ctrs {
+		if ctr.GetExternalID() != "" {
+			externalIDs = append(externalIDs, ctr.GetExternalID())
+		}
+	}
+
+	if len(externalIDs) == 0 {
+		return nil
+	}
+
+	ts, err := uc.transactionRepo.GetMany(ctx, req.ToTransactionQuery(externalIDs))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get transactions from repo, err: %v", err)
+		return err
+	}
+
+	seen := make(map[string]bool)
+	for _, t := range ts {
+		seen[t.GetExternalID()] = true
+	}
+
+	for i, ctr := range ctrs {
+		externalID := ctr.GetExternalID()
+		if externalID == "" {
+			continue
+		}
+
+		if seen[externalID] {
+			rows[i].SetDuplicate(goutil.Bool(true))
+		}
+		seen[externalID] = true
+	}
+
+	return nil
+}
+
+// toImportRows validates each statement line as a transaction of the account.
+// The create requests are index aligned with the rows.
+func (uc *importerUseCase) toImportRows(
+	userID string,
+	ac *entity.Account,
+	categoryIDs map[uint32]string,
+	rules []*entity.Rule,
+	lines []*statementLine,
+) ([]*entity.ImportRow, []*transaction.CreateTransactionRequest) {
+	var (
+		rows = make([]*entity.ImportRow, 0, len(lines))
+		ctrs = make([]*transaction.CreateTransactionRequest, 0, len(lines))
+	)
+	for _, line := range lines {
+		if line.err != nil {
+			rows = append(rows, entity.NewImportRow(line.row, nil, line.err))
+			ctrs = append(ctrs, nil)
+			continue
+		}
+
+		transactionType := uint32(entity.TransactionTypeIncome)
+		if line.amount < 0 {
+			transactionType = uint32(entity.TransactionTypeExpense)
+		}
+
+		currency := line.currency
+		if currency == "" {
+			currency = ac.GetCurrency()
+		}
+
+		ctr := &transaction.CreateTransactionRequest{
+			UserID:          goutil.String(userID),
+			AccountID:       ac.AccountID,
+			CategoryID:      goutil.String(categoryIDs[transactionType]),
+			Currency:        goutil.String(currency),
+			Amount:          goutil.Float64(math.Abs(line.amount)),
+			Note:            goutil.String(truncateNote(line.note)),
+			TransactionType: goutil.Uint32(transactionType),
+			TransactionTime: goutil.Uint64(line.transactionTime),
+			ExternalID:      goutil.String(line.externalID),
+		}
+
+		t, err := ctr.ToTransactionEntity()
+		if err == nil {
+			err = entity.CheckCurrency(currency)
+		}
+
+		if err == nil {
+			if _, ok := categoryIDs[transactionType]; !ok {
+				if r := entity.MatchRules(rules, t); r != nil {
+					ctr.CategoryID = r.CategoryID
+					t.SetCategoryID(r.CategoryID)
+					t.SetCategory(r.GetCategory())
+				} else {
+					err = ErrMissingCategory
+				}
+			}
+		}
+
+		rows = append(rows, entity.NewImportRow(line.row, t, err))
+		ctrs = append(ctrs, ctr)
+	}
+
+	return rows, ctrs
+}
+
+func truncateNote(note string) string {
+	if utf8.RuneCountInString(note) <= config.MaxTransactionNoteLength {
+		return note
+	}
+	return string([]rune(note)[:config.MaxTransactionNoteLength])
+}
@@ -0,0 +1,308 @@
+package payee
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/dep/repo"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/jseow5177/pockteer-be/usecase/common"
+)
+
+type UseCase interface {
+	GetPayee(ctx context.Context, req *GetPayeeRequest) (*GetPayeeResponse, error)
+	GetPayees(ctx context.Context, req *GetPayeesRequest) (*GetPayeesResponse, error)
+
+	CreatePayee(ctx context.Context, req *CreatePayeeRequest) (*CreatePayeeResponse, error)
+	UpdatePayee(ctx context.Context, req *UpdatePayeeRequest) (*UpdatePayeeResponse, error)
+	DeletePayee(ctx context.Context, req *DeletePayeeRequest) (*DeletePayeeResponse, error)
+
+	SumTransactionsByPayee(ctx context.Context, req *SumTransactionsByPayeeRequest) (*SumTransactionsByPayeeResponse, error)
+}
+
+type GetPayeeRequest struct {
+	UserID  *string
+	PayeeID *string
+}
+
+func (m *GetPayeeRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *GetPayeeRequest) GetPayeeID() string {
+	if m != nil && m.PayeeID != nil {
+		return *m.PayeeID
+	}
+	return ""
+}
+
+func (m *GetPayeeRequest) ToPayeeFilter() *repo.PayeeFilter {
+	return repo.NewPayeeFilter(
+		m.GetUserID(),
+		repo.WithPayeeID(m.PayeeID),
+	)
+}
+
+type GetPayeeResponse struct {
+	Payee *entity.Payee
+}
+
+func (m *GetPayeeResponse) GetPayee() *entity.Payee {
+	if m != nil && m.Payee != nil {
+		return m.Payee
+	}
+	return nil
+}
+
+type GetPayeesRequest struct {
+	UserID   *string
+	PayeeIDs []string
+}
+
+func (m *GetPayeesRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *GetPayeesRequest) GetPayeeIDs() []string {
+	if m != nil && m.PayeeIDs != nil {
+		return m.PayeeIDs
+	}
+	return nil
+}
+
+func (m *GetPayeesRequest) ToPayeeFilter() *repo.PayeeFilter {
+	return repo.NewPayeeFilter(
+		m.GetUserID(),
+		repo.WithPayeeIDs(m.PayeeIDs),
+	)
+}
+
+type GetPayeesResponse struct {
+	Payees []*entity.Payee
+}
+
+func (m *GetPayeesResponse) GetPayees() []*entity.Payee {
+	if m != nil && m.Payees != nil {
+		return m.Payees
+	}
+	return nil
+}
+
+type CreatePayeeRequest struct {
+	UserID    *string
+	PayeeName *string
+	Aliases   []string
+}
+
+func (m *CreatePayeeRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *CreatePayeeRequest) GetPayeeName() string {
+	if m != nil && m.PayeeName != nil {
+		return *m.PayeeName
+	}
+	return ""
+}
+
+func (m *CreatePayeeRequest) GetAliases() []string {
+	if m != nil && m.Aliases != nil {
+		return m.Aliases
+	}
+	return nil
+}
+
+func (m *CreatePayeeRequest) ToPayeeEntity() (*entity.Payee, error) {
+	return entity.NewPayee(
+		m.GetUserID(),
+		m.GetPayeeName(),
+		entity.WithPayeeAliases(m.Aliases),
+	)
+}
+
+func (m *CreatePayeeRequest) ToPayeeFilter() *repo.PayeeFilter {
+	return repo.NewPayeeFilter(m.GetUserID())
+}
+
+type CreatePayeeResponse struct {
+	Payee *entity.Payee
+}
+
+func (m *CreatePayeeResponse) GetPayee() *entity.Payee {
+	if m != nil && m.Payee != nil {
+		return m.Payee
+	}
+	return nil
+}
+
+type UpdatePayeeRequest struct {
+	UserID    *string
+	PayeeID   *string
+	PayeeName *string
+	Aliases   []string
+}
+
+func (m *UpdatePayeeRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *UpdatePayeeRequest) GetPayeeID() string {
+	if m != nil && m.PayeeID != nil {
+		return *m.PayeeID
+	}
+	return ""
+}
+
+func (m *UpdatePayeeRequest) GetPayeeName() string {
+	if m != nil && m.PayeeName != nil {
+		return *m.PayeeName
+	}
+	return ""
+}
+
+func (m *UpdatePayeeRequest) GetAliases() []string {
+	if m != nil && m.Aliases != nil {
+		return m.Aliases
+	}
+	return nil
+}
+
+func (m *UpdatePayeeRequest) ToPayeeFilter() *repo.PayeeFilter {
+	return repo.NewPayeeFilter(
+		m.GetUserID(),
+		repo.WithPayeeID(m.PayeeID),
+	)
+}
+
+func (m *UpdatePayeeRequest) ToPayeesFilter() *repo.PayeeFilter {
+	return repo.NewPayeeFilter(m.GetUserID())
+}
+
+type UpdatePayeeResponse struct {
+	Payee *entity.Payee
+}
+
+func (m *UpdatePayeeResponse) GetPayee() *entity.Payee {
+	if m != nil && m.Payee != nil {
+		return m.Payee
+	}
+	return nil
+}
+
+type DeletePayeeRequest struct {
+	UserID  *string
+	PayeeID *string
+}
+
+func (m *DeletePayeeRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *DeletePayeeRequest) GetPayeeID() string {
+	if m != nil && m.PayeeID != nil {
+		return *m.PayeeID
+	}
+	return ""
+}
+
+func (m *DeletePayeeRequest) ToPayeeFilter() *repo.PayeeFilter {
+	return repo.NewPayeeFilter(
+		m.GetUserID(),
+		repo.WithPayeeID(m.PayeeID),
+	)
+}
+
+type DeletePayeeResponse struct{}
+
+type SumTransactionsByPayeeRequest struct {
+	UserID          *string
+	TransactionTime *common.RangeFilter
+	TransactionType *uint32
+	Limit           *uint32
+}
+
+func (m *SumTransactionsByPayeeRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *SumTransactionsByPayeeRequest) GetTransactionTime() *common.RangeFilter {
+	if m != nil && m.TransactionTime != nil {
+		return m.TransactionTime
+	}
+	return nil
+}
+
+func (m *SumTransactionsByPayeeRequest) GetTransactionType() uint32 {
+	if m != nil && m.TransactionType != nil {
+		return *m.TransactionType
+	}
+	return 0
+}
+
+func (m *SumTransactionsByPayeeRequest) GetLimit() uint32 {
+	if m != nil && m.Limit != nil {
+		return *m.Limit
+	}
+	return config.DefaultTopPayees
+}
+
+func (m *SumTransactionsByPayeeRequest) ToPayeeFilter() *repo.PayeeFilter {
+	return repo.NewPayeeFilter(m.GetUserID())
+}
+
+func (m *SumTransactionsByPayeeRequest) ToTransactionQuery(payeeIDs []string) *repo.TransactionQuery {
+	tt := m.TransactionTime
+	if tt == nil {
+		tt = new(common.RangeFilter)
+	}
+
+	return &repo.TransactionQuery{
+		Filters: []*repo.TransactionFilter{
+			repo.NewTransactionFilter(
+				m.GetUserID(),
+				repo.WithTransactionTimeGte(tt.Gte),
+				repo.WithTransactionTimeLte(tt.Lte),
+				repo.WithTransactionType(m.TransactionType),
+				repo.WithTransactionPayeeIDs(payeeIDs),
+			),
+		},
+	}
+}
+
+func (m *SumTransactionsByPayeeRequest) ToExchangeRateFilter(to, from string, timestamp uint64) *repo.ExchangeRateFilter {
+	return repo.NewExchangeRateFilter(
+		repo.WithExchangeRateTo(goutil.String(to)),
+		repo.WithExchangeRateFrom(goutil.String(from)),
+		repo.WithExchangeRateTimestamp(goutil.Uint64(timestamp)),
+	)
+}
+
+type SumTransactionsByPayeeResponse struct {
+	Sums []*common.Summary
+}
+
+func (m *SumTransactionsByPayeeResponse) GetSums() []*common.Summary {
+	if m != nil && m.Sums != nil {
+		return m.Sums
+	}
+	return nil
+}
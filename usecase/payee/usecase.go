@@ -0,0 +1,249 @@
+package payee
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/jseow5177/pockteer-be/dep/repo"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/errutil"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/jseow5177/pockteer-be/usecase/common"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	ErrPayeeAlreadyExist = errutil.ValidationError(errors.New("payee already exists"))
+)
+
+type payeeUseCase struct {
+	payeeRepo        repo.PayeeRepo
+	transactionRepo  repo.TransactionRepo
+	exchangeRateRepo repo.ExchangeRateRepo
+}
+
+func NewPayeeUseCase(
+	payeeRepo repo.PayeeRepo,
+	transactionRepo repo.TransactionRepo,
+	exchangeRateRepo repo.ExchangeRateRepo,
+) UseCase {
+	return &payeeUseCase{
+		payeeRepo,
+		transactionRepo,
+		exchangeRateRepo,
+	}
+}
+
+func (uc *payeeUseCase) GetPayee(ctx context.Context, req *GetPayeeRequest) (*GetPayeeResponse, error) {
+	p, err := uc.payeeRepo.Get(ctx, req.ToPayeeFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get payee from repo, err: %v", err)
+		return nil, err
+	}
+
+	return &GetPayeeResponse{
+		Payee: p,
+	}, nil
+}
+
+func (uc *payeeUseCase) GetPayees(ctx context.Context, req *GetPayeesRequest) (*GetPayeesResponse, error) {
+	ps, err := uc.payeeRepo.GetMany(ctx, req.ToPayeeFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get payees from repo, err: %v", err)
+		return nil, err
+	}
+
+	return &GetPayeesResponse{
+		Payees: ps,
+	}, nil
+}
+
+func (uc *payeeUseCase) CreatePayee(ctx context.Context, req *CreatePayeeRequest) (*CreatePayeeResponse, error) {
+	p, err := req.ToPayeeEntity()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.checkPayeeName(ctx, req.ToPayeeFilter(), p); err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.payeeRepo.Create(ctx, p); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to save new payee to repo, err: %v", err)
+		return nil, err
+	}
+
+	return &CreatePayeeResponse{
+		Payee: p,
+	}, nil
+}
+
+func (uc *payeeUseCase) UpdatePayee(ctx context.Context, req *UpdatePayeeRequest) (*UpdatePayeeResponse, error) {
+	pf := req.ToPayeeFilter()
+
+	p, err := uc.payeeRepo.Get(ctx, pf)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get payee from repo, err: %v", err)
+		return nil, err
+	}
+
+	pu, err := p.Update(
+		entity.WithUpdatePayeeName(req.PayeeName),
+		entity.WithUpdatePayeeAliases(req.Aliases),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if pu == nil {
+		log.Ctx(ctx).Info().Msg("payee has no updates")
+		return &UpdatePayeeResponse{
+			Payee: p,
+		}, nil
+	}
+
+	if pu.PayeeName != nil {
+		if err := uc.checkPayeeName(ctx, req.ToPayeesFilter(), p); err != nil {
+			return nil, err
+		}
+	}
+
+	// matched transactions keep their payee, new aliases only apply to new transactions
+	if err := uc.payeeRepo.Update(ctx, pf, pu); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to save payee updates to repo, err: %v", err)
+		return nil, err
+	}
+
+	return &UpdatePayeeResponse{
+		Payee: p,
+	}, nil
+}
+
+func (uc *payeeUseCase) DeletePayee(ctx context.Context, req *DeletePayeeRequest) (*DeletePayeeResponse, error) {
+	pf := req.ToPayeeFilter()
+
+	p, err := uc.payeeRepo.Get(ctx, pf)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get payee from repo, err: %v", err)
+		return nil, err
+	}
+
+	pu, err := p.Update(
+		entity.WithUpdatePayeeStatus(goutil.Uint32(uint32(entity.PayeeStatusDeleted))),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// transactions keep the payee id, deleted payees are hidden when read
+	if err := uc.payeeRepo.Update(ctx, pf, pu); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to save payee updates to repo, err: %v", err)
+		return nil, err
+	}
+
+	return new(DeletePayeeResponse), nil
+}
+
+func (uc *payeeUseCase) SumTransactionsByPayee(
+	ctx context.Context,
+	req *SumTransactionsByPayeeRequest,
+) (*SumTransactionsByPayeeResponse, error) {
+	payees, err := uc.payeeRepo.GetMany(ctx, req.ToPayeeFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get payees from repo, err: %v", err)
+		return nil, err
+	}
+
+	if len(payees) == 0 {
+		return &SumTransactionsByPayeeResponse{
+			Sums: make([]*common.Summary, 0),
+		}, nil
+	}
+
+	var (
+		payeeIDs   = make([]string, 0)
+		sumByPayee = make(map[string]float64)
+	)
+	for _, p := range payees {
+		payeeIDs = append(payeeIDs, p.GetPayeeID())
+	}
+
+	ts, err := uc.transactionRepo.GetMany(ctx, req.ToTransactionQuery(payeeIDs))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get transactions from repo, err: %v", err)
+		return nil, err
+	}
+
+	u := entity.GetUserFromCtx(ctx)
+
+	for _, t := range ts {
+		rate := 1.0
+
+		if t.GetCurrency() != u.Meta.GetCurrency() {
+			erf := req.ToExchangeRateFilter(
+				u.Meta.GetCurrency(),
+				t.GetCurrency(),
+				t.GetTransactionTime(),
+			)
+			er, err := uc.exchangeRateRepo.Get(ctx, erf)
+			if err != nil {
+				log.Ctx(ctx).Error().Msgf("fail to get exchange rate from repo, err: %v", err)
+				return nil, err
+			}
+
+			rate = er.GetRate()
+		}
+
+		sumByPayee[t.GetPayeeID()] += t.GetAmount() * rate
+	}
+
+	// payees without transactions are left out
+	top := make([]*entity.Payee, 0)
+	for _, p := range payees {
+		if _, ok := sumByPayee[p.GetPayeeID()]; ok {
+			top = append(top, p)
+		}
+	}
+
+	// top payees have the largest sums, whether spent or received
+	sort.SliceStable(top, func(i, j int) bool {
+		return math.Abs(sumByPayee[top[i].GetPayeeID()]) > math.Abs(sumByPayee[top[j].GetPayeeID()])
+	})
+
+	if limit := int(req.GetLimit()); len(top) > limit {
+		top = top[:limit]
+	}
+
+	sums := make([]*common.Summary, 0)
+	for _, p := range top {
+		sums = append(sums, common.NewSummary(
+			common.WithSummaryPayee(p),
+			common.WithSummaryCurrency(u.Meta.Currency),
+			common.WithSummarySum(goutil.Float64(sumByPayee[p.GetPayeeID()])),
+		))
+	}
+
+	return &SumTransactionsByPayeeResponse{
+		Sums: sums,
+	}, nil
+}
+
+// checkPayeeName checks that no other payee has the same name, ignoring case.
+func (uc *payeeUseCase) checkPayeeName(ctx context.Context, pf *repo.PayeeFilter, p *entity.Payee) error {
+	ps, err := uc.payeeRepo.GetMany(ctx, pf)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get payees from repo, err: %v", err)
+		return err
+	}
+
+	for _, other := range ps {
+		if other.GetPayeeID() != p.GetPayeeID() && strings.EqualFold(other.GetPayeeName(), p.GetPayeeName()) {
+			return ErrPayeeAlreadyExist
+		}
+	}
+
+	return nil
+}
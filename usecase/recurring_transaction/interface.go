@@ -0,0 +1,514 @@
+package recurringtransaction
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/dep/repo"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/filter"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/jseow5177/pockteer-be/usecase/common"
+	"github.com/jseow5177/pockteer-be/usecase/transaction"
+)
+
+type UseCase interface {
+	GetRecurringTransaction(ctx context.Context, req *GetRecurringTransactionRequest) (*GetRecurringTransactionResponse, error)
+	GetRecurringTransactions(ctx context.Context, req *GetRecurringTransactionsRequest) (*GetRecurringTransactionsResponse, error)
+
+	CreateRecurringTransaction(ctx context.Context, req *CreateRecurringTransactionRequest) (*CreateRecurringTransactionResponse, error)
+	UpdateRecurringTransaction(ctx context.Context, req *UpdateRecurringTransactionRequest) (*UpdateRecurringTransactionResponse, error)
+	DeleteRecurringTransaction(ctx context.Context, req *DeleteRecurringTransactionRequest) (*DeleteRecurringTransactionResponse, error)
+
+	RunRecurringTransactions(ctx context.Context, req *RunRecurringTransactionsRequest) (*RunRecurringTransactionsResponse, error)
+}
+
+type GetRecurringTransactionRequest struct {
+	UserID                 *string
+	RecurringTransactionID *string
+}
+
+func (m *GetRecurringTransactionRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *GetRecurringTransactionRequest) GetRecurringTransactionID() string {
+	if m != nil && m.RecurringTransactionID != nil {
+		return *m.RecurringTransactionID
+	}
+	return ""
+}
+
+func (m *GetRecurringTransactionRequest) ToRecurringTransactionFilter() *repo.RecurringTransactionFilter {
+	return repo.NewRecurringTransactionFilter(
+		m.GetUserID(),
+		repo.WithRecurringTransactionID(m.RecurringTransactionID),
+		repo.WithRecurringTransactionStatus(nil),
+		repo.WithRecurringTransactionStatuses([]uint32{
+			uint32(entity.RecurringTransactionStatusNormal),
+			uint32(entity.RecurringTransactionStatusEnded),
+		}),
+	)
+}
+
+type GetRecurringTransactionResponse struct {
+	RecurringTransaction *entity.RecurringTransaction
+}
+
+func (m *GetRecurringTransactionResponse) GetRecurringTransaction() *entity.RecurringTransaction {
+	if m != nil && m.RecurringTransaction != nil {
+		return m.RecurringTransaction
+	}
+	return nil
+}
+
+type GetRecurringTransactionsRequest struct {
+	UserID *string
+	Paging *common.Paging
+}
+
+func (m *GetRecurringTransactionsRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *GetRecurringTransactionsRequest) GetPaging() *common.Paging {
+	if m != nil && m.Paging != nil {
+		return m.Paging
+	}
+	return nil
+}
+
+func (m *GetRecurringTransactionsRequest) ToRecurringTransactionFilter() *repo.RecurringTransactionFilter {
+	paging := m.Paging
+	if m.Paging == nil {
+		paging = new(common.Paging)
+	}
+
+	return repo.NewRecurringTransactionFilter(
+		m.GetUserID(),
+		repo.WithRecurringTransactionStatus(nil),
+		repo.WithRecurringTransactionStatuses([]uint32{
+			uint32(entity.RecurringTransactionStatusNormal),
+			uint32(entity.RecurringTransactionStatusEnded),
+		}),
+		repo.WithRecurringTransactionPaging(&repo.Paging{
+			Limit: paging.Limit,
+			Page:  paging.Page,
+			Sorts: []filter.Sort{
+				&repo.Sort{
+					Field: goutil.String("create_time"),
+					Order: goutil.String(config.OrderDesc),
+				},
+			},
+		}),
+	)
+}
+
+type GetRecurringTransactionsResponse struct {
+	RecurringTransactions []*entity.RecurringTransaction
+	Paging                *common.Paging
+}
+
+func (m *GetRecurringTransactionsResponse) GetRecurringTransactions() []*entity.RecurringTransaction {
+	if m != nil && m.RecurringTransactions != nil {
+		return m.RecurringTransactions
+	}
+	return nil
+}
+
+func (m *GetRecurringTransactionsResponse) GetPaging() *common.Paging {
+	if m != nil && m.Paging != nil {
+		return m.Paging
+	}
+	return nil
+}
+
+type CreateRecurringTransactionRequest struct {
+	AppMeta         *common.AppMeta
+	UserID          *string
+	AccountID       *string
+	CategoryID      *string
+	FromAccountID   *string
+	ToAccountID     *string
+	Currency        *string
+	Amount          *float64
+	Note            *string
+	TransactionType *uint32
+	Frequency       *uint32
+	Interval        *uint32
+	StartTime       *uint64
+	EndTime         *uint64
+	MaxOccurrences  *uint32
+}
+
+func (m *CreateRecurringTransactionRequest) GetAppMeta() *common.AppMeta {
+	if m != nil && m.AppMeta != nil {
+		return m.AppMeta
+	}
+	return nil
+}
+
+func (m *CreateRecurringTransactionRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *CreateRecurringTransactionRequest) GetAccountID() string {
+	if m != nil && m.AccountID != nil {
+		return *m.AccountID
+	}
+	return ""
+}
+
+func (m *CreateRecurringTransactionRequest) GetCategoryID() string {
+	if m != nil && m.CategoryID != nil {
+		return *m.CategoryID
+	}
+	return ""
+}
+
+func (m *CreateRecurringTransactionRequest) GetFromAccountID() string {
+	if m != nil && m.FromAccountID != nil {
+		return *m.FromAccountID
+	}
+	return ""
+}
+
+func (m *CreateRecurringTransactionRequest) GetToAccountID() string {
+	if m != nil && m.ToAccountID != nil {
+		return *m.ToAccountID
+	}
+	return ""
+}
+
+func (m *CreateRecurringTransactionRequest) GetCurrency() string {
+	if m != nil && m.Currency != nil {
+		return *m.Currency
+	}
+	return ""
+}
+
+func (m *CreateRecurringTransactionRequest) GetAmount() float64 {
+	if m != nil && m.Amount != nil {
+		return *m.Amount
+	}
+	return 0
+}
+
+func (m *CreateRecurringTransactionRequest) GetNote() string {
+	if m != nil && m.Note != nil {
+		return *m.Note
+	}
+	return ""
+}
+
+func (m *CreateRecurringTransactionRequest) GetTransactionType() uint32 {
+	if m != nil && m.TransactionType != nil {
+		return *m.TransactionType
+	}
+	return 0
+}
+
+func (m *CreateRecurringTransactionRequest) GetFrequency() uint32 {
+	if m != nil && m.Frequency != nil {
+		return *m.Frequency
+	}
+	return 0
+}
+
+func (m *CreateRecurringTransactionRequest) GetInterval() uint32 {
+	if m != nil && m.Interval != nil {
+		return *m.Interval
+	}
+	return 0
+}
+
+func (m *CreateRecurringTransactionRequest) GetStartTime() uint64 {
+	if m != nil && m.StartTime != nil {
+		return *m.StartTime
+	}
+	return 0
+}
+
+func (m *CreateRecurringTransactionRequest) GetEndTime() uint64 {
+	if m != nil && m.EndTime != nil {
+		return *m.EndTime
+	}
+	return 0
+}
+
+func (m *CreateRecurringTransactionRequest) GetMaxOccurrences() uint32 {
+	if m != nil && m.MaxOccurrences != nil {
+		return *m.MaxOccurrences
+	}
+	return 0
+}
+
+func (m *CreateRecurringTransactionRequest) ToRecurringTransactionEntity() (*entity.RecurringTransaction, error) {
+	return entity.NewRecurringTransaction(
+		m.GetUserID(),
+		entity.WithRecurringTransactionAccountID(m.AccountID),
+		entity.WithRecurringTransactionCategoryID(m.CategoryID),
+		entity.WithRecurringTransactionFromAccountID(m.FromAccountID),
+		entity.WithRecurringTransactionToAccountID(m.ToAccountID),
+		entity.WithRecurringTransactionAmount(m.Amount),
+		entity.WithRecurringTransactionCurrency(m.Currency),
+		entity.WithRecurringTransactionNote(m.Note),
+		entity.WithRecurringTransactionType(m.TransactionType),
+		entity.WithRecurringTransactionFrequency(m.Frequency),
+		entity.WithRecurringTransactionInterval(m.Interval),
+		entity.WithRecurringTransactionTimezone(m.GetAppMeta().Timezone),
+		entity.WithRecurringTransactionStartTime(m.StartTime),
+		entity.WithRecurringTransactionEndTime(m.EndTime),
+		entity.WithRecurringTransactionMaxOccurrences(m.MaxOccurrences),
+	)
+}
+
+type CreateRecurringTransactionResponse struct {
+	RecurringTransaction *entity.RecurringTransaction
+}
+
+func (m *CreateRecurringTransactionResponse) GetRecurringTransaction() *entity.RecurringTransaction {
+	if m != nil && m.RecurringTransaction != nil {
+		return m.RecurringTransaction
+	}
+	return nil
+}
+
+type UpdateRecurringTransactionRequest struct {
+	UserID                 *string
+	RecurringTransactionID *string
+	AccountID              *string
+	CategoryID             *string
+	FromAccountID          *string
+	ToAccountID            *string
+	Currency               *string
+	Amount                 *float64
+	Note                   *string
+	EndTime                *uint64
+	MaxOccurrences         *uint32
+}
+
+func (m *UpdateRecurringTransactionRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *UpdateRecurringTransactionRequest) GetRecurringTransactionID() string {
+	if m != nil && m.RecurringTransactionID != nil {
+		return *m.RecurringTransactionID
+	}
+	return ""
+}
+
+func (m *UpdateRecurringTransactionRequest) GetAccountID() string {
+	if m != nil && m.AccountID != nil {
+		return *m.AccountID
+	}
+	return ""
+}
+
+func (m *UpdateRecurringTransactionRequest) GetCategoryID() string {
+	if m != nil && m.CategoryID != nil {
+		return *m.CategoryID
+	}
+	return ""
+}
+
+func (m *UpdateRecurringTransactionRequest) GetFromAccountID() string {
+	if m != nil && m.FromAccountID != nil {
+		return *m.FromAccountID
+	}
+	return ""
+}
+
+func (m *UpdateRecurringTransactionRequest) GetToAccountID() string {
+	if m != nil && m.ToAccountID != nil {
+		return *m.ToAccountID
+	}
+	return ""
+}
+
+func (m *UpdateRecurringTransactionRequest) GetCurrency() string {
+	if m != nil && m.Currency != nil {
+		return *m.Currency
+	}
+	return ""
+}
+
+func (m *UpdateRecurringTransactionRequest) GetAmount() float64 {
+	if m != nil && m.Amount != nil {
+		return *m.Amount
+	}
+	return 0
+}
+
+func (m *UpdateRecurringTransactionRequest) GetNote() string {
+	if m != nil && m.Note != nil {
+		return *m.Note
+	}
+	return ""
+}
+
+func (m *UpdateRecurringTransactionRequest) GetEndTime() uint64 {
+	if m != nil && m.EndTime != nil {
+		return *m.EndTime
+	}
+	return 0
+}
+
+func (m *UpdateRecurringTransactionRequest) GetMaxOccurrences() uint32 {
+	if m != nil && m.MaxOccurrences != nil {
+		return *m.MaxOccurrences
+	}
+	return 0
+}
+
+func (m *UpdateRecurringTransactionRequest) ToRecurringTransactionFilter() *repo.RecurringTransactionFilter {
+	return repo.NewRecurringTransactionFilter(
+		m.GetUserID(),
+		repo.WithRecurringTransactionID(m.RecurringTransactionID),
+		repo.WithRecurringTransactionStatus(nil),
+		repo.WithRecurringTransactionStatuses([]uint32{
+			uint32(entity.RecurringTransactionStatusNormal),
+			uint32(entity.RecurringTransactionStatusEnded),
+		}),
+	)
+}
+
+func (m *UpdateRecurringTransactionRequest) ToRecurringTransactionUpdate() []entity.RecurringTransactionUpdateOption {
+	return []entity.RecurringTransactionUpdateOption{
+		entity.WithUpdateRecurringTransactionAccountID(m.AccountID),
+		entity.WithUpdateRecurringTransactionCategoryID(m.CategoryID),
+		entity.WithUpdateRecurringTransactionFromAccountID(m.FromAccountID),
+		entity.WithUpdateRecurringTransactionToAccountID(m.ToAccountID),
+		entity.WithUpdateRecurringTransactionCurrency(m.Currency),
+		entity.WithUpdateRecurringTransactionAmount(m.Amount),
+		entity.WithUpdateRecurringTransactionNote(m.Note),
+		entity.WithUpdateRecurringTransactionEndTime(m.EndTime),
+		entity.WithUpdateRecurringTransactionMaxOccurrences(m.MaxOccurrences),
+	}
+}
+
+type UpdateRecurringTransactionResponse struct {
+	RecurringTransaction *entity.RecurringTransaction
+}
+
+func (m *UpdateRecurringTransactionResponse) GetRecurringTransaction() *entity.RecurringTransaction {
+	if m != nil && m.RecurringTransaction != nil {
+		return m.RecurringTransaction
+	}
+	return nil
+}
+
+type DeleteRecurringTransactionRequest struct {
+	UserID                 *string
+	RecurringTransactionID *string
+}
+
+func (m *DeleteRecurringTransactionRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *DeleteRecurringTransactionRequest) GetRecurringTransactionID() string {
+	if m != nil && m.RecurringTransactionID != nil {
+		return *m.RecurringTransactionID
+	}
+	return ""
+}
+
+func (m *DeleteRecurringTransactionRequest) ToRecurringTransactionFilter() *repo.RecurringTransactionFilter {
+	return repo.NewRecurringTransactionFilter(
+		m.GetUserID(),
+		repo.WithRecurringTransactionID(m.RecurringTransactionID),
+		repo.WithRecurringTransactionStatus(nil),
+		repo.WithRecurringTransactionStatuses([]uint32{
+			uint32(entity.RecurringTransactionStatusNormal),
+			uint32(entity.RecurringTransactionStatusEnded),
+		}),
+	)
+}
+
+type DeleteRecurringTransactionResponse struct{}
+
+type RunRecurringTransactionsRequest struct {
+	UserID *string
+	Now    *uint64
+}
+
+func (m *RunRecurringTransactionsRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *RunRecurringTransactionsRequest) GetNow() uint64 {
+	if m != nil && m.Now != nil {
+		return *m.Now
+	}
+	return 0
+}
+
+func (m *RunRecurringTransactionsRequest) ToRecurringTransactionFilter() *repo.RecurringTransactionFilter {
+	return repo.NewRecurringTransactionFilter(
+		m.GetUserID(),
+		repo.WithRecurringTransactionNextTimeLte(m.Now),
+	)
+}
+
+func (m *RunRecurringTransactionsRequest) ToTransactionFilter(rt *entity.RecurringTransaction) *repo.TransactionFilter {
+	return repo.NewTransactionFilter(
+		m.GetUserID(),
+		repo.WithTransactionRecurringTransactionID(goutil.String(rt.GetRecurringTransactionID())),
+		repo.WithTransactionTime(goutil.Uint64(rt.GetNextTime())),
+		repo.WithTransactionStatus(nil),
+	)
+}
+
+func (m *RunRecurringTransactionsRequest) ToCreateTransactionRequest(rt *entity.RecurringTransaction) *transaction.CreateTransactionRequest {
+	return &transaction.CreateTransactionRequest{
+		UserID:                 m.UserID,
+		AccountID:              rt.AccountID,
+		CategoryID:             rt.CategoryID,
+		FromAccountID:          rt.FromAccountID,
+		ToAccountID:            rt.ToAccountID,
+		Currency:               rt.Currency,
+		Amount:                 rt.Amount,
+		Note:                   rt.Note,
+		TransactionType:        rt.TransactionType,
+		TransactionTime:        goutil.Uint64(rt.GetNextTime()),
+		RecurringTransactionID: goutil.String(rt.GetRecurringTransactionID()),
+	}
+}
+
+func (m *RunRecurringTransactionsRequest) ToRecurringTransactionFilterByID(recurringTransactionID string) *repo.RecurringTransactionFilter {
+	return repo.NewRecurringTransactionFilter(
+		m.GetUserID(),
+		repo.WithRecurringTransactionID(goutil.String(recurringTransactionID)),
+	)
+}
+
+type RunRecurringTransactionsResponse struct {
+	Transactions []*entity.Transaction
+}
+
+func (m *RunRecurringTransactionsResponse) GetTransactions() []*entity.Transaction {
+	if m != nil && m.Transactions != nil {
+		return m.Transactions
+	}
+	return nil
+}
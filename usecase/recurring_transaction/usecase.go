@@ -0,0 +1,250 @@
+package recurringtransaction
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/dep/repo"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/jseow5177/pockteer-be/usecase/transaction"
+	"github.com/rs/zerolog/log"
+)
+
+type recurringTransactionUseCase struct {
+	recurringTransactionRepo repo.RecurringTransactionRepo
+	transactionRepo          repo.TransactionRepo
+	categoryRepo             repo.CategoryRepo
+	accountRepo              repo.AccountRepo
+	transactionUseCase       transaction.UseCase
+}
+
+func NewRecurringTransactionUseCase(
+	recurringTransactionRepo repo.RecurringTransactionRepo,
+	transactionRepo repo.TransactionRepo,
+	categoryRepo repo.CategoryRepo,
+	accountRepo repo.AccountRepo,
+	transactionUseCase transaction.UseCase,
+) UseCase {
+	return &recurringTransactionUseCase{
+		recurringTransactionRepo,
+		transactionRepo,
+		categoryRepo,
+		accountRepo,
+		transactionUseCase,
+	}
+}
+
+func (uc *recurringTransactionUseCase) GetRecurringTransaction(ctx context.Context, req *GetRecurringTransactionRequest) (*GetRecurringTransactionResponse, error) {
+	rt, err := uc.recurringTransactionRepo.Get(ctx, req.ToRecurringTransactionFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get recurring transaction from repo, err: %v", err)
+		return nil, err
+	}
+
+	return &GetRecurringTransactionResponse{
+		RecurringTransaction: rt,
+	}, nil
+}
+
+func (uc *recurringTransactionUseCase) GetRecurringTransactions(ctx context.Context, req *GetRecurringTransactionsRequest) (*GetRecurringTransactionsResponse, error) {
+	rts, err := uc.recurringTransactionRepo.GetMany(ctx, req.ToRecurringTransactionFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get recurring transactions from repo, err: %v", err)
+		return nil, err
+	}
+
+	return &GetRecurringTransactionsResponse{
+		RecurringTransactions: rts,
+		Paging:                req.Paging,
+	}, nil
+}
+
+func (uc *recurringTransactionUseCase) CreateRecurringTransaction(ctx context.Context, req *CreateRecurringTransactionRequest) (*CreateRecurringTransactionResponse, error) {
+	rt, err := req.ToRecurringTransactionEntity()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.checkRecurringTransaction(ctx, req.GetUserID(), rt); err != nil {
+		return nil, err
+	}
+
+	_, err = uc.recurringTransactionRepo.Create(ctx, rt)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to save new recurring transaction to repo, err: %v", err)
+		return nil, err
+	}
+
+	return &CreateRecurringTransactionResponse{
+		RecurringTransaction: rt,
+	}, nil
+}
+
+func (uc *recurringTransactionUseCase) UpdateRecurringTransaction(ctx context.Context, req *UpdateRecurringTransactionRequest) (*UpdateRecurringTransactionResponse, error) {
+	rtf := req.ToRecurringTransactionFilter()
+
+	rt, err := uc.recurringTransactionRepo.Get(ctx, rtf)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get recurring transaction from repo, err: %v", err)
+		return nil, err
+	}
+
+	rtu, err := rt.Update(req.ToRecurringTransactionUpdate()...)
+	if err != nil {
+		return nil, err
+	}
+
+	if rtu == nil {
+		log.Ctx(ctx).Info().Msg("recurring transaction has no updates")
+		return &UpdateRecurringTransactionResponse{
+			RecurringTransaction: rt,
+		}, nil
+	}
+
+	if err := uc.checkRecurringTransaction(ctx, req.GetUserID(), rt); err != nil {
+		return nil, err
+	}
+
+	if err := uc.recurringTransactionRepo.Update(ctx, rtf, rtu); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to save recurring transaction updates to repo, err: %v", err)
+		return nil, err
+	}
+
+	return &UpdateRecurringTransactionResponse{
+		RecurringTransaction: rt,
+	}, nil
+}
+
+func (uc *recurringTransactionUseCase) DeleteRecurringTransaction(ctx context.Context, req *DeleteRecurringTransactionRequest) (*DeleteRecurringTransactionResponse, error) {
+	rtf := req.ToRecurringTransactionFilter()
+
+	rt, err := uc.recurringTransactionRepo.Get(ctx, rtf)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get recurring transaction from repo, err: %v", err)
+		return nil, err
+	}
+
+	rtu, err := rt.Update(
+		entity.WithUpdateRecurringTransactionStatus(goutil.Uint32(uint32(entity.RecurringTransactionStatusDeleted))),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// already materialised transactions are kept
+	if err := uc.recurringTransactionRepo.Update(ctx, rtf, rtu); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to mark recurring transaction as deleted, err: %v", err)
+		return nil, err
+	}
+
+	return new(DeleteRecurringTransactionResponse), nil
+}
+
+func (uc *recurringTransactionUseCase) RunRecurringTransactions(ctx context.Context, req *RunRecurringTransactionsRequest) (*RunRecurringTransactionsResponse, error) {
+	rts, err := uc.recurringTransactionRepo.GetMany(ctx, req.ToRecurringTransactionFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get due recurring transactions from repo, err: %v", err)
+		return nil, err
+	}
+
+	ts := make([]*entity.Transaction, 0)
+	for _, rt := range rts {
+		// catch up on all missed occurrences
+		for rt.IsDue(req.GetNow()) {
+			t, err := uc.runRecurringTransaction(ctx, req, rt)
+			if err != nil {
+				log.Ctx(ctx).Error().Msgf("fail to run recurring transaction, recurring_transaction_id: %v, err: %v",
+					rt.GetRecurringTransactionID(), err)
+				break
+			}
+
+			if t != nil {
+				ts = append(ts, t)
+			}
+		}
+	}
+
+	return &RunRecurringTransactionsResponse{
+		Transactions: ts,
+	}, nil
+}
+
+// runRecurringTransaction materialises the next occurrence of a recurring transaction.
+// An occurrence is only created once, so a run that fails halfway can safely be retried.
+func (uc *recurringTransactionUseCase) runRecurringTransaction(
+	ctx context.Context,
+	req *RunRecurringTransactionsRequest,
+	rt *entity.RecurringTransaction,
+) (*entity.Transaction, error) {
+	var t *entity.Transaction
+
+	_, err := uc.transactionRepo.Get(ctx, req.ToTransactionFilter(rt))
+	if err != nil && err != repo.ErrTransactionNotFound {
+		log.Ctx(ctx).Error().Msgf("fail to get transaction from repo, err: %v", err)
+		return nil, err
+	}
+
+	if err == repo.ErrTransactionNotFound {
+		res, err := uc.transactionUseCase.CreateTransaction(ctx, req.ToCreateTransactionRequest(rt))
+		if err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to create transaction, err: %v", err)
+			return nil, err
+		}
+		t = res.Transaction
+	} else {
+		log.Ctx(ctx).Info().Msgf("occurrence already created, next_time: %v", rt.GetNextTime())
+	}
+
+	rtu, err := rt.Advance()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.recurringTransactionRepo.Update(ctx, req.ToRecurringTransactionFilterByID(rt.GetRecurringTransactionID()), rtu); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to save recurring transaction updates to repo, err: %v", err)
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (uc *recurringTransactionUseCase) checkRecurringTransaction(ctx context.Context, userID string, rt *entity.RecurringTransaction) error {
+	if rt.IsTransfer() {
+		for _, accountID := range []string{rt.GetFromAccountID(), rt.GetToAccountID()} {
+			if _, err := uc.accountRepo.Get(ctx, repo.NewAccountFilter(
+				userID,
+				repo.WithAccountID(goutil.String(accountID)),
+			)); err != nil {
+				log.Ctx(ctx).Error().Msgf("fail to get account from repo, account_id: %v, err: %v", accountID, err)
+				return err
+			}
+		}
+		return nil
+	}
+
+	c, err := uc.categoryRepo.Get(ctx, repo.NewCategoryFilter(
+		userID,
+		repo.WithCategoryID(rt.CategoryID),
+	))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get category from repo, category_id: %v, err: %v",
+			rt.GetCategoryID(), err)
+		return err
+	}
+
+	if err := rt.CanTransactionUnderCategory(c); err != nil {
+		return err
+	}
+
+	ac, err := uc.accountRepo.Get(ctx, repo.NewAccountFilter(
+		userID,
+		repo.WithAccountID(rt.AccountID),
+	))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get account from repo, account_id: %v, err: %v",
+			rt.GetAccountID(), err)
+		return err
+	}
+
+	return rt.CanTransactionUnderAccount(ac)
+}
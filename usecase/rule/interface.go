@@ -0,0 +1,448 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/config"
+	"github.com/jseow5177/pockteer-be/dep/repo"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/filter"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/jseow5177/pockteer-be/usecase/common"
+)
+
+type UseCase interface {
+	GetRule(ctx context.Context, req *GetRuleRequest) (*GetRuleResponse, error)
+	GetRules(ctx context.Context, req *GetRulesRequest) (*GetRulesResponse, error)
+
+	CreateRule(ctx context.Context, req *CreateRuleRequest) (*CreateRuleResponse, error)
+	UpdateRule(ctx context.Context, req *UpdateRuleRequest) (*UpdateRuleResponse, error)
+	DeleteRule(ctx context.Context, req *DeleteRuleRequest) (*DeleteRuleResponse, error)
+
+	ApplyRules(ctx context.Context, req *ApplyRulesRequest) (*ApplyRulesResponse, error)
+}
+
+type GetRuleRequest struct {
+	UserID *string
+	RuleID *string
+}
+
+func (m *GetRuleRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *GetRuleRequest) GetRuleID() string {
+	if m != nil && m.RuleID != nil {
+		return *m.RuleID
+	}
+	return ""
+}
+
+func (m *GetRuleRequest) ToRuleFilter() *repo.RuleFilter {
+	return repo.NewRuleFilter(
+		m.GetUserID(),
+		repo.WithRuleID(m.RuleID),
+	)
+}
+
+type GetRuleResponse struct {
+	Rule *entity.Rule
+}
+
+func (m *GetRuleResponse) GetRule() *entity.Rule {
+	if m != nil && m.Rule != nil {
+		return m.Rule
+	}
+	return nil
+}
+
+type GetRulesRequest struct {
+	UserID *string
+}
+
+func (m *GetRulesRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *GetRulesRequest) ToRuleFilter() *repo.RuleFilter {
+	return newOrderedRuleFilter(m.GetUserID())
+}
+
+func (m *GetRulesRequest) ToCategoryFilter(categoryIDs []string) *repo.CategoryFilter {
+	return repo.NewCategoryFilter(
+		m.GetUserID(),
+		repo.WithCategoryIDs(categoryIDs),
+	)
+}
+
+type GetRulesResponse struct {
+	Rules []*entity.Rule
+}
+
+func (m *GetRulesResponse) GetRules() []*entity.Rule {
+	if m != nil && m.Rules != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+type CreateRuleRequest struct {
+	UserID          *string
+	RuleName        *string
+	CategoryID      *string
+	Position        *uint32
+	NoteContains    *string
+	AmountMin       *float64
+	AmountMax       *float64
+	AccountID       *string
+	Currency        *string
+	TransactionType *uint32
+}
+
+func (m *CreateRuleRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *CreateRuleRequest) GetRuleName() string {
+	if m != nil && m.RuleName != nil {
+		return *m.RuleName
+	}
+	return ""
+}
+
+func (m *CreateRuleRequest) GetCategoryID() string {
+	if m != nil && m.CategoryID != nil {
+		return *m.CategoryID
+	}
+	return ""
+}
+
+func (m *CreateRuleRequest) GetPosition() uint32 {
+	if m != nil && m.Position != nil {
+		return *m.Position
+	}
+	return 0
+}
+
+func (m *CreateRuleRequest) GetNoteContains() string {
+	if m != nil && m.NoteContains != nil {
+		return *m.NoteContains
+	}
+	return ""
+}
+
+func (m *CreateRuleRequest) GetAmountMin() float64 {
+	if m != nil && m.AmountMin != nil {
+		return *m.AmountMin
+	}
+	return 0
+}
+
+func (m *CreateRuleRequest) GetAmountMax() float64 {
+	if m != nil && m.AmountMax != nil {
+		return *m.AmountMax
+	}
+	return 0
+}
+
+func (m *CreateRuleRequest) GetAccountID() string {
+	if m != nil && m.AccountID != nil {
+		return *m.AccountID
+	}
+	return ""
+}
+
+func (m *CreateRuleRequest) GetCurrency() string {
+	if m != nil && m.Currency != nil {
+		return *m.Currency
+	}
+	return ""
+}
+
+func (m *CreateRuleRequest) GetTransactionType() uint32 {
+	if m != nil && m.TransactionType != nil {
+		return *m.TransactionType
+	}
+	return 0
+}
+
+func (m *CreateRuleRequest) ToRuleEntity(position uint32) (*entity.Rule, error) {
+	return entity.NewRule(
+		m.GetUserID(),
+		m.GetRuleName(),
+		m.GetCategoryID(),
+		entity.WithRulePosition(goutil.Uint32(position)),
+		entity.WithRuleNoteContains(m.NoteContains),
+		entity.WithRuleAmountMin(m.AmountMin),
+		entity.WithRuleAmountMax(m.AmountMax),
+		entity.WithRuleAccountID(m.AccountID),
+		entity.WithRuleCurrency(m.Currency),
+		entity.WithRuleTransactionType(m.TransactionType),
+	)
+}
+
+func (m *CreateRuleRequest) ToRuleFilter() *repo.RuleFilter {
+	return newOrderedRuleFilter(m.GetUserID())
+}
+
+func (m *CreateRuleRequest) ToCategoryFilter(categoryID string) *repo.CategoryFilter {
+	return repo.NewCategoryFilter(
+		m.GetUserID(),
+		repo.WithCategoryID(goutil.String(categoryID)),
+	)
+}
+
+func (m *CreateRuleRequest) ToAccountFilter(accountID string) *repo.AccountFilter {
+	return repo.NewAccountFilter(
+		m.GetUserID(),
+		repo.WithAccountID(goutil.String(accountID)),
+	)
+}
+
+type CreateRuleResponse struct {
+	Rule *entity.Rule
+}
+
+func (m *CreateRuleResponse) GetRule() *entity.Rule {
+	if m != nil && m.Rule != nil {
+		return m.Rule
+	}
+	return nil
+}
+
+type UpdateRuleRequest struct {
+	UserID          *string
+	RuleID          *string
+	RuleName        *string
+	CategoryID      *string
+	Position        *uint32
+	NoteContains    *string
+	AmountMin       *float64
+	AmountMax       *float64
+	AccountID       *string
+	Currency        *string
+	TransactionType *uint32
+}
+
+func (m *UpdateRuleRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *UpdateRuleRequest) GetRuleID() string {
+	if m != nil && m.RuleID != nil {
+		return *m.RuleID
+	}
+	return ""
+}
+
+func (m *UpdateRuleRequest) GetRuleName() string {
+	if m != nil && m.RuleName != nil {
+		return *m.RuleName
+	}
+	return ""
+}
+
+func (m *UpdateRuleRequest) GetCategoryID() string {
+	if m != nil && m.CategoryID != nil {
+		return *m.CategoryID
+	}
+	return ""
+}
+
+func (m *UpdateRuleRequest) GetPosition() uint32 {
+	if m != nil && m.Position != nil {
+		return *m.Position
+	}
+	return 0
+}
+
+func (m *UpdateRuleRequest) GetNoteContains() string {
+	if m != nil && m.NoteContains != nil {
+		return *m.NoteContains
+	}
+	return ""
+}
+
+func (m *UpdateRuleRequest) GetAmountMin() float64 {
+	if m != nil && m.AmountMin != nil {
+		return *m.AmountMin
+	}
+	return 0
+}
+
+func (m *UpdateRuleRequest) GetAmountMax() float64 {
+	if m != nil && m.AmountMax != nil {
+		return *m.AmountMax
+	}
+	return 0
+}
+
+func (m *UpdateRuleRequest) GetAccountID() string {
+	if m != nil && m.AccountID != nil {
+		return *m.AccountID
+	}
+	return ""
+}
+
+func (m *UpdateRuleRequest) GetCurrency() string {
+	if m != nil && m.Currency != nil {
+		return *m.Currency
+	}
+	return ""
+}
+
+func (m *UpdateRuleRequest) GetTransactionType() uint32 {
+	if m != nil && m.TransactionType != nil {
+		return *m.TransactionType
+	}
+	return 0
+}
+
+func (m *UpdateRuleRequest) ToRuleFilter() *repo.RuleFilter {
+	return repo.NewRuleFilter(
+		m.GetUserID(),
+		repo.WithRuleID(m.RuleID),
+	)
+}
+
+func (m *UpdateRuleRequest) ToCategoryFilter(categoryID string) *repo.CategoryFilter {
+	return repo.NewCategoryFilter(
+		m.GetUserID(),
+		repo.WithCategoryID(goutil.String(categoryID)),
+	)
+}
+
+func (m *UpdateRuleRequest) ToAccountFilter(accountID string) *repo.AccountFilter {
+	return repo.NewAccountFilter(
+		m.GetUserID(),
+		repo.WithAccountID(goutil.String(accountID)),
+	)
+}
+
+type UpdateRuleResponse struct {
+	Rule *entity.Rule
+}
+
+func (m *UpdateRuleResponse) GetRule() *entity.Rule {
+	if m != nil && m.Rule != nil {
+		return m.Rule
+	}
+	return nil
+}
+
+type DeleteRuleRequest struct {
+	UserID *string
+	RuleID *string
+}
+
+func (m *DeleteRuleRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *DeleteRuleRequest) GetRuleID() string {
+	if m != nil && m.RuleID != nil {
+		return *m.RuleID
+	}
+	return ""
+}
+
+func (m *DeleteRuleRequest) ToRuleFilter() *repo.RuleFilter {
+	return repo.NewRuleFilter(
+		m.GetUserID(),
+		repo.WithRuleID(m.RuleID),
+	)
+}
+
+type DeleteRuleResponse struct{}
+
+type ApplyRulesRequest struct {
+	UserID          *string
+	TransactionTime *common.RangeFilter
+}
+
+func (m *ApplyRulesRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *ApplyRulesRequest) GetTransactionTime() *common.RangeFilter {
+	if m != nil && m.TransactionTime != nil {
+		return m.TransactionTime
+	}
+	return nil
+}
+
+func (m *ApplyRulesRequest) ToTransactionQuery() *repo.TransactionQuery {
+	tt := m.TransactionTime
+	if tt == nil {
+		tt = new(common.RangeFilter)
+	}
+
+	return &repo.TransactionQuery{
+		Filters: []*repo.TransactionFilter{
+			repo.NewTransactionFilter(
+				m.GetUserID(),
+				repo.WithTransactionTimeGte(tt.Gte),
+				repo.WithTransactionTimeLte(tt.Lte),
+				repo.WithTransactionTypes([]uint32{
+					uint32(entity.TransactionTypeExpense),
+					uint32(entity.TransactionTypeIncome),
+				}),
+			),
+		},
+	}
+}
+
+func (m *ApplyRulesRequest) ToTransactionFilter(transactionID string) *repo.TransactionFilter {
+	return repo.NewTransactionFilter(
+		m.GetUserID(),
+		repo.WithTransactionID(goutil.String(transactionID)),
+	)
+}
+
+type ApplyRulesResponse struct {
+	Transactions []*entity.Transaction
+}
+
+func (m *ApplyRulesResponse) GetTransactions() []*entity.Transaction {
+	if m != nil && m.Transactions != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+// newOrderedRuleFilter returns all rules of the user in evaluation order.
+func newOrderedRuleFilter(userID string) *repo.RuleFilter {
+	return repo.NewRuleFilter(
+		userID,
+		repo.WithRulePaging(&repo.Paging{
+			Sorts: []filter.Sort{
+				&repo.Sort{
+					Field: goutil.String("position"),
+					Order: goutil.String(config.OrderAsc),
+				},
+				&repo.Sort{
+					Field: goutil.String("create_time"),
+					Order: goutil.String(config.OrderAsc),
+				},
+			},
+		}),
+	)
+}
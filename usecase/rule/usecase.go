@@ -0,0 +1,297 @@
+package rule
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jseow5177/pockteer-be/dep/repo"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/errutil"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	ErrRuleCategoryMismatch = errutil.ValidationError(errors.New("rule transaction type does not match category"))
+)
+
+type ruleUseCase struct {
+	txMgr           repo.TxMgr
+	ruleRepo        repo.RuleRepo
+	categoryRepo    repo.CategoryRepo
+	accountRepo     repo.AccountRepo
+	transactionRepo repo.TransactionRepo
+}
+
+func NewRuleUseCase(
+	txMgr repo.TxMgr,
+	ruleRepo repo.RuleRepo,
+	categoryRepo repo.CategoryRepo,
+	accountRepo repo.AccountRepo,
+	transactionRepo repo.TransactionRepo,
+) UseCase {
+	return &ruleUseCase{
+		txMgr,
+		ruleRepo,
+		categoryRepo,
+		accountRepo,
+		transactionRepo,
+	}
+}
+
+func (uc *ruleUseCase) GetRule(ctx context.Context, req *GetRuleRequest) (*GetRuleResponse, error) {
+	r, err := uc.ruleRepo.Get(ctx, req.ToRuleFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get rule from repo, err: %v", err)
+		return nil, err
+	}
+
+	return &GetRuleResponse{
+		Rule: r,
+	}, nil
+}
+
+// GetRules returns the rules in evaluation order, each with its category.
+// A rule whose category is deleted has no category and never matches.
+func (uc *ruleUseCase) GetRules(ctx context.Context, req *GetRulesRequest) (*GetRulesResponse, error) {
+	rs, err := uc.ruleRepo.GetMany(ctx, req.ToRuleFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get rules from repo, err: %v", err)
+		return nil, err
+	}
+
+	if len(rs) == 0 {
+		return &GetRulesResponse{
+			Rules: rs,
+		}, nil
+	}
+
+	categoryIDs := make([]string, 0, len(rs))
+	for _, r := range rs {
+		categoryIDs = append(categoryIDs, r.GetCategoryID())
+	}
+
+	cs, err := uc.categoryRepo.GetMany(ctx, req.ToCategoryFilter(goutil.RemoveDuplicateString(categoryIDs)))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get categories from repo, err: %v", err)
+		return nil, err
+	}
+
+	csMap := make(map[string]*entity.Category)
+	for _, c := range cs {
+		csMap[c.GetCategoryID()] = c
+	}
+
+	for _, r := range rs {
+		r.SetCategory(csMap[r.GetCategoryID()])
+	}
+
+	return &GetRulesResponse{
+		Rules: rs,
+	}, nil
+}
+
+func (uc *ruleUseCase) CreateRule(ctx context.Context, req *CreateRuleRequest) (*CreateRuleResponse, error) {
+	position := req.GetPosition()
+	if req.Position == nil {
+		rs, err := uc.ruleRepo.GetMany(ctx, req.ToRuleFilter())
+		if err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to get rules from repo, err: %v", err)
+			return nil, err
+		}
+
+		// new rule is evaluated last by default
+		if len(rs) > 0 {
+			position = rs[len(rs)-1].GetPosition() + 1
+		}
+	}
+
+	r, err := req.ToRuleEntity(position)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := uc.categoryRepo.Get(ctx, req.ToCategoryFilter(r.GetCategoryID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get category from repo, category_id: %v, err: %v",
+			r.GetCategoryID(), err)
+		return nil, err
+	}
+
+	if err := checkRuleCategory(r, c); err != nil {
+		return nil, err
+	}
+	r.SetCategory(c)
+
+	if r.GetAccountID() != "" {
+		if _, err := uc.accountRepo.Get(ctx, req.ToAccountFilter(r.GetAccountID())); err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to get account from repo, account_id: %v, err: %v",
+				r.GetAccountID(), err)
+			return nil, err
+		}
+	}
+
+	if _, err := uc.ruleRepo.Create(ctx, r); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to save new rule to repo, err: %v", err)
+		return nil, err
+	}
+
+	return &CreateRuleResponse{
+		Rule: r,
+	}, nil
+}
+
+func (uc *ruleUseCase) UpdateRule(ctx context.Context, req *UpdateRuleRequest) (*UpdateRuleResponse, error) {
+	rf := req.ToRuleFilter()
+
+	r, err := uc.ruleRepo.Get(ctx, rf)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get rule from repo, err: %v", err)
+		return nil, err
+	}
+
+	ru, err := r.Update(
+		entity.WithUpdateRuleName(req.RuleName),
+		entity.WithUpdateRuleCategoryID(req.CategoryID),
+		entity.WithUpdateRulePosition(req.Position),
+		entity.WithUpdateRuleNoteContains(req.NoteContains),
+		entity.WithUpdateRuleAmountMin(req.AmountMin),
+		entity.WithUpdateRuleAmountMax(req.AmountMax),
+		entity.WithUpdateRuleAccountID(req.AccountID),
+		entity.WithUpdateRuleCurrency(req.Currency),
+		entity.WithUpdateRuleTransactionType(req.TransactionType),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if ru == nil {
+		log.Ctx(ctx).Info().Msg("rule has no updates")
+		return &UpdateRuleResponse{
+			Rule: r,
+		}, nil
+	}
+
+	if ru.CategoryID != nil || ru.TransactionType != nil {
+		c, err := uc.categoryRepo.Get(ctx, req.ToCategoryFilter(r.GetCategoryID()))
+		if err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to get category from repo, category_id: %v, err: %v",
+				r.GetCategoryID(), err)
+			return nil, err
+		}
+
+		if err := checkRuleCategory(r, c); err != nil {
+			return nil, err
+		}
+		r.SetCategory(c)
+	}
+
+	if ru.GetAccountID() != "" {
+		if _, err := uc.accountRepo.Get(ctx, req.ToAccountFilter(ru.GetAccountID())); err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to get account from repo, account_id: %v, err: %v",
+				ru.GetAccountID(), err)
+			return nil, err
+		}
+	}
+
+	if err := uc.ruleRepo.Update(ctx, rf, ru); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to save rule updates to repo, err: %v", err)
+		return nil, err
+	}
+
+	return &UpdateRuleResponse{
+		Rule: r,
+	}, nil
+}
+
+func (uc *ruleUseCase) DeleteRule(ctx context.Context, req *DeleteRuleRequest) (*DeleteRuleResponse, error) {
+	rf := req.ToRuleFilter()
+
+	r, err := uc.ruleRepo.Get(ctx, rf)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get rule from repo, err: %v", err)
+		return nil, err
+	}
+
+	ru, err := r.Update(
+		entity.WithUpdateRuleStatus(goutil.Uint32(uint32(entity.RuleStatusDeleted))),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.ruleRepo.Update(ctx, rf, ru); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to save rule updates to repo, err: %v", err)
+		return nil, err
+	}
+
+	return new(DeleteRuleResponse), nil
+}
+
+// ApplyRules recategorises existing transactions in the time range with the first matching rule.
+// Transactions that match no rule keep their category.
+func (uc *ruleUseCase) ApplyRules(ctx context.Context, req *ApplyRulesRequest) (*ApplyRulesResponse, error) {
+	res, err := uc.GetRules(ctx, &GetRulesRequest{
+		UserID: req.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ts := make([]*entity.Transaction, 0)
+	if len(res.Rules) == 0 {
+		return &ApplyRulesResponse{
+			Transactions: ts,
+		}, nil
+	}
+
+	candidates, err := uc.transactionRepo.GetMany(ctx, req.ToTransactionQuery())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get transactions from repo, err: %v", err)
+		return nil, err
+	}
+
+	if err := uc.txMgr.WithTx(ctx, func(txCtx context.Context) error {
+		for _, t := range candidates {
+			// reconciled transactions are locked
+			if t.IsReconciled() {
+				continue
+			}
+
+			r := entity.MatchRules(res.Rules, t)
+			if r == nil || r.GetCategoryID() == t.GetCategoryID() {
+				continue
+			}
+
+			tu, err := t.Update(
+				entity.WithUpdateTransactionCategoryID(r.CategoryID),
+			)
+			if err != nil {
+				return err
+			}
+
+			if err := uc.transactionRepo.Update(txCtx, req.ToTransactionFilter(t.GetTransactionID()), tu); err != nil {
+				log.Ctx(txCtx).Error().Msgf("fail to save transaction updates to repo, err: %v", err)
+				return err
+			}
+			t.SetCategory(r.GetCategory())
+
+			ts = append(ts, t)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &ApplyRulesResponse{
+		Transactions: ts,
+	}, nil
+}
+
+func checkRuleCategory(r *entity.Rule, c *entity.Category) error {
+	if r.GetTransactionType() != uint32(entity.TransactionTypeInvalid) &&
+		r.GetTransactionType() != c.GetCategoryType() {
+		return ErrRuleCategoryMismatch
+	}
+	return nil
+}
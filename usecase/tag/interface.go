@@ -0,0 +1,299 @@
+package tag
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/dep/repo"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/jseow5177/pockteer-be/usecase/common"
+)
+
+type UseCase interface {
+	GetTag(ctx context.Context, req *GetTagRequest) (*GetTagResponse, error)
+	GetTags(ctx context.Context, req *GetTagsRequest) (*GetTagsResponse, error)
+
+	CreateTag(ctx context.Context, req *CreateTagRequest) (*CreateTagResponse, error)
+	UpdateTag(ctx context.Context, req *UpdateTagRequest) (*UpdateTagResponse, error)
+	DeleteTag(ctx context.Context, req *DeleteTagRequest) (*DeleteTagResponse, error)
+
+	SumTagTransactions(ctx context.Context, req *SumTagTransactionsRequest) (*SumTagTransactionsResponse, error)
+}
+
+type GetTagRequest struct {
+	UserID *string
+	TagID  *string
+}
+
+func (m *GetTagRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *GetTagRequest) GetTagID() string {
+	if m != nil && m.TagID != nil {
+		return *m.TagID
+	}
+	return ""
+}
+
+func (m *GetTagRequest) ToTagFilter() *repo.TagFilter {
+	return repo.NewTagFilter(
+		m.GetUserID(),
+		repo.WithTagID(m.TagID),
+	)
+}
+
+type GetTagResponse struct {
+	Tag *entity.Tag
+}
+
+func (m *GetTagResponse) GetTag() *entity.Tag {
+	if m != nil && m.Tag != nil {
+		return m.Tag
+	}
+	return nil
+}
+
+type GetTagsRequest struct {
+	UserID *string
+	TagIDs []string
+}
+
+func (m *GetTagsRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *GetTagsRequest) GetTagIDs() []string {
+	if m != nil && m.TagIDs != nil {
+		return m.TagIDs
+	}
+	return nil
+}
+
+func (m *GetTagsRequest) ToTagFilter() *repo.TagFilter {
+	return repo.NewTagFilter(
+		m.GetUserID(),
+		repo.WithTagIDs(m.TagIDs),
+	)
+}
+
+type GetTagsResponse struct {
+	Tags []*entity.Tag
+}
+
+func (m *GetTagsResponse) GetTags() []*entity.Tag {
+	if m != nil && m.Tags != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type CreateTagRequest struct {
+	UserID  *string
+	TagName *string
+}
+
+func (m *CreateTagRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *CreateTagRequest) GetTagName() string {
+	if m != nil && m.TagName != nil {
+		return *m.TagName
+	}
+	return ""
+}
+
+func (m *CreateTagRequest) ToTagEntity() (*entity.Tag, error) {
+	return entity.NewTag(
+		m.GetUserID(),
+		m.GetTagName(),
+	)
+}
+
+func (m *CreateTagRequest) ToTagFilter(tagName string) *repo.TagFilter {
+	return repo.NewTagFilter(
+		m.GetUserID(),
+		repo.WithTagName(goutil.String(tagName)),
+	)
+}
+
+type CreateTagResponse struct {
+	Tag *entity.Tag
+}
+
+func (m *CreateTagResponse) GetTag() *entity.Tag {
+	if m != nil && m.Tag != nil {
+		return m.Tag
+	}
+	return nil
+}
+
+type UpdateTagRequest struct {
+	UserID  *string
+	TagID   *string
+	TagName *string
+}
+
+func (m *UpdateTagRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *UpdateTagRequest) GetTagID() string {
+	if m != nil && m.TagID != nil {
+		return *m.TagID
+	}
+	return ""
+}
+
+func (m *UpdateTagRequest) GetTagName() string {
+	if m != nil && m.TagName != nil {
+		return *m.TagName
+	}
+	return ""
+}
+
+func (m *UpdateTagRequest) ToTagFilter() *repo.TagFilter {
+	return repo.NewTagFilter(
+		m.GetUserID(),
+		repo.WithTagID(m.TagID),
+	)
+}
+
+func (m *UpdateTagRequest) ToTagNameFilter(tagName string) *repo.TagFilter {
+	return repo.NewTagFilter(
+		m.GetUserID(),
+		repo.WithTagName(goutil.String(tagName)),
+	)
+}
+
+type UpdateTagResponse struct {
+	Tag *entity.Tag
+}
+
+func (m *UpdateTagResponse) GetTag() *entity.Tag {
+	if m != nil && m.Tag != nil {
+		return m.Tag
+	}
+	return nil
+}
+
+type DeleteTagRequest struct {
+	UserID *string
+	TagID  *string
+}
+
+func (m *DeleteTagRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *DeleteTagRequest) GetTagID() string {
+	if m != nil && m.TagID != nil {
+		return *m.TagID
+	}
+	return ""
+}
+
+func (m *DeleteTagRequest) ToTagFilter() *repo.TagFilter {
+	return repo.NewTagFilter(
+		m.GetUserID(),
+		repo.WithTagID(m.TagID),
+	)
+}
+
+type DeleteTagResponse struct{}
+
+type SumTagTransactionsRequest struct {
+	UserID          *string
+	TagIDs          []string
+	TransactionTime *common.RangeFilter
+	TransactionType *uint32
+}
+
+func (m *SumTagTransactionsRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *SumTagTransactionsRequest) GetTagIDs() []string {
+	if m != nil && m.TagIDs != nil {
+		return m.TagIDs
+	}
+	return nil
+}
+
+func (m *SumTagTransactionsRequest) GetTransactionTime() *common.RangeFilter {
+	if m != nil && m.TransactionTime != nil {
+		return m.TransactionTime
+	}
+	return nil
+}
+
+func (m *SumTagTransactionsRequest) GetTransactionType() uint32 {
+	if m != nil && m.TransactionType != nil {
+		return *m.TransactionType
+	}
+	return 0
+}
+
+func (m *SumTagTransactionsRequest) ToTagFilter() *repo.TagFilter {
+	return repo.NewTagFilter(
+		m.GetUserID(),
+		repo.WithTagIDs(m.TagIDs),
+	)
+}
+
+func (m *SumTagTransactionsRequest) ToTransactionQuery(tagIDs []string) *repo.TransactionQuery {
+	tt := m.TransactionTime
+	if tt == nil {
+		tt = new(common.RangeFilter)
+	}
+
+	return &repo.TransactionQuery{
+		Filters: []*repo.TransactionFilter{
+			repo.NewTransactionFilter(
+				m.GetUserID(),
+				repo.WithTransactionTimeGte(tt.Gte),
+				repo.WithTransactionTimeLte(tt.Lte),
+				repo.WithTransactionType(m.TransactionType),
+				repo.WithTransactionTagsIn(tagIDs),
+			),
+		},
+	}
+}
+
+func (m *SumTagTransactionsRequest) ToExchangeRateFilter(to, from string, timestamp uint64) *repo.ExchangeRateFilter {
+	return repo.NewExchangeRateFilter(
+		repo.WithExchangeRateTo(goutil.String(to)),
+		repo.WithExchangeRateFrom(goutil.String(from)),
+		repo.WithExchangeRateTimestamp(goutil.Uint64(timestamp)),
+	)
+}
+
+type SumTagTransactionsResponse struct {
+	Sums []*common.Summary
+}
+
+func (m *SumTagTransactionsResponse) GetSums() []*common.Summary {
+	if m != nil && m.Sums != nil {
+		return m.Sums
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+package tag
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jseow5177/pockteer-be/dep/repo"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/errutil"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/jseow5177/pockteer-be/usecase/common"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	ErrTagAlreadyExist = errutil.ValidationError(errors.New("tag already exists"))
+)
+
+type tagUseCase struct {
+	tagRepo          repo.TagRepo
+	transactionRepo  repo.TransactionRepo
+	exchangeRateRepo repo.ExchangeRateRepo
+}
+
+func NewTagUseCase(
+	tagRepo repo.TagRepo,
+	transactionRepo repo.TransactionRepo,
+	exchangeRateRepo repo.ExchangeRateRepo,
+) UseCase {
+	return &tagUseCase{
+		tagRepo,
+		transactionRepo,
+		exchangeRateRepo,
+	}
+}
+
+func (uc *tagUseCase) GetTag(ctx context.Context, req *GetTagRequest) (*GetTagResponse, error) {
+	t, err := uc.tagRepo.Get(ctx, req.ToTagFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get tag from repo, err: %v", err)
+		return nil, err
+	}
+
+	return &GetTagResponse{
+		Tag: t,
+	}, nil
+}
+
+func (uc *tagUseCase) GetTags(ctx context.Context, req *GetTagsRequest) (*GetTagsResponse, error) {
+	ts, err := uc.tagRepo.GetMany(ctx, req.ToTagFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get tags from repo, err: %v", err)
+		return nil, err
+	}
+
+	return &GetTagsResponse{
+		Tags: ts,
+	}, nil
+}
+
+func (uc *tagUseCase) CreateTag(ctx context.Context, req *CreateTagRequest) (*CreateTagResponse, error) {
+	t, err := req.ToTagEntity()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.checkTagName(ctx, req.ToTagFilter(t.GetTagName())); err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.tagRepo.Create(ctx, t); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to save new tag to repo, err: %v", err)
+		return nil, err
+	}
+
+	return &CreateTagResponse{
+		Tag: t,
+	}, nil
+}
+
+func (uc *tagUseCase) UpdateTag(ctx context.Context, req *UpdateTagRequest) (*UpdateTagResponse, error) {
+	tf := req.ToTagFilter()
+
+	t, err := uc.tagRepo.Get(ctx, tf)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get tag from repo, err: %v", err)
+		return nil, err
+	}
+
+	tu, err := t.Update(
+		entity.WithUpdateTagName(req.TagName),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if tu == nil {
+		log.Ctx(ctx).Info().Msg("tag has no updates")
+		return &UpdateTagResponse{
+			Tag: t,
+		}, nil
+	}
+
+	if tu.TagName != nil {
+		if err := uc.checkTagName(ctx, req.ToTagNameFilter(tu.GetTagName())); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.tagRepo.Update(ctx, tf, tu); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to save tag updates to repo, err: %v", err)
+		return nil, err
+	}
+
+	return &UpdateTagResponse{
+		Tag: t,
+	}, nil
+}
+
+func (uc *tagUseCase) DeleteTag(ctx context.Context, req *DeleteTagRequest) (*DeleteTagResponse, error) {
+	tf := req.ToTagFilter()
+
+	t, err := uc.tagRepo.Get(ctx, tf)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get tag from repo, err: %v", err)
+		return nil, err
+	}
+
+	tu, err := t.Update(
+		entity.WithUpdateTagStatus(goutil.Uint32(uint32(entity.TagStatusDeleted))),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// transactions keep the tag id, deleted tags are hidden when read
+	if err := uc.tagRepo.Update(ctx, tf, tu); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to save tag updates to repo, err: %v", err)
+		return nil, err
+	}
+
+	return new(DeleteTagResponse), nil
+}
+
+func (uc *tagUseCase) SumTagTransactions(ctx context.Context, req *SumTagTransactionsRequest) (*SumTagTransactionsResponse, error) {
+	tags, err := uc.tagRepo.GetMany(ctx, req.ToTagFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get tags from repo, err: %v", err)
+		return nil, err
+	}
+
+	if len(tags) == 0 {
+		return &SumTagTransactionsResponse{
+			Sums: make([]*common.Summary, 0),
+		}, nil
+	}
+
+	var (
+		tagIDs   = make([]string, 0)
+		tagMap   = make(map[string]*entity.Tag)
+		sumByTag = make(map[string]float64)
+	)
+	for _, t := range tags {
+		tagIDs = append(tagIDs, t.GetTagID())
+		tagMap[t.GetTagID()] = t
+		sumByTag[t.GetTagID()] = 0
+	}
+
+	ts, err := uc.transactionRepo.GetMany(ctx, req.ToTransactionQuery(tagIDs))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get transactions from repo, err: %v", err)
+		return nil, err
+	}
+
+	u := entity.GetUserFromCtx(ctx)
+
+	for _, t := range ts {
+		rate := 1.0
+
+		if t.GetCurrency() != u.Meta.GetCurrency() {
+			erf := req.ToExchangeRateFilter(
+				u.Meta.GetCurrency(),
+				t.GetCurrency(),
+				t.GetTransactionTime(),
+			)
+			er, err := uc.exchangeRateRepo.Get(ctx, erf)
+			if err != nil {
+				log.Ctx(ctx).Error().Msgf("fail to get exchange rate from repo, err: %v", err)
+				return nil, err
+			}
+
+			rate = er.GetRate()
+		}
+
+		// a transaction with many tags is summed under each of its tags
+		for _, tagID := range t.GetTagIDs() {
+			if _, ok := tagMap[tagID]; !ok {
+				continue
+			}
+			sumByTag[tagID] += t.GetAmount() * rate
+		}
+	}
+
+	sums := make([]*common.Summary, 0)
+	for _, t := range tags {
+		sums = append(sums, common.NewSummary(
+			common.WithSummaryTag(t),
+			common.WithSummaryCurrency(u.Meta.Currency),
+			common.WithSummarySum(goutil.Float64(sumByTag[t.GetTagID()])),
+		))
+	}
+
+	return &SumTagTransactionsResponse{
+		Sums: sums,
+	}, nil
+}
+
+func (uc *tagUseCase) checkTagName(ctx context.Context, tf *repo.TagFilter) error {
+	_, err := uc.tagRepo.Get(ctx, tf)
+	if err == nil {
+		return ErrTagAlreadyExist
+	}
+
+	if err != repo.ErrTagNotFound {
+		log.Ctx(ctx).Error().Msgf("fail to get tag from repo, err: %v", err)
+		return err
+	}
+
+	return nil
+}
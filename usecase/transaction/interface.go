@@ -23,6 +23,21 @@ type UseCase interface {
 
 	SumTransactions(ctx context.Context, req *SumTransactionsRequest) (*SumTransactionsResponse, error)
 	GetTransactionsSummary(ctx context.Context, req *GetTransactionsSummaryRequest) (*GetTransactionsSummaryResponse, error)
+
+	FindDuplicateTransactions(ctx context.Context, req *FindDuplicateTransactionsRequest) (*FindDuplicateTransactionsResponse, error)
+	MergeTransactions(ctx context.Context, req *MergeTransactionsRequest) (*MergeTransactionsResponse, error)
+
+	SearchTransactions(ctx context.Context, req *SearchTransactionsRequest) (*SearchTransactionsResponse, error)
+
+	BulkUpdateTransactions(ctx context.Context, req *BulkUpdateTransactionsRequest) (*BulkUpdateTransactionsResponse, error)
+	BulkDeleteTransactions(ctx context.Context, req *BulkDeleteTransactionsRequest) (*BulkDeleteTransactionsResponse, error)
+
+	GetDeletedTransactions(ctx context.Context, req *GetDeletedTransactionsRequest) (*GetDeletedTransactionsResponse, error)
+	RestoreTransaction(ctx context.Context, req *RestoreTransactionRequest) (*RestoreTransactionResponse, error)
+
+	ReconcileAccount(ctx context.Context, req *ReconcileAccountRequest) (*ReconcileAccountResponse, error)
+	GetCreditCardCycle(ctx context.Context, req *GetCreditCardCycleRequest) (*GetCreditCardCycleResponse, error)
+	GetAccountBalanceHistory(ctx context.Context, req *GetAccountBalanceHistoryRequest) (*GetAccountBalanceHistoryResponse, error)
 }
 
 type GetTransactionRequest struct {
@@ -67,6 +82,28 @@ func (m *GetTransactionRequest) ToAccountFilter(accountID string) *repo.AccountF
 	)
 }
 
+func (m *GetTransactionRequest) ToSplitCategoryFilter(categoryIDs []string) *repo.CategoryFilter {
+	return repo.NewCategoryFilter(
+		m.GetUserID(),
+		repo.WithCategoryIDs(categoryIDs),
+		repo.WithCategoryStatus(nil),
+	)
+}
+
+func (m *GetTransactionRequest) ToTagFilter(tagIDs []string) *repo.TagFilter {
+	return repo.NewTagFilter(
+		m.GetUserID(),
+		repo.WithTagIDs(tagIDs),
+	)
+}
+
+func (m *GetTransactionRequest) ToPayeeFilter(payeeIDs []string) *repo.PayeeFilter {
+	return repo.NewPayeeFilter(
+		m.GetUserID(),
+		repo.WithPayeeIDs(payeeIDs),
+	)
+}
+
 type GetTransactionResponse struct {
 	Transaction *entity.Transaction
 }
@@ -79,16 +116,29 @@ func (m *GetTransactionResponse) GetTransaction() *entity.Transaction {
 }
 
 type CreateTransactionRequest struct {
-	UserID          *string
-	AccountID       *string
-	CategoryID      *string
-	FromAccountID   *string
-	ToAccountID     *string
-	Currency        *string
-	Amount          *float64
-	Note            *string
-	TransactionType *uint32
-	TransactionTime *uint64
+	UserID                 *string
+	AccountID              *string
+	CategoryID             *string
+	FromAccountID          *string
+	ToAccountID            *string
+	Currency               *string
+	Amount                 *float64
+	ReceivedCurrency       *string
+	ReceivedAmount         *float64
+	Fee                    *float64
+	Note                   *string
+	ClearStatus            *uint32
+	TransactionType        *uint32
+	TransactionTime        *uint64
+	RecurringTransactionID *string
+	RefundedTransactionID  *string
+	PayeeID                *string
+	ExternalID             *string
+	Splits                 []*entity.TransactionSplit
+	TagIDs                 []string
+
+	// Loan repayment, interest of the repayment is split into an expense under this category
+	InterestCategoryID *string
 }
 
 func (m *CreateTransactionRequest) GetUserID() string {
@@ -140,6 +190,41 @@ func (m *CreateTransactionRequest) GetAmount() float64 {
 	return 0
 }
 
+func (m *CreateTransactionRequest) GetReceivedAmount() float64 {
+	if m != nil && m.ReceivedAmount != nil {
+		return *m.ReceivedAmount
+	}
+	return 0
+}
+
+func (m *CreateTransactionRequest) GetReceivedCurrency() string {
+	if m != nil && m.ReceivedCurrency != nil {
+		return *m.ReceivedCurrency
+	}
+	return ""
+}
+
+func (m *CreateTransactionRequest) GetFee() float64 {
+	if m != nil && m.Fee != nil {
+		return *m.Fee
+	}
+	return 0
+}
+
+func (m *CreateTransactionRequest) GetRefundedTransactionID() string {
+	if m != nil && m.RefundedTransactionID != nil {
+		return *m.RefundedTransactionID
+	}
+	return ""
+}
+
+func (m *CreateTransactionRequest) GetPayeeID() string {
+	if m != nil && m.PayeeID != nil {
+		return *m.PayeeID
+	}
+	return ""
+}
+
 func (m *CreateTransactionRequest) GetNote() string {
 	if m != nil && m.Note != nil {
 		return *m.Note
@@ -147,6 +232,13 @@ func (m *CreateTransactionRequest) GetNote() string {
 	return ""
 }
 
+func (m *CreateTransactionRequest) GetClearStatus() uint32 {
+	if m != nil && m.ClearStatus != nil {
+		return *m.ClearStatus
+	}
+	return 0
+}
+
 func (m *CreateTransactionRequest) GetTransactionType() uint32 {
 	if m != nil && m.TransactionType != nil {
 		return *m.TransactionType
@@ -161,6 +253,48 @@ func (m *CreateTransactionRequest) GetTransactionTime() uint64 {
 	return 0
 }
 
+func (m *CreateTransactionRequest) GetRecurringTransactionID() string {
+	if m != nil && m.RecurringTransactionID != nil {
+		return *m.RecurringTransactionID
+	}
+	return ""
+}
+
+func (m *CreateTransactionRequest) GetExternalID() string {
+	if m != nil && m.ExternalID != nil {
+		return *m.ExternalID
+	}
+	return ""
+}
+
+func (m *CreateTransactionRequest) GetSplits() []*entity.TransactionSplit {
+	if m != nil && m.Splits != nil {
+		return m.Splits
+	}
+	return nil
+}
+
+func (m *CreateTransactionRequest) GetTagIDs() []string {
+	if m != nil && m.TagIDs != nil {
+		return m.TagIDs
+	}
+	return nil
+}
+
+func (m *CreateTransactionRequest) GetInterestCategoryID() string {
+	if m != nil && m.InterestCategoryID != nil {
+		return *m.InterestCategoryID
+	}
+	return ""
+}
+
+func (m *CreateTransactionRequest) ToInterestCategoryFilter() *repo.CategoryFilter {
+	return repo.NewCategoryFilter(
+		m.GetUserID(),
+		repo.WithCategoryID(m.InterestCategoryID),
+	)
+}
+
 func (m *CreateTransactionRequest) ToTransactionEntity() (*entity.Transaction, error) {
 	return entity.NewTransaction(
 		m.GetUserID(),
@@ -170,9 +304,19 @@ func (m *CreateTransactionRequest) ToTransactionEntity() (*entity.Transaction, e
 		entity.WithTransactionFromAccountID(m.FromAccountID),
 		entity.WithTransactionToAccountID(m.ToAccountID),
 		entity.WithTransactionNote(m.Note),
+		entity.WithTransactionClearStatus(m.ClearStatus),
 		entity.WithTransactionType(m.TransactionType),
 		entity.WithTransactionTime(m.TransactionTime),
 		entity.WithTransactionCurrency(m.Currency),
+		entity.WithTransactionReceivedCurrency(m.ReceivedCurrency),
+		entity.WithTransactionReceivedAmount(m.ReceivedAmount),
+		entity.WithTransactionFee(m.Fee),
+		entity.WithTransactionRecurringTransactionID(m.RecurringTransactionID),
+		entity.WithTransactionRefundedTransactionID(m.RefundedTransactionID),
+		entity.WithTransactionPayeeID(m.PayeeID),
+		entity.WithTransactionExternalID(m.ExternalID),
+		entity.WithTransactionSplits(m.Splits),
+		entity.WithTransactionTagIDs(m.TagIDs),
 	)
 }
 
@@ -191,7 +335,8 @@ func (m *CreateTransactionRequest) ToAccountFilter(accountID string) *repo.Accou
 }
 
 type CreateTransactionResponse struct {
-	Transaction *entity.Transaction
+	Transaction         *entity.Transaction
+	InterestTransaction *entity.Transaction // interest split from a loan repayment
 }
 
 func (m *CreateTransactionResponse) GetTransaction() *entity.Transaction {
@@ -201,6 +346,13 @@ func (m *CreateTransactionResponse) GetTransaction() *entity.Transaction {
 	return nil
 }
 
+func (m *CreateTransactionResponse) GetInterestTransaction() *entity.Transaction {
+	if m != nil && m.InterestTransaction != nil {
+		return m.InterestTransaction
+	}
+	return nil
+}
+
 type GetTransactionsRequest struct {
 	UserID          *string
 	AccountID       *string
@@ -208,6 +360,8 @@ type GetTransactionsRequest struct {
 	CategoryIDs     []string
 	TransactionType *uint32
 	TransactionTime *common.RangeFilter
+	TagIDs          []string
+	Keywords        []string
 	Paging          *common.Paging
 }
 
@@ -253,6 +407,20 @@ func (m *GetTransactionsRequest) GetTransactionTime() *common.RangeFilter {
 	return nil
 }
 
+func (m *GetTransactionsRequest) GetTagIDs() []string {
+	if m != nil && m.TagIDs != nil {
+		return m.TagIDs
+	}
+	return nil
+}
+
+func (m *GetTransactionsRequest) GetKeywords() []string {
+	if m != nil && m.Keywords != nil {
+		return m.Keywords
+	}
+	return nil
+}
+
 func (m *GetTransactionsRequest) GetPaging() *common.Paging {
 	if m != nil && m.Paging != nil {
 		return m.Paging
@@ -296,14 +464,30 @@ func (m *GetTransactionsRequest) ToTransactionQuery() *repo.TransactionQuery {
 						m.GetUserID(),
 						repo.WithTransactionCategoryID(m.CategoryID),
 						repo.WithTransactionCategoryIDs(m.CategoryIDs),
+					),
+					// split transactions
+					repo.NewTransactionFilter(
+						m.GetUserID(),
+						repo.WithTransactionSplitCategoryID(m.CategoryID),
+						repo.WithTransactionSplitCategoryIDs(m.CategoryIDs),
+					),
+				},
+				Op: filter.Or,
+			},
+			{
+				Filters: []*repo.TransactionFilter{
+					repo.NewTransactionFilter(
+						m.GetUserID(),
 						repo.WithTransactionType(m.TransactionType),
 						repo.WithTransactionTimeGte(tt.Gte),
 						repo.WithTransactionTimeLte(tt.Lte),
+						repo.WithTransactionTags(m.TagIDs),
 					),
 				},
 			},
 		},
-		Op: filter.And,
+		Op:       filter.And,
+		Keywords: m.Keywords,
 		Paging: &repo.Paging{
 			Limit: paging.Limit,
 			Page:  paging.Page,
@@ -324,7 +508,7 @@ func (m *GetTransactionsRequest) ToTransactionQuery() *repo.TransactionQuery {
 func (m *GetTransactionsRequest) ToCategoryFilter(categoryIDs []string, categoryStatus uint32) *repo.CategoryFilter {
 	return repo.NewCategoryFilter(
 		m.GetUserID(),
-		repo.WithCategoryIDs(m.CategoryIDs),
+		repo.WithCategoryIDs(categoryIDs),
 		repo.WithCategoryStatus(goutil.Uint32(categoryStatus)),
 	)
 }
@@ -337,6 +521,20 @@ func (m *GetTransactionsRequest) ToAccountFilter(accountIDs []string) *repo.Acco
 	)
 }
 
+func (m *GetTransactionsRequest) ToTagFilter(tagIDs []string) *repo.TagFilter {
+	return repo.NewTagFilter(
+		m.GetUserID(),
+		repo.WithTagIDs(tagIDs),
+	)
+}
+
+func (m *GetTransactionsRequest) ToPayeeFilter(payeeIDs []string) *repo.PayeeFilter {
+	return repo.NewPayeeFilter(
+		m.GetUserID(),
+		repo.WithPayeeIDs(payeeIDs),
+	)
+}
+
 type GetTransactionsResponse struct {
 	Transactions []*entity.Transaction
 	Paging       *common.Paging
@@ -388,17 +586,25 @@ func (m *GetTransactionGroupsResponse) GetPaging() *common.Paging {
 }
 
 type UpdateTransactionRequest struct {
-	UserID          *string
-	TransactionID   *string
-	AccountID       *string
-	CategoryID      *string
-	FromAccountID   *string
-	ToAccountID     *string
-	TransactionType *uint32
-	Note            *string
-	Amount          *float64
-	TransactionTime *uint64
-	Currency        *string
+	UserID                *string
+	TransactionID         *string
+	AccountID             *string
+	CategoryID            *string
+	FromAccountID         *string
+	ToAccountID           *string
+	TransactionType       *uint32
+	Note                  *string
+	ClearStatus           *uint32
+	Amount                *float64
+	TransactionTime       *uint64
+	Currency              *string
+	ReceivedAmount        *float64
+	ReceivedCurrency      *string
+	Fee                   *float64
+	RefundedTransactionID *string
+	PayeeID               *string
+	Splits                []*entity.TransactionSplit
+	TagIDs                []string
 }
 
 func (m *UpdateTransactionRequest) GetUserID() string {
@@ -457,6 +663,41 @@ func (m *UpdateTransactionRequest) GetAmount() float64 {
 	return 0
 }
 
+func (m *UpdateTransactionRequest) GetReceivedAmount() float64 {
+	if m != nil && m.ReceivedAmount != nil {
+		return *m.ReceivedAmount
+	}
+	return 0
+}
+
+func (m *UpdateTransactionRequest) GetReceivedCurrency() string {
+	if m != nil && m.ReceivedCurrency != nil {
+		return *m.ReceivedCurrency
+	}
+	return ""
+}
+
+func (m *UpdateTransactionRequest) GetFee() float64 {
+	if m != nil && m.Fee != nil {
+		return *m.Fee
+	}
+	return 0
+}
+
+func (m *UpdateTransactionRequest) GetRefundedTransactionID() string {
+	if m != nil && m.RefundedTransactionID != nil {
+		return *m.RefundedTransactionID
+	}
+	return ""
+}
+
+func (m *UpdateTransactionRequest) GetPayeeID() string {
+	if m != nil && m.PayeeID != nil {
+		return *m.PayeeID
+	}
+	return ""
+}
+
 func (m *UpdateTransactionRequest) GetNote() string {
 	if m != nil && m.Note != nil {
 		return *m.Note
@@ -464,6 +705,13 @@ func (m *UpdateTransactionRequest) GetNote() string {
 	return ""
 }
 
+func (m *UpdateTransactionRequest) GetClearStatus() uint32 {
+	if m != nil && m.ClearStatus != nil {
+		return *m.ClearStatus
+	}
+	return 0
+}
+
 func (m *UpdateTransactionRequest) GetTransactionTime() uint64 {
 	if m != nil && m.TransactionTime != nil {
 		return *m.TransactionTime
@@ -471,6 +719,20 @@ func (m *UpdateTransactionRequest) GetTransactionTime() uint64 {
 	return 0
 }
 
+func (m *UpdateTransactionRequest) GetSplits() []*entity.TransactionSplit {
+	if m != nil && m.Splits != nil {
+		return m.Splits
+	}
+	return nil
+}
+
+func (m *UpdateTransactionRequest) GetTagIDs() []string {
+	if m != nil && m.TagIDs != nil {
+		return m.TagIDs
+	}
+	return nil
+}
+
 func (m *UpdateTransactionRequest) ToTransactionFilter() *repo.TransactionFilter {
 	return repo.NewTransactionFilter(
 		m.GetUserID(),
@@ -536,11 +798,11 @@ func (m *SumTransactionsRequest) ToTransactionQuery() *repo.TransactionQuery {
 		tt = new(common.RangeFilter)
 	}
 
+	// not filtered by transaction type, as refunds of type income are summed as expense
 	return &repo.TransactionQuery{
 		Filters: []*repo.TransactionFilter{
 			repo.NewTransactionFilter(
 				m.GetUserID(),
-				repo.WithTransactionType(m.TransactionType),
 				repo.WithTransactionTimeGte(tt.Gte),
 				repo.WithTransactionTimeLte(tt.Lte),
 			),
@@ -671,3 +933,864 @@ type GetTransactionsSummaryResponse struct {
 	TotalExpense []*common.Summary
 	TotalIncome  []*common.Summary
 }
+
+type FindDuplicateTransactionsRequest struct {
+	UserID          *string
+	AccountID       *string
+	TransactionTime *common.RangeFilter
+	Window          *uint64
+	MinConfidence   *float64
+}
+
+func (m *FindDuplicateTransactionsRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *FindDuplicateTransactionsRequest) GetAccountID() string {
+	if m != nil && m.AccountID != nil {
+		return *m.AccountID
+	}
+	return ""
+}
+
+func (m *FindDuplicateTransactionsRequest) GetTransactionTime() *common.RangeFilter {
+	if m != nil && m.TransactionTime != nil {
+		return m.TransactionTime
+	}
+	return nil
+}
+
+// GetWindow returns the max time apart of two duplicates in ms.
+func (m *FindDuplicateTransactionsRequest) GetWindow() uint64 {
+	if m != nil && m.Window != nil {
+		return *m.Window
+	}
+	return config.DefaultDuplicateWindow
+}
+
+func (m *FindDuplicateTransactionsRequest) GetMinConfidence() float64 {
+	if m != nil && m.MinConfidence != nil {
+		return *m.MinConfidence
+	}
+	return config.DefaultDuplicateMinConfidence
+}
+
+func (m *FindDuplicateTransactionsRequest) ToTransactionQuery() *repo.TransactionQuery {
+	tt := m.TransactionTime
+	if tt == nil {
+		tt = new(common.RangeFilter)
+	}
+
+	return &repo.TransactionQuery{
+		Filters: []*repo.TransactionFilter{
+			repo.NewTransactionFilter(
+				m.GetUserID(),
+				repo.WithTransactionAccountID(m.AccountID),
+				repo.WithTransactionTimeGte(tt.Gte),
+				repo.WithTransactionTimeLte(tt.Lte),
+			),
+		},
+		Paging: &repo.Paging{
+			Sorts: []filter.Sort{
+				&repo.Sort{
+					Field: goutil.String("transaction_time"),
+					Order: goutil.String(config.OrderAsc),
+				},
+			},
+		},
+	}
+}
+
+// DuplicateTransaction is a pair of transactions that are likely the same,
+// with a confidence score from 0 to 1. Duplicate is the later of the two.
+type DuplicateTransaction struct {
+	Transaction *entity.Transaction
+	Duplicate   *entity.Transaction
+	Confidence  *float64
+}
+
+func (m *DuplicateTransaction) GetTransaction() *entity.Transaction {
+	if m != nil && m.Transaction != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *DuplicateTransaction) GetDuplicate() *entity.Transaction {
+	if m != nil && m.Duplicate != nil {
+		return m.Duplicate
+	}
+	return nil
+}
+
+func (m *DuplicateTransaction) GetConfidence() float64 {
+	if m != nil && m.Confidence != nil {
+		return *m.Confidence
+	}
+	return 0
+}
+
+type FindDuplicateTransactionsResponse struct {
+	Duplicates []*DuplicateTransaction
+}
+
+func (m *FindDuplicateTransactionsResponse) GetDuplicates() []*DuplicateTransaction {
+	if m != nil && m.Duplicates != nil {
+		return m.Duplicates
+	}
+	return nil
+}
+
+type MergeTransactionsRequest struct {
+	UserID                 *string
+	TransactionID          *string
+	DuplicateTransactionID *string
+}
+
+func (m *MergeTransactionsRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *MergeTransactionsRequest) GetTransactionID() string {
+	if m != nil && m.TransactionID != nil {
+		return *m.TransactionID
+	}
+	return ""
+}
+
+func (m *MergeTransactionsRequest) GetDuplicateTransactionID() string {
+	if m != nil && m.DuplicateTransactionID != nil {
+		return *m.DuplicateTransactionID
+	}
+	return ""
+}
+
+func (m *MergeTransactionsRequest) ToTransactionFilter(transactionID string) *repo.TransactionFilter {
+	return repo.NewTransactionFilter(
+		m.GetUserID(),
+		repo.WithTransactionID(goutil.String(transactionID)),
+	)
+}
+
+type MergeTransactionsResponse struct {
+	Transaction *entity.Transaction
+}
+
+func (m *MergeTransactionsResponse) GetTransaction() *entity.Transaction {
+	if m != nil && m.Transaction != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+type GetDeletedTransactionsRequest struct {
+	UserID *string
+	Paging *common.Paging
+}
+
+func (m *GetDeletedTransactionsRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *GetDeletedTransactionsRequest) GetPaging() *common.Paging {
+	if m != nil && m.Paging != nil {
+		return m.Paging
+	}
+	return nil
+}
+
+func (m *GetDeletedTransactionsRequest) ToTransactionQuery() *repo.TransactionQuery {
+	paging := m.Paging
+	if m.Paging == nil {
+		paging = new(common.Paging)
+	}
+
+	return &repo.TransactionQuery{
+		Filters: []*repo.TransactionFilter{
+			repo.NewTransactionFilter(
+				m.GetUserID(),
+				repo.WithTransactionStatus(goutil.Uint32(uint32(entity.TransactionStatusDeleted))),
+			),
+		},
+		Paging: &repo.Paging{
+			Limit: paging.Limit,
+			Page:  paging.Page,
+			Sorts: []filter.Sort{
+				// most recently deleted first
+				&repo.Sort{
+					Field: goutil.String("update_time"),
+					Order: goutil.String(config.OrderDesc),
+				},
+			},
+		},
+	}
+}
+
+func (m *GetDeletedTransactionsRequest) ToAccountFilter(accountIDs []string) *repo.AccountFilter {
+	return repo.NewAccountFilter(
+		m.GetUserID(),
+		repo.WithAccountIDs(accountIDs),
+		repo.WithAccountStatus(nil), // any status is ok
+	)
+}
+
+type GetDeletedTransactionsResponse struct {
+	Transactions []*entity.Transaction
+	Paging       *common.Paging
+}
+
+func (m *GetDeletedTransactionsResponse) GetTransactions() []*entity.Transaction {
+	if m != nil && m.Transactions != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+func (m *GetDeletedTransactionsResponse) GetPaging() *common.Paging {
+	if m != nil && m.Paging != nil {
+		return m.Paging
+	}
+	return nil
+}
+
+type RestoreTransactionRequest struct {
+	UserID        *string
+	TransactionID *string
+}
+
+func (m *RestoreTransactionRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *RestoreTransactionRequest) GetTransactionID() string {
+	if m != nil && m.TransactionID != nil {
+		return *m.TransactionID
+	}
+	return ""
+}
+
+func (m *RestoreTransactionRequest) ToTransactionFilter() *repo.TransactionFilter {
+	return repo.NewTransactionFilter(
+		m.GetUserID(),
+		repo.WithTransactionID(m.TransactionID),
+		repo.WithTransactionStatus(goutil.Uint32(uint32(entity.TransactionStatusDeleted))),
+	)
+}
+
+func (m *RestoreTransactionRequest) ToAccountFilter(accountID string) *repo.AccountFilter {
+	return repo.NewAccountFilter(
+		m.GetUserID(),
+		repo.WithAccountID(goutil.String(accountID)),
+	)
+}
+
+func (m *RestoreTransactionRequest) ToCategoryFilter(categoryID string) *repo.CategoryFilter {
+	return repo.NewCategoryFilter(
+		m.GetUserID(),
+		repo.WithCategoryID(goutil.String(categoryID)),
+	)
+}
+
+type RestoreTransactionResponse struct {
+	Transaction *entity.Transaction
+}
+
+func (m *RestoreTransactionResponse) GetTransaction() *entity.Transaction {
+	if m != nil && m.Transaction != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+type SearchTransactionsRequest struct {
+	UserID          *string
+	Keyword         *string
+	AccountID       *string
+	CategoryID      *string
+	TransactionTime *common.RangeFilter
+	Paging          *common.Paging
+}
+
+func (m *SearchTransactionsRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *SearchTransactionsRequest) GetKeyword() string {
+	if m != nil && m.Keyword != nil {
+		return *m.Keyword
+	}
+	return ""
+}
+
+func (m *SearchTransactionsRequest) GetAccountID() string {
+	if m != nil && m.AccountID != nil {
+		return *m.AccountID
+	}
+	return ""
+}
+
+func (m *SearchTransactionsRequest) GetCategoryID() string {
+	if m != nil && m.CategoryID != nil {
+		return *m.CategoryID
+	}
+	return ""
+}
+
+func (m *SearchTransactionsRequest) GetTransactionTime() *common.RangeFilter {
+	if m != nil && m.TransactionTime != nil {
+		return m.TransactionTime
+	}
+	return nil
+}
+
+func (m *SearchTransactionsRequest) GetPaging() *common.Paging {
+	if m != nil && m.Paging != nil {
+		return m.Paging
+	}
+	return nil
+}
+
+func (m *SearchTransactionsRequest) ToGetTransactionsRequest(keywords []string) *GetTransactionsRequest {
+	return &GetTransactionsRequest{
+		UserID:          m.UserID,
+		AccountID:       m.AccountID,
+		CategoryID:      m.CategoryID,
+		TransactionTime: m.TransactionTime,
+		Keywords:        keywords,
+		Paging:          m.Paging,
+	}
+}
+
+type SearchTransactionsResponse struct {
+	Transactions []*entity.Transaction
+	Paging       *common.Paging
+}
+
+func (m *SearchTransactionsResponse) GetTransactions() []*entity.Transaction {
+	if m != nil && m.Transactions != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+func (m *SearchTransactionsResponse) GetPaging() *common.Paging {
+	if m != nil && m.Paging != nil {
+		return m.Paging
+	}
+	return nil
+}
+
+// BulkTransactionSelection picks transactions by ID, or else by the query.
+type BulkTransactionSelection struct {
+	TransactionIDs []string
+	Query          *GetTransactionsRequest
+}
+
+func (m *BulkTransactionSelection) GetTransactionIDs() []string {
+	if m != nil && m.TransactionIDs != nil {
+		return m.TransactionIDs
+	}
+	return nil
+}
+
+func (m *BulkTransactionSelection) GetQuery() *GetTransactionsRequest {
+	if m != nil && m.Query != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *BulkTransactionSelection) ToTransactionQuery(userID string) *repo.TransactionQuery {
+	if len(m.GetTransactionIDs()) > 0 {
+		return &repo.TransactionQuery{
+			Filters: []*repo.TransactionFilter{
+				repo.NewTransactionFilter(
+					userID,
+					repo.WithTransactionIDs(m.TransactionIDs),
+				),
+			},
+		}
+	}
+
+	q := *m.GetQuery()
+	q.UserID = goutil.String(userID)
+	// one more than allowed, so a query selecting too many is told apart without loading all
+	q.Paging = &common.Paging{
+		Limit: goutil.Uint32(config.MaxBulkTransactions + 1),
+		Page:  goutil.Uint32(1),
+	}
+
+	return q.ToTransactionQuery()
+}
+
+type BulkUpdateTransactionsRequest struct {
+	UserID     *string
+	Selection  *BulkTransactionSelection
+	CategoryID *string
+	AccountID  *string
+	TagIDs     []string
+}
+
+func (m *BulkUpdateTransactionsRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *BulkUpdateTransactionsRequest) GetSelection() *BulkTransactionSelection {
+	if m != nil && m.Selection != nil {
+		return m.Selection
+	}
+	return nil
+}
+
+func (m *BulkUpdateTransactionsRequest) GetCategoryID() string {
+	if m != nil && m.CategoryID != nil {
+		return *m.CategoryID
+	}
+	return ""
+}
+
+func (m *BulkUpdateTransactionsRequest) GetAccountID() string {
+	if m != nil && m.AccountID != nil {
+		return *m.AccountID
+	}
+	return ""
+}
+
+func (m *BulkUpdateTransactionsRequest) GetTagIDs() []string {
+	if m != nil && m.TagIDs != nil {
+		return m.TagIDs
+	}
+	return nil
+}
+
+func (m *BulkUpdateTransactionsRequest) ToCategoryFilter() *repo.CategoryFilter {
+	return repo.NewCategoryFilter(
+		m.GetUserID(),
+		repo.WithCategoryID(m.CategoryID),
+	)
+}
+
+func (m *BulkUpdateTransactionsRequest) ToAccountFilter() *repo.AccountFilter {
+	return repo.NewAccountFilter(
+		m.GetUserID(),
+		repo.WithAccountID(m.AccountID),
+	)
+}
+
+func (m *BulkUpdateTransactionsRequest) ToUpdateTransactionRequest(transactionID string) *UpdateTransactionRequest {
+	return &UpdateTransactionRequest{
+		UserID:        m.UserID,
+		TransactionID: goutil.String(transactionID),
+		CategoryID:    m.CategoryID,
+		AccountID:     m.AccountID,
+		TagIDs:        m.TagIDs,
+	}
+}
+
+type BulkUpdateTransactionsResponse struct {
+	Transactions []*entity.Transaction
+}
+
+func (m *BulkUpdateTransactionsResponse) GetTransactions() []*entity.Transaction {
+	if m != nil && m.Transactions != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+type BulkDeleteTransactionsRequest struct {
+	UserID    *string
+	Selection *BulkTransactionSelection
+}
+
+func (m *BulkDeleteTransactionsRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *BulkDeleteTransactionsRequest) GetSelection() *BulkTransactionSelection {
+	if m != nil && m.Selection != nil {
+		return m.Selection
+	}
+	return nil
+}
+
+func (m *BulkDeleteTransactionsRequest) ToDeleteTransactionRequest(transactionID string) *DeleteTransactionRequest {
+	return &DeleteTransactionRequest{
+		UserID:        m.UserID,
+		TransactionID: goutil.String(transactionID),
+	}
+}
+
+type BulkDeleteTransactionsResponse struct {
+	Deleted *uint32
+}
+
+func (m *BulkDeleteTransactionsResponse) GetDeleted() uint32 {
+	if m != nil && m.Deleted != nil {
+		return *m.Deleted
+	}
+	return 0
+}
+
+type ReconcileAccountRequest struct {
+	UserID         *string
+	AccountID      *string
+	StatementTime  *uint64  // transactions at or before this time are on the statement
+	ClosingBalance *float64 // statement balance, in the account currency
+}
+
+func (m *ReconcileAccountRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *ReconcileAccountRequest) GetAccountID() string {
+	if m != nil && m.AccountID != nil {
+		return *m.AccountID
+	}
+	return ""
+}
+
+func (m *ReconcileAccountRequest) GetStatementTime() uint64 {
+	if m != nil && m.StatementTime != nil {
+		return *m.StatementTime
+	}
+	return 0
+}
+
+func (m *ReconcileAccountRequest) GetClosingBalance() float64 {
+	if m != nil && m.ClosingBalance != nil {
+		return *m.ClosingBalance
+	}
+	return 0
+}
+
+func (m *ReconcileAccountRequest) ToAccountFilter() *repo.AccountFilter {
+	return repo.NewAccountFilter(
+		m.GetUserID(),
+		repo.WithAccountID(m.AccountID),
+	)
+}
+
+func (m *ReconcileAccountRequest) ToTransactionQuery() *repo.TransactionQuery {
+	return &repo.TransactionQuery{
+		Filters: []*repo.TransactionFilter{
+			repo.NewTransactionFilter(
+				m.GetUserID(),
+				repo.WithTransactionAccountID(m.AccountID),
+			),
+			repo.NewTransactionFilter(
+				m.GetUserID(),
+				repo.WithTransactionFromAccountID(m.AccountID),
+			),
+			repo.NewTransactionFilter(
+				m.GetUserID(),
+				repo.WithTransactionToAccountID(m.AccountID),
+			),
+		},
+		Op: filter.Or,
+	}
+}
+
+func (m *ReconcileAccountRequest) ToTransactionFilter(transactionID string) *repo.TransactionFilter {
+	return repo.NewTransactionFilter(
+		m.GetUserID(),
+		repo.WithTransactionID(goutil.String(transactionID)),
+	)
+}
+
+type ReconcileAccountResponse struct {
+	ClearedBalance *float64 // balance of cleared transactions up to the statement time
+	Difference     *float64 // closing balance minus cleared balance
+	Reconciled     *uint32  // number of transactions locked, only if there is no difference
+}
+
+func (m *ReconcileAccountResponse) GetClearedBalance() float64 {
+	if m != nil && m.ClearedBalance != nil {
+		return *m.ClearedBalance
+	}
+	return 0
+}
+
+func (m *ReconcileAccountResponse) GetDifference() float64 {
+	if m != nil && m.Difference != nil {
+		return *m.Difference
+	}
+	return 0
+}
+
+func (m *ReconcileAccountResponse) GetReconciled() uint32 {
+	if m != nil && m.Reconciled != nil {
+		return *m.Reconciled
+	}
+	return 0
+}
+
+// IsReconciled is true if the statement matched the cleared balance.
+func (m *ReconcileAccountResponse) IsReconciled() bool {
+	return m.GetDifference() == 0
+}
+
+type GetCreditCardCycleRequest struct {
+	UserID    *string
+	AccountID *string
+	AppMeta   *common.AppMeta
+}
+
+func (m *GetCreditCardCycleRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *GetCreditCardCycleRequest) GetAccountID() string {
+	if m != nil && m.AccountID != nil {
+		return *m.AccountID
+	}
+	return ""
+}
+
+func (m *GetCreditCardCycleRequest) GetAppMeta() *common.AppMeta {
+	if m != nil && m.AppMeta != nil {
+		return m.AppMeta
+	}
+	return nil
+}
+
+func (m *GetCreditCardCycleRequest) ToAccountFilter() *repo.AccountFilter {
+	return repo.NewAccountFilter(
+		m.GetUserID(),
+		repo.WithAccountID(m.AccountID),
+	)
+}
+
+func (m *GetCreditCardCycleRequest) ToTransactionQuery(start uint64) *repo.TransactionQuery {
+	return &repo.TransactionQuery{
+		Filters: []*repo.TransactionFilter{
+			repo.NewTransactionFilter(
+				m.GetUserID(),
+				repo.WithTransactionAccountID(m.AccountID),
+				repo.WithTransactionTimeGte(goutil.Uint64(start)),
+			),
+			repo.NewTransactionFilter(
+				m.GetUserID(),
+				repo.WithTransactionFromAccountID(m.AccountID),
+				repo.WithTransactionTimeGte(goutil.Uint64(start)),
+			),
+			repo.NewTransactionFilter(
+				m.GetUserID(),
+				repo.WithTransactionToAccountID(m.AccountID),
+				repo.WithTransactionTimeGte(goutil.Uint64(start)),
+			),
+		},
+		Op: filter.Or,
+	}
+}
+
+type GetCreditCardCycleResponse struct {
+	Account          *entity.Account
+	CycleStart       *uint64
+	CycleEnd         *uint64
+	DueTime          *uint64
+	CycleSpend       *float64 // expenses less refunds in the open cycle
+	StatementBalance *float64 // amount owed on the last statement
+	Payments         *float64 // transfers into the card since the last statement
+	MinimumPayment   *float64 // minimum payment of the last statement
+	AmountDue        *float64 // statement balance not yet paid
+	Utilisation      *float64 // percent of the credit limit used, nil if there is no limit
+}
+
+func (m *GetCreditCardCycleResponse) GetAccount() *entity.Account {
+	if m != nil && m.Account != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *GetCreditCardCycleResponse) GetCycleStart() uint64 {
+	if m != nil && m.CycleStart != nil {
+		return *m.CycleStart
+	}
+	return 0
+}
+
+func (m *GetCreditCardCycleResponse) GetCycleEnd() uint64 {
+	if m != nil && m.CycleEnd != nil {
+		return *m.CycleEnd
+	}
+	return 0
+}
+
+func (m *GetCreditCardCycleResponse) GetDueTime() uint64 {
+	if m != nil && m.DueTime != nil {
+		return *m.DueTime
+	}
+	return 0
+}
+
+func (m *GetCreditCardCycleResponse) GetCycleSpend() float64 {
+	if m != nil && m.CycleSpend != nil {
+		return *m.CycleSpend
+	}
+	return 0
+}
+
+func (m *GetCreditCardCycleResponse) GetStatementBalance() float64 {
+	if m != nil && m.StatementBalance != nil {
+		return *m.StatementBalance
+	}
+	return 0
+}
+
+func (m *GetCreditCardCycleResponse) GetPayments() float64 {
+	if m != nil && m.Payments != nil {
+		return *m.Payments
+	}
+	return 0
+}
+
+func (m *GetCreditCardCycleResponse) GetMinimumPayment() float64 {
+	if m != nil && m.MinimumPayment != nil {
+		return *m.MinimumPayment
+	}
+	return 0
+}
+
+func (m *GetCreditCardCycleResponse) GetAmountDue() float64 {
+	if m != nil && m.AmountDue != nil {
+		return *m.AmountDue
+	}
+	return 0
+}
+
+func (m *GetCreditCardCycleResponse) GetUtilisation() float64 {
+	if m != nil && m.Utilisation != nil {
+		return *m.Utilisation
+	}
+	return 0
+}
+
+type GetAccountBalanceHistoryRequest struct {
+	UserID    *string
+	AccountID *string
+	AppMeta   *common.AppMeta
+	Unit      *uint32
+	Interval  *uint32
+}
+
+func (m *GetAccountBalanceHistoryRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *GetAccountBalanceHistoryRequest) GetAccountID() string {
+	if m != nil && m.AccountID != nil {
+		return *m.AccountID
+	}
+	return ""
+}
+
+func (m *GetAccountBalanceHistoryRequest) GetAppMeta() *common.AppMeta {
+	if m != nil && m.AppMeta != nil {
+		return m.AppMeta
+	}
+	return nil
+}
+
+func (m *GetAccountBalanceHistoryRequest) GetUnit() uint32 {
+	if m != nil && m.Unit != nil {
+		return *m.Unit
+	}
+	return 0
+}
+
+func (m *GetAccountBalanceHistoryRequest) GetInterval() uint32 {
+	if m != nil && m.Interval != nil {
+		return *m.Interval
+	}
+	return 0
+}
+
+func (m *GetAccountBalanceHistoryRequest) ToAccountFilter() *repo.AccountFilter {
+	return repo.NewAccountFilter(
+		m.GetUserID(),
+		repo.WithAccountID(m.AccountID),
+	)
+}
+
+// ToTransactionQuery gets all transactions of the account from start, latest first.
+func (m *GetAccountBalanceHistoryRequest) ToTransactionQuery(start uint64) *repo.TransactionQuery {
+	return &repo.TransactionQuery{
+		Filters: []*repo.TransactionFilter{
+			repo.NewTransactionFilter(
+				m.GetUserID(),
+				repo.WithTransactionAccountID(m.AccountID),
+				repo.WithTransactionTimeGte(goutil.Uint64(start)),
+			),
+			repo.NewTransactionFilter(
+				m.GetUserID(),
+				repo.WithTransactionFromAccountID(m.AccountID),
+				repo.WithTransactionTimeGte(goutil.Uint64(start)),
+			),
+			repo.NewTransactionFilter(
+				m.GetUserID(),
+				repo.WithTransactionToAccountID(m.AccountID),
+				repo.WithTransactionTimeGte(goutil.Uint64(start)),
+			),
+		},
+		Op: filter.Or,
+		Paging: &repo.Paging{
+			Sorts: []filter.Sort{
+				&repo.Sort{
+					Field: goutil.String("transaction_time"),
+					Order: goutil.String(config.OrderDesc),
+				},
+			},
+		},
+	}
+}
+
+type GetAccountBalanceHistoryResponse struct {
+	Account  *entity.Account
+	Balances []*common.Summary // balance at the end of each unit, earliest first
+}
+
+func (m *GetAccountBalanceHistoryResponse) GetAccount() *entity.Account {
+	if m != nil && m.Account != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *GetAccountBalanceHistoryResponse) GetBalances() []*common.Summary {
+	if m != nil && m.Balances != nil {
+		return m.Balances
+	}
+	return nil
+}
@@ -3,20 +3,41 @@ package transaction
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math"
 	"sort"
 	"time"
 
+	"github.com/jseow5177/pockteer-be/config"
 	"github.com/jseow5177/pockteer-be/dep/repo"
 	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/errutil"
+	"github.com/jseow5177/pockteer-be/pkg/filter"
 	"github.com/jseow5177/pockteer-be/pkg/goutil"
 	"github.com/jseow5177/pockteer-be/usecase/common"
+	"github.com/jseow5177/pockteer-be/usecase/rule"
 	"github.com/jseow5177/pockteer-be/util"
 	"github.com/rs/zerolog/log"
 )
 
 var (
-	ErrInvalidCategoryIDs = errors.New("invalid category_ids")
+	ErrInvalidCategoryIDs   = errors.New("invalid category_ids")
+	ErrNoMatchingRule       = errutil.ValidationError(errors.New("no category_id and no matching rule"))
+	ErrMergeSameTransaction = errutil.ValidationError(errors.New("cannot merge a transaction with itself"))
+	ErrEmptySearchKeyword   = errutil.ValidationError(errors.New("keyword has no words to search"))
+	ErrNoBulkUpdate         = errutil.ValidationError(errors.New("one of category_id, account_id or tag_ids is required"))
+	ErrNoBulkSelection      = errutil.ValidationError(errors.New("one of transaction_ids or query is required"))
+	ErrTooManyTransactions  = errutil.ValidationError(fmt.Errorf("cannot update more than %d transactions at once", config.MaxBulkTransactions))
+	ErrBulkUpdateTransfer   = errutil.ValidationError(errors.New("transfer has no category or account to update"))
+	ErrBulkUpdateSplit      = errutil.ValidationError(errors.New("split transaction has no category to update"))
+	ErrRefundCategory       = errutil.ValidationError(errors.New("refund must be under a category of the refunded transaction"))
+	ErrRefundExceedsAmount  = errutil.ValidationError(errors.New("refunds cannot exceed the refunded amount"))
+	ErrReconciledLocked     = errutil.ValidationError(errors.New("reconciled transaction is locked, set it back to cleared first"))
+	ErrNotCreditCard        = errutil.ValidationError(errors.New("account is not a credit card"))
+	ErrNoStatementCycle     = errutil.ValidationError(errors.New("statement_day and due_day of the credit card are not set"))
+	ErrNotLoanRepayment     = errutil.ValidationError(errors.New("interest can only be split from a transfer to a loan or mortgage"))
+	ErrNoBalanceHistory     = errutil.ValidationError(errors.New("balance history of an investment account is based on its holdings"))
+	ErrRestoreCategory      = errutil.ValidationError(errors.New("category of the transaction is deleted or merged"))
 )
 
 // intermediate state
@@ -35,6 +56,9 @@ type transactionUseCase struct {
 	transactionRepo  repo.TransactionRepo
 	budgetRepo       repo.BudgetRepo
 	exchangeRateRepo repo.ExchangeRateRepo
+	tagRepo          repo.TagRepo
+	payeeRepo        repo.PayeeRepo
+	ruleUseCase      rule.UseCase
 }
 
 func NewTransactionUseCase(
@@ -44,6 +68,9 @@ func NewTransactionUseCase(
 	transactionRepo repo.TransactionRepo,
 	budgetRepo repo.BudgetRepo,
 	exchangeRateRepo repo.ExchangeRateRepo,
+	tagRepo repo.TagRepo,
+	payeeRepo repo.PayeeRepo,
+	ruleUseCase rule.UseCase,
 ) UseCase {
 	return &transactionUseCase{
 		txMgr,
@@ -52,6 +79,9 @@ func NewTransactionUseCase(
 		transactionRepo,
 		budgetRepo,
 		exchangeRateRepo,
+		tagRepo,
+		payeeRepo,
+		ruleUseCase,
 	}
 }
 
@@ -92,6 +122,15 @@ func (uc *transactionUseCase) GetTransaction(ctx context.Context, req *GetTransa
 			t.SetCategory(c)
 		}
 
+		if t.IsSplit() {
+			cs, err := uc.categoryRepo.GetMany(ctx, req.ToSplitCategoryFilter(t.GetSplitCategoryIDs()))
+			if err != nil {
+				log.Ctx(ctx).Error().Msgf("fail to get split categories from repo, err: %v", err)
+				return nil, err
+			}
+			setSplitCategories(t, cs)
+		}
+
 		ac, err := uc.accountRepo.Get(ctx, req.ToAccountFilter(t.GetAccountID()))
 		if err != nil {
 			log.Ctx(ctx).Error().Msgf("fail to get account from repo, err: %v", err)
@@ -101,6 +140,24 @@ func (uc *transactionUseCase) GetTransaction(ctx context.Context, req *GetTransa
 		t.SetAccount(ac)
 	}
 
+	if len(t.GetTagIDs()) > 0 {
+		tags, err := uc.tagRepo.GetMany(ctx, req.ToTagFilter(t.GetTagIDs()))
+		if err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to get tags from repo, err: %v", err)
+			return nil, err
+		}
+		setTags(t, tags)
+	}
+
+	if t.GetPayeeID() != "" {
+		ps, err := uc.payeeRepo.GetMany(ctx, req.ToPayeeFilter([]string{t.GetPayeeID()}))
+		if err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to get payees from repo, err: %v", err)
+			return nil, err
+		}
+		setPayees([]*entity.Transaction{t}, ps)
+	}
+
 	return &GetTransactionResponse{
 		Transaction: t,
 	}, nil
@@ -123,6 +180,12 @@ func (uc *transactionUseCase) GetTransactionGroups(
 
 	u := entity.GetUserFromCtx(ctx)
 
+	// categories queried, resolved by GetTransactions
+	categoryIDs := req.GetTransactionsRequest.GetCategoryIDs()
+	if req.GetTransactionsRequest.GetCategoryID() != "" {
+		categoryIDs = append(categoryIDs, req.GetTransactionsRequest.GetCategoryID())
+	}
+
 	var (
 		transactionGroups    = make([]*transactionSummary, 0)
 		transactionGroupsMap = make(map[string]*transactionSummary)
@@ -154,9 +217,15 @@ func (uc *transactionUseCase) GetTransactionGroups(
 				log.Ctx(ctx).Error().Msgf("fail convert transaction currency, err: %v", err)
 				return nil, err
 			}
+
+			// only count the splits under the queried categories
+			if t.IsSplit() && len(categoryIDs) > 0 && t.GetAmount() != 0 {
+				amount *= t.GetAmountByCategories(categoryIDs...) / t.GetAmount()
+			}
 		}
 
-		if t.IsExpense() {
+		// a refund reduces expense instead of adding to income
+		if t.IsExpense() || t.IsRefund() {
 			transactionGroup.TotalExpense += amount
 		} else if t.IsIncome() {
 			transactionGroup.TotalIncome += amount
@@ -220,8 +289,16 @@ func (uc *transactionUseCase) GetTransactions(
 	var (
 		categoryIDs = make([]string, 0)
 		accountIDs  = make([]string, 0)
+		tagIDs      = make([]string, 0)
+		payeeIDs    = make([]string, 0)
 	)
 	for _, t := range ts {
+		tagIDs = append(tagIDs, t.GetTagIDs()...)
+
+		if t.GetPayeeID() != "" {
+			payeeIDs = append(payeeIDs, t.GetPayeeID())
+		}
+
 		if t.IsTransfer() {
 			accountIDs = append(accountIDs, t.GetFromAccountID(), t.GetToAccountID())
 		} else {
@@ -231,10 +308,14 @@ func (uc *transactionUseCase) GetTransactions(
 		if t.GetCategoryID() != "" {
 			categoryIDs = append(categoryIDs, t.GetCategoryID())
 		}
+
+		categoryIDs = append(categoryIDs, t.GetSplitCategoryIDs()...)
 	}
 
 	categoryIDs = goutil.RemoveDuplicateString(categoryIDs)
 	accountIDs = goutil.RemoveDuplicateString(accountIDs)
+	tagIDs = goutil.RemoveDuplicateString(tagIDs)
+	payeeIDs = goutil.RemoveDuplicateString(payeeIDs)
 
 	// get categories
 	var cs []*entity.Category
@@ -269,6 +350,26 @@ func (uc *transactionUseCase) GetTransactions(
 		acsMap[ac.GetAccountID()] = ac
 	}
 
+	// get tags
+	var tags []*entity.Tag
+	if len(tagIDs) > 0 {
+		tags, err = uc.tagRepo.GetMany(ctx, req.ToTagFilter(tagIDs))
+		if err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to get tags from repo, err: %v", err)
+			return nil, err
+		}
+	}
+
+	// get payees
+	if len(payeeIDs) > 0 {
+		ps, err := uc.payeeRepo.GetMany(ctx, req.ToPayeeFilter(payeeIDs))
+		if err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to get payees from repo, err: %v", err)
+			return nil, err
+		}
+		setPayees(ts, ps)
+	}
+
 	// set accounts and categories
 	for _, t := range ts {
 		if t.IsTransfer() {
@@ -288,6 +389,14 @@ func (uc *transactionUseCase) GetTransactions(
 		} else {
 			t.SetCategoryID(goutil.String(""))
 		}
+
+		for _, split := range t.GetSplits() {
+			split.SetCategory(csMap[split.GetCategoryID()])
+		}
+
+		if len(t.GetTagIDs()) > 0 {
+			setTags(t, tags)
+		}
 	}
 
 	return &GetTransactionsResponse{
@@ -305,6 +414,10 @@ func (uc *transactionUseCase) DeleteTransaction(ctx context.Context, req *Delete
 		return nil, err
 	}
 
+	if t.IsReconciled() {
+		return nil, ErrReconciledLocked
+	}
+
 	if err := uc.txMgr.WithTx(ctx, func(txCtx context.Context) error {
 		tu, err := t.Update(
 			entity.WithUpdateTransactionStatus(goutil.Uint32(uint32(entity.TransactionStatusDeleted))),
@@ -366,6 +479,12 @@ func (uc *transactionUseCase) DeleteTransaction(ctx context.Context, req *Delete
 			}
 		}
 
+		if t.IsTransfer() {
+			if err := uc.deleteInterestTransaction(txCtx, t); err != nil {
+				return err
+			}
+		}
+
 		return nil
 	}); err != nil {
 		return nil, err
@@ -383,6 +502,8 @@ func (uc *transactionUseCase) CreateTransaction(ctx context.Context, req *Create
 	// left: to minus amount, right: to plus amount
 	acs := make(map[*entity.Account]*entity.Account)
 
+	var interestT *entity.Transaction
+
 	if t.IsTransfer() {
 		fromAc, err := uc.accountRepo.Get(ctx, req.ToAccountFilter(req.GetFromAccountID()))
 		if err != nil {
@@ -400,18 +521,48 @@ func (uc *transactionUseCase) CreateTransaction(ctx context.Context, req *Create
 		}
 		t.SetToAccount(toAc)
 
+		if req.GetInterestCategoryID() != "" {
+			interestT, err = uc.newInterestTransaction(ctx, req, t, toAc)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		acs[fromAc] = toAc
 	} else {
-		c, err := uc.categoryRepo.Get(ctx, req.ToCategoryFilter())
-		if err != nil {
-			log.Ctx(ctx).Error().Msgf("fail to get category from repo, category_id: %v, err: %v",
-				req.GetCategoryID(), err)
-			return nil, err
+		if req.GetInterestCategoryID() != "" {
+			return nil, ErrNotLoanRepayment
 		}
-		t.SetCategory(c)
 
-		if err := t.CanTransactionUnderCategory(c); err != nil {
-			return nil, err
+		if t.IsRefund() {
+			if err := uc.checkRefund(ctx, req.GetUserID(), t); err != nil {
+				return nil, err
+			}
+		} else if t.IsSplit() {
+			if err := uc.checkSplitCategories(ctx, req.GetUserID(), t); err != nil {
+				return nil, err
+			}
+		} else {
+			var c *entity.Category
+			if req.GetCategoryID() == "" {
+				c, err = uc.matchRuleCategory(ctx, req.GetUserID(), t)
+				if err != nil {
+					return nil, err
+				}
+				t.SetCategoryID(c.CategoryID)
+			} else {
+				c, err = uc.categoryRepo.Get(ctx, req.ToCategoryFilter())
+				if err != nil {
+					log.Ctx(ctx).Error().Msgf("fail to get category from repo, category_id: %v, err: %v",
+						req.GetCategoryID(), err)
+					return nil, err
+				}
+			}
+			t.SetCategory(c)
+
+			if err := t.CanTransactionUnderCategory(c); err != nil {
+				return nil, err
+			}
 		}
 
 		ac, err := uc.accountRepo.Get(ctx, req.ToAccountFilter(req.GetAccountID()))
@@ -429,6 +580,20 @@ func (uc *transactionUseCase) CreateTransaction(ctx context.Context, req *Create
 		acs[nil] = ac
 	}
 
+	if len(t.GetTagIDs()) > 0 {
+		if err := uc.checkTags(ctx, req.GetUserID(), t); err != nil {
+			return nil, err
+		}
+	}
+
+	if t.GetPayeeID() != "" {
+		if err := uc.checkPayee(ctx, req.GetUserID(), t); err != nil {
+			return nil, err
+		}
+	} else if err := uc.matchPayee(ctx, req.GetUserID(), t); err != nil {
+		return nil, err
+	}
+
 	if err := uc.txMgr.WithTx(ctx, func(txCtx context.Context) error {
 		// create transaction
 		_, err := uc.transactionRepo.Create(txCtx, t)
@@ -453,13 +618,28 @@ func (uc *transactionUseCase) CreateTransaction(ctx context.Context, req *Create
 			}
 		}
 
+		if interestT != nil {
+			interestT.SetRepaymentTransactionID(t.TransactionID)
+
+			if _, err := uc.transactionRepo.Create(txCtx, interestT); err != nil {
+				log.Ctx(txCtx).Error().Msgf("fail to save interest transaction to repo, err: %v", err)
+				return err
+			}
+
+			if err := uc.updateAccountBalance(txCtx, interestT, interestT.GetAccount(), true); err != nil {
+				log.Ctx(txCtx).Error().Msgf("fail to update account balance, err: %v", err)
+				return err
+			}
+		}
+
 		return nil
 	}); err != nil {
 		return nil, err
 	}
 
 	return &CreateTransactionResponse{
-		Transaction: t,
+		Transaction:         t,
+		InterestTransaction: interestT,
 	}, nil
 }
 
@@ -478,12 +658,20 @@ func (uc *transactionUseCase) UpdateTransaction(ctx context.Context, req *Update
 		entity.WithUpdateTransactionAmount(req.Amount),
 		entity.WithUpdateTransactionTime(req.TransactionTime),
 		entity.WithUpdateTransactionNote(req.Note),
+		entity.WithUpdateTransactionClearStatus(req.ClearStatus),
 		entity.WithUpdateTransactionAccountID(req.AccountID),
 		entity.WithUpdateTransactionCategoryID(req.CategoryID),
 		entity.WithUpdateTransactionFromAccountID(req.FromAccountID),
 		entity.WithUpdateTransactionToAccountID(req.ToAccountID),
 		entity.WithUpdateTransactionType(req.TransactionType),
 		entity.WithUpdateTransactionCurrency(req.Currency),
+		entity.WithUpdateTransactionReceivedAmount(req.ReceivedAmount),
+		entity.WithUpdateTransactionReceivedCurrency(req.ReceivedCurrency),
+		entity.WithUpdateTransactionFee(req.Fee),
+		entity.WithUpdateTransactionRefundedTransactionID(req.RefundedTransactionID),
+		entity.WithUpdateTransactionPayeeID(req.PayeeID),
+		entity.WithUpdateTransactionSplits(req.Splits),
+		entity.WithUpdateTransactionTagIDs(req.TagIDs),
 	)
 	if err != nil {
 		return nil, err
@@ -496,10 +684,17 @@ func (uc *transactionUseCase) UpdateTransaction(ctx context.Context, req *Update
 		}, nil
 	}
 
+	// a reconciled transaction can only be unlocked by setting it back to cleared
+	if oldT.IsReconciled() &&
+		!(tu.IsClearStatusOnly() && tu.GetClearStatus() == uint32(entity.ClearStatusCleared)) {
+		return nil, ErrReconciledLocked
+	}
+
 	var (
 		needResetAccountBalance = tu.TransactionTime != nil || tu.Currency != nil ||
 			tu.TransactionType != nil || tu.Amount != nil || tu.AccountID != nil ||
-			tu.FromAccountID != nil || tu.ToAccountID != nil
+			tu.FromAccountID != nil || tu.ToAccountID != nil ||
+			tu.ReceivedAmount != nil || tu.ReceivedCurrency != nil || tu.Fee != nil
 
 		transactionOps = []*struct {
 			AccountID string
@@ -538,7 +733,37 @@ func (uc *transactionUseCase) UpdateTransaction(ctx context.Context, req *Update
 		}
 	}
 
-	if tu.CategoryID != nil {
+	if t.IsSplit() && (tu.Splits != nil || tu.TransactionType != nil) {
+		if err := uc.checkSplitCategories(ctx, req.GetUserID(), t); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(tu.TagIDs) > 0 {
+		if err := uc.checkTags(ctx, req.GetUserID(), t); err != nil {
+			return nil, err
+		}
+	}
+
+	if tu.PayeeID != nil && t.GetPayeeID() != "" {
+		if err := uc.checkPayee(ctx, req.GetUserID(), t); err != nil {
+			return nil, err
+		}
+	} else if tu.Note != nil && t.GetPayeeID() == "" {
+		if err := uc.matchPayee(ctx, req.GetUserID(), t); err != nil {
+			return nil, err
+		}
+		tu.PayeeID = t.PayeeID
+	}
+
+	if t.IsRefund() && (tu.RefundedTransactionID != nil || tu.CategoryID != nil ||
+		tu.Amount != nil || tu.Currency != nil || tu.TransactionType != nil) {
+		if err := uc.checkRefund(ctx, req.GetUserID(), t); err != nil {
+			return nil, err
+		}
+		// category may be taken from the refunded transaction
+		tu = t.ToTransactionUpdate(oldT)
+	} else if tu.CategoryID != nil && !t.IsSplit() {
 		newCategory, err := uc.categoryRepo.Get(ctx, req.ToCategoryFilter())
 		if err != nil {
 			log.Ctx(ctx).Info().Msgf("fail to get new category from repo, err: %v", err)
@@ -548,6 +773,20 @@ func (uc *transactionUseCase) UpdateTransaction(ctx context.Context, req *Update
 		if err := t.CanTransactionUnderCategory(newCategory); err != nil {
 			return nil, err
 		}
+	} else if tu.RefundedTransactionID != nil && t.GetCategoryID() != "" {
+		// no longer a refund, so the category must match the transaction type again
+		c, err := uc.categoryRepo.Get(ctx, repo.NewCategoryFilter(
+			req.GetUserID(),
+			repo.WithCategoryID(t.CategoryID),
+		))
+		if err != nil {
+			log.Ctx(ctx).Info().Msgf("fail to get category from repo, err: %v", err)
+			return nil, err
+		}
+
+		if err := t.CanTransactionUnderCategory(c); err != nil {
+			return nil, err
+		}
 	}
 
 	if err = uc.txMgr.WithTx(ctx, func(txCtx context.Context) error {
@@ -569,6 +808,13 @@ func (uc *transactionUseCase) UpdateTransaction(ctx context.Context, req *Update
 			}
 		}
 
+		// interest split from a repayment follows the repayment
+		if oldT.IsTransfer() && (needResetAccountBalance || tu.ClearStatus != nil) {
+			if err := uc.updateInterestTransaction(txCtx, t); err != nil {
+				return err
+			}
+		}
+
 		return nil
 	}); err != nil {
 		return nil, err
@@ -597,13 +843,23 @@ func (uc *transactionUseCase) SumTransactions(ctx context.Context, req *SumTrans
 	u := entity.GetUserFromCtx(ctx)
 
 	for _, t := range ts {
+		// a refund reduces expense instead of adding to income
+		tt := t.GetTransactionType()
+		if t.IsRefund() {
+			tt = uint32(entity.TransactionTypeExpense)
+		}
+
+		if _, ok := sumByTT[tt]; !ok {
+			continue
+		}
+
 		amount, err := uc.getAmountAfterConversion(ctx, t, u.Meta.GetCurrency())
 		if err != nil {
 			log.Ctx(ctx).Error().Msgf("fail convert transaction currency, err: %v", err)
 			return nil, err
 		}
 
-		sumByTT[t.GetTransactionType()] += amount
+		sumByTT[tt] += amount
 	}
 
 	sums := make([]*common.Summary, 0)
@@ -715,7 +971,8 @@ func (uc *transactionUseCase) GetTransactionsSummary(ctx context.Context, req *G
 
 		transactionGroup := transactionGroupsMap[d]
 
-		if transaction.IsExpense() {
+		// a refund reduces expense instead of adding to income
+		if transaction.IsExpense() || transaction.IsRefund() {
 			transactionGroup.TotalExpense += amount
 		} else if transaction.IsIncome() {
 			transactionGroup.TotalIncome += amount
@@ -802,58 +1059,1268 @@ func (uc *transactionUseCase) GetTransactionsSummary(ctx context.Context, req *G
 	return resp, nil
 }
 
-func (uc *transactionUseCase) getAmountAfterConversion(ctx context.Context, t *entity.Transaction, currency string) (float64, error) {
-	amount := t.GetAmount()
+// FindDuplicateTransactions pairs up transactions with the same account, amount, and currency
+// that are within the time window, scored by how close in time and how similar their notes are.
+func (uc *transactionUseCase) FindDuplicateTransactions(
+	ctx context.Context,
+	req *FindDuplicateTransactionsRequest,
+) (*FindDuplicateTransactionsResponse, error) {
+	ts, err := uc.transactionRepo.GetMany(ctx, req.ToTransactionQuery())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get transactions from repo, err: %v", err)
+		return nil, err
+	}
 
-	if t.GetCurrency() == currency {
-		return amount, nil
+	// transactions are sorted by time, so each group is too
+	groups := make(map[string][]*entity.Transaction)
+	for _, t := range ts {
+		key := fmt.Sprintf("%d|%s|%s|%s|%s|%v", t.GetTransactionType(), t.GetAccountID(),
+			t.GetFromAccountID(), t.GetToAccountID(), t.GetCurrency(), t.GetAmount())
+		groups[key] = append(groups[key], t)
 	}
 
-	erf := repo.NewExchangeRateFilter(
-		repo.WithExchangeRateFrom(t.Currency),
-		repo.WithExchangeRateTo(goutil.String(currency)),
-		repo.WithExchangeRateTimestamp(t.TransactionTime),
-	)
+	window := req.GetWindow()
 
-	er, err := uc.exchangeRateRepo.Get(ctx, erf)
-	if err != nil {
-		return 0, err
+	dts := make([]*DuplicateTransaction, 0)
+	for _, group := range groups {
+		for i, t := range group {
+			for _, d := range group[i+1:] {
+				gap := d.GetTransactionTime() - t.GetTransactionTime()
+				if gap > window {
+					break
+				}
+
+				// statement lines with different references are distinct
+				if t.GetExternalID() != "" && d.GetExternalID() != "" {
+					continue
+				}
+
+				confidence := getDuplicateConfidence(t, d, gap, window)
+				if confidence < req.GetMinConfidence() {
+					continue
+				}
+
+				dts = append(dts, &DuplicateTransaction{
+					Transaction: t,
+					Duplicate:   d,
+					Confidence:  goutil.Float64(confidence),
+				})
+			}
+		}
 	}
 
-	amount *= er.GetRate()
+	sort.SliceStable(dts, func(i, j int) bool {
+		if dts[i].GetConfidence() != dts[j].GetConfidence() {
+			return dts[i].GetConfidence() > dts[j].GetConfidence()
+		}
+		return dts[i].Transaction.GetTransactionTime() > dts[j].Transaction.GetTransactionTime()
+	})
 
-	return amount, nil
+	return &FindDuplicateTransactionsResponse{
+		Duplicates: dts,
+	}, nil
 }
 
-func (uc *transactionUseCase) updateAccountBalance(ctx context.Context, t *entity.Transaction, ac *entity.Account, add bool) error {
-	// make currency conversion if necessary
-	amount, err := uc.getAmountAfterConversion(ctx, t, ac.GetCurrency())
+// MergeTransactions keeps one transaction and deletes its duplicate, reversing the balance effect
+// of the duplicate. The kept transaction inherits the external reference and tags of the duplicate.
+func (uc *transactionUseCase) MergeTransactions(ctx context.Context, req *MergeTransactionsRequest) (*MergeTransactionsResponse, error) {
+	if req.GetTransactionID() == req.GetDuplicateTransactionID() {
+		return nil, ErrMergeSameTransaction
+	}
+
+	tf := req.ToTransactionFilter(req.GetTransactionID())
+
+	t, err := uc.transactionRepo.Get(ctx, tf)
 	if err != nil {
-		return err
+		log.Ctx(ctx).Error().Msgf("fail to get transaction from repo, err: %v", err)
+		return nil, err
 	}
 
-	var newBalance float64
-	if add {
-		newBalance = ac.GetBalance() + amount
-	} else {
-		newBalance = ac.GetBalance() - amount
+	d, err := uc.transactionRepo.Get(ctx, req.ToTransactionFilter(req.GetDuplicateTransactionID()))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get duplicate transaction from repo, err: %v", err)
+		return nil, err
 	}
 
-	nac, err := ac.Update(
-		entity.WithUpdateAccountBalance(goutil.Float64(newBalance)),
+	externalID := t.ExternalID
+	if t.GetExternalID() == "" {
+		externalID = d.ExternalID
+	}
+
+	tu, err := t.Update(
+		entity.WithUpdateTransactionExternalID(externalID),
+		entity.WithUpdateTransactionTagIDs(append(t.GetTagIDs(), d.GetTagIDs()...)),
 	)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if nac != nil {
-		if err := uc.accountRepo.Update(ctx, repo.NewAccountFilter(
-			ac.GetUserID(),
-			repo.WithAccountID(ac.AccountID),
-		), nac); err != nil {
+	if tu != nil && t.IsReconciled() {
+		return nil, ErrReconciledLocked
+	}
+
+	// all or nothing, DeleteTransaction joins the outer transaction
+	if err := uc.txMgr.WithTx(ctx, func(txCtx context.Context) error {
+		if tu != nil {
+			if err := uc.transactionRepo.Update(txCtx, tf, tu); err != nil {
+				log.Ctx(txCtx).Error().Msgf("fail to save transaction updates to repo, err: %v", err)
+				return err
+			}
+		}
+
+		if _, err := uc.DeleteTransaction(txCtx, &DeleteTransactionRequest{
+			UserID:        req.UserID,
+			TransactionID: req.DuplicateTransactionID,
+		}); err != nil {
+			log.Ctx(txCtx).Error().Msgf("fail to delete duplicate transaction, err: %v", err)
 			return err
 		}
+
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	return nil
+	return &MergeTransactionsResponse{
+		Transaction: t,
+	}, nil
+}
+
+// SearchTransactions finds transactions whose note has every word of the keyword,
+// each word matching a whole word or the start of one, ignoring case.
+func (uc *transactionUseCase) SearchTransactions(ctx context.Context, req *SearchTransactionsRequest) (*SearchTransactionsResponse, error) {
+	keywords := util.GetWords(req.GetKeyword())
+	if len(keywords) == 0 {
+		return nil, ErrEmptySearchKeyword
+	}
+
+	res, err := uc.GetTransactions(ctx, req.ToGetTransactionsRequest(goutil.RemoveDuplicateString(keywords)))
+	if err != nil {
+		return nil, err
+	}
+
+	return &SearchTransactionsResponse{
+		Transactions: res.Transactions,
+		Paging:       res.Paging,
+	}, nil
+}
+
+// BulkUpdateTransactions recategorises, moves or retags the selected transactions.
+// All transactions are updated in one transaction, so either all or none are saved.
+func (uc *transactionUseCase) BulkUpdateTransactions(ctx context.Context, req *BulkUpdateTransactionsRequest) (*BulkUpdateTransactionsResponse, error) {
+	if req.CategoryID == nil && req.AccountID == nil && req.TagIDs == nil {
+		return nil, ErrNoBulkUpdate
+	}
+
+	ts, err := uc.getBulkTransactions(ctx, req.GetUserID(), req.GetSelection())
+	if err != nil {
+		return nil, err
+	}
+
+	var ac *entity.Account
+	if req.AccountID != nil {
+		ac, err = uc.accountRepo.Get(ctx, req.ToAccountFilter())
+		if err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to get account from repo, account_id: %v, err: %v",
+				req.GetAccountID(), err)
+			return nil, err
+		}
+	}
+
+	for _, t := range ts {
+		if t.IsTransfer() && (req.CategoryID != nil || req.AccountID != nil) {
+			return nil, ErrBulkUpdateTransfer
+		}
+
+		if t.IsSplit() && req.CategoryID != nil {
+			return nil, ErrBulkUpdateSplit
+		}
+
+		if ac != nil {
+			if err := t.CanTransactionUnderAccount(ac); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	nts := make([]*entity.Transaction, 0, len(ts))
+	if err := uc.txMgr.WithTx(ctx, func(txCtx context.Context) error {
+		for _, t := range ts {
+			// balances are read in the transaction, so earlier updates are accounted for
+			res, err := uc.UpdateTransaction(txCtx, req.ToUpdateTransactionRequest(t.GetTransactionID()))
+			if err != nil {
+				return err
+			}
+			nts = append(nts, res.Transaction)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &BulkUpdateTransactionsResponse{
+		Transactions: nts,
+	}, nil
+}
+
+// BulkDeleteTransactions deletes the selected transactions and reverts their balance effects.
+// All transactions are deleted in one transaction, so either all or none are deleted.
+func (uc *transactionUseCase) BulkDeleteTransactions(ctx context.Context, req *BulkDeleteTransactionsRequest) (*BulkDeleteTransactionsResponse, error) {
+	ts, err := uc.getBulkTransactions(ctx, req.GetUserID(), req.GetSelection())
+	if err != nil {
+		return nil, err
+	}
+
+	selected := make(map[string]bool)
+	for _, t := range ts {
+		selected[t.GetTransactionID()] = true
+	}
+
+	if err := uc.txMgr.WithTx(ctx, func(txCtx context.Context) error {
+		for _, t := range ts {
+			// deleted with its repayment
+			if selected[t.GetRepaymentTransactionID()] {
+				continue
+			}
+
+			if _, err := uc.DeleteTransaction(txCtx, req.ToDeleteTransactionRequest(t.GetTransactionID())); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &BulkDeleteTransactionsResponse{
+		Deleted: goutil.Uint32(uint32(len(ts))),
+	}, nil
+}
+
+func (uc *transactionUseCase) GetDeletedTransactions(ctx context.Context, req *GetDeletedTransactionsRequest) (*GetDeletedTransactionsResponse, error) {
+	ts, err := uc.transactionRepo.GetMany(ctx, req.ToTransactionQuery())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get deleted transactions from repo, err: %v", err)
+		return nil, err
+	}
+
+	accountIDs := make([]string, 0)
+	for _, t := range ts {
+		if t.IsTransfer() {
+			accountIDs = append(accountIDs, t.GetFromAccountID(), t.GetToAccountID())
+		} else {
+			accountIDs = append(accountIDs, t.GetAccountID())
+		}
+	}
+
+	var acs []*entity.Account
+	if len(accountIDs) > 0 {
+		acs, err = uc.accountRepo.GetMany(ctx, req.ToAccountFilter(goutil.RemoveDuplicateString(accountIDs)))
+		if err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to get accounts from repo, err: %v", err)
+			return nil, err
+		}
+	}
+	acsMap := make(map[string]*entity.Account)
+	for _, ac := range acs {
+		acsMap[ac.GetAccountID()] = ac
+	}
+
+	for _, t := range ts {
+		if t.IsTransfer() {
+			t.SetFromAccount(acsMap[t.GetFromAccountID()])
+			t.SetToAccount(acsMap[t.GetToAccountID()])
+		} else {
+			t.SetAccount(acsMap[t.GetAccountID()])
+		}
+	}
+
+	return &GetDeletedTransactionsResponse{
+		Transactions: ts,
+		Paging:       req.Paging,
+	}, nil
+}
+
+// RestoreTransaction flips a deleted transaction back to normal and re-applies its balance effects.
+// The accounts and categories of the transaction must not be deleted.
+func (uc *transactionUseCase) RestoreTransaction(ctx context.Context, req *RestoreTransactionRequest) (*RestoreTransactionResponse, error) {
+	tf := req.ToTransactionFilter()
+
+	t, err := uc.transactionRepo.Get(ctx, tf)
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get deleted transaction from repo, err: %v", err)
+		return nil, err
+	}
+
+	// left: to minus amount, right: to plus amount
+	acs := make(map[*entity.Account]*entity.Account)
+
+	if t.IsTransfer() {
+		fromAc, err := uc.accountRepo.Get(ctx, req.ToAccountFilter(t.GetFromAccountID()))
+		if err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to get from_account from repo, account_id: %v, err: %v",
+				t.GetFromAccountID(), err)
+			return nil, err
+		}
+		t.SetFromAccount(fromAc)
+
+		toAc, err := uc.accountRepo.Get(ctx, req.ToAccountFilter(t.GetToAccountID()))
+		if err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to get to_account from repo, account_id: %v, err: %v",
+				t.GetToAccountID(), err)
+			return nil, err
+		}
+		t.SetToAccount(toAc)
+
+		acs[fromAc] = toAc
+	} else {
+		ac, err := uc.accountRepo.Get(ctx, req.ToAccountFilter(t.GetAccountID()))
+		if err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to get account from repo, account_id: %v, err: %v",
+				t.GetAccountID(), err)
+			return nil, err
+		}
+		t.SetAccount(ac)
+
+		acs[nil] = ac
+
+		if err := uc.checkRestoreCategories(ctx, req, t); err != nil {
+			return nil, err
+		}
+	}
+
+	deleteTime := t.GetUpdateTime()
+
+	if err := uc.txMgr.WithTx(ctx, func(txCtx context.Context) error {
+		tu, err := t.Update(
+			entity.WithUpdateTransactionStatus(goutil.Uint32(uint32(entity.TransactionStatusNormal))),
+		)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.transactionRepo.Update(txCtx, tf, tu); err != nil {
+			log.Ctx(txCtx).Error().Msgf("fail to save transaction updates to repo, err: %v", err)
+			return err
+		}
+
+		if t.GetAmount() == 0 {
+			return nil
+		}
+
+		for minusAc, addAc := range acs {
+			if minusAc != nil {
+				if err := uc.updateAccountBalance(txCtx, t, minusAc, false); err != nil {
+					log.Ctx(txCtx).Error().Msgf("fail to update account balance, err: %v", err)
+					return err
+				}
+			}
+
+			if addAc != nil {
+				if err := uc.updateAccountBalance(txCtx, t, addAc, true); err != nil {
+					log.Ctx(txCtx).Error().Msgf("fail to update account balance, err: %v", err)
+					return err
+				}
+			}
+		}
+
+		if t.IsTransfer() {
+			if err := uc.restoreInterestTransaction(txCtx, t, deleteTime); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &RestoreTransactionResponse{
+		Transaction: t,
+	}, nil
+}
+
+// ReconcileAccount compares the closing balance of a statement with the cleared balance of the account,
+// which is the account balance less pending transactions and transactions after the statement time.
+// If both agree, the cleared transactions up to the statement time are reconciled and locked.
+func (uc *transactionUseCase) ReconcileAccount(ctx context.Context, req *ReconcileAccountRequest) (*ReconcileAccountResponse, error) {
+	ac, err := uc.accountRepo.Get(ctx, req.ToAccountFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get account from repo, err: %v", err)
+		return nil, err
+	}
+
+	ts, err := uc.transactionRepo.GetMany(ctx, req.ToTransactionQuery())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get transactions from repo, err: %v", err)
+		return nil, err
+	}
+
+	var (
+		clearedBalance = ac.GetBalance()
+		cleared        = make([]*entity.Transaction, 0)
+	)
+	for _, t := range ts {
+		if t.IsCleared() && t.GetTransactionTime() <= req.GetStatementTime() {
+			if !t.IsReconciled() {
+				cleared = append(cleared, t)
+			}
+			continue
+		}
+
+		amount, err := uc.getAccountAmount(ctx, t, ac)
+		if err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to get account amount, err: %v", err)
+			return nil, err
+		}
+
+		// the from_account of a transfer is debited
+		if t.IsTransfer() && t.GetFromAccountID() == ac.GetAccountID() {
+			amount = -amount
+		}
+		clearedBalance -= amount
+	}
+
+	clearedBalance = util.RoundFloatToStandardDP(clearedBalance)
+
+	res := &ReconcileAccountResponse{
+		ClearedBalance: goutil.Float64(clearedBalance),
+		Difference:     goutil.Float64(util.RoundFloatToStandardDP(req.GetClosingBalance() - clearedBalance)),
+		Reconciled:     goutil.Uint32(0),
+	}
+
+	if !res.IsReconciled() {
+		return res, nil
+	}
+
+	if err := uc.txMgr.WithTx(ctx, func(txCtx context.Context) error {
+		for _, t := range cleared {
+			tu, err := t.Update(
+				entity.WithUpdateTransactionClearStatus(goutil.Uint32(uint32(entity.ClearStatusReconciled))),
+			)
+			if err != nil {
+				return err
+			}
+
+			if err := uc.transactionRepo.Update(txCtx, req.ToTransactionFilter(t.GetTransactionID()), tu); err != nil {
+				log.Ctx(txCtx).Error().Msgf("fail to save transaction updates to repo, err: %v", err)
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	res.Reconciled = goutil.Uint32(uint32(len(cleared)))
+
+	return res, nil
+}
+
+func (uc *transactionUseCase) GetCreditCardCycle(ctx context.Context, req *GetCreditCardCycleRequest) (*GetCreditCardCycleResponse, error) {
+	loc, err := time.LoadLocation(req.GetAppMeta().GetTimezone())
+	if err != nil {
+		return nil, entity.ErrInvalidTimezone
+	}
+
+	ac, err := uc.accountRepo.Get(ctx, req.ToAccountFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get account from repo, err: %v", err)
+		return nil, err
+	}
+
+	if !ac.IsCreditCard() {
+		return nil, ErrNotCreditCard
+	}
+
+	cycle := ac.GetStatementCycle(time.Now().In(loc))
+	if cycle == nil {
+		return nil, ErrNoStatementCycle
+	}
+
+	ts, err := uc.transactionRepo.GetMany(ctx, req.ToTransactionQuery(uint64(cycle.Start.UnixMilli())))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get transactions from repo, err: %v", err)
+		return nil, err
+	}
+
+	var sinceStatement, cycleSpend, payments float64
+	for _, t := range ts {
+		amount, err := uc.getAccountAmount(ctx, t, ac)
+		if err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to get account amount, err: %v", err)
+			return nil, err
+		}
+
+		switch {
+		case t.IsTransfer() && t.GetFromAccountID() == ac.GetAccountID():
+			amount = -amount
+		case t.IsTransfer():
+			payments += amount
+		case t.IsExpense(), t.IsRefund():
+			cycleSpend -= amount
+		}
+		sinceStatement += amount
+	}
+
+	// debt balances are negative, so the amount owed is the negated balance
+	statementBalance := math.Max(0, -(ac.GetBalance() - sinceStatement))
+
+	minimumPayment := statementBalance * config.DefaultMinPaymentPercent / 100
+
+	res := &GetCreditCardCycleResponse{
+		Account:          ac,
+		CycleStart:       goutil.Uint64(uint64(cycle.Start.UnixMilli())),
+		CycleEnd:         goutil.Uint64(uint64(cycle.End.UnixMilli())),
+		DueTime:          goutil.Uint64(uint64(cycle.DueDate.UnixMilli())),
+		CycleSpend:       goutil.Float64(util.RoundFloatToStandardDP(cycleSpend)),
+		StatementBalance: goutil.Float64(util.RoundFloatToStandardDP(statementBalance)),
+		Payments:         goutil.Float64(util.RoundFloatToStandardDP(payments)),
+		MinimumPayment:   goutil.Float64(util.RoundFloatToStandardDP(minimumPayment)),
+		AmountDue:        goutil.Float64(util.RoundFloatToStandardDP(math.Max(0, statementBalance-payments))),
+	}
+
+	if ac.GetCreditLimit() > 0 {
+		utilisation := math.Max(0, -ac.GetBalance()) / ac.GetCreditLimit() * 100
+		res.Utilisation = goutil.Float64(util.RoundFloatToStandardDP(utilisation))
+	}
+
+	return res, nil
+}
+
+// GetAccountBalanceHistory reconstructs the balance of an account at the end of each unit by walking
+// its transactions backwards from the current balance.
+func (uc *transactionUseCase) GetAccountBalanceHistory(ctx context.Context, req *GetAccountBalanceHistoryRequest) (*GetAccountBalanceHistoryResponse, error) {
+	loc, err := time.LoadLocation(req.GetAppMeta().GetTimezone())
+	if err != nil {
+		return nil, entity.ErrInvalidTimezone
+	}
+
+	ac, err := uc.accountRepo.Get(ctx, req.ToAccountFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get account from repo, err: %v", err)
+		return nil, err
+	}
+
+	if ac.IsInvestment() {
+		return nil, ErrNoBalanceHistory
+	}
+
+	var (
+		now                          = time.Now().In(loc)
+		date                         time.Time
+		yearDiff, monthDiff, dayDiff int
+	)
+	switch req.GetUnit() {
+	case uint32(entity.SnapshotUnitMonth):
+		date = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()) // start of month
+		monthDiff = -1
+	case uint32(entity.SnapshotUnitDay):
+		date = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()) // start of day
+		dayDiff = -1
+	default:
+		return nil, entity.ErrInvalidSnapshotUnit
+	}
+
+	// start of each unit, latest first
+	dates := []time.Time{date}
+	for i := req.GetInterval(); i > 0; i-- {
+		date = date.AddDate(yearDiff, monthDiff, dayDiff)
+		dates = append(dates, date)
+	}
+
+	ts, err := uc.transactionRepo.GetMany(ctx, req.ToTransactionQuery(uint64(date.UnixMilli())))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get transactions from repo, err: %v", err)
+		return nil, err
+	}
+
+	var (
+		balance  = ac.GetBalance()
+		end      = dates[0].AddDate(-yearDiff, -monthDiff, -dayDiff) // end of the latest unit
+		balances = make([]*common.Summary, len(dates))
+		idx      int
+	)
+	for i, d := range dates {
+		// undo every transaction made on or after the end of the unit
+		for ; idx < len(ts) && ts[idx].GetTransactionTime() >= uint64(end.UnixMilli()); idx++ {
+			amount, err := uc.getAccountAmount(ctx, ts[idx], ac)
+			if err != nil {
+				log.Ctx(ctx).Error().Msgf("fail to get account amount, err: %v", err)
+				return nil, err
+			}
+
+			if ts[idx].IsTransfer() && ts[idx].GetFromAccountID() == ac.GetAccountID() {
+				amount = -amount
+			}
+			balance -= amount
+		}
+
+		balances[len(dates)-1-i] = common.NewSummary(
+			common.WithSummaryDate(goutil.String(util.FormatDate(d))),
+			common.WithSummarySum(goutil.Float64(util.RoundFloatToStandardDP(balance))),
+			common.WithSummaryCurrency(ac.Currency),
+		)
+
+		end = d
+	}
+
+	return &GetAccountBalanceHistoryResponse{
+		Account:  ac,
+		Balances: balances,
+	}, nil
+}
+
+// newInterestTransaction splits the interest out of a repayment to a loan. The interest accrues on the
+// outstanding balance before the repayment since the previous repayment, recorded as an expense on the
+// loan, so the repayment only reduces the principal by what is left.
+func (uc *transactionUseCase) newInterestTransaction(ctx context.Context, req *CreateTransactionRequest, t *entity.Transaction, loanAc *entity.Account) (*entity.Transaction, error) {
+	if !loanAc.IsLoan() {
+		return nil, ErrNotLoanRepayment
+	}
+
+	c, err := uc.categoryRepo.Get(ctx, req.ToInterestCategoryFilter())
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get interest category from repo, category_id: %v, err: %v",
+			req.GetInterestCategoryID(), err)
+		return nil, err
+	}
+
+	interest, err := uc.getLoanInterest(ctx, t, loanAc, loanAc.GetBalance())
+	if err != nil {
+		return nil, err
+	}
+
+	if interest <= 0 {
+		return nil, nil
+	}
+
+	interestT, err := entity.NewTransaction(
+		req.GetUserID(),
+		entity.WithTransactionType(goutil.Uint32(uint32(entity.TransactionTypeExpense))),
+		entity.WithTransactionAccountID(loanAc.AccountID),
+		entity.WithTransactionCategoryID(c.CategoryID),
+		entity.WithTransactionAmount(goutil.Float64(interest)),
+		entity.WithTransactionCurrency(loanAc.Currency),
+		entity.WithTransactionTime(t.TransactionTime),
+		entity.WithTransactionClearStatus(t.ClearStatus),
+		entity.WithTransactionNote(goutil.String("Interest")),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := interestT.CanTransactionUnderCategory(c); err != nil {
+		return nil, err
+	}
+	interestT.SetCategory(c)
+	interestT.SetAccount(loanAc)
+
+	return interestT, nil
+}
+
+// getLoanInterest accrues the interest of a repayment daily on the loan balance before it, from the previous
+// repayment to the loan, or from when the loan is opened if there is none. The interest is capped at the repayment.
+func (uc *transactionUseCase) getLoanInterest(ctx context.Context, t *entity.Transaction, loanAc *entity.Account, balance float64) (float64, error) {
+	repayment, err := uc.getAccountAmount(ctx, t, loanAc)
+	if err != nil {
+		return 0, err
+	}
+
+	ts, err := uc.transactionRepo.GetMany(ctx, &repo.TransactionQuery{
+		Filters: []*repo.TransactionFilter{
+			repo.NewTransactionFilter(
+				t.GetUserID(),
+				repo.WithTransactionToAccountID(loanAc.AccountID),
+				repo.WithTransactionTimeLte(t.TransactionTime),
+			),
+		},
+		Paging: &repo.Paging{
+			Limit: goutil.Uint32(2), // the repayment itself may be included
+			Sorts: []filter.Sort{
+				&repo.Sort{
+					Field: goutil.String("transaction_time"),
+					Order: goutil.String(config.OrderDesc),
+				},
+			},
+		},
+	})
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get previous repayments from repo, err: %v", err)
+		return 0, err
+	}
+
+	from := loanAc.GetStartTime()
+	if from == 0 {
+		from = loanAc.GetCreateTime()
+	}
+	for _, pt := range ts {
+		if pt.GetTransactionID() != t.GetTransactionID() {
+			from = pt.GetTransactionTime()
+			break
+		}
+	}
+
+	var days float64
+	if t.GetTransactionTime() > from {
+		days = float64(t.GetTransactionTime()-from) / float64(24*time.Hour/time.Millisecond)
+	}
+
+	return math.Min(loanAc.GetLoanInterest(balance, days), repayment), nil
+}
+
+// getInterestTransaction gets the interest split from a loan repayment, if any.
+func (uc *transactionUseCase) getInterestTransaction(ctx context.Context, t *entity.Transaction) (*entity.Transaction, error) {
+	interestT, err := uc.transactionRepo.Get(ctx, repo.NewTransactionFilter(
+		t.GetUserID(),
+		repo.WithTransactionRepaymentTransactionID(t.TransactionID),
+	))
+	if err != nil {
+		if err == repo.ErrTransactionNotFound {
+			return nil, nil
+		}
+		log.Ctx(ctx).Error().Msgf("fail to get interest transaction from repo, err: %v", err)
+		return nil, err
+	}
+
+	if interestT.IsReconciled() {
+		return nil, ErrReconciledLocked
+	}
+
+	return interestT, nil
+}
+
+// getInterestAccount gets the loan of an interest transaction, nil if the loan is deleted.
+func (uc *transactionUseCase) getInterestAccount(ctx context.Context, interestT *entity.Transaction) (*entity.Account, error) {
+	ac, err := uc.accountRepo.Get(ctx, repo.NewAccountFilter(
+		interestT.GetUserID(),
+		repo.WithAccountID(interestT.AccountID),
+	))
+	if err != nil {
+		if err == repo.ErrAccountNotFound {
+			return nil, nil
+		}
+		log.Ctx(ctx).Error().Msgf("fail to get loan account from repo, err: %v", err)
+		return nil, err
+	}
+
+	return ac, nil
+}
+
+// deleteInterestTransaction deletes the interest split from a loan repayment, if any,
+// and reverts it from the loan balance.
+func (uc *transactionUseCase) deleteInterestTransaction(ctx context.Context, t *entity.Transaction) error {
+	interestT, err := uc.getInterestTransaction(ctx, t)
+	if err != nil || interestT == nil {
+		return err
+	}
+
+	ac, err := uc.getInterestAccount(ctx, interestT)
+	if err != nil {
+		return err
+	}
+
+	if ac != nil {
+		if err := uc.updateAccountBalance(ctx, interestT, ac, false); err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to update account balance, err: %v", err)
+			return err
+		}
+	}
+
+	return uc.setInterestTransactionStatus(ctx, interestT, entity.TransactionStatusDeleted)
+}
+
+// updateInterestTransaction recomputes the interest split from a loan repayment after the repayment
+// is updated. The interest is deleted if the transaction no longer repays the same loan.
+func (uc *transactionUseCase) updateInterestTransaction(ctx context.Context, t *entity.Transaction) error {
+	interestT, err := uc.getInterestTransaction(ctx, t)
+	if err != nil || interestT == nil {
+		return err
+	}
+
+	ac, err := uc.getInterestAccount(ctx, interestT)
+	if err != nil {
+		return err
+	}
+
+	if ac == nil || !t.IsTransfer() || t.GetToAccountID() != interestT.GetAccountID() {
+		return uc.deleteInterestTransaction(ctx, t)
+	}
+
+	// revert the old interest, the balance then includes the updated repayment
+	if err := uc.updateAccountBalance(ctx, interestT, ac, false); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to update account balance, err: %v", err)
+		return err
+	}
+
+	repayment, err := uc.getAccountAmount(ctx, t, ac)
+	if err != nil {
+		return err
+	}
+
+	interest, err := uc.getLoanInterest(ctx, t, ac, ac.GetBalance()-repayment)
+	if err != nil {
+		return err
+	}
+
+	if interest <= 0 {
+		return uc.setInterestTransactionStatus(ctx, interestT, entity.TransactionStatusDeleted)
+	}
+
+	tu, err := interestT.Update(
+		entity.WithUpdateTransactionAmount(goutil.Float64(interest)),
+		entity.WithUpdateTransactionTime(t.TransactionTime),
+		entity.WithUpdateTransactionClearStatus(t.ClearStatus),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.transactionRepo.Update(ctx, repo.NewTransactionFilter(
+		interestT.GetUserID(),
+		repo.WithTransactionID(interestT.TransactionID),
+	), tu); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to save interest transaction updates to repo, err: %v", err)
+		return err
+	}
+
+	if err := uc.updateAccountBalance(ctx, interestT, ac, true); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to update account balance, err: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// restoreInterestTransaction restores the interest deleted together with a loan repayment, if any,
+// and re-applies it to the loan balance. Interest deleted before the repayment stays deleted.
+func (uc *transactionUseCase) restoreInterestTransaction(ctx context.Context, t *entity.Transaction, deleteTime uint64) error {
+	interestT, err := uc.transactionRepo.Get(ctx, repo.NewTransactionFilter(
+		t.GetUserID(),
+		repo.WithTransactionRepaymentTransactionID(t.TransactionID),
+		repo.WithTransactionStatus(goutil.Uint32(uint32(entity.TransactionStatusDeleted))),
+	))
+	if err != nil {
+		if err == repo.ErrTransactionNotFound {
+			return nil
+		}
+		log.Ctx(ctx).Error().Msgf("fail to get deleted interest transaction from repo, err: %v", err)
+		return err
+	}
+
+	if interestT.GetUpdateTime() < deleteTime {
+		return nil
+	}
+
+	ac, err := uc.getInterestAccount(ctx, interestT)
+	if err != nil || ac == nil {
+		return err
+	}
+
+	if err := uc.setInterestTransactionStatus(ctx, interestT, entity.TransactionStatusNormal); err != nil {
+		return err
+	}
+
+	if err := uc.updateAccountBalance(ctx, interestT, ac, true); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to update account balance, err: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (uc *transactionUseCase) setInterestTransactionStatus(ctx context.Context, interestT *entity.Transaction, status entity.TransactionStatus) error {
+	tu, err := interestT.Update(
+		entity.WithUpdateTransactionStatus(goutil.Uint32(uint32(status))),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.transactionRepo.Update(ctx, repo.NewTransactionFilter(
+		interestT.GetUserID(),
+		repo.WithTransactionID(interestT.TransactionID),
+		repo.WithTransactionStatus(nil), // any status is ok
+	), tu); err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to save interest transaction updates to repo, err: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// checkSplitCategories checks that every split is under a valid category of the same transaction type.
+func (uc *transactionUseCase) checkSplitCategories(ctx context.Context, userID string, t *entity.Transaction) error {
+	categoryIDs := goutil.RemoveDuplicateString(t.GetSplitCategoryIDs())
+
+	cs, err := uc.categoryRepo.GetMany(ctx, repo.NewCategoryFilter(
+		userID,
+		repo.WithCategoryIDs(categoryIDs),
+	))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get split categories from repo, err: %v", err)
+		return err
+	}
+
+	if len(cs) != len(categoryIDs) {
+		return repo.ErrCategoryNotFound
+	}
+
+	for _, c := range cs {
+		if err := t.CanTransactionUnderCategory(c); err != nil {
+			return err
+		}
+	}
+
+	setSplitCategories(t, cs)
+
+	return nil
+}
+
+// checkRestoreCategories checks that the category, or every split category, of a deleted
+// transaction is still valid for it.
+func (uc *transactionUseCase) checkRestoreCategories(ctx context.Context, req *RestoreTransactionRequest, t *entity.Transaction) error {
+	if t.IsSplit() {
+		if err := uc.checkSplitCategories(ctx, req.GetUserID(), t); err != nil {
+			if err == repo.ErrCategoryNotFound {
+				return ErrRestoreCategory
+			}
+			return err
+		}
+		return nil
+	}
+
+	if t.GetCategoryID() == "" {
+		return nil
+	}
+
+	c, err := uc.categoryRepo.Get(ctx, req.ToCategoryFilter(t.GetCategoryID()))
+	if err != nil {
+		if err == repo.ErrCategoryNotFound {
+			return ErrRestoreCategory
+		}
+		log.Ctx(ctx).Error().Msgf("fail to get category from repo, category_id: %v, err: %v",
+			t.GetCategoryID(), err)
+		return err
+	}
+
+	if err := t.CanTransactionUnderCategory(c); err != nil {
+		return err
+	}
+	t.SetCategory(c)
+
+	return nil
+}
+
+// checkRefund checks a refund against the transaction it refunds. A refund takes the category
+// of the refunded transaction if it has none, and all refunds under a category cannot exceed
+// the refunded amount under it, which is the split amount for a split transaction.
+func (uc *transactionUseCase) checkRefund(ctx context.Context, userID string, t *entity.Transaction) error {
+	original, err := uc.transactionRepo.Get(ctx, repo.NewTransactionFilter(
+		userID,
+		repo.WithTransactionID(t.RefundedTransactionID),
+	))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get refunded transaction from repo, transaction_id: %v, err: %v",
+			t.GetRefundedTransactionID(), err)
+		return err
+	}
+
+	if err := t.CanRefund(original); err != nil {
+		return err
+	}
+
+	categoryAmounts := original.GetCategoryAmounts()
+	if t.GetCategoryID() == "" && len(categoryAmounts) == 1 {
+		t.SetCategoryID(goutil.String(original.GetCategoryID()))
+	}
+
+	if _, ok := categoryAmounts[t.GetCategoryID()]; !ok {
+		return ErrRefundCategory
+	}
+
+	refunds, err := uc.transactionRepo.GetMany(ctx, &repo.TransactionQuery{
+		Filters: []*repo.TransactionFilter{
+			repo.NewTransactionFilter(
+				userID,
+				repo.WithTransactionRefundedTransactionID(original.TransactionID),
+			),
+		},
+	})
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get refunds from repo, err: %v", err)
+		return err
+	}
+
+	refunded := t.GetAmount()
+	for _, refund := range refunds {
+		if refund.GetTransactionID() != t.GetTransactionID() && refund.GetCategoryID() == t.GetCategoryID() {
+			refunded += refund.GetAmount()
+		}
+	}
+
+	if util.RoundFloatToStandardDP(refunded) > math.Abs(categoryAmounts[t.GetCategoryID()]) {
+		return ErrRefundExceedsAmount
+	}
+
+	if t.GetCategoryID() != "" {
+		c, err := uc.categoryRepo.Get(ctx, repo.NewCategoryFilter(
+			userID,
+			repo.WithCategoryID(t.CategoryID),
+		))
+		if err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to get category from repo, category_id: %v, err: %v",
+				t.GetCategoryID(), err)
+			return err
+		}
+
+		if err := t.CanTransactionUnderCategory(c); err != nil {
+			return err
+		}
+		t.SetCategory(c)
+	}
+
+	return nil
+}
+
+func setSplitCategories(t *entity.Transaction, cs []*entity.Category) {
+	csMap := make(map[string]*entity.Category)
+	for _, c := range cs {
+		csMap[c.GetCategoryID()] = c
+	}
+
+	for _, split := range t.GetSplits() {
+		// hide deleted category
+		if c, ok := csMap[split.GetCategoryID()]; ok && !c.IsDeleted() {
+			split.SetCategory(c)
+		}
+	}
+}
+
+// matchRuleCategory returns the category of the first rule matching the transaction.
+func (uc *transactionUseCase) matchRuleCategory(ctx context.Context, userID string, t *entity.Transaction) (*entity.Category, error) {
+	res, err := uc.ruleUseCase.GetRules(ctx, &rule.GetRulesRequest{
+		UserID: goutil.String(userID),
+	})
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get rules, err: %v", err)
+		return nil, err
+	}
+
+	r := entity.MatchRules(res.Rules, t)
+	if r == nil {
+		return nil, ErrNoMatchingRule
+	}
+
+	return r.GetCategory(), nil
+}
+
+func (uc *transactionUseCase) checkTags(ctx context.Context, userID string, t *entity.Transaction) error {
+	tags, err := uc.tagRepo.GetMany(ctx, repo.NewTagFilter(
+		userID,
+		repo.WithTagIDs(t.GetTagIDs()),
+	))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get tags from repo, err: %v", err)
+		return err
+	}
+
+	if len(tags) != len(t.GetTagIDs()) {
+		return repo.ErrTagNotFound
+	}
+
+	t.SetTags(tags)
+
+	return nil
+}
+
+func (uc *transactionUseCase) checkPayee(ctx context.Context, userID string, t *entity.Transaction) error {
+	p, err := uc.payeeRepo.Get(ctx, repo.NewPayeeFilter(
+		userID,
+		repo.WithPayeeID(t.PayeeID),
+	))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get payee from repo, payee_id: %v, err: %v", t.GetPayeeID(), err)
+		return err
+	}
+
+	t.SetPayee(p)
+
+	return nil
+}
+
+// matchPayee sets the payee whose name or alias is found in the note, if any.
+func (uc *transactionUseCase) matchPayee(ctx context.Context, userID string, t *entity.Transaction) error {
+	if t.IsTransfer() || t.GetNote() == "" {
+		return nil
+	}
+
+	ps, err := uc.payeeRepo.GetMany(ctx, repo.NewPayeeFilter(userID))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get payees from repo, err: %v", err)
+		return err
+	}
+
+	if p := entity.MatchPayees(ps, t.GetNote()); p != nil {
+		t.SetPayeeID(p.PayeeID)
+		t.SetPayee(p)
+	}
+
+	return nil
+}
+
+// setPayees hides deleted payees, which are not found.
+func setPayees(ts []*entity.Transaction, ps []*entity.Payee) {
+	psMap := make(map[string]*entity.Payee)
+	for _, p := range ps {
+		psMap[p.GetPayeeID()] = p
+	}
+
+	for _, t := range ts {
+		if p, ok := psMap[t.GetPayeeID()]; ok {
+			t.SetPayee(p)
+		}
+	}
+}
+
+func setTags(t *entity.Transaction, tags []*entity.Tag) {
+	tagsMap := make(map[string]*entity.Tag)
+	for _, tag := range tags {
+		tagsMap[tag.GetTagID()] = tag
+	}
+
+	var (
+		tagIDs = make([]string, 0)
+		ts     = make([]*entity.Tag, 0)
+	)
+	for _, tagID := range t.GetTagIDs() {
+		// hide deleted tag
+		if tag, ok := tagsMap[tagID]; ok {
+			tagIDs = append(tagIDs, tagID)
+			ts = append(ts, tag)
+		}
+	}
+
+	t.SetTagIDs(tagIDs)
+	t.SetTags(ts)
+}
+
+func (uc *transactionUseCase) getAmountAfterConversion(ctx context.Context, t *entity.Transaction, currency string) (float64, error) {
+	return uc.convertAmount(ctx, t.GetAmount(), t.GetCurrency(), currency, t.GetTransactionTime())
+}
+
+// getAccountAmount is the amount a transaction moves in the account, in the account currency.
+// Each side of a transfer is adjusted by its own amount: the from_account is debited
+// the amount plus fee, and the to_account is credited the received amount, if recorded.
+func (uc *transactionUseCase) getAccountAmount(ctx context.Context, t *entity.Transaction, ac *entity.Account) (float64, error) {
+	if !t.IsTransfer() {
+		return uc.getAmountAfterConversion(ctx, t, ac.GetCurrency())
+	}
+
+	if ac.GetAccountID() == t.GetFromAccountID() {
+		return uc.convertAmount(ctx, t.GetSentAmount(), t.GetCurrency(), ac.GetCurrency(), t.GetTransactionTime())
+	}
+
+	if t.HasReceivedAmount() {
+		return uc.convertAmount(ctx, t.GetReceivedAmount(), t.GetReceivedCurrency(), ac.GetCurrency(), t.GetTransactionTime())
+	}
+
+	return uc.getAmountAfterConversion(ctx, t, ac.GetCurrency())
+}
+
+func (uc *transactionUseCase) convertAmount(ctx context.Context, amount float64, from, to string, timestamp uint64) (float64, error) {
+	if from == to {
+		return amount, nil
+	}
+
+	erf := repo.NewExchangeRateFilter(
+		repo.WithExchangeRateFrom(goutil.String(from)),
+		repo.WithExchangeRateTo(goutil.String(to)),
+		repo.WithExchangeRateTimestamp(goutil.Uint64(timestamp)),
+	)
+
+	er, err := uc.exchangeRateRepo.Get(ctx, erf)
+	if err != nil {
+		return 0, err
+	}
+
+	amount *= er.GetRate()
+
+	return amount, nil
+}
+
+func (uc *transactionUseCase) getBulkTransactions(ctx context.Context, userID string, sel *BulkTransactionSelection) ([]*entity.Transaction, error) {
+	transactionIDs := goutil.RemoveDuplicateString(sel.GetTransactionIDs())
+	if len(transactionIDs) == 0 && sel.GetQuery() == nil {
+		return nil, ErrNoBulkSelection
+	}
+
+	if len(transactionIDs) > config.MaxBulkTransactions {
+		return nil, ErrTooManyTransactions
+	}
+
+	ts, err := uc.transactionRepo.GetMany(ctx, sel.ToTransactionQuery(userID))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get transactions from repo, err: %v", err)
+		return nil, err
+	}
+
+	// every listed transaction must exist
+	if len(transactionIDs) > 0 && len(ts) != len(transactionIDs) {
+		return nil, repo.ErrTransactionNotFound
+	}
+
+	if len(ts) > config.MaxBulkTransactions {
+		return nil, ErrTooManyTransactions
+	}
+
+	return ts, nil
+}
+
+func (uc *transactionUseCase) updateAccountBalance(ctx context.Context, t *entity.Transaction, ac *entity.Account, add bool) error {
+	// make currency conversion if necessary
+	amount, err := uc.getAccountAmount(ctx, t, ac)
+	if err != nil {
+		return err
+	}
+
+	var newBalance float64
+	if add {
+		newBalance = ac.GetBalance() + amount
+	} else {
+		newBalance = ac.GetBalance() - amount
+	}
+
+	nac, err := ac.Update(
+		entity.WithUpdateAccountBalance(goutil.Float64(newBalance)),
+	)
+	if err != nil {
+		return err
+	}
+
+	if nac != nil {
+		if err := uc.accountRepo.Update(ctx, repo.NewAccountFilter(
+			ac.GetUserID(),
+			repo.WithAccountID(ac.AccountID),
+		), nac); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getDuplicateConfidence weighs the time gap and note similarity of two transactions equally.
+// A missing note counts as half similar.
+func getDuplicateConfidence(t, d *entity.Transaction, gap, window uint64) float64 {
+	timeScore := 1.0
+	if window > 0 {
+		timeScore = 1 - float64(gap)/float64(window)
+	}
+
+	noteScore := 0.5
+	if t.GetNote() != "" && d.GetNote() != "" {
+		noteScore = util.GetNoteSimilarity(t.GetNote(), d.GetNote())
+	}
+
+	return util.RoundFloatToStandardDP((timeScore + noteScore) / 2)
 }
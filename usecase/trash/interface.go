@@ -0,0 +1,189 @@
+package trash
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/dep/repo"
+	"github.com/jseow5177/pockteer-be/entity"
+	"github.com/jseow5177/pockteer-be/pkg/filter"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+)
+
+type UseCase interface {
+	PurgeTrash(ctx context.Context, req *PurgeTrashRequest) (*PurgeTrashResponse, error)
+}
+
+type PurgeTrashRequest struct {
+	UserID *string
+	Before *uint64 // records deleted at or before this time are purged
+}
+
+func (m *PurgeTrashRequest) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+func (m *PurgeTrashRequest) GetBefore() uint64 {
+	if m != nil && m.Before != nil {
+		return *m.Before
+	}
+	return 0
+}
+
+func (m *PurgeTrashRequest) ToTransactionFilter() *repo.TransactionFilter {
+	return repo.NewTransactionFilter(
+		m.GetUserID(),
+		repo.WithTransactionStatus(goutil.Uint32(uint32(entity.TransactionStatusDeleted))),
+		repo.WithTransactionUpdateTimeLte(m.Before),
+	)
+}
+
+func (m *PurgeTrashRequest) ToTransactionQuery() *repo.TransactionQuery {
+	return &repo.TransactionQuery{
+		Filters: []*repo.TransactionFilter{
+			m.ToTransactionFilter(),
+		},
+	}
+}
+
+func (m *PurgeTrashRequest) ToAttachmentFilter(transactionIDs []string) *repo.AttachmentFilter {
+	return repo.NewAttachmentFilter(
+		m.GetUserID(),
+		repo.WithAttachmentTransactionIDs(transactionIDs),
+	)
+}
+
+func (m *PurgeTrashRequest) ToAccountFilter() *repo.AccountFilter {
+	return repo.NewAccountFilter(
+		m.GetUserID(),
+		repo.WithAccountStatus(goutil.Uint32(uint32(entity.AccountStatusDeleted))),
+		repo.WithAccountUpdateTimeLte(m.Before),
+	)
+}
+
+func (m *PurgeTrashRequest) ToCategoryFilter() *repo.CategoryFilter {
+	return repo.NewCategoryFilter(
+		m.GetUserID(),
+		repo.WithCategoryStatus(goutil.Uint32(uint32(entity.CategoryStatusDeleted))),
+		repo.WithCategoryUpdateTimeLte(m.Before),
+	)
+}
+
+// ToPurgeAccountFilter narrows the deleted accounts to those that can be purged.
+func (m *PurgeTrashRequest) ToPurgeAccountFilter(accountIDs []string) *repo.AccountFilter {
+	return repo.NewAccountFilter(
+		m.GetUserID(),
+		repo.WithAccountIDs(accountIDs),
+		repo.WithAccountStatus(goutil.Uint32(uint32(entity.AccountStatusDeleted))),
+		repo.WithAccountUpdateTimeLte(m.Before),
+	)
+}
+
+// ToPurgeCategoryFilter narrows the deleted categories to those that can be purged.
+func (m *PurgeTrashRequest) ToPurgeCategoryFilter(categoryIDs []string) *repo.CategoryFilter {
+	return repo.NewCategoryFilter(
+		m.GetUserID(),
+		repo.WithCategoryIDs(categoryIDs),
+		repo.WithCategoryStatus(goutil.Uint32(uint32(entity.CategoryStatusDeleted))),
+		repo.WithCategoryUpdateTimeLte(m.Before),
+	)
+}
+
+// ToAccountTransactionQuery gets the remaining transactions still referencing any of the accounts,
+// including deleted ones not yet purged, as they can still be restored.
+func (m *PurgeTrashRequest) ToAccountTransactionQuery(accountIDs []string) *repo.TransactionQuery {
+	return &repo.TransactionQuery{
+		Filters: []*repo.TransactionFilter{
+			repo.NewTransactionFilter(
+				m.GetUserID(),
+				repo.WithTransactionAccountIDs(accountIDs),
+				repo.WithTransactionStatus(nil),
+			),
+			repo.NewTransactionFilter(
+				m.GetUserID(),
+				repo.WithTransactionFromAccountIDs(accountIDs),
+				repo.WithTransactionStatus(nil),
+			),
+			repo.NewTransactionFilter(
+				m.GetUserID(),
+				repo.WithTransactionToAccountIDs(accountIDs),
+				repo.WithTransactionStatus(nil),
+			),
+		},
+		Op: filter.Or,
+	}
+}
+
+// ToCategoryTransactionQuery gets the remaining transactions still referencing any of the categories,
+// including deleted ones not yet purged, as they can still be restored.
+func (m *PurgeTrashRequest) ToCategoryTransactionQuery(categoryIDs []string) *repo.TransactionQuery {
+	return &repo.TransactionQuery{
+		Filters: []*repo.TransactionFilter{
+			repo.NewTransactionFilter(
+				m.GetUserID(),
+				repo.WithTransactionCategoryIDs(categoryIDs),
+				repo.WithTransactionStatus(nil),
+			),
+			// split transactions
+			repo.NewTransactionFilter(
+				m.GetUserID(),
+				repo.WithTransactionSplitCategoryIDs(categoryIDs),
+				repo.WithTransactionStatus(nil),
+			),
+		},
+		Op: filter.Or,
+	}
+}
+
+func (m *PurgeTrashRequest) ToHoldingFilter(accountIDs []string) *repo.HoldingFilter {
+	return repo.NewHoldingFilter(
+		repo.WithHoldingUserID(m.UserID),
+		repo.WithHoldingAccountIDs(accountIDs),
+		repo.WithHoldingStatus(nil), // any status is ok
+	)
+}
+
+func (m *PurgeTrashRequest) ToLotFilter(holdingIDs []string) *repo.LotFilter {
+	return repo.NewLotFilter(
+		m.GetUserID(),
+		repo.WithLotHoldingIDs(holdingIDs),
+		repo.WithLotStatus(nil), // any status is ok
+	)
+}
+
+type PurgeTrashResponse struct {
+	Transactions *uint32
+	Accounts     *uint32
+	Categories   *uint32
+	Attachments  *uint32
+}
+
+func (m *PurgeTrashResponse) GetTransactions() uint32 {
+	if m != nil && m.Transactions != nil {
+		return *m.Transactions
+	}
+	return 0
+}
+
+func (m *PurgeTrashResponse) GetAccounts() uint32 {
+	if m != nil && m.Accounts != nil {
+		return *m.Accounts
+	}
+	return 0
+}
+
+func (m *PurgeTrashResponse) GetCategories() uint32 {
+	if m != nil && m.Categories != nil {
+		return *m.Categories
+	}
+	return 0
+}
+
+func (m *PurgeTrashResponse) GetAttachments() uint32 {
+	if m != nil && m.Attachments != nil {
+		return *m.Attachments
+	}
+	return 0
+}
@@ -0,0 +1,236 @@
+package trash
+
+import (
+	"context"
+
+	"github.com/jseow5177/pockteer-be/dep/blob"
+	"github.com/jseow5177/pockteer-be/dep/repo"
+	"github.com/jseow5177/pockteer-be/pkg/goutil"
+	"github.com/rs/zerolog/log"
+)
+
+type trashUseCase struct {
+	txMgr           repo.TxMgr
+	transactionRepo repo.TransactionRepo
+	accountRepo     repo.AccountRepo
+	categoryRepo    repo.CategoryRepo
+	holdingRepo     repo.HoldingRepo
+	lotRepo         repo.LotRepo
+	attachmentRepo  repo.AttachmentRepo
+	blobStore       blob.Store
+}
+
+func NewTrashUseCase(
+	txMgr repo.TxMgr,
+	transactionRepo repo.TransactionRepo,
+	accountRepo repo.AccountRepo,
+	categoryRepo repo.CategoryRepo,
+	holdingRepo repo.HoldingRepo,
+	lotRepo repo.LotRepo,
+	attachmentRepo repo.AttachmentRepo,
+	blobStore blob.Store,
+) UseCase {
+	return &trashUseCase{
+		txMgr,
+		transactionRepo,
+		accountRepo,
+		categoryRepo,
+		holdingRepo,
+		lotRepo,
+		attachmentRepo,
+		blobStore,
+	}
+}
+
+// PurgeTrash hard deletes transactions, accounts and categories deleted at or before the cutoff.
+// Holdings and lots of purged accounts, and attachments of purged transactions, are deleted with them.
+// Accounts and categories still referenced by remaining transactions are kept.
+func (uc *trashUseCase) PurgeTrash(ctx context.Context, req *PurgeTrashRequest) (*PurgeTrashResponse, error) {
+	var (
+		res         = new(PurgeTrashResponse)
+		storageKeys = make([]string, 0)
+	)
+
+	if err := uc.txMgr.WithTx(ctx, func(txCtx context.Context) error {
+		ts, err := uc.transactionRepo.GetMany(txCtx, req.ToTransactionQuery())
+		if err != nil {
+			log.Ctx(txCtx).Error().Msgf("fail to get deleted transactions from repo, err: %v", err)
+			return err
+		}
+
+		if len(ts) > 0 {
+			transactionIDs := make([]string, 0)
+			for _, t := range ts {
+				transactionIDs = append(transactionIDs, t.GetTransactionID())
+			}
+
+			as, err := uc.attachmentRepo.GetMany(txCtx, req.ToAttachmentFilter(transactionIDs))
+			if err != nil {
+				log.Ctx(txCtx).Error().Msgf("fail to get attachments from repo, err: %v", err)
+				return err
+			}
+
+			if len(as) > 0 {
+				if err := uc.attachmentRepo.DeleteMany(txCtx, req.ToAttachmentFilter(transactionIDs)); err != nil {
+					log.Ctx(txCtx).Error().Msgf("fail to purge attachments, err: %v", err)
+					return err
+				}
+			}
+			for _, a := range as {
+				storageKeys = append(storageKeys, a.GetStorageKey())
+			}
+			res.Attachments = goutil.Uint32(uint32(len(as)))
+
+			if err := uc.transactionRepo.DeleteMany(txCtx, req.ToTransactionFilter()); err != nil {
+				log.Ctx(txCtx).Error().Msgf("fail to purge transactions, err: %v", err)
+				return err
+			}
+		}
+		res.Transactions = goutil.Uint32(uint32(len(ts)))
+
+		cs, err := uc.categoryRepo.GetMany(txCtx, req.ToCategoryFilter())
+		if err != nil {
+			log.Ctx(txCtx).Error().Msgf("fail to get deleted categories from repo, err: %v", err)
+			return err
+		}
+
+		categoryIDs := make([]string, 0)
+		for _, c := range cs {
+			categoryIDs = append(categoryIDs, c.GetCategoryID())
+		}
+
+		categoryIDs, err = uc.getUnreferencedCategoryIDs(txCtx, req, categoryIDs)
+		if err != nil {
+			return err
+		}
+
+		if len(categoryIDs) > 0 {
+			if err := uc.categoryRepo.DeleteMany(txCtx, req.ToPurgeCategoryFilter(categoryIDs)); err != nil {
+				log.Ctx(txCtx).Error().Msgf("fail to purge categories, err: %v", err)
+				return err
+			}
+		}
+		res.Categories = goutil.Uint32(uint32(len(categoryIDs)))
+
+		acs, err := uc.accountRepo.GetMany(txCtx, req.ToAccountFilter())
+		if err != nil {
+			log.Ctx(txCtx).Error().Msgf("fail to get deleted accounts from repo, err: %v", err)
+			return err
+		}
+
+		accountIDs := make([]string, 0)
+		for _, ac := range acs {
+			accountIDs = append(accountIDs, ac.GetAccountID())
+		}
+
+		accountIDs, err = uc.getUnreferencedAccountIDs(txCtx, req, accountIDs)
+		if err != nil {
+			return err
+		}
+		res.Accounts = goutil.Uint32(uint32(len(accountIDs)))
+
+		if len(accountIDs) == 0 {
+			return nil
+		}
+
+		hs, err := uc.holdingRepo.GetMany(txCtx, req.ToHoldingFilter(accountIDs))
+		if err != nil {
+			log.Ctx(txCtx).Error().Msgf("fail to get holdings from repo, err: %v", err)
+			return err
+		}
+
+		if len(hs) > 0 {
+			holdingIDs := make([]string, 0)
+			for _, h := range hs {
+				holdingIDs = append(holdingIDs, h.GetHoldingID())
+			}
+
+			if err := uc.lotRepo.DeleteMany(txCtx, req.ToLotFilter(holdingIDs)); err != nil {
+				log.Ctx(txCtx).Error().Msgf("fail to purge lots, err: %v", err)
+				return err
+			}
+
+			if err := uc.holdingRepo.DeleteMany(txCtx, req.ToHoldingFilter(accountIDs)); err != nil {
+				log.Ctx(txCtx).Error().Msgf("fail to purge holdings, err: %v", err)
+				return err
+			}
+		}
+
+		if err := uc.accountRepo.DeleteMany(txCtx, req.ToPurgeAccountFilter(accountIDs)); err != nil {
+			log.Ctx(txCtx).Error().Msgf("fail to purge accounts, err: %v", err)
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// blobs are deleted only after the records are gone, a failure leaves an unreachable blob behind
+	for _, key := range storageKeys {
+		if err := uc.blobStore.Delete(ctx, key); err != nil {
+			log.Ctx(ctx).Error().Msgf("fail to delete attachment from blob store, key: %v, err: %v", key, err)
+		}
+	}
+
+	return res, nil
+}
+
+// getUnreferencedAccountIDs filters out the accounts still referenced by remaining transactions.
+func (uc *trashUseCase) getUnreferencedAccountIDs(ctx context.Context, req *PurgeTrashRequest, accountIDs []string) ([]string, error) {
+	if len(accountIDs) == 0 {
+		return accountIDs, nil
+	}
+
+	ts, err := uc.transactionRepo.GetMany(ctx, req.ToAccountTransactionQuery(accountIDs))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get account transactions from repo, err: %v", err)
+		return nil, err
+	}
+
+	referenced := make(map[string]bool)
+	for _, t := range ts {
+		referenced[t.GetAccountID()] = true
+		referenced[t.GetFromAccountID()] = true
+		referenced[t.GetToAccountID()] = true
+	}
+
+	ids := make([]string, 0)
+	for _, accountID := range accountIDs {
+		if !referenced[accountID] {
+			ids = append(ids, accountID)
+		}
+	}
+
+	return ids, nil
+}
+
+// getUnreferencedCategoryIDs filters out the categories still referenced by remaining transactions or their splits.
+func (uc *trashUseCase) getUnreferencedCategoryIDs(ctx context.Context, req *PurgeTrashRequest, categoryIDs []string) ([]string, error) {
+	if len(categoryIDs) == 0 {
+		return categoryIDs, nil
+	}
+
+	ts, err := uc.transactionRepo.GetMany(ctx, req.ToCategoryTransactionQuery(categoryIDs))
+	if err != nil {
+		log.Ctx(ctx).Error().Msgf("fail to get category transactions from repo, err: %v", err)
+		return nil, err
+	}
+
+	referenced := make(map[string]bool)
+	for _, t := range ts {
+		referenced[t.GetCategoryID()] = true
+		for _, split := range t.Splits {
+			referenced[split.GetCategoryID()] = true
+		}
+	}
+
+	ids := make([]string, 0)
+	for _, categoryID := range categoryIDs {
+		if !referenced[categoryID] {
+			ids = append(ids, categoryID)
+		}
+	}
+
+	return ids, nil
+}
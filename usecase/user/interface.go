@@ -440,11 +440,12 @@ func (m *InitUserRequest) GetCategories() []*category.CreateCategoryRequest {
 
 func (m *InitUserRequest) ToAccountEntities() ([]*entity.Account, error) {
 	acs := make([]*entity.Account, 0)
-	for _, r := range m.Accounts {
+	for i, r := range m.Accounts {
 		ac, err := r.ToAccountEntity()
 		if err != nil {
 			return nil, err
 		}
+		ac.SetPosition(goutil.Uint32(uint32(i)))
 		acs = append(acs, ac)
 	}
 
@@ -453,11 +454,12 @@ func (m *InitUserRequest) ToAccountEntities() ([]*entity.Account, error) {
 
 func (m *InitUserRequest) ToCategoryEntities() ([]*entity.Category, error) {
 	cs := make([]*entity.Category, 0)
-	for _, r := range m.Categories {
+	for i, r := range m.Categories {
 		c, err := r.ToCategoryEntity()
 		if err != nil {
 			return nil, err
 		}
+		c.SetPosition(goutil.Uint32(uint32(i)))
 		cs = append(cs, c)
 	}
 	return cs, nil
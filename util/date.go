@@ -1,6 +1,7 @@
 package util
 
 import (
+	"fmt"
 	"strconv"
 	"time"
 )
@@ -104,6 +105,172 @@ func getMonthRange(date, timezone string) (start, end time.Time, err error) {
 	return
 }
 
+func GetQuarterRangeAsDate(date, timezone string) (start, end uint64, err error) {
+	fd, ld, err := getQuarterRange(date, timezone)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	start = FormatDateAsInt(fd)
+	end = FormatDateAsInt(ld)
+
+	return
+}
+
+func GetQuarterRangeAsUnix(date, timezone string) (start, end uint64, err error) {
+	fd, ld, err := getQuarterRange(date, timezone)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	start = uint64(fd.UnixMilli())
+	end = uint64(ld.UnixMilli())
+
+	return
+}
+
+func getQuarterRange(date, timezone string) (start, end time.Time, err error) {
+	zeroTime := time.Time{}
+
+	t, err := ParseDate(date)
+	if err != nil {
+		return zeroTime, zeroTime, err
+	}
+
+	l := t.Location()
+	if timezone != "" {
+		l, err = time.LoadLocation(timezone)
+		if err != nil {
+			return zeroTime, zeroTime, err
+		}
+	}
+
+	y, m, _ := t.Date()
+	qm := time.Month((int(m)-1)/3*3 + 1)
+
+	start = time.Date(y, qm, 1, 0, 0, 0, 0, l)
+	end = start.AddDate(0, 3, -1)
+
+	return
+}
+
+func GetWeekRangeAsDate(date, timezone string, weekStart time.Weekday) (start, end uint64, err error) {
+	fd, ld, err := getWeekRange(date, timezone, weekStart)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	start = FormatDateAsInt(fd)
+	end = FormatDateAsInt(ld)
+
+	return
+}
+
+func GetWeekRangeAsUnix(date, timezone string, weekStart time.Weekday) (start, end uint64, err error) {
+	fd, ld, err := getWeekRange(date, timezone, weekStart)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	start = uint64(fd.UnixMilli())
+	end = uint64(ld.UnixMilli())
+
+	return
+}
+
+func getWeekRange(date, timezone string, weekStart time.Weekday) (start, end time.Time, err error) {
+	zeroTime := time.Time{}
+
+	t, err := ParseDate(date)
+	if err != nil {
+		return zeroTime, zeroTime, err
+	}
+
+	l := t.Location()
+	if timezone != "" {
+		l, err = time.LoadLocation(timezone)
+		if err != nil {
+			return zeroTime, zeroTime, err
+		}
+	}
+
+	y, m, d := t.Date()
+	offset := (int(t.Weekday()) - int(weekStart) + 7) % 7
+
+	start = time.Date(y, m, d-offset, 0, 0, 0, 0, l)
+	end = start.AddDate(0, 0, 6)
+
+	return
+}
+
+// GetPeriodRangeAsDate returns the range of the N-day period containing date,
+// where periods repeat every N days from anchor.
+func GetPeriodRangeAsDate(date, timezone, anchor string, days int) (start, end uint64, err error) {
+	fd, ld, err := getPeriodRange(date, timezone, anchor, days)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	start = FormatDateAsInt(fd)
+	end = FormatDateAsInt(ld)
+
+	return
+}
+
+func GetPeriodRangeAsUnix(date, timezone, anchor string, days int) (start, end uint64, err error) {
+	fd, ld, err := getPeriodRange(date, timezone, anchor, days)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	start = uint64(fd.UnixMilli())
+	end = uint64(ld.UnixMilli())
+
+	return
+}
+
+func getPeriodRange(date, timezone, anchor string, days int) (start, end time.Time, err error) {
+	zeroTime := time.Time{}
+
+	if days <= 0 {
+		return zeroTime, zeroTime, fmt.Errorf("invalid period of %d days", days)
+	}
+
+	t, err := ParseDate(date)
+	if err != nil {
+		return zeroTime, zeroTime, err
+	}
+
+	a, err := ParseDate(anchor)
+	if err != nil {
+		return zeroTime, zeroTime, err
+	}
+
+	l := t.Location()
+	if timezone != "" {
+		l, err = time.LoadLocation(timezone)
+		if err != nil {
+			return zeroTime, zeroTime, err
+		}
+	}
+
+	// dates are parsed in UTC, so every day is exactly 24 hours
+	diff := int(t.Sub(a).Hours() / 24)
+
+	// periods before the anchor count backwards
+	n := diff / days
+	if diff%days < 0 {
+		n--
+	}
+
+	y, m, d := a.AddDate(0, 0, n*days).Date()
+
+	start = time.Date(y, m, d, 0, 0, 0, 0, l)
+	end = start.AddDate(0, 0, days-1)
+
+	return
+}
+
 func FormatDate(t time.Time) string {
 	return t.Format(layout)
 }
@@ -130,3 +297,27 @@ func ParseDateToInt(s string) (uint64, error) {
 
 	return di, nil
 }
+
+// AddDaysToDate adds days to a date (YYYYMMDD).
+func AddDaysToDate(date uint64, days int) (uint64, error) {
+	t, err := ParseDate(strconv.FormatUint(date, 10))
+	if err != nil {
+		return 0, err
+	}
+
+	return FormatDateAsInt(t.AddDate(0, 0, days)), nil
+}
+
+// AddMonths adds months to t, clamping the day to the last day
+// of the resulting month (e.g. Jan 31 + 1 month = Feb 28).
+func AddMonths(t time.Time, months int) time.Time {
+	y, m, d := t.Date()
+
+	first := time.Date(y, m+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := first.AddDate(0, 1, -1).Day()
+	if d > lastDay {
+		d = lastDay
+	}
+
+	return first.AddDate(0, 0, d-1)
+}
@@ -4,6 +4,7 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/jseow5177/pockteer-be/config"
 )
@@ -42,3 +43,62 @@ func GetEmailPrefix(email string) string {
 	}
 	return ""
 }
+
+// GetNoteSimilarity returns the Dice coefficient of the words in two notes, from 0 to 1.
+// Words are compared case-insensitively, ignoring punctuation.
+func GetNoteSimilarity(a, b string) float64 {
+	wa, wb := toWordSet(a), toWordSet(b)
+	if len(wa) == 0 || len(wb) == 0 {
+		return 0
+	}
+
+	var common int
+	for w := range wa {
+		if wb[w] {
+			common++
+		}
+	}
+
+	return 2 * float64(common) / float64(len(wa)+len(wb))
+}
+
+func toWordSet(s string) map[string]bool {
+	set := make(map[string]bool)
+	for _, w := range GetWords(s) {
+		set[w] = true
+	}
+	return set
+}
+
+// GetWords splits s into lower case words, ignoring punctuation.
+func GetWords(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// GetWordPrefixes returns the distinct prefixes of the words in s that are at least minLen long.
+// Words shorter than minLen are returned whole. The result is never nil.
+func GetWordPrefixes(s string, minLen int) []string {
+	var (
+		prefixes = make([]string, 0)
+		seen     = make(map[string]bool)
+	)
+	for _, w := range GetWords(s) {
+		rs := []rune(w)
+
+		start := minLen
+		if len(rs) < minLen {
+			start = len(rs)
+		}
+
+		for i := start; i <= len(rs); i++ {
+			p := string(rs[:i])
+			if !seen[p] {
+				seen[p] = true
+				prefixes = append(prefixes, p)
+			}
+		}
+	}
+	return prefixes
+}